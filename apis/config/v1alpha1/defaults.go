@@ -44,6 +44,15 @@ func SetDefaults_LandscaperConfiguration(obj *LandscaperConfiguration) {
 		obj.DeployItemTimeouts.Abort = &v1alpha1.Duration{Duration: 5 * time.Minute}
 	}
 
+	if obj.TemplateLimits == nil {
+		obj.TemplateLimits = &TemplateLimits{}
+	}
+	SetDefaults_TemplateLimits(obj.TemplateLimits)
+
+	if obj.ComponentIndex != nil {
+		SetDefaults_ComponentIndexConfiguration(obj.ComponentIndex)
+	}
+
 	SetDefaults_BlueprintStore(&obj.BlueprintStore)
 	SetDefaults_CrdManagementConfiguration(&obj.CrdManagement)
 
@@ -54,6 +63,29 @@ func SetDefaults_LandscaperConfiguration(obj *LandscaperConfiguration) {
 	}
 }
 
+// SetDefaults_TemplateLimits sets the defaults for the template limits.
+func SetDefaults_TemplateLimits(obj *TemplateLimits) {
+	if obj.Timeout == nil {
+		obj.Timeout = &v1alpha1.Duration{Duration: 2 * time.Minute}
+	}
+	if len(obj.MaxOutputSize) == 0 {
+		obj.MaxOutputSize = "10Mi"
+	}
+	if len(obj.MaxBlobSize) == 0 {
+		obj.MaxBlobSize = "10Mi"
+	}
+	if obj.MaxIncludeDepth == nil {
+		obj.MaxIncludeDepth = ptr.To[int32](100)
+	}
+}
+
+// SetDefaults_ComponentIndexConfiguration sets the defaults for the component index configuration.
+func SetDefaults_ComponentIndexConfiguration(obj *ComponentIndexConfiguration) {
+	if obj.RefreshInterval == nil {
+		obj.RefreshInterval = &v1alpha1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
 // SetDefaults_CrdManagementConfiguration sets the defaults for the crd management configuration.
 func SetDefaults_CrdManagementConfiguration(obj *CrdManagementConfiguration) {
 	if obj.DeployCustomResourceDefinitions == nil {
@@ -40,6 +40,17 @@ var _ = Describe("Defaults", func() {
 
 	})
 
+	It("should default the template limits", func() {
+		cfg := &v1alpha1.LandscaperConfiguration{}
+		v1alpha1.SetDefaults_LandscaperConfiguration(cfg)
+		Expect(cfg.TemplateLimits).ToNot(BeNil())
+		Expect(cfg.TemplateLimits.Timeout).ToNot(BeNil())
+		Expect(cfg.TemplateLimits.Timeout.Duration).To(Equal(2 * time.Minute))
+		Expect(cfg.TemplateLimits.MaxOutputSize).To(Equal("10Mi"))
+		Expect(cfg.TemplateLimits.MaxBlobSize).To(Equal("10Mi"))
+		Expect(cfg.TemplateLimits.MaxIncludeDepth).To(gstruct.PointTo(Equal(int32(100))))
+	})
+
 	Context("CommonControllerConfig", func() {
 
 		checkCommonConfig := func(cfg *v1alpha1.CommonControllerConfig) {
@@ -35,6 +35,19 @@ type LandscaperConfiguration struct {
 	// DeployItemTimeouts contains configuration for multiple deploy item timeouts
 	// +optional
 	DeployItemTimeouts *DeployItemTimeouts `json:"deployItemTimeouts,omitempty"`
+	// TemplateLimits contains the resource limits of the template executions of blueprints.
+	// +optional
+	TemplateLimits *TemplateLimits `json:"templateLimits,omitempty"`
+	// TargetLookup configures the template functions that read the state of target clusters.
+	// +optional
+	TargetLookup *TargetLookupConfiguration `json:"targetLookup,omitempty"`
+	// DeployItemValidation configures the validation of the provider configurations of rendered deploy items.
+	// +optional
+	DeployItemValidation *DeployItemValidationConfiguration `json:"deployItemValidation,omitempty"`
+	// ComponentIndex configures the index of the components, resources and deploy items used by installations.
+	// The index is served as http/json api on the configured port. The index is disabled if not set.
+	// +optional
+	ComponentIndex *ComponentIndexConfiguration `json:"componentIndex,omitempty"`
 	// LsDeployments contains the names of the landscaper deployments
 	// +optional
 	LsDeployments *LsDeployments `json:"lsDeployments,omitempty"`
@@ -164,12 +177,121 @@ type DeployItemTimeouts struct {
 	Abort *lsv1alpha1.Duration `json:"abort,omitempty"`
 }
 
+// TemplateLimits contains the resource limits of the template executions of blueprints.
+type TemplateLimits struct {
+	// Timeout defines how long a single template execution may take.
+	// Allowed values are 'none' (to disable the timeout) and anything that is understood by golang's time.ParseDuration method.
+	// Defaults to two minutes if not specified.
+	// +optional
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
+	// MaxOutputSize is the maximum size of the rendered output of a single template execution.
+	// See the kubernetes quantity docs for detailed description of the format.
+	// The value 0 disables the limit. Defaults to 10Mi if not specified.
+	// +optional
+	MaxOutputSize string `json:"maxOutputSize,omitempty"`
+	// MaxBlobSize is the maximum size of a file or resource content that is fetched by a template function
+	// like readFile or getResourceContent.
+	// The value 0 disables the limit. Defaults to 10Mi if not specified.
+	// +optional
+	MaxBlobSize string `json:"maxBlobSize,omitempty"`
+	// MaxIncludeDepth is the maximum depth of nested includes of go templates.
+	// Defaults to 100 if not specified.
+	// +optional
+	MaxIncludeDepth *int32 `json:"maxIncludeDepth,omitempty"`
+}
+
+// TargetLookupConfiguration configures the template functions that read the state of target clusters.
+type TargetLookupConfiguration struct {
+	// AllowedResources is the list of resources that blueprints can read from target clusters with the lookup template function.
+	// Resources that are not in the list cannot be read.
+	// +optional
+	AllowedResources []TargetLookupResource `json:"allowedResources,omitempty"`
+}
+
+// TargetLookupResource defines a kind of resources that can be read from target clusters.
+type TargetLookupResource struct {
+	// APIVersion is the api version of the resource, e.g. "v1" or "apps/v1".
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the resource, e.g. "ConfigMap".
+	// The value "*" allows all kinds of the api version.
+	Kind string `json:"kind"`
+	// Namespaces restricts the namespaces from which resources can be read.
+	// Resources can be read from all namespaces if not set, and cluster-scoped resources can only be read if not set.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// DeployItemValidationConfiguration configures the validation of the provider configurations of rendered deploy items.
+type DeployItemValidationConfiguration struct {
+	// RejectUnknownFields configures whether provider configurations with fields that are not defined
+	// in the schema of their api version are rejected.
+	// Defaults to false.
+	// +optional
+	RejectUnknownFields bool `json:"rejectUnknownFields,omitempty"`
+	// DeployerRegistrations contains the provider configuration schemas of deployers.
+	// The schemas of the landscaper deployers (helm, manifest, container and mock) are registered by default
+	// and are overwritten by registrations for the same deploy item type and api version.
+	// +optional
+	DeployerRegistrations []DeployerRegistration `json:"deployerRegistrations,omitempty"`
+}
+
+// DeployerRegistration registers the provider configuration schemas of a deployer.
+type DeployerRegistration struct {
+	// DeployItemType is the type of the deploy items that are handled by the deployer.
+	DeployItemType string `json:"deployItemType"`
+	// Schemas contains the json schemas of the provider configuration api versions of the deployer.
+	Schemas []ProviderConfigurationSchema `json:"schemas"`
+}
+
+// ProviderConfigurationSchema is the json schema of a provider configuration api version.
+type ProviderConfigurationSchema struct {
+	// APIVersion is the api version of the provider configuration, e.g. "example.com/v1alpha1".
+	APIVersion string `json:"apiVersion"`
+	// Schema is the json schema of the provider configuration.
+	Schema lsv1alpha1.AnyJSON `json:"schema"`
+}
+
+// ComponentIndexConfiguration configures the index of the components, resources and deploy items used by installations.
+// The index is built and served by the replica of the landscaper controller that holds the leader lease.
+// Clients are authenticated with their kubernetes bearer token and must be allowed to list, or for a single
+// installation get, the installations in the requested namespace.
+type ComponentIndexConfiguration struct {
+	// Port is the port on which the http/json api of the index is served.
+	Port int32 `json:"port"`
+	// RefreshInterval is the interval in which the index is rebuilt.
+	// Defaults to ten minutes if not specified.
+	// +optional
+	RefreshInterval *lsv1alpha1.Duration `json:"refreshInterval,omitempty"`
+	// BindAddress is the address on which the api is served.
+	// Defaults to all interfaces if not specified.
+	// +optional
+	BindAddress string `json:"bindAddress,omitempty"`
+	// TLS configures the certificate with which the api is served via https.
+	// The api is served via http if not specified.
+	// +optional
+	TLS *ComponentIndexTLSConfiguration `json:"tls,omitempty"`
+}
+
+// ComponentIndexTLSConfiguration contains the certificate of the component index api.
+type ComponentIndexTLSConfiguration struct {
+	// CertFile is the path to the PEM encoded certificate.
+	CertFile string `json:"certFile"`
+	// KeyFile is the path to the PEM encoded private key of the certificate.
+	KeyFile string `json:"keyFile"`
+}
+
 // RegistryConfiguration contains the configuration for the used definition registry
 type RegistryConfiguration struct {
 	// Local defines a local registry to use for definitions
 	// +optional
 	Local *LocalRegistryConfiguration `json:"local,omitempty"`
 
+	// Filesystem configures the directory below which repository contexts of type "filesystem" can reference
+	// component archives, e.g. directories that are mounted from config maps.
+	// Repository contexts of type "filesystem" are rejected if not set.
+	// +optional
+	Filesystem *FilesystemRegistryConfiguration `json:"filesystem,omitempty"`
+
 	// OCI defines a oci registry to use for definitions
 	// +optional
 	OCI *OCIConfiguration `json:"oci,omitempty"`
@@ -182,6 +304,14 @@ type LocalRegistryConfiguration struct {
 	RootPath string `json:"rootPath"`
 }
 
+// FilesystemRegistryConfiguration contains the configuration for repository contexts of type "filesystem".
+// The type is meant for development and test setups, because all archives below the root path can be read from
+// installations in any namespace.
+type FilesystemRegistryConfiguration struct {
+	// RootPath is the directory below which the paths of repository contexts of type "filesystem" are resolved.
+	RootPath string `json:"rootPath"`
+}
+
 // OCIConfiguration holds configuration for the oci registry
 type OCIConfiguration struct {
 	// ConfigFiles path to additional docker configuration files
@@ -196,6 +326,33 @@ type OCIConfiguration struct {
 	AllowPlainHttp bool `json:"allowPlainHttp"`
 	// InsecureSkipVerify skips the certificate validation of the oci registry
 	InsecureSkipVerify bool `json:"insecureSkipVerify"`
+
+	// TokenSources are token sources that obtain registry credentials with the identity of the landscaper,
+	// e.g. its workload identity or a projected service account token.
+	// Registry credentials of contexts reference them by name.
+	// +optional
+	TokenSources []OperatorTokenSource `json:"tokenSources,omitempty"`
+}
+
+// OperatorTokenSource is a token source of registry credentials that is configured by the operator of the landscaper.
+// In contrast to the token sources of contexts, it may use the identity of the landscaper, so it is only available
+// to contexts in the allowed namespaces and for the allowed registries.
+type OperatorTokenSource struct {
+	// Name is the name by which the registry credentials of contexts reference the token source.
+	Name string `json:"name"`
+	// Type is the type of the token source, e.g. "oauth2", "gcp" or "aws".
+	Type string `json:"type"`
+	// Config contains the type specific configuration of the token source.
+	// +optional
+	Config *lsv1alpha1.AnyJSON `json:"config,omitempty"`
+	// Namespaces are the namespaces of the contexts that may use the token source.
+	// The entry "*" allows all namespaces.
+	Namespaces []string `json:"namespaces"`
+	// Registries are the registries for which contexts may use the token source. The registry of every registry
+	// credential that references the token source must be covered by one of them. The entries have the format of the
+	// registries of registry credentials, i.e. a host with an optional port that may contain "*" as a wildcard,
+	// followed by an optional repository prefix.
+	Registries []string `json:"registries"`
 }
 
 // OCICacheConfiguration contains the configuration for the oci cache
@@ -208,6 +365,97 @@ type OCICacheConfiguration struct {
 	// Defaults to /tmp/ocicache
 	// +optional
 	Path string `json:"path"`
+
+	// Shared configures a cache that is shared by all replicas.
+	// Blobs that are not found in the local cache are read from the shared cache before they are fetched from their origin,
+	// and blobs that are fetched from their origin are also written to the shared cache.
+	// +optional
+	Shared *SharedOCICacheConfiguration `json:"shared,omitempty"`
+
+	// HelmChartRepoIndex configures the cache for the indexes of helm chart repositories.
+	// +optional
+	HelmChartRepoIndex *HelmChartRepoIndexCacheConfiguration `json:"helmChartRepoIndex,omitempty"`
+}
+
+// HelmChartRepoIndexCacheConfiguration contains the configuration for the cache of helm chart repository indexes.
+// The indexes are kept in memory and, if a path is configured for the oci cache, stored in the directory
+// "helmchartrepoindex" below that path, so that they are shared by all processes using the same path.
+// Indexes that are fetched with different credentials are cached separately.
+type HelmChartRepoIndexCacheConfiguration struct {
+	// TTL is the duration for which a cached index is used without contacting the helm chart repository.
+	// After the ttl has expired, the index is revalidated with a conditional request.
+	// Defaults to 0, i.e. the index is revalidated every time it is used.
+	// +optional
+	TTL *lsv1alpha1.Duration `json:"ttl,omitempty"`
+	// MaxSize is the maximal total size of the indexes that are kept in memory.
+	// If it is exceeded, the least recently used indexes are evicted.
+	// See the kubernetes quantity docs for detailed description of the format.
+	// Defaults to 256Mi if not specified. The value 0 disables the limit.
+	// +optional
+	MaxSize string `json:"maxSize,omitempty"`
+}
+
+// SharedOCICacheConfiguration contains the configuration for a shared oci cache.
+// Exactly one backend has to be configured.
+type SharedOCICacheConfiguration struct {
+	// OCI configures an oci repository as shared cache.
+	// +optional
+	OCI *SharedOCIRegistryCacheConfiguration `json:"oci,omitempty"`
+
+	// S3 configures an S3 compatible bucket as shared cache.
+	// +optional
+	S3 *SharedS3CacheConfiguration `json:"s3,omitempty"`
+}
+
+// SharedOCIRegistryCacheConfiguration configures an oci repository as shared cache.
+// Every blob is stored as the layer of an artifact that is tagged with the digest of the blob, e.g. "sha256-<hex>".
+// The credentials are read from the docker config files of the oci configuration.
+type SharedOCIRegistryCacheConfiguration struct {
+	// Repository is the oci repository the blobs are stored in, e.g. "registry.example.com/landscaper/cache".
+	Repository string `json:"repository"`
+
+	// AllowPlainHttp allows the fallback to http if https is not supported by the registry.
+	// +optional
+	AllowPlainHttp bool `json:"allowPlainHttp,omitempty"`
+
+	// InsecureSkipVerify skips the certificate validation of the registry.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// SharedS3CacheConfiguration configures an S3 compatible bucket as shared cache.
+type SharedS3CacheConfiguration struct {
+	// Endpoint is the url of the S3 compatible service, e.g. "http://minio.minio:9000".
+	// Defaults to the AWS endpoint of the region.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Region is the region of the bucket.
+	// Defaults to "us-east-1".
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Bucket is the name of the bucket the blobs are stored in.
+	Bucket string `json:"bucket"`
+
+	// Prefix is prepended to the keys of all blobs.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// UsePathStyle addresses the bucket in the path instead of the host name of the requests.
+	// This is required by most S3 compatible services like MinIO.
+	// +optional
+	UsePathStyle bool `json:"usePathStyle,omitempty"`
+
+	// CredentialsFile is the path to a file in the AWS shared credentials format.
+	// If it is not set, the default credential chain of the AWS SDK is used,
+	// e.g. the environment variables AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+	// +optional
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+
+	// InsecureSkipVerify skips the certificate validation of the endpoint.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 }
 
 // MetricsConfiguration allows to configure how metrics are exposed
@@ -58,6 +58,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ComponentIndexConfiguration)(nil), (*config.ComponentIndexConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentIndexConfiguration_To_config_ComponentIndexConfiguration(a.(*ComponentIndexConfiguration), b.(*config.ComponentIndexConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComponentIndexConfiguration)(nil), (*ComponentIndexConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComponentIndexConfiguration_To_v1alpha1_ComponentIndexConfiguration(a.(*config.ComponentIndexConfiguration), b.(*ComponentIndexConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComponentIndexTLSConfiguration)(nil), (*config.ComponentIndexTLSConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentIndexTLSConfiguration_To_config_ComponentIndexTLSConfiguration(a.(*ComponentIndexTLSConfiguration), b.(*config.ComponentIndexTLSConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComponentIndexTLSConfiguration)(nil), (*ComponentIndexTLSConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComponentIndexTLSConfiguration_To_v1alpha1_ComponentIndexTLSConfiguration(a.(*config.ComponentIndexTLSConfiguration), b.(*ComponentIndexTLSConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ContextControllerConfig)(nil), (*config.ContextControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ContextControllerConfig_To_config_ContextControllerConfig(a.(*ContextControllerConfig), b.(*config.ContextControllerConfig), scope)
 	}); err != nil {
@@ -118,6 +138,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployItemValidationConfiguration)(nil), (*config.DeployItemValidationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployItemValidationConfiguration_To_config_DeployItemValidationConfiguration(a.(*DeployItemValidationConfiguration), b.(*config.DeployItemValidationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DeployItemValidationConfiguration)(nil), (*DeployItemValidationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DeployItemValidationConfiguration_To_v1alpha1_DeployItemValidationConfiguration(a.(*config.DeployItemValidationConfiguration), b.(*DeployItemValidationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployItemsController)(nil), (*config.DeployItemsController)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployItemsController_To_config_DeployItemsController(a.(*DeployItemsController), b.(*config.DeployItemsController), scope)
 	}); err != nil {
@@ -128,6 +158,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployerRegistration)(nil), (*config.DeployerRegistration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployerRegistration_To_config_DeployerRegistration(a.(*DeployerRegistration), b.(*config.DeployerRegistration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DeployerRegistration)(nil), (*DeployerRegistration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DeployerRegistration_To_v1alpha1_DeployerRegistration(a.(*config.DeployerRegistration), b.(*DeployerRegistration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExecutionsController)(nil), (*config.ExecutionsController)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExecutionsController_To_config_ExecutionsController(a.(*ExecutionsController), b.(*config.ExecutionsController), scope)
 	}); err != nil {
@@ -138,6 +178,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FilesystemRegistryConfiguration)(nil), (*config.FilesystemRegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FilesystemRegistryConfiguration_To_config_FilesystemRegistryConfiguration(a.(*FilesystemRegistryConfiguration), b.(*config.FilesystemRegistryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FilesystemRegistryConfiguration)(nil), (*FilesystemRegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FilesystemRegistryConfiguration_To_v1alpha1_FilesystemRegistryConfiguration(a.(*config.FilesystemRegistryConfiguration), b.(*FilesystemRegistryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GarbageCollectionConfiguration)(nil), (*config.GarbageCollectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GarbageCollectionConfiguration_To_config_GarbageCollectionConfiguration(a.(*GarbageCollectionConfiguration), b.(*config.GarbageCollectionConfiguration), scope)
 	}); err != nil {
@@ -158,6 +208,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HelmChartRepoIndexCacheConfiguration)(nil), (*config.HelmChartRepoIndexCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HelmChartRepoIndexCacheConfiguration_To_config_HelmChartRepoIndexCacheConfiguration(a.(*HelmChartRepoIndexCacheConfiguration), b.(*config.HelmChartRepoIndexCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HelmChartRepoIndexCacheConfiguration)(nil), (*HelmChartRepoIndexCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HelmChartRepoIndexCacheConfiguration_To_v1alpha1_HelmChartRepoIndexCacheConfiguration(a.(*config.HelmChartRepoIndexCacheConfiguration), b.(*HelmChartRepoIndexCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstallationsController)(nil), (*config.InstallationsController)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstallationsController_To_config_InstallationsController(a.(*InstallationsController), b.(*config.InstallationsController), scope)
 	}); err != nil {
@@ -228,6 +288,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OperatorTokenSource)(nil), (*config.OperatorTokenSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperatorTokenSource_To_config_OperatorTokenSource(a.(*OperatorTokenSource), b.(*config.OperatorTokenSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OperatorTokenSource)(nil), (*OperatorTokenSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OperatorTokenSource_To_v1alpha1_OperatorTokenSource(a.(*config.OperatorTokenSource), b.(*OperatorTokenSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProviderConfigurationSchema)(nil), (*config.ProviderConfigurationSchema)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProviderConfigurationSchema_To_config_ProviderConfigurationSchema(a.(*ProviderConfigurationSchema), b.(*config.ProviderConfigurationSchema), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProviderConfigurationSchema)(nil), (*ProviderConfigurationSchema)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProviderConfigurationSchema_To_v1alpha1_ProviderConfigurationSchema(a.(*config.ProviderConfigurationSchema), b.(*ProviderConfigurationSchema), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RegistryConfiguration)(nil), (*config.RegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RegistryConfiguration_To_config_RegistryConfiguration(a.(*RegistryConfiguration), b.(*config.RegistryConfiguration), scope)
 	}); err != nil {
@@ -238,6 +318,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SharedOCICacheConfiguration)(nil), (*config.SharedOCICacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SharedOCICacheConfiguration_To_config_SharedOCICacheConfiguration(a.(*SharedOCICacheConfiguration), b.(*config.SharedOCICacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SharedOCICacheConfiguration)(nil), (*SharedOCICacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SharedOCICacheConfiguration_To_v1alpha1_SharedOCICacheConfiguration(a.(*config.SharedOCICacheConfiguration), b.(*SharedOCICacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SharedOCIRegistryCacheConfiguration)(nil), (*config.SharedOCIRegistryCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SharedOCIRegistryCacheConfiguration_To_config_SharedOCIRegistryCacheConfiguration(a.(*SharedOCIRegistryCacheConfiguration), b.(*config.SharedOCIRegistryCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SharedOCIRegistryCacheConfiguration)(nil), (*SharedOCIRegistryCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SharedOCIRegistryCacheConfiguration_To_v1alpha1_SharedOCIRegistryCacheConfiguration(a.(*config.SharedOCIRegistryCacheConfiguration), b.(*SharedOCIRegistryCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SharedS3CacheConfiguration)(nil), (*config.SharedS3CacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SharedS3CacheConfiguration_To_config_SharedS3CacheConfiguration(a.(*SharedS3CacheConfiguration), b.(*config.SharedS3CacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SharedS3CacheConfiguration)(nil), (*SharedS3CacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SharedS3CacheConfiguration_To_v1alpha1_SharedS3CacheConfiguration(a.(*config.SharedS3CacheConfiguration), b.(*SharedS3CacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetLookupConfiguration)(nil), (*config.TargetLookupConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetLookupConfiguration_To_config_TargetLookupConfiguration(a.(*TargetLookupConfiguration), b.(*config.TargetLookupConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetLookupConfiguration)(nil), (*TargetLookupConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetLookupConfiguration_To_v1alpha1_TargetLookupConfiguration(a.(*config.TargetLookupConfiguration), b.(*TargetLookupConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetLookupResource)(nil), (*config.TargetLookupResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetLookupResource_To_config_TargetLookupResource(a.(*TargetLookupResource), b.(*config.TargetLookupResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetLookupResource)(nil), (*TargetLookupResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetLookupResource_To_v1alpha1_TargetLookupResource(a.(*config.TargetLookupResource), b.(*TargetLookupResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TemplateLimits)(nil), (*config.TemplateLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TemplateLimits_To_config_TemplateLimits(a.(*TemplateLimits), b.(*config.TemplateLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TemplateLimits)(nil), (*TemplateLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TemplateLimits_To_v1alpha1_TemplateLimits(a.(*config.TemplateLimits), b.(*TemplateLimits), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -313,6 +453,54 @@ func Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(in
 	return autoConvert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_ComponentIndexConfiguration_To_config_ComponentIndexConfiguration(in *ComponentIndexConfiguration, out *config.ComponentIndexConfiguration, s conversion.Scope) error {
+	out.Port = in.Port
+	out.RefreshInterval = (*core.Duration)(unsafe.Pointer(in.RefreshInterval))
+	out.BindAddress = in.BindAddress
+	out.TLS = (*config.ComponentIndexTLSConfiguration)(unsafe.Pointer(in.TLS))
+	return nil
+}
+
+// Convert_v1alpha1_ComponentIndexConfiguration_To_config_ComponentIndexConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentIndexConfiguration_To_config_ComponentIndexConfiguration(in *ComponentIndexConfiguration, out *config.ComponentIndexConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentIndexConfiguration_To_config_ComponentIndexConfiguration(in, out, s)
+}
+
+func autoConvert_config_ComponentIndexConfiguration_To_v1alpha1_ComponentIndexConfiguration(in *config.ComponentIndexConfiguration, out *ComponentIndexConfiguration, s conversion.Scope) error {
+	out.Port = in.Port
+	out.RefreshInterval = (*corev1alpha1.Duration)(unsafe.Pointer(in.RefreshInterval))
+	out.BindAddress = in.BindAddress
+	out.TLS = (*ComponentIndexTLSConfiguration)(unsafe.Pointer(in.TLS))
+	return nil
+}
+
+// Convert_config_ComponentIndexConfiguration_To_v1alpha1_ComponentIndexConfiguration is an autogenerated conversion function.
+func Convert_config_ComponentIndexConfiguration_To_v1alpha1_ComponentIndexConfiguration(in *config.ComponentIndexConfiguration, out *ComponentIndexConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ComponentIndexConfiguration_To_v1alpha1_ComponentIndexConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComponentIndexTLSConfiguration_To_config_ComponentIndexTLSConfiguration(in *ComponentIndexTLSConfiguration, out *config.ComponentIndexTLSConfiguration, s conversion.Scope) error {
+	out.CertFile = in.CertFile
+	out.KeyFile = in.KeyFile
+	return nil
+}
+
+// Convert_v1alpha1_ComponentIndexTLSConfiguration_To_config_ComponentIndexTLSConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentIndexTLSConfiguration_To_config_ComponentIndexTLSConfiguration(in *ComponentIndexTLSConfiguration, out *config.ComponentIndexTLSConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentIndexTLSConfiguration_To_config_ComponentIndexTLSConfiguration(in, out, s)
+}
+
+func autoConvert_config_ComponentIndexTLSConfiguration_To_v1alpha1_ComponentIndexTLSConfiguration(in *config.ComponentIndexTLSConfiguration, out *ComponentIndexTLSConfiguration, s conversion.Scope) error {
+	out.CertFile = in.CertFile
+	out.KeyFile = in.KeyFile
+	return nil
+}
+
+// Convert_config_ComponentIndexTLSConfiguration_To_v1alpha1_ComponentIndexTLSConfiguration is an autogenerated conversion function.
+func Convert_config_ComponentIndexTLSConfiguration_To_v1alpha1_ComponentIndexTLSConfiguration(in *config.ComponentIndexTLSConfiguration, out *ComponentIndexTLSConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ComponentIndexTLSConfiguration_To_v1alpha1_ComponentIndexTLSConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ContextControllerConfig_To_config_ContextControllerConfig(in *ContextControllerConfig, out *config.ContextControllerConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_ContextControllerDefaultConfig_To_config_ContextControllerDefaultConfig(&in.Default, &out.Default, s); err != nil {
 		return err
@@ -479,6 +667,28 @@ func Convert_config_DeployItemTimeouts_To_v1alpha1_DeployItemTimeouts(in *config
 	return autoConvert_config_DeployItemTimeouts_To_v1alpha1_DeployItemTimeouts(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployItemValidationConfiguration_To_config_DeployItemValidationConfiguration(in *DeployItemValidationConfiguration, out *config.DeployItemValidationConfiguration, s conversion.Scope) error {
+	out.RejectUnknownFields = in.RejectUnknownFields
+	out.DeployerRegistrations = *(*[]config.DeployerRegistration)(unsafe.Pointer(&in.DeployerRegistrations))
+	return nil
+}
+
+// Convert_v1alpha1_DeployItemValidationConfiguration_To_config_DeployItemValidationConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_DeployItemValidationConfiguration_To_config_DeployItemValidationConfiguration(in *DeployItemValidationConfiguration, out *config.DeployItemValidationConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployItemValidationConfiguration_To_config_DeployItemValidationConfiguration(in, out, s)
+}
+
+func autoConvert_config_DeployItemValidationConfiguration_To_v1alpha1_DeployItemValidationConfiguration(in *config.DeployItemValidationConfiguration, out *DeployItemValidationConfiguration, s conversion.Scope) error {
+	out.RejectUnknownFields = in.RejectUnknownFields
+	out.DeployerRegistrations = *(*[]DeployerRegistration)(unsafe.Pointer(&in.DeployerRegistrations))
+	return nil
+}
+
+// Convert_config_DeployItemValidationConfiguration_To_v1alpha1_DeployItemValidationConfiguration is an autogenerated conversion function.
+func Convert_config_DeployItemValidationConfiguration_To_v1alpha1_DeployItemValidationConfiguration(in *config.DeployItemValidationConfiguration, out *DeployItemValidationConfiguration, s conversion.Scope) error {
+	return autoConvert_config_DeployItemValidationConfiguration_To_v1alpha1_DeployItemValidationConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployItemsController_To_config_DeployItemsController(in *DeployItemsController, out *config.DeployItemsController, s conversion.Scope) error {
 	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
@@ -503,6 +713,28 @@ func Convert_config_DeployItemsController_To_v1alpha1_DeployItemsController(in *
 	return autoConvert_config_DeployItemsController_To_v1alpha1_DeployItemsController(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployerRegistration_To_config_DeployerRegistration(in *DeployerRegistration, out *config.DeployerRegistration, s conversion.Scope) error {
+	out.DeployItemType = in.DeployItemType
+	out.Schemas = *(*[]config.ProviderConfigurationSchema)(unsafe.Pointer(&in.Schemas))
+	return nil
+}
+
+// Convert_v1alpha1_DeployerRegistration_To_config_DeployerRegistration is an autogenerated conversion function.
+func Convert_v1alpha1_DeployerRegistration_To_config_DeployerRegistration(in *DeployerRegistration, out *config.DeployerRegistration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployerRegistration_To_config_DeployerRegistration(in, out, s)
+}
+
+func autoConvert_config_DeployerRegistration_To_v1alpha1_DeployerRegistration(in *config.DeployerRegistration, out *DeployerRegistration, s conversion.Scope) error {
+	out.DeployItemType = in.DeployItemType
+	out.Schemas = *(*[]ProviderConfigurationSchema)(unsafe.Pointer(&in.Schemas))
+	return nil
+}
+
+// Convert_config_DeployerRegistration_To_v1alpha1_DeployerRegistration is an autogenerated conversion function.
+func Convert_config_DeployerRegistration_To_v1alpha1_DeployerRegistration(in *config.DeployerRegistration, out *DeployerRegistration, s conversion.Scope) error {
+	return autoConvert_config_DeployerRegistration_To_v1alpha1_DeployerRegistration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ExecutionsController_To_config_ExecutionsController(in *ExecutionsController, out *config.ExecutionsController, s conversion.Scope) error {
 	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
@@ -527,6 +759,26 @@ func Convert_config_ExecutionsController_To_v1alpha1_ExecutionsController(in *co
 	return autoConvert_config_ExecutionsController_To_v1alpha1_ExecutionsController(in, out, s)
 }
 
+func autoConvert_v1alpha1_FilesystemRegistryConfiguration_To_config_FilesystemRegistryConfiguration(in *FilesystemRegistryConfiguration, out *config.FilesystemRegistryConfiguration, s conversion.Scope) error {
+	out.RootPath = in.RootPath
+	return nil
+}
+
+// Convert_v1alpha1_FilesystemRegistryConfiguration_To_config_FilesystemRegistryConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_FilesystemRegistryConfiguration_To_config_FilesystemRegistryConfiguration(in *FilesystemRegistryConfiguration, out *config.FilesystemRegistryConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FilesystemRegistryConfiguration_To_config_FilesystemRegistryConfiguration(in, out, s)
+}
+
+func autoConvert_config_FilesystemRegistryConfiguration_To_v1alpha1_FilesystemRegistryConfiguration(in *config.FilesystemRegistryConfiguration, out *FilesystemRegistryConfiguration, s conversion.Scope) error {
+	out.RootPath = in.RootPath
+	return nil
+}
+
+// Convert_config_FilesystemRegistryConfiguration_To_v1alpha1_FilesystemRegistryConfiguration is an autogenerated conversion function.
+func Convert_config_FilesystemRegistryConfiguration_To_v1alpha1_FilesystemRegistryConfiguration(in *config.FilesystemRegistryConfiguration, out *FilesystemRegistryConfiguration, s conversion.Scope) error {
+	return autoConvert_config_FilesystemRegistryConfiguration_To_v1alpha1_FilesystemRegistryConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_GarbageCollectionConfiguration_To_config_GarbageCollectionConfiguration(in *GarbageCollectionConfiguration, out *config.GarbageCollectionConfiguration, s conversion.Scope) error {
 	out.Size = in.Size
 	out.GCHighThreshold = in.GCHighThreshold
@@ -579,6 +831,28 @@ func Convert_config_HPAMainConfiguration_To_v1alpha1_HPAMainConfiguration(in *co
 	return autoConvert_config_HPAMainConfiguration_To_v1alpha1_HPAMainConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_HelmChartRepoIndexCacheConfiguration_To_config_HelmChartRepoIndexCacheConfiguration(in *HelmChartRepoIndexCacheConfiguration, out *config.HelmChartRepoIndexCacheConfiguration, s conversion.Scope) error {
+	out.TTL = (*core.Duration)(unsafe.Pointer(in.TTL))
+	out.MaxSize = in.MaxSize
+	return nil
+}
+
+// Convert_v1alpha1_HelmChartRepoIndexCacheConfiguration_To_config_HelmChartRepoIndexCacheConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_HelmChartRepoIndexCacheConfiguration_To_config_HelmChartRepoIndexCacheConfiguration(in *HelmChartRepoIndexCacheConfiguration, out *config.HelmChartRepoIndexCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HelmChartRepoIndexCacheConfiguration_To_config_HelmChartRepoIndexCacheConfiguration(in, out, s)
+}
+
+func autoConvert_config_HelmChartRepoIndexCacheConfiguration_To_v1alpha1_HelmChartRepoIndexCacheConfiguration(in *config.HelmChartRepoIndexCacheConfiguration, out *HelmChartRepoIndexCacheConfiguration, s conversion.Scope) error {
+	out.TTL = (*corev1alpha1.Duration)(unsafe.Pointer(in.TTL))
+	out.MaxSize = in.MaxSize
+	return nil
+}
+
+// Convert_config_HelmChartRepoIndexCacheConfiguration_To_v1alpha1_HelmChartRepoIndexCacheConfiguration is an autogenerated conversion function.
+func Convert_config_HelmChartRepoIndexCacheConfiguration_To_v1alpha1_HelmChartRepoIndexCacheConfiguration(in *config.HelmChartRepoIndexCacheConfiguration, out *HelmChartRepoIndexCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_config_HelmChartRepoIndexCacheConfiguration_To_v1alpha1_HelmChartRepoIndexCacheConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstallationsController_To_config_InstallationsController(in *InstallationsController, out *config.InstallationsController, s conversion.Scope) error {
 	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
@@ -619,6 +893,10 @@ func autoConvert_v1alpha1_LandscaperConfiguration_To_config_LandscaperConfigurat
 		return err
 	}
 	out.DeployItemTimeouts = (*config.DeployItemTimeouts)(unsafe.Pointer(in.DeployItemTimeouts))
+	out.TemplateLimits = (*config.TemplateLimits)(unsafe.Pointer(in.TemplateLimits))
+	out.TargetLookup = (*config.TargetLookupConfiguration)(unsafe.Pointer(in.TargetLookup))
+	out.DeployItemValidation = (*config.DeployItemValidationConfiguration)(unsafe.Pointer(in.DeployItemValidation))
+	out.ComponentIndex = (*config.ComponentIndexConfiguration)(unsafe.Pointer(in.ComponentIndex))
 	out.LsDeployments = (*config.LsDeployments)(unsafe.Pointer(in.LsDeployments))
 	out.HPAMainConfiguration = (*config.HPAMainConfiguration)(unsafe.Pointer(in.HPAMainConfiguration))
 	out.UseOCMLib = in.UseOCMLib
@@ -646,6 +924,10 @@ func autoConvert_config_LandscaperConfiguration_To_v1alpha1_LandscaperConfigurat
 		return err
 	}
 	out.DeployItemTimeouts = (*DeployItemTimeouts)(unsafe.Pointer(in.DeployItemTimeouts))
+	out.TemplateLimits = (*TemplateLimits)(unsafe.Pointer(in.TemplateLimits))
+	out.TargetLookup = (*TargetLookupConfiguration)(unsafe.Pointer(in.TargetLookup))
+	out.DeployItemValidation = (*DeployItemValidationConfiguration)(unsafe.Pointer(in.DeployItemValidation))
+	out.ComponentIndex = (*ComponentIndexConfiguration)(unsafe.Pointer(in.ComponentIndex))
 	out.LsDeployments = (*LsDeployments)(unsafe.Pointer(in.LsDeployments))
 	out.HPAMainConfiguration = (*HPAMainConfiguration)(unsafe.Pointer(in.HPAMainConfiguration))
 	out.UseOCMLib = in.UseOCMLib
@@ -730,6 +1012,8 @@ func Convert_config_MetricsConfiguration_To_v1alpha1_MetricsConfiguration(in *co
 func autoConvert_v1alpha1_OCICacheConfiguration_To_config_OCICacheConfiguration(in *OCICacheConfiguration, out *config.OCICacheConfiguration, s conversion.Scope) error {
 	out.UseInMemoryOverlay = in.UseInMemoryOverlay
 	out.Path = in.Path
+	out.Shared = (*config.SharedOCICacheConfiguration)(unsafe.Pointer(in.Shared))
+	out.HelmChartRepoIndex = (*config.HelmChartRepoIndexCacheConfiguration)(unsafe.Pointer(in.HelmChartRepoIndex))
 	return nil
 }
 
@@ -741,6 +1025,8 @@ func Convert_v1alpha1_OCICacheConfiguration_To_config_OCICacheConfiguration(in *
 func autoConvert_config_OCICacheConfiguration_To_v1alpha1_OCICacheConfiguration(in *config.OCICacheConfiguration, out *OCICacheConfiguration, s conversion.Scope) error {
 	out.UseInMemoryOverlay = in.UseInMemoryOverlay
 	out.Path = in.Path
+	out.Shared = (*SharedOCICacheConfiguration)(unsafe.Pointer(in.Shared))
+	out.HelmChartRepoIndex = (*HelmChartRepoIndexCacheConfiguration)(unsafe.Pointer(in.HelmChartRepoIndex))
 	return nil
 }
 
@@ -754,6 +1040,7 @@ func autoConvert_v1alpha1_OCIConfiguration_To_config_OCIConfiguration(in *OCICon
 	out.Cache = (*config.OCICacheConfiguration)(unsafe.Pointer(in.Cache))
 	out.AllowPlainHttp = in.AllowPlainHttp
 	out.InsecureSkipVerify = in.InsecureSkipVerify
+	out.TokenSources = *(*[]config.OperatorTokenSource)(unsafe.Pointer(&in.TokenSources))
 	return nil
 }
 
@@ -767,6 +1054,7 @@ func autoConvert_config_OCIConfiguration_To_v1alpha1_OCIConfiguration(in *config
 	out.Cache = (*OCICacheConfiguration)(unsafe.Pointer(in.Cache))
 	out.AllowPlainHttp = in.AllowPlainHttp
 	out.InsecureSkipVerify = in.InsecureSkipVerify
+	out.TokenSources = *(*[]OperatorTokenSource)(unsafe.Pointer(&in.TokenSources))
 	return nil
 }
 
@@ -775,8 +1063,63 @@ func Convert_config_OCIConfiguration_To_v1alpha1_OCIConfiguration(in *config.OCI
 	return autoConvert_config_OCIConfiguration_To_v1alpha1_OCIConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_OperatorTokenSource_To_config_OperatorTokenSource(in *OperatorTokenSource, out *config.OperatorTokenSource, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Config = (*core.AnyJSON)(unsafe.Pointer(in.Config))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.Registries = *(*[]string)(unsafe.Pointer(&in.Registries))
+	return nil
+}
+
+// Convert_v1alpha1_OperatorTokenSource_To_config_OperatorTokenSource is an autogenerated conversion function.
+func Convert_v1alpha1_OperatorTokenSource_To_config_OperatorTokenSource(in *OperatorTokenSource, out *config.OperatorTokenSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperatorTokenSource_To_config_OperatorTokenSource(in, out, s)
+}
+
+func autoConvert_config_OperatorTokenSource_To_v1alpha1_OperatorTokenSource(in *config.OperatorTokenSource, out *OperatorTokenSource, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Config = (*corev1alpha1.AnyJSON)(unsafe.Pointer(in.Config))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.Registries = *(*[]string)(unsafe.Pointer(&in.Registries))
+	return nil
+}
+
+// Convert_config_OperatorTokenSource_To_v1alpha1_OperatorTokenSource is an autogenerated conversion function.
+func Convert_config_OperatorTokenSource_To_v1alpha1_OperatorTokenSource(in *config.OperatorTokenSource, out *OperatorTokenSource, s conversion.Scope) error {
+	return autoConvert_config_OperatorTokenSource_To_v1alpha1_OperatorTokenSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProviderConfigurationSchema_To_config_ProviderConfigurationSchema(in *ProviderConfigurationSchema, out *config.ProviderConfigurationSchema, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	if err := corev1alpha1.Convert_v1alpha1_AnyJSON_To_core_AnyJSON(&in.Schema, &out.Schema, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ProviderConfigurationSchema_To_config_ProviderConfigurationSchema is an autogenerated conversion function.
+func Convert_v1alpha1_ProviderConfigurationSchema_To_config_ProviderConfigurationSchema(in *ProviderConfigurationSchema, out *config.ProviderConfigurationSchema, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProviderConfigurationSchema_To_config_ProviderConfigurationSchema(in, out, s)
+}
+
+func autoConvert_config_ProviderConfigurationSchema_To_v1alpha1_ProviderConfigurationSchema(in *config.ProviderConfigurationSchema, out *ProviderConfigurationSchema, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	if err := corev1alpha1.Convert_core_AnyJSON_To_v1alpha1_AnyJSON(&in.Schema, &out.Schema, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_ProviderConfigurationSchema_To_v1alpha1_ProviderConfigurationSchema is an autogenerated conversion function.
+func Convert_config_ProviderConfigurationSchema_To_v1alpha1_ProviderConfigurationSchema(in *config.ProviderConfigurationSchema, out *ProviderConfigurationSchema, s conversion.Scope) error {
+	return autoConvert_config_ProviderConfigurationSchema_To_v1alpha1_ProviderConfigurationSchema(in, out, s)
+}
+
 func autoConvert_v1alpha1_RegistryConfiguration_To_config_RegistryConfiguration(in *RegistryConfiguration, out *config.RegistryConfiguration, s conversion.Scope) error {
 	out.Local = (*config.LocalRegistryConfiguration)(unsafe.Pointer(in.Local))
+	out.Filesystem = (*config.FilesystemRegistryConfiguration)(unsafe.Pointer(in.Filesystem))
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	return nil
 }
@@ -788,6 +1131,7 @@ func Convert_v1alpha1_RegistryConfiguration_To_config_RegistryConfiguration(in *
 
 func autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in *config.RegistryConfiguration, out *RegistryConfiguration, s conversion.Scope) error {
 	out.Local = (*LocalRegistryConfiguration)(unsafe.Pointer(in.Local))
+	out.Filesystem = (*FilesystemRegistryConfiguration)(unsafe.Pointer(in.Filesystem))
 	out.OCI = (*OCIConfiguration)(unsafe.Pointer(in.OCI))
 	return nil
 }
@@ -796,3 +1140,151 @@ func autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(
 func Convert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in *config.RegistryConfiguration, out *RegistryConfiguration, s conversion.Scope) error {
 	return autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_SharedOCICacheConfiguration_To_config_SharedOCICacheConfiguration(in *SharedOCICacheConfiguration, out *config.SharedOCICacheConfiguration, s conversion.Scope) error {
+	out.OCI = (*config.SharedOCIRegistryCacheConfiguration)(unsafe.Pointer(in.OCI))
+	out.S3 = (*config.SharedS3CacheConfiguration)(unsafe.Pointer(in.S3))
+	return nil
+}
+
+// Convert_v1alpha1_SharedOCICacheConfiguration_To_config_SharedOCICacheConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SharedOCICacheConfiguration_To_config_SharedOCICacheConfiguration(in *SharedOCICacheConfiguration, out *config.SharedOCICacheConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SharedOCICacheConfiguration_To_config_SharedOCICacheConfiguration(in, out, s)
+}
+
+func autoConvert_config_SharedOCICacheConfiguration_To_v1alpha1_SharedOCICacheConfiguration(in *config.SharedOCICacheConfiguration, out *SharedOCICacheConfiguration, s conversion.Scope) error {
+	out.OCI = (*SharedOCIRegistryCacheConfiguration)(unsafe.Pointer(in.OCI))
+	out.S3 = (*SharedS3CacheConfiguration)(unsafe.Pointer(in.S3))
+	return nil
+}
+
+// Convert_config_SharedOCICacheConfiguration_To_v1alpha1_SharedOCICacheConfiguration is an autogenerated conversion function.
+func Convert_config_SharedOCICacheConfiguration_To_v1alpha1_SharedOCICacheConfiguration(in *config.SharedOCICacheConfiguration, out *SharedOCICacheConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SharedOCICacheConfiguration_To_v1alpha1_SharedOCICacheConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SharedOCIRegistryCacheConfiguration_To_config_SharedOCIRegistryCacheConfiguration(in *SharedOCIRegistryCacheConfiguration, out *config.SharedOCIRegistryCacheConfiguration, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.AllowPlainHttp = in.AllowPlainHttp
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_v1alpha1_SharedOCIRegistryCacheConfiguration_To_config_SharedOCIRegistryCacheConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SharedOCIRegistryCacheConfiguration_To_config_SharedOCIRegistryCacheConfiguration(in *SharedOCIRegistryCacheConfiguration, out *config.SharedOCIRegistryCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SharedOCIRegistryCacheConfiguration_To_config_SharedOCIRegistryCacheConfiguration(in, out, s)
+}
+
+func autoConvert_config_SharedOCIRegistryCacheConfiguration_To_v1alpha1_SharedOCIRegistryCacheConfiguration(in *config.SharedOCIRegistryCacheConfiguration, out *SharedOCIRegistryCacheConfiguration, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.AllowPlainHttp = in.AllowPlainHttp
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_config_SharedOCIRegistryCacheConfiguration_To_v1alpha1_SharedOCIRegistryCacheConfiguration is an autogenerated conversion function.
+func Convert_config_SharedOCIRegistryCacheConfiguration_To_v1alpha1_SharedOCIRegistryCacheConfiguration(in *config.SharedOCIRegistryCacheConfiguration, out *SharedOCIRegistryCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SharedOCIRegistryCacheConfiguration_To_v1alpha1_SharedOCIRegistryCacheConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SharedS3CacheConfiguration_To_config_SharedS3CacheConfiguration(in *SharedS3CacheConfiguration, out *config.SharedS3CacheConfiguration, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.UsePathStyle = in.UsePathStyle
+	out.CredentialsFile = in.CredentialsFile
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_v1alpha1_SharedS3CacheConfiguration_To_config_SharedS3CacheConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SharedS3CacheConfiguration_To_config_SharedS3CacheConfiguration(in *SharedS3CacheConfiguration, out *config.SharedS3CacheConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SharedS3CacheConfiguration_To_config_SharedS3CacheConfiguration(in, out, s)
+}
+
+func autoConvert_config_SharedS3CacheConfiguration_To_v1alpha1_SharedS3CacheConfiguration(in *config.SharedS3CacheConfiguration, out *SharedS3CacheConfiguration, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.UsePathStyle = in.UsePathStyle
+	out.CredentialsFile = in.CredentialsFile
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_config_SharedS3CacheConfiguration_To_v1alpha1_SharedS3CacheConfiguration is an autogenerated conversion function.
+func Convert_config_SharedS3CacheConfiguration_To_v1alpha1_SharedS3CacheConfiguration(in *config.SharedS3CacheConfiguration, out *SharedS3CacheConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SharedS3CacheConfiguration_To_v1alpha1_SharedS3CacheConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetLookupConfiguration_To_config_TargetLookupConfiguration(in *TargetLookupConfiguration, out *config.TargetLookupConfiguration, s conversion.Scope) error {
+	out.AllowedResources = *(*[]config.TargetLookupResource)(unsafe.Pointer(&in.AllowedResources))
+	return nil
+}
+
+// Convert_v1alpha1_TargetLookupConfiguration_To_config_TargetLookupConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_TargetLookupConfiguration_To_config_TargetLookupConfiguration(in *TargetLookupConfiguration, out *config.TargetLookupConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetLookupConfiguration_To_config_TargetLookupConfiguration(in, out, s)
+}
+
+func autoConvert_config_TargetLookupConfiguration_To_v1alpha1_TargetLookupConfiguration(in *config.TargetLookupConfiguration, out *TargetLookupConfiguration, s conversion.Scope) error {
+	out.AllowedResources = *(*[]TargetLookupResource)(unsafe.Pointer(&in.AllowedResources))
+	return nil
+}
+
+// Convert_config_TargetLookupConfiguration_To_v1alpha1_TargetLookupConfiguration is an autogenerated conversion function.
+func Convert_config_TargetLookupConfiguration_To_v1alpha1_TargetLookupConfiguration(in *config.TargetLookupConfiguration, out *TargetLookupConfiguration, s conversion.Scope) error {
+	return autoConvert_config_TargetLookupConfiguration_To_v1alpha1_TargetLookupConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetLookupResource_To_config_TargetLookupResource(in *TargetLookupResource, out *config.TargetLookupResource, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_v1alpha1_TargetLookupResource_To_config_TargetLookupResource is an autogenerated conversion function.
+func Convert_v1alpha1_TargetLookupResource_To_config_TargetLookupResource(in *TargetLookupResource, out *config.TargetLookupResource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetLookupResource_To_config_TargetLookupResource(in, out, s)
+}
+
+func autoConvert_config_TargetLookupResource_To_v1alpha1_TargetLookupResource(in *config.TargetLookupResource, out *TargetLookupResource, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_config_TargetLookupResource_To_v1alpha1_TargetLookupResource is an autogenerated conversion function.
+func Convert_config_TargetLookupResource_To_v1alpha1_TargetLookupResource(in *config.TargetLookupResource, out *TargetLookupResource, s conversion.Scope) error {
+	return autoConvert_config_TargetLookupResource_To_v1alpha1_TargetLookupResource(in, out, s)
+}
+
+func autoConvert_v1alpha1_TemplateLimits_To_config_TemplateLimits(in *TemplateLimits, out *config.TemplateLimits, s conversion.Scope) error {
+	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
+	out.MaxOutputSize = in.MaxOutputSize
+	out.MaxBlobSize = in.MaxBlobSize
+	out.MaxIncludeDepth = (*int32)(unsafe.Pointer(in.MaxIncludeDepth))
+	return nil
+}
+
+// Convert_v1alpha1_TemplateLimits_To_config_TemplateLimits is an autogenerated conversion function.
+func Convert_v1alpha1_TemplateLimits_To_config_TemplateLimits(in *TemplateLimits, out *config.TemplateLimits, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TemplateLimits_To_config_TemplateLimits(in, out, s)
+}
+
+func autoConvert_config_TemplateLimits_To_v1alpha1_TemplateLimits(in *config.TemplateLimits, out *TemplateLimits, s conversion.Scope) error {
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	out.MaxOutputSize = in.MaxOutputSize
+	out.MaxBlobSize = in.MaxBlobSize
+	out.MaxIncludeDepth = (*int32)(unsafe.Pointer(in.MaxIncludeDepth))
+	return nil
+}
+
+// Convert_config_TemplateLimits_To_v1alpha1_TemplateLimits is an autogenerated conversion function.
+func Convert_config_TemplateLimits_To_v1alpha1_TemplateLimits(in *config.TemplateLimits, out *TemplateLimits, s conversion.Scope) error {
+	return autoConvert_config_TemplateLimits_To_v1alpha1_TemplateLimits(in, out, s)
+}
@@ -26,6 +26,12 @@ func SetObjectDefaults_LandscaperConfiguration(in *LandscaperConfiguration) {
 	SetDefaults_CommonControllerConfig(&in.Controllers.Executions.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&in.Controllers.DeployItems.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&in.Controllers.Contexts.CommonControllerConfig)
+	if in.TemplateLimits != nil {
+		SetDefaults_TemplateLimits(in.TemplateLimits)
+	}
+	if in.ComponentIndex != nil {
+		SetDefaults_ComponentIndexConfiguration(in.ComponentIndex)
+	}
 	SetDefaults_BlueprintStore(&in.BlueprintStore)
 	SetDefaults_CrdManagementConfiguration(&in.CrdManagement)
 }
@@ -74,6 +74,48 @@ func (in *CommonControllerConfig) DeepCopy() *CommonControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentIndexConfiguration) DeepCopyInto(out *ComponentIndexConfiguration) {
+	*out = *in
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(core.Duration)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ComponentIndexTLSConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentIndexConfiguration.
+func (in *ComponentIndexConfiguration) DeepCopy() *ComponentIndexConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentIndexConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentIndexTLSConfiguration) DeepCopyInto(out *ComponentIndexTLSConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentIndexTLSConfiguration.
+func (in *ComponentIndexTLSConfiguration) DeepCopy() *ComponentIndexTLSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentIndexTLSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContextControllerConfig) DeepCopyInto(out *ContextControllerConfig) {
 	*out = *in
@@ -211,6 +253,29 @@ func (in *DeployItemTimeouts) DeepCopy() *DeployItemTimeouts {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployItemValidationConfiguration) DeepCopyInto(out *DeployItemValidationConfiguration) {
+	*out = *in
+	if in.DeployerRegistrations != nil {
+		in, out := &in.DeployerRegistrations, &out.DeployerRegistrations
+		*out = make([]DeployerRegistration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemValidationConfiguration.
+func (in *DeployItemValidationConfiguration) DeepCopy() *DeployItemValidationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployItemValidationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemsController) DeepCopyInto(out *DeployItemsController) {
 	*out = *in
@@ -228,6 +293,29 @@ func (in *DeployItemsController) DeepCopy() *DeployItemsController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployerRegistration) DeepCopyInto(out *DeployerRegistration) {
+	*out = *in
+	if in.Schemas != nil {
+		in, out := &in.Schemas, &out.Schemas
+		*out = make([]ProviderConfigurationSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerRegistration.
+func (in *DeployerRegistration) DeepCopy() *DeployerRegistration {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployerRegistration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionsController) DeepCopyInto(out *ExecutionsController) {
 	*out = *in
@@ -245,6 +333,22 @@ func (in *ExecutionsController) DeepCopy() *ExecutionsController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilesystemRegistryConfiguration) DeepCopyInto(out *FilesystemRegistryConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilesystemRegistryConfiguration.
+func (in *FilesystemRegistryConfiguration) DeepCopy() *FilesystemRegistryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(FilesystemRegistryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GarbageCollectionConfiguration) DeepCopyInto(out *GarbageCollectionConfiguration) {
 	*out = *in
@@ -278,6 +382,27 @@ func (in *HPAMainConfiguration) DeepCopy() *HPAMainConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartRepoIndexCacheConfiguration) DeepCopyInto(out *HelmChartRepoIndexCacheConfiguration) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(core.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartRepoIndexCacheConfiguration.
+func (in *HelmChartRepoIndexCacheConfiguration) DeepCopy() *HelmChartRepoIndexCacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartRepoIndexCacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallationsController) DeepCopyInto(out *InstallationsController) {
 	*out = *in
@@ -317,6 +442,26 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 		*out = new(DeployItemTimeouts)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TemplateLimits != nil {
+		in, out := &in.TemplateLimits, &out.TemplateLimits
+		*out = new(TemplateLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetLookup != nil {
+		in, out := &in.TargetLookup, &out.TargetLookup
+		*out = new(TargetLookupConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeployItemValidation != nil {
+		in, out := &in.DeployItemValidation, &out.DeployItemValidation
+		*out = new(DeployItemValidationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComponentIndex != nil {
+		in, out := &in.ComponentIndex, &out.ComponentIndex
+		*out = new(ComponentIndexConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LsDeployments != nil {
 		in, out := &in.LsDeployments, &out.LsDeployments
 		*out = new(LsDeployments)
@@ -404,6 +549,16 @@ func (in *MetricsConfiguration) DeepCopy() *MetricsConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OCICacheConfiguration) DeepCopyInto(out *OCICacheConfiguration) {
 	*out = *in
+	if in.Shared != nil {
+		in, out := &in.Shared, &out.Shared
+		*out = new(SharedOCICacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelmChartRepoIndex != nil {
+		in, out := &in.HelmChartRepoIndex, &out.HelmChartRepoIndex
+		*out = new(HelmChartRepoIndexCacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -428,7 +583,14 @@ func (in *OCIConfiguration) DeepCopyInto(out *OCIConfiguration) {
 	if in.Cache != nil {
 		in, out := &in.Cache, &out.Cache
 		*out = new(OCICacheConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenSources != nil {
+		in, out := &in.TokenSources, &out.TokenSources
+		*out = make([]OperatorTokenSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -443,6 +605,54 @@ func (in *OCIConfiguration) DeepCopy() *OCIConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorTokenSource) DeepCopyInto(out *OperatorTokenSource) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(core.AnyJSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorTokenSource.
+func (in *OperatorTokenSource) DeepCopy() *OperatorTokenSource {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorTokenSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigurationSchema) DeepCopyInto(out *ProviderConfigurationSchema) {
+	*out = *in
+	in.Schema.DeepCopyInto(&out.Schema)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigurationSchema.
+func (in *ProviderConfigurationSchema) DeepCopy() *ProviderConfigurationSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigurationSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryConfiguration) DeepCopyInto(out *RegistryConfiguration) {
 	*out = *in
@@ -451,6 +661,11 @@ func (in *RegistryConfiguration) DeepCopyInto(out *RegistryConfiguration) {
 		*out = new(LocalRegistryConfiguration)
 		**out = **in
 	}
+	if in.Filesystem != nil {
+		in, out := &in.Filesystem, &out.Filesystem
+		*out = new(FilesystemRegistryConfiguration)
+		**out = **in
+	}
 	if in.OCI != nil {
 		in, out := &in.OCI, &out.OCI
 		*out = new(OCIConfiguration)
@@ -468,3 +683,131 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedOCICacheConfiguration) DeepCopyInto(out *SharedOCICacheConfiguration) {
+	*out = *in
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(SharedOCIRegistryCacheConfiguration)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(SharedS3CacheConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedOCICacheConfiguration.
+func (in *SharedOCICacheConfiguration) DeepCopy() *SharedOCICacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedOCICacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedOCIRegistryCacheConfiguration) DeepCopyInto(out *SharedOCIRegistryCacheConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedOCIRegistryCacheConfiguration.
+func (in *SharedOCIRegistryCacheConfiguration) DeepCopy() *SharedOCIRegistryCacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedOCIRegistryCacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedS3CacheConfiguration) DeepCopyInto(out *SharedS3CacheConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedS3CacheConfiguration.
+func (in *SharedS3CacheConfiguration) DeepCopy() *SharedS3CacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedS3CacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetLookupConfiguration) DeepCopyInto(out *TargetLookupConfiguration) {
+	*out = *in
+	if in.AllowedResources != nil {
+		in, out := &in.AllowedResources, &out.AllowedResources
+		*out = make([]TargetLookupResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetLookupConfiguration.
+func (in *TargetLookupConfiguration) DeepCopy() *TargetLookupConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetLookupConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetLookupResource) DeepCopyInto(out *TargetLookupResource) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetLookupResource.
+func (in *TargetLookupResource) DeepCopy() *TargetLookupResource {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetLookupResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateLimits) DeepCopyInto(out *TemplateLimits) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(core.Duration)
+		**out = **in
+	}
+	if in.MaxIncludeDepth != nil {
+		in, out := &in.MaxIncludeDepth, &out.MaxIncludeDepth
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateLimits.
+func (in *TemplateLimits) DeepCopy() *TemplateLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateLimits)
+	in.DeepCopyInto(out)
+	return out
+}
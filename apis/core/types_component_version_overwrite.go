@@ -29,6 +29,9 @@ type ComponentVersionOverwrites struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Overwrites defines a list of component overwrites
 	Overwrites ComponentVersionOverwriteList `json:"overwrites,omitempty"`
+	// Status contains the installations that are currently affected by the overwrites.
+	// +optional
+	Status ComponentVersionOverwritesStatus `json:"status,omitempty"`
 }
 
 // ComponentVersionOverwriteList is a list of component overwrites.
@@ -48,9 +51,49 @@ type ComponentVersionOverwriteReference struct {
 	// +optional
 	RepositoryContext *cdv2.UnstructuredTypedObject `json:"repositoryContext,omitempty"`
 	// ComponentName defines the unique of the component containing the resource.
+	// In the source of an overwrite, the name may contain the wildcard "*", which matches any sequence of characters,
+	// e.g. "github.com/acme/*".
 	// +optional
 	ComponentName string `json:"componentName"`
 	// Version defines the version of the component.
 	// +optional
 	Version string `json:"version"`
+	// VersionConstraint defines a semantic version constraint, e.g. ">= 1.2, < 2".
+	// It is only evaluated in the source of an overwrite, where it matches all versions that satisfy the constraint.
+	// It must not be combined with a version.
+	// +optional
+	VersionConstraint string `json:"versionConstraint,omitempty"`
+}
+
+// ComponentVersionOverwritesStatus contains the installations that are currently affected by the overwrites.
+type ComponentVersionOverwritesStatus struct {
+	// ObservedGeneration is the most recent generation observed.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// AffectedInstallations are the installations in the same namespace whose component reference, or the reference
+	// of a transitively referenced component, is currently replaced by the overwrites.
+	// An installation is listed once for each replaced component reference.
+	// +optional
+	AffectedInstallations []AffectedInstallation `json:"affectedInstallations,omitempty"`
+	// InvalidOverwrites lists the overwrites that are ignored because they are invalid,
+	// e.g. because of an invalid version constraint.
+	// +optional
+	InvalidOverwrites []string `json:"invalidOverwrites,omitempty"`
+	// LastUpdateTime is the time when the status was updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// AffectedInstallation describes a component reference of an installation that is replaced by an overwrite.
+type AffectedInstallation struct {
+	// Name is the name of the installation.
+	Name string `json:"name"`
+	// Context is the name of the context of the installation, which references the overwrites.
+	Context string `json:"context"`
+	// ComponentName is the name of the replaced component, which is referenced by the installation or by one
+	// of its transitively referenced components.
+	ComponentName string `json:"componentName"`
+	// Version is the version of the replaced component.
+	Version string `json:"version"`
+	// Substitution is the component reference that is used instead.
+	Substitution ComponentDescriptorReference `json:"substitution"`
 }
@@ -45,6 +45,12 @@ type ContextConfiguration struct {
 	// Note that the type information is used to determine the secret key and the type of the secret.
 	// +optional
 	RegistryPullSecrets []corev1.LocalObjectReference `json:"registryPullSecrets,omitempty"`
+	// RegistryCredentials define the credentials for dedicated registries.
+	// They are used to pull component descriptors, blueprints and other resources, helm charts from oci registries,
+	// and the images of the container deployer.
+	// For a registry that is matched by a registry credential, the registry pull secrets are not used.
+	// +optional
+	RegistryCredentials []RegistryCredential `json:"registryCredentials,omitempty"`
 	// Configurations contains arbitrary configuration information for dedicated purposes given by a string key.
 	// The key should use a dns-like syntax to express the purpose and avoid conflicts.
 	// +optional
@@ -54,4 +60,82 @@ type ContextConfiguration struct {
 	// If the string is empty, no overwrites will be used.
 	// +optional
 	ComponentVersionOverwritesReference string `json:"componentVersionOverwrites"`
+	// Verification defines how the signatures of the components of installations that reference this context are verified.
+	// If it is not set, no signatures are verified.
+	// +optional
+	Verification *ComponentVerification `json:"verification,omitempty"`
+}
+
+// RegistryCredential defines the credentials for the registries that match a pattern.
+type RegistryCredential struct {
+	// Registry is the pattern of the registries the credential is used for.
+	// It consists of a host, an optional port and an optional repository prefix, e.g. "registry.example.com:5000/my-project".
+	// A "*" in the host matches any sequence of characters, e.g. "*.dkr.ecr.*.amazonaws.com".
+	// If several credentials match a registry, the one with the longest repository prefix is used.
+	Registry string `json:"registry"`
+	// SecretRef references a secret in the namespace of the context.
+	// Without a token source, the secret contains either the keys "username" and "password" for basic authentication,
+	// or the key "token" for bearer token authentication.
+	// With a token source of a given type, the secret is required and contains the input of the token source,
+	// e.g. a client secret.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+	// TokenSource obtains short-lived credentials, e.g. with an OAuth2 token exchange.
+	// +optional
+	TokenSource *RegistryTokenSource `json:"tokenSource,omitempty"`
+}
+
+// RegistryTokenSource defines how short-lived registry credentials are obtained.
+// Either a type or the name of a token source of the landscaper configuration has to be set.
+type RegistryTokenSource struct {
+	// Type is the type of the token source, e.g. "oauth2", "gcp" or "aws".
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Config contains the type specific configuration of the token source.
+	// +optional
+	Config *AnyJSON `json:"config,omitempty"`
+	// Name references a token source of the landscaper configuration.
+	// Such token sources may use the identity of the landscaper, e.g. its workload identity,
+	// and are only available to contexts in the namespaces that are allowed by the operator.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// VerificationMode defines whether the signature of a component version is verified.
+type VerificationMode string
+
+const (
+	// VerificationModeRequired requires that a component version has a valid signature of a trusted key.
+	VerificationModeRequired VerificationMode = "Required"
+	// VerificationModeOptional verifies the signatures of signed component versions, but accepts unsigned component versions.
+	VerificationModeOptional VerificationMode = "Optional"
+	// VerificationModeDisabled disables the verification of component versions.
+	VerificationModeDisabled VerificationMode = "Disabled"
+)
+
+// ComponentVerification defines how the signatures of component versions are verified.
+type ComponentVerification struct {
+	// Policies define which component versions are verified with which keys.
+	// The first policy whose component name pattern matches the name of a component is applied.
+	// Component versions that are not matched by any policy are not verified.
+	Policies []ComponentVerificationPolicy `json:"policies"`
+}
+
+// ComponentVerificationPolicy defines how the signatures of the component versions with a matching name are verified.
+type ComponentVerificationPolicy struct {
+	// ComponentNamePattern selects the components the policy applies to.
+	// A "*" matches any sequence of characters, e.g. "github.com/gardener/*".
+	// Defaults to "*", which matches all components.
+	// +optional
+	ComponentNamePattern string `json:"componentNamePattern,omitempty"`
+	// Mode defines whether the signature of a component version is required, optional or not verified.
+	Mode VerificationMode `json:"mode"`
+	// SignatureName is the name of the signature that is verified.
+	// If it is empty, a component version is valid if any of its signatures is verified by a trusted key.
+	// +optional
+	SignatureName string `json:"signatureName,omitempty"`
+	// TrustedKeys reference secrets in the namespace of the context that contain PEM encoded public keys or certificates.
+	// If no key of a secret is given, all entries of the secret are read.
+	// +optional
+	TrustedKeys []LocalSecretReference `json:"trustedKeys,omitempty"`
 }
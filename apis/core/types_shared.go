@@ -162,6 +162,8 @@ const (
 	ErrorForInfoOnly ErrorCode = "ERR_FOR_INFO_ONLY"
 	// ErrorNoRetry indicates that no retry is required.
 	ErrorNoRetry ErrorCode = "ERR_NO_RETRY"
+	// ErrorVerificationFailed indicates that the signature or a resource digest of a component version could not be verified.
+	ErrorVerificationFailed ErrorCode = "ERR_VERIFICATION_FAILED"
 )
 
 // Condition holds the information about the state of a resource.
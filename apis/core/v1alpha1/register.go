@@ -55,6 +55,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LsHealthCheckList{},
 		&ComponentVersionOverwrites{},
 		&ComponentVersionOverwritesList{},
+		&ComponentPrefetch{},
+		&ComponentPrefetchList{},
 		&SyncObject{},
 		&SyncObjectList{},
 		&TargetSync{},
@@ -174,6 +174,12 @@ const GOTemplateType TemplateType = "GoTemplate"
 // SpiffTemplateType describes the spiff templating type.
 const SpiffTemplateType TemplateType = "Spiff"
 
+// CUETemplateType describes the cue templating type.
+const CUETemplateType TemplateType = "CUE"
+
+// JsonnetTemplateType describes the jsonnet templating type.
+const JsonnetTemplateType TemplateType = "Jsonnet"
+
 // TemplateExecutor describes a templating mechanism and configuration.
 type TemplateExecutor struct {
 	// Name is the unique name of the template
@@ -188,6 +194,18 @@ type TemplateExecutor struct {
 	// and either a string or valid yaml/json for spiff.
 	// + optional
 	Template AnyJSON `json:"template,omitempty"`
+	// Libraries references template libraries whose named templates and stubs are made available to the template.
+	// +optional
+	Libraries []TemplateLibraryReference `json:"libraries,omitempty"`
+}
+
+// TemplateLibraryReference references a template library that is defined as a resource of a component.
+type TemplateLibraryReference struct {
+	// Name is the unique name of the library in the template executor.
+	Name string `json:"name"`
+	// Ref references the library resource in the component descriptor of the blueprint,
+	// e.g. "cd://resources/my-library" or "cd://componentReferences/lib/resources/my-library".
+	Ref string `json:"ref"`
 }
 
 // SubinstallationTemplateList is a list of installation templates
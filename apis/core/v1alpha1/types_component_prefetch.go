@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ComponentPrefetchList contains a list of ComponentPrefetch objects
+type ComponentPrefetchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComponentPrefetch `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=cpf
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Prefetched",type="integer",JSONPath=".status.prefetched"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failed"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ComponentPrefetch defines component versions that are loaded into the caches of the landscaper
+// together with their blueprints and helm charts.
+// The component versions are prefetched whenever the object changes and after every restart of the landscaper.
+type ComponentPrefetch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification
+	Spec ComponentPrefetchSpec `json:"spec"`
+
+	// Status contains the status
+	// +optional
+	Status ComponentPrefetchStatus `json:"status"`
+}
+
+// ComponentPrefetchSpec contains the specification for a ComponentPrefetch.
+type ComponentPrefetchSpec struct {
+	// Context is the name of the context in the namespace of the ComponentPrefetch object
+	// that is used to resolve the listed components.
+	// It defines the repository context, the registry pull secrets and whether OCM is used.
+	// Defaults to the default context.
+	// +optional
+	Context string `json:"context,omitempty"`
+
+	// Components lists the component versions that are prefetched.
+	// If the repository context of a component is not set, the repository context of the context is used.
+	// +optional
+	Components []ComponentDescriptorReference `json:"components,omitempty"`
+
+	// Installations defines whether the component versions of all installations in the namespace of the
+	// ComponentPrefetch object are prefetched. These component versions are resolved with the context of the
+	// respective installation.
+	// +optional
+	Installations bool `json:"installations,omitempty"`
+
+	// IncludeReferences defines whether the component versions referenced by the prefetched component versions
+	// are prefetched transitively.
+	// +optional
+	IncludeReferences bool `json:"includeReferences,omitempty"`
+}
+
+// ComponentPrefetchPhase describes the phase of a ComponentPrefetch.
+type ComponentPrefetchPhase string
+
+const (
+	// ComponentPrefetchPhaseProgressing indicates that the component versions are currently prefetched.
+	ComponentPrefetchPhaseProgressing ComponentPrefetchPhase = "Progressing"
+	// ComponentPrefetchPhaseSucceeded indicates that all component versions have been prefetched.
+	ComponentPrefetchPhaseSucceeded ComponentPrefetchPhase = "Succeeded"
+	// ComponentPrefetchPhaseFailed indicates that at least one component version could not be prefetched.
+	ComponentPrefetchPhaseFailed ComponentPrefetchPhase = "Failed"
+)
+
+// ComponentPrefetchStatus contains the status of a ComponentPrefetch.
+type ComponentPrefetchStatus struct {
+	// ObservedGeneration is the most recent generation observed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Phase is the current phase of the prefetch.
+	// +optional
+	Phase ComponentPrefetchPhase `json:"phase,omitempty"`
+
+	// Total is the number of component versions that have been found so far.
+	// +optional
+	Total int `json:"total"`
+
+	// Prefetched is the number of component versions that have been prefetched successfully.
+	// +optional
+	Prefetched int `json:"prefetched"`
+
+	// Failed is the number of component versions that could not be prefetched.
+	// +optional
+	Failed int `json:"failed"`
+
+	// Components contains the state of at most 100 component versions. If there are more, failed component versions take precedence over prefetched ones.
+	// +optional
+	Components []PrefetchedComponent `json:"components,omitempty"`
+
+	// LastError describes the last error that prevented the component versions from being collected.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// Last time the status was updated
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// Last time a prefetch was completed
+	// +optional
+	LastCompletionTime *metav1.Time `json:"lastCompletionTime,omitempty"`
+}
+
+// PrefetchedComponent describes the state of a prefetched component version.
+type PrefetchedComponent struct {
+	// ComponentName is the name of the component.
+	ComponentName string `json:"componentName"`
+
+	// Version is the version of the component.
+	Version string `json:"version"`
+
+	// Prefetched is true if the component version and its resources have been prefetched successfully.
+	Prefetched bool `json:"prefetched"`
+
+	// Message describes why the component version could not be prefetched.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -25,6 +25,26 @@ const SubinstallationNameAnnotation = "landscaper.gardener.cloud/subinstallation
 // todo: keep only subinstallations?
 const KeepChildrenAnnotation = "landscaper.gardener.cloud/keep-children"
 
+// CaptureRenderedOutputAnnotation is the annotation that enables the capturing of the raw rendered output
+// of all template executions of an installation. If set to "true", the output of every execution is stored
+// (truncated) in the secret "<installation name>-rendered-output" in the namespace of the installation,
+// so that it can be inspected after the reconcile.
+const CaptureRenderedOutputAnnotation = "landscaper.gardener.cloud/capture-rendered-output"
+
+// RotateCredentialsAnnotation is the annotation that rotates credentials that are generated by template functions
+// like generatePassword, generateCA and generateCert. Its value is a comma-separated list of credential names or "*"
+// for all credentials of the installation. A credential is rotated once per value of the annotation.
+const RotateCredentialsAnnotation = "landscaper.gardener.cloud/rotate-credentials"
+
+// ApproveVersionUpgradeAnnotation is the annotation that approves the upgrade of an installation to the version that
+// has been proposed by its version policy. The value of the annotation has to match the proposed version.
+const ApproveVersionUpgradeAnnotation = "landscaper.gardener.cloud/approve-version-upgrade"
+
+// RollbackVersionUpgradeAnnotation is the annotation that rolls the component version of an installation back to the
+// version before the last automatic upgrade, if it is set to "true". The version that has been rolled back is not
+// selected again by the version policy.
+const RollbackVersionUpgradeAnnotation = "landscaper.gardener.cloud/rollback-version-upgrade"
+
 // EnsureSubInstallationsCondition is the Conditions type to indicate the sub installation status.
 const EnsureSubInstallationsCondition ConditionType = "EnsureSubInstallations"
 
@@ -185,6 +205,11 @@ type InstallationSpec struct {
 	// +optional
 	AutomaticReconcile *AutomaticReconcile `json:"automaticReconcile,omitempty"`
 
+	// VersionPolicy enables automatic upgrades of the version of the referenced component.
+	// It is only evaluated for installations that reference their component with a component descriptor reference.
+	// +optional
+	VersionPolicy *VersionPolicy `json:"versionPolicy,omitempty"`
+
 	// Optimization contains settings to improve execution performance.
 	// +optional
 	Optimization *Optimization `json:"optimization,omitempty"`
@@ -223,6 +248,55 @@ type FailedReconcile struct {
 	Interval *Duration `json:"interval,omitempty"`
 }
 
+// VersionPolicy configures automatic upgrades of the version of the component of an installation.
+type VersionPolicy struct {
+	// Constraint defines the versions the installation may be upgraded to.
+	// It is either a semantic version constraint, e.g. "~1.4" or ">= 1.4, < 2", or one of "latest", "latest-minor" and
+	// "latest-patch", which allow any newer version, newer versions with the same major version, and newer versions
+	// with the same major and minor version as the current version.
+	Constraint string `json:"constraint"`
+
+	// Mode defines whether the newest allowed version is applied to the installation or only proposed in its status.
+	// Proposed versions are applied when they are approved with the approve-version-upgrade annotation.
+	// Defaults to "Apply".
+	// +optional
+	Mode VersionPolicyMode `json:"mode,omitempty"`
+
+	// Schedule is a standard crontab specification of the times when the available versions are checked.
+	// Defaults to "0 * * * *", i.e. every hour.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// MaintenanceWindow restricts the times when versions are applied automatically.
+	// If not set, versions are applied whenever they are found.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// VersionPolicyMode defines how the versions selected by a version policy are handled.
+type VersionPolicyMode string
+
+const (
+	// VersionPolicyModeApply applies the selected versions to the installation.
+	VersionPolicyModeApply VersionPolicyMode = "Apply"
+	// VersionPolicyModePlan only proposes the selected versions in the status of the installation.
+	VersionPolicyModePlan VersionPolicyMode = "Plan"
+)
+
+// MaintenanceWindow is a daily time window.
+type MaintenanceWindow struct {
+	// Begin is the begin of the time window in the format "HH:MM", e.g. "22:00".
+	Begin string `json:"begin"`
+
+	// End is the end of the time window in the format "HH:MM", e.g. "04:00".
+	// A time window ends on the next day if the end is before the begin.
+	End string `json:"end"`
+
+	// Location is the name of the time zone of the begin and end, e.g. "Europe/Berlin". Defaults to "UTC".
+	// +optional
+	Location string `json:"location,omitempty"`
+}
+
 // InstallationStatus contains the current status of a Installation.
 type InstallationStatus struct {
 	// ObservedGeneration is the most recent generation observed for this ControllerInstallations.
@@ -262,6 +336,10 @@ type InstallationStatus struct {
 	// +optional
 	AutomaticReconcileStatus *AutomaticReconcileStatus `json:"automaticReconcileStatus,omitempty"`
 
+	// VersionUpgradeStatus describes the automatic upgrades of the component version.
+	// +optional
+	VersionUpgradeStatus *VersionUpgradeStatus `json:"versionUpgradeStatus,omitempty"`
+
 	// DependentsToTrigger lists dependent installations to be triggered
 	// +optional
 	DependentsToTrigger []DependentToTrigger `json:"dependentsToTrigger,omitempty"`
@@ -276,6 +354,35 @@ type DependentToTrigger struct {
 	Name string `json:"name,omitempty"`
 }
 
+// VersionUpgradeStatus describes the automatic upgrades of the component version of an installation.
+type VersionUpgradeStatus struct {
+	// LastCheckTime is the time when the available versions were checked the last time.
+	// +optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+
+	// ProposedVersion is the newest version allowed by the version policy that has not been applied yet,
+	// because the policy only proposes versions or because the maintenance window has not been reached.
+	// +optional
+	ProposedVersion string `json:"proposedVersion,omitempty"`
+
+	// PreviousVersion is the version before the last automatic upgrade.
+	// It is used to roll the upgrade back with the rollback-version-upgrade annotation.
+	// +optional
+	PreviousVersion string `json:"previousVersion,omitempty"`
+
+	// LastUpgradeTime is the time of the last automatic upgrade.
+	// +optional
+	LastUpgradeTime *metav1.Time `json:"lastUpgradeTime,omitempty"`
+
+	// RejectedVersion is the version that has been rolled back. It is not selected again by the version policy.
+	// +optional
+	RejectedVersion string `json:"rejectedVersion,omitempty"`
+
+	// LastError describes the last error that prevented the available versions from being checked.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+}
+
 // AutomaticReconcileStatus describes the status of automatically triggered reconciles.
 type AutomaticReconcileStatus struct {
 	// Generation describes the generation of the installation for which the status holds.
@@ -30,6 +30,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AffectedInstallation)(nil), (*core.AffectedInstallation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AffectedInstallation_To_core_AffectedInstallation(a.(*AffectedInstallation), b.(*core.AffectedInstallation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AffectedInstallation)(nil), (*AffectedInstallation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AffectedInstallation_To_v1alpha1_AffectedInstallation(a.(*core.AffectedInstallation), b.(*AffectedInstallation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AnyJSON)(nil), (*core.AnyJSON)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AnyJSON_To_core_AnyJSON(a.(*AnyJSON), b.(*core.AnyJSON), scope)
 	}); err != nil {
@@ -120,6 +130,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ComponentPrefetch)(nil), (*core.ComponentPrefetch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentPrefetch_To_core_ComponentPrefetch(a.(*ComponentPrefetch), b.(*core.ComponentPrefetch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ComponentPrefetch)(nil), (*ComponentPrefetch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ComponentPrefetch_To_v1alpha1_ComponentPrefetch(a.(*core.ComponentPrefetch), b.(*ComponentPrefetch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComponentPrefetchList)(nil), (*core.ComponentPrefetchList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentPrefetchList_To_core_ComponentPrefetchList(a.(*ComponentPrefetchList), b.(*core.ComponentPrefetchList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ComponentPrefetchList)(nil), (*ComponentPrefetchList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ComponentPrefetchList_To_v1alpha1_ComponentPrefetchList(a.(*core.ComponentPrefetchList), b.(*ComponentPrefetchList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComponentPrefetchSpec)(nil), (*core.ComponentPrefetchSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentPrefetchSpec_To_core_ComponentPrefetchSpec(a.(*ComponentPrefetchSpec), b.(*core.ComponentPrefetchSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ComponentPrefetchSpec)(nil), (*ComponentPrefetchSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ComponentPrefetchSpec_To_v1alpha1_ComponentPrefetchSpec(a.(*core.ComponentPrefetchSpec), b.(*ComponentPrefetchSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComponentPrefetchStatus)(nil), (*core.ComponentPrefetchStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentPrefetchStatus_To_core_ComponentPrefetchStatus(a.(*ComponentPrefetchStatus), b.(*core.ComponentPrefetchStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ComponentPrefetchStatus)(nil), (*ComponentPrefetchStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ComponentPrefetchStatus_To_v1alpha1_ComponentPrefetchStatus(a.(*core.ComponentPrefetchStatus), b.(*ComponentPrefetchStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComponentVerification)(nil), (*core.ComponentVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentVerification_To_core_ComponentVerification(a.(*ComponentVerification), b.(*core.ComponentVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ComponentVerification)(nil), (*ComponentVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ComponentVerification_To_v1alpha1_ComponentVerification(a.(*core.ComponentVerification), b.(*ComponentVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComponentVerificationPolicy)(nil), (*core.ComponentVerificationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentVerificationPolicy_To_core_ComponentVerificationPolicy(a.(*ComponentVerificationPolicy), b.(*core.ComponentVerificationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ComponentVerificationPolicy)(nil), (*ComponentVerificationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ComponentVerificationPolicy_To_v1alpha1_ComponentVerificationPolicy(a.(*core.ComponentVerificationPolicy), b.(*ComponentVerificationPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ComponentVersionOverwrite)(nil), (*core.ComponentVersionOverwrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ComponentVersionOverwrite_To_core_ComponentVersionOverwrite(a.(*ComponentVersionOverwrite), b.(*core.ComponentVersionOverwrite), scope)
 	}); err != nil {
@@ -160,6 +230,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ComponentVersionOverwritesStatus)(nil), (*core.ComponentVersionOverwritesStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus(a.(*ComponentVersionOverwritesStatus), b.(*core.ComponentVersionOverwritesStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ComponentVersionOverwritesStatus)(nil), (*ComponentVersionOverwritesStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus(a.(*core.ComponentVersionOverwritesStatus), b.(*ComponentVersionOverwritesStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Condition)(nil), (*core.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Condition_To_core_Condition(a.(*Condition), b.(*core.Condition), scope)
 	}); err != nil {
@@ -580,6 +660,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindow)(nil), (*core.MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(a.(*MaintenanceWindow), b.(*core.MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceWindow)(nil), (*MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(a.(*core.MaintenanceWindow), b.(*MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamedObjectReference)(nil), (*core.NamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(a.(*NamedObjectReference), b.(*core.NamedObjectReference), scope)
 	}); err != nil {
@@ -620,6 +710,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PrefetchedComponent)(nil), (*core.PrefetchedComponent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrefetchedComponent_To_core_PrefetchedComponent(a.(*PrefetchedComponent), b.(*core.PrefetchedComponent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PrefetchedComponent)(nil), (*PrefetchedComponent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PrefetchedComponent_To_v1alpha1_PrefetchedComponent(a.(*core.PrefetchedComponent), b.(*PrefetchedComponent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistryCredential)(nil), (*core.RegistryCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RegistryCredential_To_core_RegistryCredential(a.(*RegistryCredential), b.(*core.RegistryCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RegistryCredential)(nil), (*RegistryCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RegistryCredential_To_v1alpha1_RegistryCredential(a.(*core.RegistryCredential), b.(*RegistryCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistryTokenSource)(nil), (*core.RegistryTokenSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RegistryTokenSource_To_core_RegistryTokenSource(a.(*RegistryTokenSource), b.(*core.RegistryTokenSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RegistryTokenSource)(nil), (*RegistryTokenSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RegistryTokenSource_To_v1alpha1_RegistryTokenSource(a.(*core.RegistryTokenSource), b.(*RegistryTokenSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteBlueprintReference)(nil), (*core.RemoteBlueprintReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(a.(*RemoteBlueprintReference), b.(*core.RemoteBlueprintReference), scope)
 	}); err != nil {
@@ -900,6 +1020,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TemplateLibraryReference)(nil), (*core.TemplateLibraryReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TemplateLibraryReference_To_core_TemplateLibraryReference(a.(*TemplateLibraryReference), b.(*core.TemplateLibraryReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TemplateLibraryReference)(nil), (*TemplateLibraryReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TemplateLibraryReference_To_v1alpha1_TemplateLibraryReference(a.(*core.TemplateLibraryReference), b.(*TemplateLibraryReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TokenRotation)(nil), (*core.TokenRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TokenRotation_To_core_TokenRotation(a.(*TokenRotation), b.(*core.TokenRotation), scope)
 	}); err != nil {
@@ -930,6 +1060,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VersionPolicy)(nil), (*core.VersionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VersionPolicy_To_core_VersionPolicy(a.(*VersionPolicy), b.(*core.VersionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionPolicy)(nil), (*VersionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionPolicy_To_v1alpha1_VersionPolicy(a.(*core.VersionPolicy), b.(*VersionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionUpgradeStatus)(nil), (*core.VersionUpgradeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VersionUpgradeStatus_To_core_VersionUpgradeStatus(a.(*VersionUpgradeStatus), b.(*core.VersionUpgradeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionUpgradeStatus)(nil), (*VersionUpgradeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionUpgradeStatus_To_v1alpha1_VersionUpgradeStatus(a.(*core.VersionUpgradeStatus), b.(*VersionUpgradeStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VersionedNamedObjectReference)(nil), (*core.VersionedNamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VersionedNamedObjectReference_To_core_VersionedNamedObjectReference(a.(*VersionedNamedObjectReference), b.(*core.VersionedNamedObjectReference), scope)
 	}); err != nil {
@@ -983,6 +1133,38 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_AffectedInstallation_To_core_AffectedInstallation(in *AffectedInstallation, out *core.AffectedInstallation, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Context = in.Context
+	out.ComponentName = in.ComponentName
+	out.Version = in.Version
+	if err := Convert_v1alpha1_ComponentDescriptorReference_To_core_ComponentDescriptorReference(&in.Substitution, &out.Substitution, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_AffectedInstallation_To_core_AffectedInstallation is an autogenerated conversion function.
+func Convert_v1alpha1_AffectedInstallation_To_core_AffectedInstallation(in *AffectedInstallation, out *core.AffectedInstallation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AffectedInstallation_To_core_AffectedInstallation(in, out, s)
+}
+
+func autoConvert_core_AffectedInstallation_To_v1alpha1_AffectedInstallation(in *core.AffectedInstallation, out *AffectedInstallation, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Context = in.Context
+	out.ComponentName = in.ComponentName
+	out.Version = in.Version
+	if err := Convert_core_ComponentDescriptorReference_To_v1alpha1_ComponentDescriptorReference(&in.Substitution, &out.Substitution, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_AffectedInstallation_To_v1alpha1_AffectedInstallation is an autogenerated conversion function.
+func Convert_core_AffectedInstallation_To_v1alpha1_AffectedInstallation(in *core.AffectedInstallation, out *AffectedInstallation, s conversion.Scope) error {
+	return autoConvert_core_AffectedInstallation_To_v1alpha1_AffectedInstallation(in, out, s)
+}
+
 func autoConvert_v1alpha1_AnyJSON_To_core_AnyJSON(in *AnyJSON, out *core.AnyJSON, s conversion.Scope) error {
 	out.RawMessage = *(*json.RawMessage)(unsafe.Pointer(&in.RawMessage))
 	return nil
@@ -1203,6 +1385,168 @@ func Convert_core_ComponentDescriptorReference_To_v1alpha1_ComponentDescriptorRe
 	return autoConvert_core_ComponentDescriptorReference_To_v1alpha1_ComponentDescriptorReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_ComponentPrefetch_To_core_ComponentPrefetch(in *ComponentPrefetch, out *core.ComponentPrefetch, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ComponentPrefetchSpec_To_core_ComponentPrefetchSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ComponentPrefetchStatus_To_core_ComponentPrefetchStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ComponentPrefetch_To_core_ComponentPrefetch is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentPrefetch_To_core_ComponentPrefetch(in *ComponentPrefetch, out *core.ComponentPrefetch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentPrefetch_To_core_ComponentPrefetch(in, out, s)
+}
+
+func autoConvert_core_ComponentPrefetch_To_v1alpha1_ComponentPrefetch(in *core.ComponentPrefetch, out *ComponentPrefetch, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ComponentPrefetchSpec_To_v1alpha1_ComponentPrefetchSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_ComponentPrefetchStatus_To_v1alpha1_ComponentPrefetchStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ComponentPrefetch_To_v1alpha1_ComponentPrefetch is an autogenerated conversion function.
+func Convert_core_ComponentPrefetch_To_v1alpha1_ComponentPrefetch(in *core.ComponentPrefetch, out *ComponentPrefetch, s conversion.Scope) error {
+	return autoConvert_core_ComponentPrefetch_To_v1alpha1_ComponentPrefetch(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComponentPrefetchList_To_core_ComponentPrefetchList(in *ComponentPrefetchList, out *core.ComponentPrefetchList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.ComponentPrefetch)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ComponentPrefetchList_To_core_ComponentPrefetchList is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentPrefetchList_To_core_ComponentPrefetchList(in *ComponentPrefetchList, out *core.ComponentPrefetchList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentPrefetchList_To_core_ComponentPrefetchList(in, out, s)
+}
+
+func autoConvert_core_ComponentPrefetchList_To_v1alpha1_ComponentPrefetchList(in *core.ComponentPrefetchList, out *ComponentPrefetchList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ComponentPrefetch)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_ComponentPrefetchList_To_v1alpha1_ComponentPrefetchList is an autogenerated conversion function.
+func Convert_core_ComponentPrefetchList_To_v1alpha1_ComponentPrefetchList(in *core.ComponentPrefetchList, out *ComponentPrefetchList, s conversion.Scope) error {
+	return autoConvert_core_ComponentPrefetchList_To_v1alpha1_ComponentPrefetchList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComponentPrefetchSpec_To_core_ComponentPrefetchSpec(in *ComponentPrefetchSpec, out *core.ComponentPrefetchSpec, s conversion.Scope) error {
+	out.Context = in.Context
+	out.Components = *(*[]core.ComponentDescriptorReference)(unsafe.Pointer(&in.Components))
+	out.Installations = in.Installations
+	out.IncludeReferences = in.IncludeReferences
+	return nil
+}
+
+// Convert_v1alpha1_ComponentPrefetchSpec_To_core_ComponentPrefetchSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentPrefetchSpec_To_core_ComponentPrefetchSpec(in *ComponentPrefetchSpec, out *core.ComponentPrefetchSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentPrefetchSpec_To_core_ComponentPrefetchSpec(in, out, s)
+}
+
+func autoConvert_core_ComponentPrefetchSpec_To_v1alpha1_ComponentPrefetchSpec(in *core.ComponentPrefetchSpec, out *ComponentPrefetchSpec, s conversion.Scope) error {
+	out.Context = in.Context
+	out.Components = *(*[]ComponentDescriptorReference)(unsafe.Pointer(&in.Components))
+	out.Installations = in.Installations
+	out.IncludeReferences = in.IncludeReferences
+	return nil
+}
+
+// Convert_core_ComponentPrefetchSpec_To_v1alpha1_ComponentPrefetchSpec is an autogenerated conversion function.
+func Convert_core_ComponentPrefetchSpec_To_v1alpha1_ComponentPrefetchSpec(in *core.ComponentPrefetchSpec, out *ComponentPrefetchSpec, s conversion.Scope) error {
+	return autoConvert_core_ComponentPrefetchSpec_To_v1alpha1_ComponentPrefetchSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComponentPrefetchStatus_To_core_ComponentPrefetchStatus(in *ComponentPrefetchStatus, out *core.ComponentPrefetchStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = core.ComponentPrefetchPhase(in.Phase)
+	out.Total = in.Total
+	out.Prefetched = in.Prefetched
+	out.Failed = in.Failed
+	out.Components = *(*[]core.PrefetchedComponent)(unsafe.Pointer(&in.Components))
+	out.LastError = in.LastError
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_v1alpha1_ComponentPrefetchStatus_To_core_ComponentPrefetchStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentPrefetchStatus_To_core_ComponentPrefetchStatus(in *ComponentPrefetchStatus, out *core.ComponentPrefetchStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentPrefetchStatus_To_core_ComponentPrefetchStatus(in, out, s)
+}
+
+func autoConvert_core_ComponentPrefetchStatus_To_v1alpha1_ComponentPrefetchStatus(in *core.ComponentPrefetchStatus, out *ComponentPrefetchStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = ComponentPrefetchPhase(in.Phase)
+	out.Total = in.Total
+	out.Prefetched = in.Prefetched
+	out.Failed = in.Failed
+	out.Components = *(*[]PrefetchedComponent)(unsafe.Pointer(&in.Components))
+	out.LastError = in.LastError
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_core_ComponentPrefetchStatus_To_v1alpha1_ComponentPrefetchStatus is an autogenerated conversion function.
+func Convert_core_ComponentPrefetchStatus_To_v1alpha1_ComponentPrefetchStatus(in *core.ComponentPrefetchStatus, out *ComponentPrefetchStatus, s conversion.Scope) error {
+	return autoConvert_core_ComponentPrefetchStatus_To_v1alpha1_ComponentPrefetchStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComponentVerification_To_core_ComponentVerification(in *ComponentVerification, out *core.ComponentVerification, s conversion.Scope) error {
+	out.Policies = *(*[]core.ComponentVerificationPolicy)(unsafe.Pointer(&in.Policies))
+	return nil
+}
+
+// Convert_v1alpha1_ComponentVerification_To_core_ComponentVerification is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentVerification_To_core_ComponentVerification(in *ComponentVerification, out *core.ComponentVerification, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentVerification_To_core_ComponentVerification(in, out, s)
+}
+
+func autoConvert_core_ComponentVerification_To_v1alpha1_ComponentVerification(in *core.ComponentVerification, out *ComponentVerification, s conversion.Scope) error {
+	out.Policies = *(*[]ComponentVerificationPolicy)(unsafe.Pointer(&in.Policies))
+	return nil
+}
+
+// Convert_core_ComponentVerification_To_v1alpha1_ComponentVerification is an autogenerated conversion function.
+func Convert_core_ComponentVerification_To_v1alpha1_ComponentVerification(in *core.ComponentVerification, out *ComponentVerification, s conversion.Scope) error {
+	return autoConvert_core_ComponentVerification_To_v1alpha1_ComponentVerification(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComponentVerificationPolicy_To_core_ComponentVerificationPolicy(in *ComponentVerificationPolicy, out *core.ComponentVerificationPolicy, s conversion.Scope) error {
+	out.ComponentNamePattern = in.ComponentNamePattern
+	out.Mode = core.VerificationMode(in.Mode)
+	out.SignatureName = in.SignatureName
+	out.TrustedKeys = *(*[]core.LocalSecretReference)(unsafe.Pointer(&in.TrustedKeys))
+	return nil
+}
+
+// Convert_v1alpha1_ComponentVerificationPolicy_To_core_ComponentVerificationPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentVerificationPolicy_To_core_ComponentVerificationPolicy(in *ComponentVerificationPolicy, out *core.ComponentVerificationPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentVerificationPolicy_To_core_ComponentVerificationPolicy(in, out, s)
+}
+
+func autoConvert_core_ComponentVerificationPolicy_To_v1alpha1_ComponentVerificationPolicy(in *core.ComponentVerificationPolicy, out *ComponentVerificationPolicy, s conversion.Scope) error {
+	out.ComponentNamePattern = in.ComponentNamePattern
+	out.Mode = VerificationMode(in.Mode)
+	out.SignatureName = in.SignatureName
+	out.TrustedKeys = *(*[]LocalSecretReference)(unsafe.Pointer(&in.TrustedKeys))
+	return nil
+}
+
+// Convert_core_ComponentVerificationPolicy_To_v1alpha1_ComponentVerificationPolicy is an autogenerated conversion function.
+func Convert_core_ComponentVerificationPolicy_To_v1alpha1_ComponentVerificationPolicy(in *core.ComponentVerificationPolicy, out *ComponentVerificationPolicy, s conversion.Scope) error {
+	return autoConvert_core_ComponentVerificationPolicy_To_v1alpha1_ComponentVerificationPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_ComponentVersionOverwrite_To_core_ComponentVersionOverwrite(in *ComponentVersionOverwrite, out *core.ComponentVersionOverwrite, s conversion.Scope) error {
 	if err := Convert_v1alpha1_ComponentVersionOverwriteReference_To_core_ComponentVersionOverwriteReference(&in.Source, &out.Source, s); err != nil {
 		return err
@@ -1237,6 +1581,7 @@ func autoConvert_v1alpha1_ComponentVersionOverwriteReference_To_core_ComponentVe
 	out.RepositoryContext = (*v2.UnstructuredTypedObject)(unsafe.Pointer(in.RepositoryContext))
 	out.ComponentName = in.ComponentName
 	out.Version = in.Version
+	out.VersionConstraint = in.VersionConstraint
 	return nil
 }
 
@@ -1249,6 +1594,7 @@ func autoConvert_core_ComponentVersionOverwriteReference_To_v1alpha1_ComponentVe
 	out.RepositoryContext = (*v2.UnstructuredTypedObject)(unsafe.Pointer(in.RepositoryContext))
 	out.ComponentName = in.ComponentName
 	out.Version = in.Version
+	out.VersionConstraint = in.VersionConstraint
 	return nil
 }
 
@@ -1260,6 +1606,9 @@ func Convert_core_ComponentVersionOverwriteReference_To_v1alpha1_ComponentVersio
 func autoConvert_v1alpha1_ComponentVersionOverwrites_To_core_ComponentVersionOverwrites(in *ComponentVersionOverwrites, out *core.ComponentVersionOverwrites, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Overwrites = *(*core.ComponentVersionOverwriteList)(unsafe.Pointer(&in.Overwrites))
+	if err := Convert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1271,6 +1620,9 @@ func Convert_v1alpha1_ComponentVersionOverwrites_To_core_ComponentVersionOverwri
 func autoConvert_core_ComponentVersionOverwrites_To_v1alpha1_ComponentVersionOverwrites(in *core.ComponentVersionOverwrites, out *ComponentVersionOverwrites, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Overwrites = *(*ComponentVersionOverwriteList)(unsafe.Pointer(&in.Overwrites))
+	if err := Convert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1301,6 +1653,32 @@ func Convert_core_ComponentVersionOverwritesList_To_v1alpha1_ComponentVersionOve
 	return autoConvert_core_ComponentVersionOverwritesList_To_v1alpha1_ComponentVersionOverwritesList(in, out, s)
 }
 
+func autoConvert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus(in *ComponentVersionOverwritesStatus, out *core.ComponentVersionOverwritesStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.AffectedInstallations = *(*[]core.AffectedInstallation)(unsafe.Pointer(&in.AffectedInstallations))
+	out.InvalidOverwrites = *(*[]string)(unsafe.Pointer(&in.InvalidOverwrites))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus(in *ComponentVersionOverwritesStatus, out *core.ComponentVersionOverwritesStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus(in, out, s)
+}
+
+func autoConvert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus(in *core.ComponentVersionOverwritesStatus, out *ComponentVersionOverwritesStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.AffectedInstallations = *(*[]AffectedInstallation)(unsafe.Pointer(&in.AffectedInstallations))
+	out.InvalidOverwrites = *(*[]string)(unsafe.Pointer(&in.InvalidOverwrites))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus is an autogenerated conversion function.
+func Convert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus(in *core.ComponentVersionOverwritesStatus, out *ComponentVersionOverwritesStatus, s conversion.Scope) error {
+	return autoConvert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Condition_To_core_Condition(in *Condition, out *core.Condition, s conversion.Scope) error {
 	out.Type = core.ConditionType(in.Type)
 	out.Status = core.ConditionStatus(in.Status)
@@ -1389,8 +1767,10 @@ func autoConvert_v1alpha1_ContextConfiguration_To_core_ContextConfiguration(in *
 	out.RepositoryContext = (*v2.UnstructuredTypedObject)(unsafe.Pointer(in.RepositoryContext))
 	out.UseOCM = in.UseOCM
 	out.RegistryPullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
+	out.RegistryCredentials = *(*[]core.RegistryCredential)(unsafe.Pointer(&in.RegistryCredentials))
 	out.Configurations = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
+	out.Verification = (*core.ComponentVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -1403,8 +1783,10 @@ func autoConvert_core_ContextConfiguration_To_v1alpha1_ContextConfiguration(in *
 	out.RepositoryContext = (*v2.UnstructuredTypedObject)(unsafe.Pointer(in.RepositoryContext))
 	out.UseOCM = in.UseOCM
 	out.RegistryPullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
+	out.RegistryCredentials = *(*[]RegistryCredential)(unsafe.Pointer(&in.RegistryCredentials))
 	out.Configurations = *(*map[string]AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
+	out.Verification = (*ComponentVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -2240,6 +2622,7 @@ func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *Installa
 	}
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*core.AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
+	out.VersionPolicy = (*core.VersionPolicy)(unsafe.Pointer(in.VersionPolicy))
 	out.Optimization = (*core.Optimization)(unsafe.Pointer(in.Optimization))
 	return nil
 }
@@ -2264,6 +2647,7 @@ func autoConvert_core_InstallationSpec_To_v1alpha1_InstallationSpec(in *core.Ins
 	}
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
+	out.VersionPolicy = (*VersionPolicy)(unsafe.Pointer(in.VersionPolicy))
 	out.Optimization = (*Optimization)(unsafe.Pointer(in.Optimization))
 	return nil
 }
@@ -2285,6 +2669,7 @@ func autoConvert_v1alpha1_InstallationStatus_To_core_InstallationStatus(in *Inst
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*core.AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
+	out.VersionUpgradeStatus = (*core.VersionUpgradeStatus)(unsafe.Pointer(in.VersionUpgradeStatus))
 	out.DependentsToTrigger = *(*[]core.DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
 	return nil
@@ -2307,6 +2692,7 @@ func autoConvert_core_InstallationStatus_To_v1alpha1_InstallationStatus(in *core
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
+	out.VersionUpgradeStatus = (*VersionUpgradeStatus)(unsafe.Pointer(in.VersionUpgradeStatus))
 	out.DependentsToTrigger = *(*[]DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
 	return nil
@@ -2499,6 +2885,30 @@ func Convert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in *core.LsHea
 	return autoConvert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in, out, s)
 }
 
+func autoConvert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in *MaintenanceWindow, out *core.MaintenanceWindow, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Location = in.Location
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in *MaintenanceWindow, out *core.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *core.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Location = in.Location
+	return nil
+}
+
+// Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow is an autogenerated conversion function.
+func Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *core.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in, out, s)
+}
+
 func autoConvert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(in *NamedObjectReference, out *core.NamedObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_ObjectReference_To_core_ObjectReference(&in.Reference, &out.Reference, s); err != nil {
@@ -2589,6 +2999,80 @@ func Convert_core_Optimization_To_v1alpha1_Optimization(in *core.Optimization, o
 	return autoConvert_core_Optimization_To_v1alpha1_Optimization(in, out, s)
 }
 
+func autoConvert_v1alpha1_PrefetchedComponent_To_core_PrefetchedComponent(in *PrefetchedComponent, out *core.PrefetchedComponent, s conversion.Scope) error {
+	out.ComponentName = in.ComponentName
+	out.Version = in.Version
+	out.Prefetched = in.Prefetched
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_PrefetchedComponent_To_core_PrefetchedComponent is an autogenerated conversion function.
+func Convert_v1alpha1_PrefetchedComponent_To_core_PrefetchedComponent(in *PrefetchedComponent, out *core.PrefetchedComponent, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrefetchedComponent_To_core_PrefetchedComponent(in, out, s)
+}
+
+func autoConvert_core_PrefetchedComponent_To_v1alpha1_PrefetchedComponent(in *core.PrefetchedComponent, out *PrefetchedComponent, s conversion.Scope) error {
+	out.ComponentName = in.ComponentName
+	out.Version = in.Version
+	out.Prefetched = in.Prefetched
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_core_PrefetchedComponent_To_v1alpha1_PrefetchedComponent is an autogenerated conversion function.
+func Convert_core_PrefetchedComponent_To_v1alpha1_PrefetchedComponent(in *core.PrefetchedComponent, out *PrefetchedComponent, s conversion.Scope) error {
+	return autoConvert_core_PrefetchedComponent_To_v1alpha1_PrefetchedComponent(in, out, s)
+}
+
+func autoConvert_v1alpha1_RegistryCredential_To_core_RegistryCredential(in *RegistryCredential, out *core.RegistryCredential, s conversion.Scope) error {
+	out.Registry = in.Registry
+	out.SecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	out.TokenSource = (*core.RegistryTokenSource)(unsafe.Pointer(in.TokenSource))
+	return nil
+}
+
+// Convert_v1alpha1_RegistryCredential_To_core_RegistryCredential is an autogenerated conversion function.
+func Convert_v1alpha1_RegistryCredential_To_core_RegistryCredential(in *RegistryCredential, out *core.RegistryCredential, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RegistryCredential_To_core_RegistryCredential(in, out, s)
+}
+
+func autoConvert_core_RegistryCredential_To_v1alpha1_RegistryCredential(in *core.RegistryCredential, out *RegistryCredential, s conversion.Scope) error {
+	out.Registry = in.Registry
+	out.SecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	out.TokenSource = (*RegistryTokenSource)(unsafe.Pointer(in.TokenSource))
+	return nil
+}
+
+// Convert_core_RegistryCredential_To_v1alpha1_RegistryCredential is an autogenerated conversion function.
+func Convert_core_RegistryCredential_To_v1alpha1_RegistryCredential(in *core.RegistryCredential, out *RegistryCredential, s conversion.Scope) error {
+	return autoConvert_core_RegistryCredential_To_v1alpha1_RegistryCredential(in, out, s)
+}
+
+func autoConvert_v1alpha1_RegistryTokenSource_To_core_RegistryTokenSource(in *RegistryTokenSource, out *core.RegistryTokenSource, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Config = (*core.AnyJSON)(unsafe.Pointer(in.Config))
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_RegistryTokenSource_To_core_RegistryTokenSource is an autogenerated conversion function.
+func Convert_v1alpha1_RegistryTokenSource_To_core_RegistryTokenSource(in *RegistryTokenSource, out *core.RegistryTokenSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RegistryTokenSource_To_core_RegistryTokenSource(in, out, s)
+}
+
+func autoConvert_core_RegistryTokenSource_To_v1alpha1_RegistryTokenSource(in *core.RegistryTokenSource, out *RegistryTokenSource, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Config = (*AnyJSON)(unsafe.Pointer(in.Config))
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_core_RegistryTokenSource_To_v1alpha1_RegistryTokenSource is an autogenerated conversion function.
+func Convert_core_RegistryTokenSource_To_v1alpha1_RegistryTokenSource(in *core.RegistryTokenSource, out *RegistryTokenSource, s conversion.Scope) error {
+	return autoConvert_core_RegistryTokenSource_To_v1alpha1_RegistryTokenSource(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(in *RemoteBlueprintReference, out *core.RemoteBlueprintReference, s conversion.Scope) error {
 	out.ResourceName = in.ResourceName
 	return nil
@@ -3258,6 +3742,7 @@ func autoConvert_v1alpha1_TemplateExecutor_To_core_TemplateExecutor(in *Template
 	if err := Convert_v1alpha1_AnyJSON_To_core_AnyJSON(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
+	out.Libraries = *(*[]core.TemplateLibraryReference)(unsafe.Pointer(&in.Libraries))
 	return nil
 }
 
@@ -3273,6 +3758,7 @@ func autoConvert_core_TemplateExecutor_To_v1alpha1_TemplateExecutor(in *core.Tem
 	if err := Convert_core_AnyJSON_To_v1alpha1_AnyJSON(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
+	out.Libraries = *(*[]TemplateLibraryReference)(unsafe.Pointer(&in.Libraries))
 	return nil
 }
 
@@ -3281,6 +3767,28 @@ func Convert_core_TemplateExecutor_To_v1alpha1_TemplateExecutor(in *core.Templat
 	return autoConvert_core_TemplateExecutor_To_v1alpha1_TemplateExecutor(in, out, s)
 }
 
+func autoConvert_v1alpha1_TemplateLibraryReference_To_core_TemplateLibraryReference(in *TemplateLibraryReference, out *core.TemplateLibraryReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Ref = in.Ref
+	return nil
+}
+
+// Convert_v1alpha1_TemplateLibraryReference_To_core_TemplateLibraryReference is an autogenerated conversion function.
+func Convert_v1alpha1_TemplateLibraryReference_To_core_TemplateLibraryReference(in *TemplateLibraryReference, out *core.TemplateLibraryReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TemplateLibraryReference_To_core_TemplateLibraryReference(in, out, s)
+}
+
+func autoConvert_core_TemplateLibraryReference_To_v1alpha1_TemplateLibraryReference(in *core.TemplateLibraryReference, out *TemplateLibraryReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Ref = in.Ref
+	return nil
+}
+
+// Convert_core_TemplateLibraryReference_To_v1alpha1_TemplateLibraryReference is an autogenerated conversion function.
+func Convert_core_TemplateLibraryReference_To_v1alpha1_TemplateLibraryReference(in *core.TemplateLibraryReference, out *TemplateLibraryReference, s conversion.Scope) error {
+	return autoConvert_core_TemplateLibraryReference_To_v1alpha1_TemplateLibraryReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_TokenRotation_To_core_TokenRotation(in *TokenRotation, out *core.TokenRotation, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -3355,6 +3863,62 @@ func Convert_core_TypedObjectReference_To_v1alpha1_TypedObjectReference(in *core
 	return autoConvert_core_TypedObjectReference_To_v1alpha1_TypedObjectReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_VersionPolicy_To_core_VersionPolicy(in *VersionPolicy, out *core.VersionPolicy, s conversion.Scope) error {
+	out.Constraint = in.Constraint
+	out.Mode = core.VersionPolicyMode(in.Mode)
+	out.Schedule = in.Schedule
+	out.MaintenanceWindow = (*core.MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	return nil
+}
+
+// Convert_v1alpha1_VersionPolicy_To_core_VersionPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_VersionPolicy_To_core_VersionPolicy(in *VersionPolicy, out *core.VersionPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VersionPolicy_To_core_VersionPolicy(in, out, s)
+}
+
+func autoConvert_core_VersionPolicy_To_v1alpha1_VersionPolicy(in *core.VersionPolicy, out *VersionPolicy, s conversion.Scope) error {
+	out.Constraint = in.Constraint
+	out.Mode = VersionPolicyMode(in.Mode)
+	out.Schedule = in.Schedule
+	out.MaintenanceWindow = (*MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	return nil
+}
+
+// Convert_core_VersionPolicy_To_v1alpha1_VersionPolicy is an autogenerated conversion function.
+func Convert_core_VersionPolicy_To_v1alpha1_VersionPolicy(in *core.VersionPolicy, out *VersionPolicy, s conversion.Scope) error {
+	return autoConvert_core_VersionPolicy_To_v1alpha1_VersionPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_VersionUpgradeStatus_To_core_VersionUpgradeStatus(in *VersionUpgradeStatus, out *core.VersionUpgradeStatus, s conversion.Scope) error {
+	out.LastCheckTime = (*metav1.Time)(unsafe.Pointer(in.LastCheckTime))
+	out.ProposedVersion = in.ProposedVersion
+	out.PreviousVersion = in.PreviousVersion
+	out.LastUpgradeTime = (*metav1.Time)(unsafe.Pointer(in.LastUpgradeTime))
+	out.RejectedVersion = in.RejectedVersion
+	out.LastError = in.LastError
+	return nil
+}
+
+// Convert_v1alpha1_VersionUpgradeStatus_To_core_VersionUpgradeStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VersionUpgradeStatus_To_core_VersionUpgradeStatus(in *VersionUpgradeStatus, out *core.VersionUpgradeStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VersionUpgradeStatus_To_core_VersionUpgradeStatus(in, out, s)
+}
+
+func autoConvert_core_VersionUpgradeStatus_To_v1alpha1_VersionUpgradeStatus(in *core.VersionUpgradeStatus, out *VersionUpgradeStatus, s conversion.Scope) error {
+	out.LastCheckTime = (*metav1.Time)(unsafe.Pointer(in.LastCheckTime))
+	out.ProposedVersion = in.ProposedVersion
+	out.PreviousVersion = in.PreviousVersion
+	out.LastUpgradeTime = (*metav1.Time)(unsafe.Pointer(in.LastUpgradeTime))
+	out.RejectedVersion = in.RejectedVersion
+	out.LastError = in.LastError
+	return nil
+}
+
+// Convert_core_VersionUpgradeStatus_To_v1alpha1_VersionUpgradeStatus is an autogenerated conversion function.
+func Convert_core_VersionUpgradeStatus_To_v1alpha1_VersionUpgradeStatus(in *core.VersionUpgradeStatus, out *VersionUpgradeStatus, s conversion.Scope) error {
+	return autoConvert_core_VersionUpgradeStatus_To_v1alpha1_VersionUpgradeStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_VersionedNamedObjectReference_To_core_VersionedNamedObjectReference(in *VersionedNamedObjectReference, out *core.VersionedNamedObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_VersionedObjectReference_To_core_VersionedObjectReference(&in.Reference, &out.Reference, s); err != nil {
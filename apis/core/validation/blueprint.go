@@ -225,6 +225,37 @@ func ValidateTemplateExecutorList(fldPath *field.Path, list []core.TemplateExecu
 			allErrs = append(allErrs, field.Duplicate(execPath, "duplicated executor name"))
 		}
 		names.Insert(exec.Name)
+
+		if exec.Type == core.CUETemplateType && len(exec.Libraries) != 0 {
+			allErrs = append(allErrs, field.Forbidden(execPath.Child("libraries"), "template libraries are not supported by CUE templates"))
+		}
+		allErrs = append(allErrs, ValidateTemplateLibraryReferences(execPath.Child("libraries"), exec.Libraries)...)
+	}
+	return allErrs
+}
+
+// ValidateTemplateLibraryReferences validates the library references of a template executor
+func ValidateTemplateLibraryReferences(fldPath *field.Path, libraries []core.TemplateLibraryReference) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.NewString()
+	for i, lib := range libraries {
+		libPath := fldPath.Index(i)
+		if len(lib.Name) == 0 {
+			allErrs = append(allErrs, field.Required(libPath.Child("name"), "name must be defined"))
+		} else {
+			libPath = libPath.Key(lib.Name)
+		}
+
+		if len(lib.Ref) == 0 {
+			allErrs = append(allErrs, field.Required(libPath.Child("ref"), "ref must be defined"))
+		} else if !strings.HasPrefix(lib.Ref, "cd://") {
+			allErrs = append(allErrs, field.Invalid(libPath.Child("ref"), lib.Ref, "ref must be a component descriptor reference of the form cd://<path>"))
+		}
+
+		if len(lib.Name) != 0 && names.Has(lib.Name) {
+			allErrs = append(allErrs, field.Duplicate(libPath, "duplicated library name"))
+		}
+		names.Insert(lib.Name)
 	}
 	return allErrs
 }
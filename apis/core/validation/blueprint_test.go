@@ -234,6 +234,48 @@ var _ = Describe("Blueprint", func() {
 				"Field": Equal("b[0][myname].type"),
 			}))))
 		})
+
+		It("should fail if a library reference is invalid", func() {
+			executor := core.TemplateExecutor{}
+			executor.Name = "myname"
+			executor.Type = "mytype"
+			executor.Libraries = []core.TemplateLibraryReference{
+				{Name: "lib", Ref: "cd://resources/lib"},
+				{Name: "lib", Ref: "resources/lib"},
+				{Ref: "cd://resources/lib"},
+			}
+
+			allErrs := validation.ValidateTemplateExecutorList(field.NewPath("b"), []core.TemplateExecutor{executor})
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("b[0][myname].libraries[1][lib].ref"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("b[0][myname].libraries[1][lib]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("b[0][myname].libraries[2].name"),
+				})),
+			))
+		})
+
+		It("should fail if a CUE template references libraries", func() {
+			executor := core.TemplateExecutor{}
+			executor.Name = "myname"
+			executor.Type = core.CUETemplateType
+			executor.Libraries = []core.TemplateLibraryReference{{Name: "lib", Ref: "cd://resources/lib"}}
+
+			allErrs := validation.ValidateTemplateExecutorList(field.NewPath("b"), []core.TemplateExecutor{executor})
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("b[0][myname].libraries"),
+				})),
+			))
+		})
 	})
 
 	Context("InstallationTemplate", func() {
@@ -6,7 +6,9 @@ package validation
 
 import (
 	"regexp"
+	"time"
 
+	"github.com/robfig/cron/v3"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -58,6 +60,54 @@ func ValidateInstallationSpec(spec *core.InstallationSpec, fldPath *field.Path)
 	allErrs = append(allErrs, ValidateInstallationBlueprint(spec.Blueprint, fldPath.Child("blueprint"))...)
 	allErrs = append(allErrs, ValidateInstallationComponentDescriptor(spec.ComponentDescriptor, fldPath.Child("componentDescriptor"))...)
 
+	if spec.VersionPolicy != nil {
+		allErrs = append(allErrs, ValidateInstallationVersionPolicy(spec.VersionPolicy, fldPath.Child("versionPolicy"))...)
+		if spec.ComponentDescriptor == nil || spec.ComponentDescriptor.Reference == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("versionPolicy"),
+				"a version policy requires a component descriptor reference"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateInstallationVersionPolicy validates the version policy of an Installation.
+// The semantic version constraint is validated when the policy is evaluated.
+func ValidateInstallationVersionPolicy(policy *core.VersionPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(policy.Constraint) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("constraint"), "a version constraint is required"))
+	}
+
+	switch policy.Mode {
+	case "", core.VersionPolicyModeApply, core.VersionPolicyModePlan:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), policy.Mode,
+			[]string{string(core.VersionPolicyModeApply), string(core.VersionPolicyModePlan)}))
+	}
+
+	if len(policy.Schedule) != 0 {
+		if _, err := cron.ParseStandard(policy.Schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), policy.Schedule, err.Error()))
+		}
+	}
+
+	if window := policy.MaintenanceWindow; window != nil {
+		windowPath := fldPath.Child("maintenanceWindow")
+		if _, err := time.Parse("15:04", window.Begin); err != nil {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("begin"), window.Begin, "must have the format HH:MM"))
+		}
+		if _, err := time.Parse("15:04", window.End); err != nil {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("end"), window.End, "must have the format HH:MM"))
+		}
+		if len(window.Location) != 0 {
+			if _, err := time.LoadLocation(window.Location); err != nil {
+				allErrs = append(allErrs, field.Invalid(windowPath.Child("location"), window.Location, err.Error()))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -203,6 +203,75 @@ var _ = Describe("Installation", func() {
 		})
 	})
 
+	Context("InstallationVersionPolicy", func() {
+		It("should accept a valid version policy", func() {
+			policy := &core.VersionPolicy{
+				Constraint: "~1.4",
+				Mode:       core.VersionPolicyModePlan,
+				Schedule:   "*/10 * * * *",
+				MaintenanceWindow: &core.MaintenanceWindow{
+					Begin:    "22:00",
+					End:      "04:00",
+					Location: "Europe/Berlin",
+				},
+			}
+
+			allErrs := validation.ValidateInstallationVersionPolicy(policy, field.NewPath("versionPolicy"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should reject an invalid version policy", func() {
+			policy := &core.VersionPolicy{
+				Mode:     "Always",
+				Schedule: "hourly",
+				MaintenanceWindow: &core.MaintenanceWindow{
+					Begin:    "10pm",
+					End:      "04:00",
+					Location: "Nowhere/Nowhere",
+				},
+			}
+
+			allErrs := validation.ValidateInstallationVersionPolicy(policy, field.NewPath("versionPolicy"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("versionPolicy.constraint"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("versionPolicy.mode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("versionPolicy.schedule"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("versionPolicy.maintenanceWindow.begin"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("versionPolicy.maintenanceWindow.location"),
+				})),
+			))
+		})
+
+		It("should reject a version policy for an installation without component descriptor reference", func() {
+			spec := &core.InstallationSpec{
+				Blueprint: core.BlueprintDefinition{
+					Inline: &core.InlineBlueprint{},
+				},
+				VersionPolicy: &core.VersionPolicy{Constraint: "latest"},
+			}
+
+			allErrs := validation.ValidateInstallationSpec(spec, field.NewPath("spec"))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.versionPolicy"),
+			}))))
+		})
+	})
+
 	Context("InstallationImports", func() {
 		It("should pass if imports are valid", func() {
 			imp := core.InstallationImports{
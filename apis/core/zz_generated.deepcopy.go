@@ -16,6 +16,23 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AffectedInstallation) DeepCopyInto(out *AffectedInstallation) {
+	*out = *in
+	in.Substitution.DeepCopyInto(&out.Substitution)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AffectedInstallation.
+func (in *AffectedInstallation) DeepCopy() *AffectedInstallation {
+	if in == nil {
+		return nil
+	}
+	out := new(AffectedInstallation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AnyJSON) DeepCopyInto(out *AnyJSON) {
 	*out = *in
@@ -278,6 +295,163 @@ func (in *ComponentDescriptorReference) DeepCopy() *ComponentDescriptorReference
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentPrefetch) DeepCopyInto(out *ComponentPrefetch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentPrefetch.
+func (in *ComponentPrefetch) DeepCopy() *ComponentPrefetch {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentPrefetch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentPrefetch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentPrefetchList) DeepCopyInto(out *ComponentPrefetchList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComponentPrefetch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentPrefetchList.
+func (in *ComponentPrefetchList) DeepCopy() *ComponentPrefetchList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentPrefetchList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentPrefetchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentPrefetchSpec) DeepCopyInto(out *ComponentPrefetchSpec) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentDescriptorReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentPrefetchSpec.
+func (in *ComponentPrefetchSpec) DeepCopy() *ComponentPrefetchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentPrefetchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentPrefetchStatus) DeepCopyInto(out *ComponentPrefetchStatus) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]PrefetchedComponent, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentPrefetchStatus.
+func (in *ComponentPrefetchStatus) DeepCopy() *ComponentPrefetchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentPrefetchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVerification) DeepCopyInto(out *ComponentVerification) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]ComponentVerificationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVerification.
+func (in *ComponentVerification) DeepCopy() *ComponentVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVerificationPolicy) DeepCopyInto(out *ComponentVerificationPolicy) {
+	*out = *in
+	if in.TrustedKeys != nil {
+		in, out := &in.TrustedKeys, &out.TrustedKeys
+		*out = make([]LocalSecretReference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVerificationPolicy.
+func (in *ComponentVerificationPolicy) DeepCopy() *ComponentVerificationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVerificationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentVersionOverwrite) DeepCopyInto(out *ComponentVersionOverwrite) {
 	*out = *in
@@ -350,6 +524,7 @@ func (in *ComponentVersionOverwrites) DeepCopyInto(out *ComponentVersionOverwrit
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -404,6 +579,38 @@ func (in *ComponentVersionOverwritesList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVersionOverwritesStatus) DeepCopyInto(out *ComponentVersionOverwritesStatus) {
+	*out = *in
+	if in.AffectedInstallations != nil {
+		in, out := &in.AffectedInstallations, &out.AffectedInstallations
+		*out = make([]AffectedInstallation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InvalidOverwrites != nil {
+		in, out := &in.InvalidOverwrites, &out.InvalidOverwrites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVersionOverwritesStatus.
+func (in *ComponentVersionOverwritesStatus) DeepCopy() *ComponentVersionOverwritesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVersionOverwritesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -483,6 +690,13 @@ func (in *ContextConfiguration) DeepCopyInto(out *ContextConfiguration) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.RegistryCredentials != nil {
+		in, out := &in.RegistryCredentials, &out.RegistryCredentials
+		*out = make([]RegistryCredential, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Configurations != nil {
 		in, out := &in.Configurations, &out.Configurations
 		*out = make(map[string]AnyJSON, len(*in))
@@ -490,6 +704,11 @@ func (in *ContextConfiguration) DeepCopyInto(out *ContextConfiguration) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ComponentVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1446,6 +1665,11 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(AutomaticReconcile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VersionPolicy != nil {
+		in, out := &in.VersionPolicy, &out.VersionPolicy
+		*out = new(VersionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Optimization != nil {
 		in, out := &in.Optimization, &out.Optimization
 		*out = new(Optimization)
@@ -1498,6 +1722,11 @@ func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
 		*out = new(AutomaticReconcileStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VersionUpgradeStatus != nil {
+		in, out := &in.VersionUpgradeStatus, &out.VersionUpgradeStatus
+		*out = new(VersionUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DependentsToTrigger != nil {
 		in, out := &in.DependentsToTrigger, &out.DependentsToTrigger
 		*out = make([]DependentToTrigger, len(*in))
@@ -1724,6 +1953,22 @@ func (in *LsHealthCheckList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamedObjectReference) DeepCopyInto(out *NamedObjectReference) {
 	*out = *in
@@ -1789,6 +2034,69 @@ func (in *Optimization) DeepCopy() *Optimization {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefetchedComponent) DeepCopyInto(out *PrefetchedComponent) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefetchedComponent.
+func (in *PrefetchedComponent) DeepCopy() *PrefetchedComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefetchedComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryCredential) DeepCopyInto(out *RegistryCredential) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.TokenSource != nil {
+		in, out := &in.TokenSource, &out.TokenSource
+		*out = new(RegistryTokenSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryCredential.
+func (in *RegistryCredential) DeepCopy() *RegistryCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryCredential)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryTokenSource) DeepCopyInto(out *RegistryTokenSource) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(AnyJSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryTokenSource.
+func (in *RegistryTokenSource) DeepCopy() *RegistryTokenSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryTokenSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteBlueprintReference) DeepCopyInto(out *RemoteBlueprintReference) {
 	*out = *in
@@ -2463,6 +2771,11 @@ func (in *TargetTemplate) DeepCopy() *TargetTemplate {
 func (in *TemplateExecutor) DeepCopyInto(out *TemplateExecutor) {
 	*out = *in
 	in.Template.DeepCopyInto(&out.Template)
+	if in.Libraries != nil {
+		in, out := &in.Libraries, &out.Libraries
+		*out = make([]TemplateLibraryReference, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2476,6 +2789,22 @@ func (in *TemplateExecutor) DeepCopy() *TemplateExecutor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateLibraryReference) DeepCopyInto(out *TemplateLibraryReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateLibraryReference.
+func (in *TemplateLibraryReference) DeepCopy() *TemplateLibraryReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateLibraryReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenRotation) DeepCopyInto(out *TokenRotation) {
 	*out = *in
@@ -2541,6 +2870,51 @@ func (in *TypedObjectReference) DeepCopy() *TypedObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionPolicy) DeepCopyInto(out *VersionPolicy) {
+	*out = *in
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionPolicy.
+func (in *VersionPolicy) DeepCopy() *VersionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionUpgradeStatus) DeepCopyInto(out *VersionUpgradeStatus) {
+	*out = *in
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUpgradeTime != nil {
+		in, out := &in.LastUpgradeTime, &out.LastUpgradeTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionUpgradeStatus.
+func (in *VersionUpgradeStatus) DeepCopy() *VersionUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VersionedNamedObjectReference) DeepCopyInto(out *VersionedNamedObjectReference) {
 	*out = *in
@@ -14,6 +14,10 @@ import (
 // Force deletion means that the delete container is skipped and all other resources are cleaned up.
 const ContainerDeployerOperationForceCleanupAnnotation = "container.deployer.landscaper.gardener.cloud/force-cleanup"
 
+// ContainerDeployerApprovePlanAnnotation is the name of the annotation that approves the plan of a deploy item
+// that requires a plan approval. The value of the annotation has to match the job id of the plan that is approved.
+const ContainerDeployerApprovePlanAnnotation = "container.deployer.landscaper.gardener.cloud/approve-plan"
+
 // ContainerDeployerFinalizer is the finalizer that is set by the container deployer
 const ContainerDeployerFinalizer = "container.deployer.landscaper.gardener.cloud/finalizer"
 
@@ -35,12 +39,37 @@ const ContainerDeployerDeployItemNamespaceLabel = "deployitem.container.deployer
 // ContainerDeployerDeployItemGenerationLabel is the name of the label that indicates the deploy item generation.
 const ContainerDeployerDeployItemGenerationLabel = "deployitem.container.deployer.landscaper.gardener.cloud/generation"
 
+// ContainerDeployerRunnerPoolLabel is the name of the label that identifies the runner pool of a runner pod.
+const ContainerDeployerRunnerPoolLabel = "runner.container.deployer.landscaper.gardener.cloud/pool"
+
+// ContainerDeployerRunnerStateLabel is the name of the label that indicates whether a runner pod is idle or busy.
+const ContainerDeployerRunnerStateLabel = "runner.container.deployer.landscaper.gardener.cloud/state"
+
+// ContainerDeployerRunnerRunAnnotation is the name of the annotation that contains the run that is dispatched to a runner pod.
+const ContainerDeployerRunnerRunAnnotation = "runner.container.deployer.landscaper.gardener.cloud/run"
+
+// ContainerDeployerRunnerRunStatusAnnotation is the name of the annotation that contains the status of the run
+// that is reported by the runner pod.
+const ContainerDeployerRunnerRunStatusAnnotation = "runner.container.deployer.landscaper.gardener.cloud/run-status"
+
 // InitContainerConditionType defines the condition for the current init container
 const InitContainerConditionType = "InitContainer"
 
 // WaitContainerConditionType defines the condition of the current wait container
 const WaitContainerConditionType = "WaitContainer"
 
+// PlanApprovalConditionType defines the condition of the plan approval of a deploy item that requires a plan approval.
+const PlanApprovalConditionType = "PlanApproval"
+
+// RunnerStateIdle is the value of the runner state label of a runner pod that can accept a new run.
+const RunnerStateIdle = "idle"
+
+// RunnerStateBusy is the value of the runner state label of a runner pod that executes a run.
+const RunnerStateBusy = "busy"
+
+// RunnerStateTerminating is the value of the runner state label of a runner pod that terminates because of its idle timeout.
+const RunnerStateTerminating = "terminating"
+
 // OperationName is the name of the env var that specifies the current operation that the image should execute
 const OperationName = "OPERATION"
 
@@ -53,6 +82,10 @@ const OperationReconcile OperationType = "RECONCILE"
 // OperationDelete is the value of the Operation env var that defines a delete operation.
 const OperationDelete OperationType = "DELETE"
 
+// OperationPlan is the value of the Operation env var that defines a plan operation.
+// A plan operation should only compute the changes of a reconcile and write them to the plan file.
+const OperationPlan OperationType = "PLAN"
+
 // BasePath is the base path inside a container that contains the container deployer specific data.
 const BasePath = "/data/ls"
 
@@ -74,6 +107,12 @@ const ExportsPathName = "EXPORTS_PATH"
 // ExportsPath is the path to the export file.
 var ExportsPath = filepath.Join(SharedBasePath, "exports", "values")
 
+// PlanPathName is the name of the env var that points to the plan file.
+const PlanPathName = "PLAN_PATH"
+
+// PlanPath is the path to the plan file.
+var PlanPath = filepath.Join(SharedBasePath, "plan", "plan")
+
 // ComponentDescriptorPathName is the name of the env var that points to the component descriptor.
 const ComponentDescriptorPathName = "COMPONENT_DESCRIPTOR_PATH"
 
@@ -118,6 +157,16 @@ var RegistrySecretBasePath = filepath.Join(BasePath, "registry_secrets")
 
 var UseOCMName = "USE_OCM"
 
+// RunnerBinaryPath is the path of the runner binary that is installed into the main container of a runner pod.
+var RunnerBinaryPath = filepath.Join(BasePath, "runner", "runner")
+
+// RunnerHandoverPath is the path of the directory through which the wait container of a runner pod
+// hands over the commands of its runs to the main container.
+var RunnerHandoverPath = filepath.Join(BasePath, "runner-handover")
+
+// RunnerIdleTimeoutName is the name of the env var that contains the idle timeout of a runner pod.
+const RunnerIdleTimeoutName = "RUNNER_IDLE_TIMEOUT"
+
 // RegistrySecretBasePathName is the environment variable pointing to the file system location of all OCI pull secrets
 const RegistrySecretBasePathName = "REGISTRY_SECRETS_DIR"
 
@@ -160,6 +209,10 @@ var (
 			Name:  ExportsPathName,
 			Value: ExportsPath,
 		},
+		{
+			Name:  PlanPathName,
+			Value: PlanPath,
+		},
 		{
 			Name:  ComponentDescriptorPathName,
 			Value: ComponentDescriptorPath,
@@ -7,6 +7,7 @@ package container
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 
@@ -56,6 +57,18 @@ type Configuration struct {
 	// HPAConfiguration contains the configuration for horizontal pod autoscaling.
 	HPAConfiguration *HPAConfiguration `json:"hpa,omitempty"`
 
+	// RunnerPool configures the optional runner pool mode.
+	// If configured, deploy items are dispatched to long-lived runner pods of their image
+	// instead of creating a new pod for every run.
+	// +optional
+	RunnerPool *RunnerPoolConfiguration `json:"runnerPool,omitempty"`
+
+	// NamespaceIsolation configures the optional namespace isolation.
+	// If configured, the pods of every deploy item are executed in a dedicated namespace of the host cluster
+	// instead of the configured namespace.
+	// +optional
+	NamespaceIsolation *NamespaceIsolationConfiguration `json:"namespaceIsolation,omitempty"`
+
 	// Controller contains configuration concerning the controller framework.
 	Controller Controller `json:"controller,omitempty"`
 
@@ -121,6 +134,33 @@ type HPAConfiguration struct {
 	MaxReplicas int32 `json:"maxReplicas,omitempty"`
 }
 
+// RunnerPoolConfiguration contains the configuration of the runner pool mode.
+type RunnerPoolConfiguration struct {
+	// Size defines the maximum number of runner pods that are kept per namespace of the deploy items and image.
+	// Defaults to 1.
+	// +optional
+	Size int `json:"size,omitempty"`
+	// IdleTimeoutSeconds defines the duration after which a runner pod that has not executed any run terminates itself.
+	// Defaults to 1800.
+	// +optional
+	IdleTimeoutSeconds int `json:"idleTimeoutSeconds,omitempty"`
+}
+
+// NamespaceIsolationConfiguration contains the configuration of the namespace isolation.
+type NamespaceIsolationConfiguration struct {
+	// NamePrefix is the prefix of the dedicated namespaces.
+	// Defaults to "ls-container-".
+	// +optional
+	NamePrefix string `json:"namePrefix,omitempty"`
+	// NetworkPolicies are network policy manifests that are created in every dedicated namespace.
+	// The namespace of the manifests is set to the dedicated namespace.
+	// +optional
+	NetworkPolicies []runtime.RawExtension `json:"networkPolicies,omitempty"`
+	// ResourceQuota is the spec of a resource quota that is created in every dedicated namespace.
+	// +optional
+	ResourceQuota *corev1.ResourceQuotaSpec `json:"resourceQuota,omitempty"`
+}
+
 // Controller contains configuration concerning the controller framework.
 type Controller struct {
 	lsconfigv1alpha1.CommonControllerConfig
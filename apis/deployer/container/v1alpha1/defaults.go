@@ -22,6 +22,12 @@ func SetDefaults_Configuration(obj *Configuration) {
 		obj.DefaultImage.Image = "ubuntu:18.04"
 	}
 	SetDefaults_GarbageCollection(&obj.GarbageCollection)
+	if obj.RunnerPool != nil {
+		SetDefaults_RunnerPoolConfiguration(obj.RunnerPool)
+	}
+	if obj.NamespaceIsolation != nil {
+		SetDefaults_NamespaceIsolationConfiguration(obj.NamespaceIsolation)
+	}
 }
 
 // SetDefaults_GarbageCollection sets the defaults for the container deployer configuration.
@@ -33,3 +39,20 @@ func SetDefaults_GarbageCollection(obj *GarbageCollection) {
 		obj.RequeueTimeSeconds = 60 * 60
 	}
 }
+
+// SetDefaults_RunnerPoolConfiguration sets the defaults for the runner pool configuration.
+func SetDefaults_RunnerPoolConfiguration(obj *RunnerPoolConfiguration) {
+	if obj.Size <= 0 {
+		obj.Size = 1
+	}
+	if obj.IdleTimeoutSeconds <= 0 {
+		obj.IdleTimeoutSeconds = 30 * 60
+	}
+}
+
+// SetDefaults_NamespaceIsolationConfiguration sets the defaults for the namespace isolation configuration.
+func SetDefaults_NamespaceIsolationConfiguration(obj *NamespaceIsolationConfiguration) {
+	if len(obj.NamePrefix) == 0 {
+		obj.NamePrefix = "ls-container-"
+	}
+}
@@ -63,6 +63,15 @@ type ProviderConfiguration struct {
 	// ContinuousReconcile contains the schedule for continuous reconciliation.
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+	// RequirePlanApproval enables the two-phase plan/apply workflow.
+	// If enabled, the image is first executed with the "PLAN" operation and the apply (RECONCILE) is only executed
+	// after the plan has been approved with the approve-plan annotation.
+	// +optional
+	RequirePlanApproval bool `json:"requirePlanApproval,omitempty"`
+	// ExportSchema is an optional json schema the exports of the container are validated against.
+	// If the exports do not match the schema, the deploy item fails with a validation error.
+	// +optional
+	ExportSchema *lsv1alpha1.JSONSchemaDefinition `json:"exportSchema,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -75,6 +84,20 @@ type ProviderStatus struct {
 	LastOperation string `json:"lastOperation"`
 	// PodStatus indicated the status of the executed pod.
 	PodStatus *PodStatus `json:"podStatus,omitempty"`
+	// PlanStatus contains the status of the last plan if the deploy item requires a plan approval.
+	// +optional
+	PlanStatus *PlanStatus `json:"planStatus,omitempty"`
+}
+
+// PlanStatus describes the status of a plan that has to be approved before it is applied.
+type PlanStatus struct {
+	// JobID is the job id of the deploy item for which the plan was computed.
+	JobID string `json:"jobID"`
+	// PlanReference is the reference to the secret in the landscaper cluster that contains the plan output.
+	// +optional
+	PlanReference *lsv1alpha1.ObjectReference `json:"planReference,omitempty"`
+	// Approved defines whether the plan has been approved.
+	Approved bool `json:"approved"`
 }
 
 // PodStatus describes the status of a pod with its init, wait and main container
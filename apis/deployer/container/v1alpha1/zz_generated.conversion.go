@@ -100,6 +100,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceIsolationConfiguration)(nil), (*container.NamespaceIsolationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceIsolationConfiguration_To_container_NamespaceIsolationConfiguration(a.(*NamespaceIsolationConfiguration), b.(*container.NamespaceIsolationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.NamespaceIsolationConfiguration)(nil), (*NamespaceIsolationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_NamespaceIsolationConfiguration_To_v1alpha1_NamespaceIsolationConfiguration(a.(*container.NamespaceIsolationConfiguration), b.(*NamespaceIsolationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlanStatus)(nil), (*container.PlanStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlanStatus_To_container_PlanStatus(a.(*PlanStatus), b.(*container.PlanStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.PlanStatus)(nil), (*PlanStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_PlanStatus_To_v1alpha1_PlanStatus(a.(*container.PlanStatus), b.(*PlanStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodStatus)(nil), (*container.PodStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodStatus_To_container_PodStatus(a.(*PodStatus), b.(*container.PodStatus), scope)
 	}); err != nil {
@@ -130,6 +150,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RunnerPoolConfiguration)(nil), (*container.RunnerPoolConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RunnerPoolConfiguration_To_container_RunnerPoolConfiguration(a.(*RunnerPoolConfiguration), b.(*container.RunnerPoolConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.RunnerPoolConfiguration)(nil), (*RunnerPoolConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_RunnerPoolConfiguration_To_v1alpha1_RunnerPoolConfiguration(a.(*container.RunnerPoolConfiguration), b.(*RunnerPoolConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -152,6 +182,8 @@ func autoConvert_v1alpha1_Configuration_To_container_Configuration(in *Configura
 	}
 	out.DebugOptions = (*container.DebugOptions)(unsafe.Pointer(in.DebugOptions))
 	out.HPAConfiguration = (*container.HPAConfiguration)(unsafe.Pointer(in.HPAConfiguration))
+	out.RunnerPool = (*container.RunnerPoolConfiguration)(unsafe.Pointer(in.RunnerPool))
+	out.NamespaceIsolation = (*container.NamespaceIsolationConfiguration)(unsafe.Pointer(in.NamespaceIsolation))
 	if err := Convert_v1alpha1_Controller_To_container_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
@@ -183,6 +215,8 @@ func autoConvert_container_Configuration_To_v1alpha1_Configuration(in *container
 	}
 	out.DebugOptions = (*DebugOptions)(unsafe.Pointer(in.DebugOptions))
 	out.HPAConfiguration = (*HPAConfiguration)(unsafe.Pointer(in.HPAConfiguration))
+	out.RunnerPool = (*RunnerPoolConfiguration)(unsafe.Pointer(in.RunnerPool))
+	out.NamespaceIsolation = (*NamespaceIsolationConfiguration)(unsafe.Pointer(in.NamespaceIsolation))
 	if err := Convert_container_Controller_To_v1alpha1_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
@@ -335,6 +369,54 @@ func Convert_container_HPAConfiguration_To_v1alpha1_HPAConfiguration(in *contain
 	return autoConvert_container_HPAConfiguration_To_v1alpha1_HPAConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_NamespaceIsolationConfiguration_To_container_NamespaceIsolationConfiguration(in *NamespaceIsolationConfiguration, out *container.NamespaceIsolationConfiguration, s conversion.Scope) error {
+	out.NamePrefix = in.NamePrefix
+	out.NetworkPolicies = *(*[]runtime.RawExtension)(unsafe.Pointer(&in.NetworkPolicies))
+	out.ResourceQuota = (*v1.ResourceQuotaSpec)(unsafe.Pointer(in.ResourceQuota))
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceIsolationConfiguration_To_container_NamespaceIsolationConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceIsolationConfiguration_To_container_NamespaceIsolationConfiguration(in *NamespaceIsolationConfiguration, out *container.NamespaceIsolationConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceIsolationConfiguration_To_container_NamespaceIsolationConfiguration(in, out, s)
+}
+
+func autoConvert_container_NamespaceIsolationConfiguration_To_v1alpha1_NamespaceIsolationConfiguration(in *container.NamespaceIsolationConfiguration, out *NamespaceIsolationConfiguration, s conversion.Scope) error {
+	out.NamePrefix = in.NamePrefix
+	out.NetworkPolicies = *(*[]runtime.RawExtension)(unsafe.Pointer(&in.NetworkPolicies))
+	out.ResourceQuota = (*v1.ResourceQuotaSpec)(unsafe.Pointer(in.ResourceQuota))
+	return nil
+}
+
+// Convert_container_NamespaceIsolationConfiguration_To_v1alpha1_NamespaceIsolationConfiguration is an autogenerated conversion function.
+func Convert_container_NamespaceIsolationConfiguration_To_v1alpha1_NamespaceIsolationConfiguration(in *container.NamespaceIsolationConfiguration, out *NamespaceIsolationConfiguration, s conversion.Scope) error {
+	return autoConvert_container_NamespaceIsolationConfiguration_To_v1alpha1_NamespaceIsolationConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlanStatus_To_container_PlanStatus(in *PlanStatus, out *container.PlanStatus, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.PlanReference = (*corev1alpha1.ObjectReference)(unsafe.Pointer(in.PlanReference))
+	out.Approved = in.Approved
+	return nil
+}
+
+// Convert_v1alpha1_PlanStatus_To_container_PlanStatus is an autogenerated conversion function.
+func Convert_v1alpha1_PlanStatus_To_container_PlanStatus(in *PlanStatus, out *container.PlanStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlanStatus_To_container_PlanStatus(in, out, s)
+}
+
+func autoConvert_container_PlanStatus_To_v1alpha1_PlanStatus(in *container.PlanStatus, out *PlanStatus, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.PlanReference = (*corev1alpha1.ObjectReference)(unsafe.Pointer(in.PlanReference))
+	out.Approved = in.Approved
+	return nil
+}
+
+// Convert_container_PlanStatus_To_v1alpha1_PlanStatus is an autogenerated conversion function.
+func Convert_container_PlanStatus_To_v1alpha1_PlanStatus(in *container.PlanStatus, out *PlanStatus, s conversion.Scope) error {
+	return autoConvert_container_PlanStatus_To_v1alpha1_PlanStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodStatus_To_container_PodStatus(in *PodStatus, out *container.PodStatus, s conversion.Scope) error {
 	out.PodName = in.PodName
 	out.LastRun = (*metav1.Time)(unsafe.Pointer(in.LastRun))
@@ -386,6 +468,8 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_container_ProviderConfigurati
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
 	out.RegistryPullSecrets = *(*[]corev1alpha1.ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.RequirePlanApproval = in.RequirePlanApproval
+	out.ExportSchema = (*corev1alpha1.JSONSchemaDefinition)(unsafe.Pointer(in.ExportSchema))
 	return nil
 }
 
@@ -403,6 +487,8 @@ func autoConvert_container_ProviderConfiguration_To_v1alpha1_ProviderConfigurati
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
 	out.RegistryPullSecrets = *(*[]corev1alpha1.ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.RequirePlanApproval = in.RequirePlanApproval
+	out.ExportSchema = (*corev1alpha1.JSONSchemaDefinition)(unsafe.Pointer(in.ExportSchema))
 	return nil
 }
 
@@ -414,6 +500,7 @@ func Convert_container_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(i
 func autoConvert_v1alpha1_ProviderStatus_To_container_ProviderStatus(in *ProviderStatus, out *container.ProviderStatus, s conversion.Scope) error {
 	out.LastOperation = in.LastOperation
 	out.PodStatus = (*container.PodStatus)(unsafe.Pointer(in.PodStatus))
+	out.PlanStatus = (*container.PlanStatus)(unsafe.Pointer(in.PlanStatus))
 	return nil
 }
 
@@ -425,6 +512,7 @@ func Convert_v1alpha1_ProviderStatus_To_container_ProviderStatus(in *ProviderSta
 func autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *container.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.LastOperation = in.LastOperation
 	out.PodStatus = (*PodStatus)(unsafe.Pointer(in.PodStatus))
+	out.PlanStatus = (*PlanStatus)(unsafe.Pointer(in.PlanStatus))
 	return nil
 }
 
@@ -432,3 +520,25 @@ func autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *contain
 func Convert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *container.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	return autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_RunnerPoolConfiguration_To_container_RunnerPoolConfiguration(in *RunnerPoolConfiguration, out *container.RunnerPoolConfiguration, s conversion.Scope) error {
+	out.Size = in.Size
+	out.IdleTimeoutSeconds = in.IdleTimeoutSeconds
+	return nil
+}
+
+// Convert_v1alpha1_RunnerPoolConfiguration_To_container_RunnerPoolConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_RunnerPoolConfiguration_To_container_RunnerPoolConfiguration(in *RunnerPoolConfiguration, out *container.RunnerPoolConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RunnerPoolConfiguration_To_container_RunnerPoolConfiguration(in, out, s)
+}
+
+func autoConvert_container_RunnerPoolConfiguration_To_v1alpha1_RunnerPoolConfiguration(in *container.RunnerPoolConfiguration, out *RunnerPoolConfiguration, s conversion.Scope) error {
+	out.Size = in.Size
+	out.IdleTimeoutSeconds = in.IdleTimeoutSeconds
+	return nil
+}
+
+// Convert_container_RunnerPoolConfiguration_To_v1alpha1_RunnerPoolConfiguration is an autogenerated conversion function.
+func Convert_container_RunnerPoolConfiguration_To_v1alpha1_RunnerPoolConfiguration(in *container.RunnerPoolConfiguration, out *RunnerPoolConfiguration, s conversion.Scope) error {
+	return autoConvert_container_RunnerPoolConfiguration_To_v1alpha1_RunnerPoolConfiguration(in, out, s)
+}
@@ -11,6 +11,7 @@ package v1alpha1
 import (
 	json "encoding/json"
 
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	config "github.com/gardener/landscaper/apis/config"
@@ -48,6 +49,16 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		*out = new(HPAConfiguration)
 		**out = **in
 	}
+	if in.RunnerPool != nil {
+		in, out := &in.RunnerPool, &out.RunnerPool
+		*out = new(RunnerPoolConfiguration)
+		**out = **in
+	}
+	if in.NamespaceIsolation != nil {
+		in, out := &in.NamespaceIsolation, &out.NamespaceIsolation
+		*out = new(NamespaceIsolationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Controller.DeepCopyInto(&out.Controller)
 	return
 }
@@ -183,6 +194,55 @@ func (in *HPAConfiguration) DeepCopy() *HPAConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceIsolationConfiguration) DeepCopyInto(out *NamespaceIsolationConfiguration) {
+	*out = *in
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(v1.ResourceQuotaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceIsolationConfiguration.
+func (in *NamespaceIsolationConfiguration) DeepCopy() *NamespaceIsolationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceIsolationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanStatus) DeepCopyInto(out *PlanStatus) {
+	*out = *in
+	if in.PlanReference != nil {
+		in, out := &in.PlanReference, &out.PlanReference
+		*out = new(corev1alpha1.ObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanStatus.
+func (in *PlanStatus) DeepCopy() *PlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodStatus) DeepCopyInto(out *PodStatus) {
 	*out = *in
@@ -250,6 +310,11 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = new(continuousreconcile.ContinuousReconcileSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExportSchema != nil {
+		in, out := &in.ExportSchema, &out.ExportSchema
+		*out = new(corev1alpha1.JSONSchemaDefinition)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -280,6 +345,11 @@ func (in *ProviderStatus) DeepCopyInto(out *ProviderStatus) {
 		*out = new(PodStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PlanStatus != nil {
+		in, out := &in.PlanStatus, &out.PlanStatus
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -300,3 +370,19 @@ func (in *ProviderStatus) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunnerPoolConfiguration) DeepCopyInto(out *RunnerPoolConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerPoolConfiguration.
+func (in *RunnerPoolConfiguration) DeepCopy() *RunnerPoolConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(RunnerPoolConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
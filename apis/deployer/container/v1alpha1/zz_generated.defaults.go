@@ -25,5 +25,11 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 func SetObjectDefaults_Configuration(in *Configuration) {
 	SetDefaults_Configuration(in)
 	SetDefaults_GarbageCollection(&in.GarbageCollection)
+	if in.RunnerPool != nil {
+		SetDefaults_RunnerPoolConfiguration(in.RunnerPool)
+	}
+	if in.NamespaceIsolation != nil {
+		SetDefaults_NamespaceIsolationConfiguration(in.NamespaceIsolation)
+	}
 	v1alpha1.SetDefaults_CommonControllerConfig(&in.Controller.CommonControllerConfig)
 }
@@ -36,4 +36,90 @@ type ProviderConfiguration struct {
 	// ContinuousReconcile contains the schedule for continuous reconciliation.
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+
+	// Scenario is a scripted sequence of steps that are executed in subsequent reconciliations of the DeployItem.
+	// If set, Phase, InitialPhase, ProviderStatus and Export are ignored.
+	// +optional
+	Scenario []ScenarioStep `json:"scenario,omitempty"`
+}
+
+// ScenarioStep describes a step of a mock scenario.
+type ScenarioStep struct {
+	// Name is an optional name of the step that is used in logs and errors.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Phase sets the phase of the DeployItem after the step has been executed.
+	// Defaults to "Progressing" if an error is configured and to "Succeeded" otherwise.
+	// +optional
+	Phase *lsv1alpha1.DeployItemPhase `json:"phase,omitempty"`
+
+	// Delay is the duration that has to pass before the step is executed.
+	// Meanwhile, the DeployItem is in phase "Progressing".
+	// +optional
+	Delay *lsv1alpha1.Duration `json:"delay,omitempty"`
+
+	// Times is the number of reconciliations in which the step is executed
+	// before the scenario continues with the next step. Defaults to 1.
+	// The last step of a scenario is repeated for all further reconciliations.
+	// +optional
+	Times int32 `json:"times,omitempty"`
+
+	// Hang keeps the DeployItem in phase "Progressing" until it is interrupted,
+	// e.g. by a timeout or by an interruption of its installation.
+	// +optional
+	Hang bool `json:"hang,omitempty"`
+
+	// Export sets the exported configuration to the given value
+	// +optional
+	Export *json.RawMessage `json:"export,omitempty"`
+
+	// Error lets the reconciliation fail with the given error.
+	// +optional
+	Error *ScenarioError `json:"error,omitempty"`
+}
+
+// ScenarioError describes an error that is returned by a scenario step.
+type ScenarioError struct {
+	// Message is the message of the error.
+	Message string `json:"message"`
+
+	// Codes are the error codes of the error.
+	// Unrecoverable error codes set the DeployItem to phase "Failed".
+	// +optional
+	Codes []lsv1alpha1.ErrorCode `json:"codes,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderStatus is the mock deployer status of a DeployItem.
+// It is only set if a scenario is configured.
+type ProviderStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Scenario contains the progress of the scenario.
+	// +optional
+	Scenario *ScenarioStatus `json:"scenario,omitempty"`
+}
+
+// ScenarioStatus describes the progress of a mock scenario.
+type ScenarioStatus struct {
+	// ObservedGeneration is the generation of the DeployItem for which the scenario has been started.
+	// The scenario is restarted if the DeployItem changes.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Step is the index of the current step.
+	Step int32 `json:"step"`
+
+	// Attempt is the number of times the current step has already been executed.
+	Attempt int32 `json:"attempt"`
+
+	// StepStartTime is the time when the current execution of the step has been started.
+	// +optional
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+
+	// JobID is the job ID of the DeployItem in which the current execution of the step has been started.
+	// A hanging step is finished as soon as the DeployItem has a new job ID.
+	// +optional
+	JobID string `json:"jobID,omitempty"`
 }
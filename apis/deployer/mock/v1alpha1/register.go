@@ -40,6 +40,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Configuration{},
 		&ProviderConfiguration{},
+		&ProviderStatus{},
 	)
 	return nil
 }
@@ -12,6 +12,7 @@ import (
 	json "encoding/json"
 	unsafe "unsafe"
 
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
@@ -47,6 +48,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProviderStatus)(nil), (*mock.ProviderStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProviderStatus_To_mock_ProviderStatus(a.(*ProviderStatus), b.(*mock.ProviderStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*mock.ProviderStatus)(nil), (*ProviderStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_mock_ProviderStatus_To_v1alpha1_ProviderStatus(a.(*mock.ProviderStatus), b.(*ProviderStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScenarioError)(nil), (*mock.ScenarioError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScenarioError_To_mock_ScenarioError(a.(*ScenarioError), b.(*mock.ScenarioError), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*mock.ScenarioError)(nil), (*ScenarioError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_mock_ScenarioError_To_v1alpha1_ScenarioError(a.(*mock.ScenarioError), b.(*ScenarioError), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScenarioStatus)(nil), (*mock.ScenarioStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScenarioStatus_To_mock_ScenarioStatus(a.(*ScenarioStatus), b.(*mock.ScenarioStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*mock.ScenarioStatus)(nil), (*ScenarioStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_mock_ScenarioStatus_To_v1alpha1_ScenarioStatus(a.(*mock.ScenarioStatus), b.(*ScenarioStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScenarioStep)(nil), (*mock.ScenarioStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScenarioStep_To_mock_ScenarioStep(a.(*ScenarioStep), b.(*mock.ScenarioStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*mock.ScenarioStep)(nil), (*ScenarioStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_mock_ScenarioStep_To_v1alpha1_ScenarioStep(a.(*mock.ScenarioStep), b.(*ScenarioStep), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -78,6 +119,7 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_mock_ProviderConfiguration(in
 	out.ProviderStatus = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderStatus))
 	out.Export = (*json.RawMessage)(unsafe.Pointer(in.Export))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.Scenario = *(*[]mock.ScenarioStep)(unsafe.Pointer(&in.Scenario))
 	return nil
 }
 
@@ -92,6 +134,7 @@ func autoConvert_mock_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.ProviderStatus = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderStatus))
 	out.Export = (*json.RawMessage)(unsafe.Pointer(in.Export))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.Scenario = *(*[]ScenarioStep)(unsafe.Pointer(&in.Scenario))
 	return nil
 }
 
@@ -99,3 +142,105 @@ func autoConvert_mock_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 func Convert_mock_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in *mock.ProviderConfiguration, out *ProviderConfiguration, s conversion.Scope) error {
 	return autoConvert_mock_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_ProviderStatus_To_mock_ProviderStatus(in *ProviderStatus, out *mock.ProviderStatus, s conversion.Scope) error {
+	out.Scenario = (*mock.ScenarioStatus)(unsafe.Pointer(in.Scenario))
+	return nil
+}
+
+// Convert_v1alpha1_ProviderStatus_To_mock_ProviderStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ProviderStatus_To_mock_ProviderStatus(in *ProviderStatus, out *mock.ProviderStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProviderStatus_To_mock_ProviderStatus(in, out, s)
+}
+
+func autoConvert_mock_ProviderStatus_To_v1alpha1_ProviderStatus(in *mock.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
+	out.Scenario = (*ScenarioStatus)(unsafe.Pointer(in.Scenario))
+	return nil
+}
+
+// Convert_mock_ProviderStatus_To_v1alpha1_ProviderStatus is an autogenerated conversion function.
+func Convert_mock_ProviderStatus_To_v1alpha1_ProviderStatus(in *mock.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
+	return autoConvert_mock_ProviderStatus_To_v1alpha1_ProviderStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ScenarioError_To_mock_ScenarioError(in *ScenarioError, out *mock.ScenarioError, s conversion.Scope) error {
+	out.Message = in.Message
+	out.Codes = *(*[]corev1alpha1.ErrorCode)(unsafe.Pointer(&in.Codes))
+	return nil
+}
+
+// Convert_v1alpha1_ScenarioError_To_mock_ScenarioError is an autogenerated conversion function.
+func Convert_v1alpha1_ScenarioError_To_mock_ScenarioError(in *ScenarioError, out *mock.ScenarioError, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScenarioError_To_mock_ScenarioError(in, out, s)
+}
+
+func autoConvert_mock_ScenarioError_To_v1alpha1_ScenarioError(in *mock.ScenarioError, out *ScenarioError, s conversion.Scope) error {
+	out.Message = in.Message
+	out.Codes = *(*[]corev1alpha1.ErrorCode)(unsafe.Pointer(&in.Codes))
+	return nil
+}
+
+// Convert_mock_ScenarioError_To_v1alpha1_ScenarioError is an autogenerated conversion function.
+func Convert_mock_ScenarioError_To_v1alpha1_ScenarioError(in *mock.ScenarioError, out *ScenarioError, s conversion.Scope) error {
+	return autoConvert_mock_ScenarioError_To_v1alpha1_ScenarioError(in, out, s)
+}
+
+func autoConvert_v1alpha1_ScenarioStatus_To_mock_ScenarioStatus(in *ScenarioStatus, out *mock.ScenarioStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Step = in.Step
+	out.Attempt = in.Attempt
+	out.StepStartTime = (*v1.Time)(unsafe.Pointer(in.StepStartTime))
+	out.JobID = in.JobID
+	return nil
+}
+
+// Convert_v1alpha1_ScenarioStatus_To_mock_ScenarioStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ScenarioStatus_To_mock_ScenarioStatus(in *ScenarioStatus, out *mock.ScenarioStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScenarioStatus_To_mock_ScenarioStatus(in, out, s)
+}
+
+func autoConvert_mock_ScenarioStatus_To_v1alpha1_ScenarioStatus(in *mock.ScenarioStatus, out *ScenarioStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Step = in.Step
+	out.Attempt = in.Attempt
+	out.StepStartTime = (*v1.Time)(unsafe.Pointer(in.StepStartTime))
+	out.JobID = in.JobID
+	return nil
+}
+
+// Convert_mock_ScenarioStatus_To_v1alpha1_ScenarioStatus is an autogenerated conversion function.
+func Convert_mock_ScenarioStatus_To_v1alpha1_ScenarioStatus(in *mock.ScenarioStatus, out *ScenarioStatus, s conversion.Scope) error {
+	return autoConvert_mock_ScenarioStatus_To_v1alpha1_ScenarioStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ScenarioStep_To_mock_ScenarioStep(in *ScenarioStep, out *mock.ScenarioStep, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Phase = (*corev1alpha1.DeployItemPhase)(unsafe.Pointer(in.Phase))
+	out.Delay = (*corev1alpha1.Duration)(unsafe.Pointer(in.Delay))
+	out.Times = in.Times
+	out.Hang = in.Hang
+	out.Export = (*json.RawMessage)(unsafe.Pointer(in.Export))
+	out.Error = (*mock.ScenarioError)(unsafe.Pointer(in.Error))
+	return nil
+}
+
+// Convert_v1alpha1_ScenarioStep_To_mock_ScenarioStep is an autogenerated conversion function.
+func Convert_v1alpha1_ScenarioStep_To_mock_ScenarioStep(in *ScenarioStep, out *mock.ScenarioStep, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScenarioStep_To_mock_ScenarioStep(in, out, s)
+}
+
+func autoConvert_mock_ScenarioStep_To_v1alpha1_ScenarioStep(in *mock.ScenarioStep, out *ScenarioStep, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Phase = (*corev1alpha1.DeployItemPhase)(unsafe.Pointer(in.Phase))
+	out.Delay = (*corev1alpha1.Duration)(unsafe.Pointer(in.Delay))
+	out.Times = in.Times
+	out.Hang = in.Hang
+	out.Export = (*json.RawMessage)(unsafe.Pointer(in.Export))
+	out.Error = (*ScenarioError)(unsafe.Pointer(in.Error))
+	return nil
+}
+
+// Convert_mock_ScenarioStep_To_v1alpha1_ScenarioStep is an autogenerated conversion function.
+func Convert_mock_ScenarioStep_To_v1alpha1_ScenarioStep(in *mock.ScenarioStep, out *ScenarioStep, s conversion.Scope) error {
+	return autoConvert_mock_ScenarioStep_To_v1alpha1_ScenarioStep(in, out, s)
+}
@@ -11,6 +11,10 @@ const (
 	// OldBlueprintType is the old name of the blueprint type in a component descriptor.
 	OldBlueprintType = "blueprint"
 
+	// BlueprintLibraryType is the name of the template library type in a component descriptor.
+	// A template library is packaged like a blueprint and contains templates that are shared by multiple blueprints.
+	BlueprintLibraryType = "landscaper.gardener.cloud/blueprint-library"
+
 	// BlueprintArtifactsMediaTypeV0 is the reserved media type for a blueprint that is stored as its own artifact.
 	// This is the legacy deprecated artifact media type that was used for the layer and the config type.
 	// Use BlueprintArtifactsConfigMediaTypeV1 or BlueprintArtifactsLayerMediaTypeV1 instead.
@@ -49,6 +49,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config.ExecutionsController":                                      schema_gardener_landscaper_apis_config_ExecutionsController(ref),
 		"github.com/gardener/landscaper/apis/config.GarbageCollectionConfiguration":                            schema_gardener_landscaper_apis_config_GarbageCollectionConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.HPAMainConfiguration":                                      schema_gardener_landscaper_apis_config_HPAMainConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config.HelmChartRepoIndexCacheConfiguration":                      schema_gardener_landscaper_apis_config_HelmChartRepoIndexCacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.InstallationsController":                                   schema_gardener_landscaper_apis_config_InstallationsController(ref),
 		"github.com/gardener/landscaper/apis/config.LandscaperConfiguration":                                   schema_gardener_landscaper_apis_config_LandscaperConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.LocalRegistryConfiguration":                                schema_gardener_landscaper_apis_config_LocalRegistryConfiguration(ref),
@@ -57,6 +58,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config.OCICacheConfiguration":                                     schema_gardener_landscaper_apis_config_OCICacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.OCIConfiguration":                                          schema_gardener_landscaper_apis_config_OCIConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.RegistryConfiguration":                                     schema_gardener_landscaper_apis_config_RegistryConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config.SharedOCICacheConfiguration":                               schema_gardener_landscaper_apis_config_SharedOCICacheConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config.SharedOCIRegistryCacheConfiguration":                       schema_gardener_landscaper_apis_config_SharedOCIRegistryCacheConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config.SharedS3CacheConfiguration":                                schema_gardener_landscaper_apis_config_SharedS3CacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.AdditionalDeployments":                            schema_landscaper_apis_config_v1alpha1_AdditionalDeployments(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore":                                   schema_landscaper_apis_config_v1alpha1_BlueprintStore(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig":                           schema_landscaper_apis_config_v1alpha1_CommonControllerConfig(ref),
@@ -70,6 +74,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config/v1alpha1.ExecutionsController":                             schema_landscaper_apis_config_v1alpha1_ExecutionsController(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.GarbageCollectionConfiguration":                   schema_landscaper_apis_config_v1alpha1_GarbageCollectionConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.HPAMainConfiguration":                             schema_landscaper_apis_config_v1alpha1_HPAMainConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.HelmChartRepoIndexCacheConfiguration":             schema_landscaper_apis_config_v1alpha1_HelmChartRepoIndexCacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.InstallationsController":                          schema_landscaper_apis_config_v1alpha1_InstallationsController(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.LandscaperConfiguration":                          schema_landscaper_apis_config_v1alpha1_LandscaperConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.LocalRegistryConfiguration":                       schema_landscaper_apis_config_v1alpha1_LocalRegistryConfiguration(ref),
@@ -78,6 +83,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config/v1alpha1.OCICacheConfiguration":                            schema_landscaper_apis_config_v1alpha1_OCICacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.OCIConfiguration":                                 schema_landscaper_apis_config_v1alpha1_OCIConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.RegistryConfiguration":                            schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.SharedOCICacheConfiguration":                      schema_landscaper_apis_config_v1alpha1_SharedOCICacheConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.SharedOCIRegistryCacheConfiguration":              schema_landscaper_apis_config_v1alpha1_SharedOCIRegistryCacheConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.SharedS3CacheConfiguration":                       schema_landscaper_apis_config_v1alpha1_SharedS3CacheConfiguration(ref),
+		"github.com/gardener/landscaper/apis/core.AffectedInstallation":                                        schema_gardener_landscaper_apis_core_AffectedInstallation(ref),
 		"github.com/gardener/landscaper/apis/core.AnyJSON":                                                     schema_gardener_landscaper_apis_core_AnyJSON(ref),
 		"github.com/gardener/landscaper/apis/core.AutomaticReconcile":                                          schema_gardener_landscaper_apis_core_AutomaticReconcile(ref),
 		"github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus":                                    schema_gardener_landscaper_apis_core_AutomaticReconcileStatus(ref),
@@ -87,10 +96,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.BlueprintStaticDataValueFrom":                                schema_gardener_landscaper_apis_core_BlueprintStaticDataValueFrom(ref),
 		"github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition":                               schema_gardener_landscaper_apis_core_ComponentDescriptorDefinition(ref),
 		"github.com/gardener/landscaper/apis/core.ComponentDescriptorReference":                                schema_gardener_landscaper_apis_core_ComponentDescriptorReference(ref),
+		"github.com/gardener/landscaper/apis/core.ComponentPrefetch":                                           schema_gardener_landscaper_apis_core_ComponentPrefetch(ref),
+		"github.com/gardener/landscaper/apis/core.ComponentPrefetchList":                                       schema_gardener_landscaper_apis_core_ComponentPrefetchList(ref),
+		"github.com/gardener/landscaper/apis/core.ComponentPrefetchSpec":                                       schema_gardener_landscaper_apis_core_ComponentPrefetchSpec(ref),
+		"github.com/gardener/landscaper/apis/core.ComponentPrefetchStatus":                                     schema_gardener_landscaper_apis_core_ComponentPrefetchStatus(ref),
+		"github.com/gardener/landscaper/apis/core.ComponentVerification":                                       schema_gardener_landscaper_apis_core_ComponentVerification(ref),
+		"github.com/gardener/landscaper/apis/core.ComponentVerificationPolicy":                                 schema_gardener_landscaper_apis_core_ComponentVerificationPolicy(ref),
 		"github.com/gardener/landscaper/apis/core.ComponentVersionOverwrite":                                   schema_gardener_landscaper_apis_core_ComponentVersionOverwrite(ref),
 		"github.com/gardener/landscaper/apis/core.ComponentVersionOverwriteReference":                          schema_gardener_landscaper_apis_core_ComponentVersionOverwriteReference(ref),
 		"github.com/gardener/landscaper/apis/core.ComponentVersionOverwrites":                                  schema_gardener_landscaper_apis_core_ComponentVersionOverwrites(ref),
 		"github.com/gardener/landscaper/apis/core.ComponentVersionOverwritesList":                              schema_gardener_landscaper_apis_core_ComponentVersionOverwritesList(ref),
+		"github.com/gardener/landscaper/apis/core.ComponentVersionOverwritesStatus":                            schema_gardener_landscaper_apis_core_ComponentVersionOverwritesStatus(ref),
 		"github.com/gardener/landscaper/apis/core.Condition":                                                   schema_gardener_landscaper_apis_core_Condition(ref),
 		"github.com/gardener/landscaper/apis/core.ConfigMapReference":                                          schema_gardener_landscaper_apis_core_ConfigMapReference(ref),
 		"github.com/gardener/landscaper/apis/core.Context":                                                     schema_gardener_landscaper_apis_core_Context(ref),
@@ -134,10 +150,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.LocalSecretReference":                                        schema_gardener_landscaper_apis_core_LocalSecretReference(ref),
 		"github.com/gardener/landscaper/apis/core.LsHealthCheck":                                               schema_gardener_landscaper_apis_core_LsHealthCheck(ref),
 		"github.com/gardener/landscaper/apis/core.LsHealthCheckList":                                           schema_gardener_landscaper_apis_core_LsHealthCheckList(ref),
+		"github.com/gardener/landscaper/apis/core.MaintenanceWindow":                                           schema_gardener_landscaper_apis_core_MaintenanceWindow(ref),
 		"github.com/gardener/landscaper/apis/core.NamedObjectReference":                                        schema_gardener_landscaper_apis_core_NamedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core.ObjectReference":                                             schema_gardener_landscaper_apis_core_ObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core.OnDeleteConfig":                                              schema_gardener_landscaper_apis_core_OnDeleteConfig(ref),
 		"github.com/gardener/landscaper/apis/core.Optimization":                                                schema_gardener_landscaper_apis_core_Optimization(ref),
+		"github.com/gardener/landscaper/apis/core.PrefetchedComponent":                                         schema_gardener_landscaper_apis_core_PrefetchedComponent(ref),
+		"github.com/gardener/landscaper/apis/core.RegistryCredential":                                          schema_gardener_landscaper_apis_core_RegistryCredential(ref),
+		"github.com/gardener/landscaper/apis/core.RegistryTokenSource":                                         schema_gardener_landscaper_apis_core_RegistryTokenSource(ref),
 		"github.com/gardener/landscaper/apis/core.RemoteBlueprintReference":                                    schema_gardener_landscaper_apis_core_RemoteBlueprintReference(ref),
 		"github.com/gardener/landscaper/apis/core.Requirement":                                                 schema_gardener_landscaper_apis_core_Requirement(ref),
 		"github.com/gardener/landscaper/apis/core.ResolvedTarget":                                              schema_gardener_landscaper_apis_core_ResolvedTarget(ref),
@@ -166,12 +186,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.TargetSyncStatus":                                            schema_gardener_landscaper_apis_core_TargetSyncStatus(ref),
 		"github.com/gardener/landscaper/apis/core.TargetTemplate":                                              schema_gardener_landscaper_apis_core_TargetTemplate(ref),
 		"github.com/gardener/landscaper/apis/core.TemplateExecutor":                                            schema_gardener_landscaper_apis_core_TemplateExecutor(ref),
+		"github.com/gardener/landscaper/apis/core.TemplateLibraryReference":                                    schema_gardener_landscaper_apis_core_TemplateLibraryReference(ref),
 		"github.com/gardener/landscaper/apis/core.TokenRotation":                                               schema_gardener_landscaper_apis_core_TokenRotation(ref),
 		"github.com/gardener/landscaper/apis/core.TransitionTimes":                                             schema_gardener_landscaper_apis_core_TransitionTimes(ref),
 		"github.com/gardener/landscaper/apis/core.TypedObjectReference":                                        schema_gardener_landscaper_apis_core_TypedObjectReference(ref),
+		"github.com/gardener/landscaper/apis/core.VersionPolicy":                                               schema_gardener_landscaper_apis_core_VersionPolicy(ref),
+		"github.com/gardener/landscaper/apis/core.VersionUpgradeStatus":                                        schema_gardener_landscaper_apis_core_VersionUpgradeStatus(ref),
 		"github.com/gardener/landscaper/apis/core.VersionedNamedObjectReference":                               schema_gardener_landscaper_apis_core_VersionedNamedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core.VersionedObjectReference":                                    schema_gardener_landscaper_apis_core_VersionedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core.VersionedResourceReference":                                  schema_gardener_landscaper_apis_core_VersionedResourceReference(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.AffectedInstallation":                               schema_landscaper_apis_core_v1alpha1_AffectedInstallation(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON":                                            schema_landscaper_apis_core_v1alpha1_AnyJSON(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile":                                 schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus":                           schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref),
@@ -181,10 +205,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintStaticDataValueFrom":                       schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataValueFrom(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition":                      schema_landscaper_apis_core_v1alpha1_ComponentDescriptorDefinition(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference":                       schema_landscaper_apis_core_v1alpha1_ComponentDescriptorReference(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentPrefetch":                                  schema_landscaper_apis_core_v1alpha1_ComponentPrefetch(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentPrefetchList":                              schema_landscaper_apis_core_v1alpha1_ComponentPrefetchList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentPrefetchSpec":                              schema_landscaper_apis_core_v1alpha1_ComponentPrefetchSpec(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentPrefetchStatus":                            schema_landscaper_apis_core_v1alpha1_ComponentPrefetchStatus(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVerification":                              schema_landscaper_apis_core_v1alpha1_ComponentVerification(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVerificationPolicy":                        schema_landscaper_apis_core_v1alpha1_ComponentVerificationPolicy(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite":                          schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrite(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference":                 schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwriteReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites":                         schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwritesList":                     schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwritesStatus":                   schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Condition":                                          schema_landscaper_apis_core_v1alpha1_Condition(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ConfigMapReference":                                 schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Context":                                            schema_landscaper_apis_core_v1alpha1_Context(ref),
@@ -228,10 +259,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference":                               schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck":                                      schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheckList":                                  schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow":                                  schema_landscaper_apis_core_v1alpha1_MaintenanceWindow(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.NamedObjectReference":                               schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference":                                    schema_landscaper_apis_core_v1alpha1_ObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig":                                     schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Optimization":                                       schema_landscaper_apis_core_v1alpha1_Optimization(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.PrefetchedComponent":                                schema_landscaper_apis_core_v1alpha1_PrefetchedComponent(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RegistryCredential":                                 schema_landscaper_apis_core_v1alpha1_RegistryCredential(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RegistryTokenSource":                                schema_landscaper_apis_core_v1alpha1_RegistryTokenSource(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference":                           schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Requirement":                                        schema_landscaper_apis_core_v1alpha1_Requirement(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ResolvedTarget":                                     schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref),
@@ -260,9 +295,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus":                                   schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetTemplate":                                     schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor":                                   schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.TemplateLibraryReference":                           schema_landscaper_apis_core_v1alpha1_TemplateLibraryReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation":                                      schema_landscaper_apis_core_v1alpha1_TokenRotation(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes":                                    schema_landscaper_apis_core_v1alpha1_TransitionTimes(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference":                               schema_landscaper_apis_core_v1alpha1_TypedObjectReference(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.VersionPolicy":                                      schema_landscaper_apis_core_v1alpha1_VersionPolicy(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.VersionUpgradeStatus":                               schema_landscaper_apis_core_v1alpha1_VersionUpgradeStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedNamedObjectReference":                      schema_landscaper_apis_core_v1alpha1_VersionedNamedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference":                           schema_landscaper_apis_core_v1alpha1_VersionedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedResourceReference":                         schema_landscaper_apis_core_v1alpha1_VersionedResourceReference(ref),
@@ -273,6 +311,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/container.DebugOptions":                                  schema_landscaper_apis_deployer_container_DebugOptions(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.GarbageCollection":                             schema_landscaper_apis_deployer_container_GarbageCollection(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration":                              schema_landscaper_apis_deployer_container_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.PlanStatus":                                    schema_landscaper_apis_deployer_container_PlanStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.PodStatus":                                     schema_landscaper_apis_deployer_container_PodStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.ProviderConfiguration":                         schema_landscaper_apis_deployer_container_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.ProviderStatus":                                schema_landscaper_apis_deployer_container_ProviderStatus(ref),
@@ -283,9 +322,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions":                         schema_apis_deployer_container_v1alpha1_DebugOptions(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection":                    schema_apis_deployer_container_v1alpha1_GarbageCollection(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration":                     schema_apis_deployer_container_v1alpha1_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.NamespaceIsolationConfiguration":      schema_apis_deployer_container_v1alpha1_NamespaceIsolationConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PlanStatus":                           schema_apis_deployer_container_v1alpha1_PlanStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus":                            schema_apis_deployer_container_v1alpha1_PodStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ProviderConfiguration":                schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ProviderStatus":                       schema_apis_deployer_container_v1alpha1_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.RunnerPoolConfiguration":              schema_apis_deployer_container_v1alpha1_RunnerPoolConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess":                                      schema_landscaper_apis_deployer_helm_ArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.Auth":                                               schema_landscaper_apis_deployer_helm_Auth(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.Chart":                                              schema_landscaper_apis_deployer_helm_Chart(ref),
@@ -339,8 +381,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ProviderStatus":                        schema_apis_deployer_manifest_v1alpha2_ProviderStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/mock.Configuration":                                      schema_landscaper_apis_deployer_mock_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/mock.ProviderConfiguration":                              schema_landscaper_apis_deployer_mock_ProviderConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/mock.ProviderStatus":                                     schema_landscaper_apis_deployer_mock_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/mock.ScenarioError":                                      schema_landscaper_apis_deployer_mock_ScenarioError(ref),
+		"github.com/gardener/landscaper/apis/deployer/mock.ScenarioStatus":                                     schema_landscaper_apis_deployer_mock_ScenarioStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/mock.ScenarioStep":                                       schema_landscaper_apis_deployer_mock_ScenarioStep(ref),
 		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.Configuration":                             schema_apis_deployer_mock_v1alpha1_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ProviderConfiguration":                     schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ProviderStatus":                            schema_apis_deployer_mock_v1alpha1_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ScenarioError":                             schema_apis_deployer_mock_v1alpha1_ScenarioError(ref),
+		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ScenarioStatus":                            schema_apis_deployer_mock_v1alpha1_ScenarioStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ScenarioStep":                              schema_apis_deployer_mock_v1alpha1_ScenarioStep(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec":       schema_apis_deployer_utils_continuousreconcile_ContinuousReconcileSpec(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.CustomResourceGroup":               schema_apis_deployer_utils_managedresource_CustomResourceGroup(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition":           schema_apis_deployer_utils_managedresource_DeletionGroupDefinition(ref),
@@ -1752,6 +1802,27 @@ func schema_gardener_landscaper_apis_config_HPAMainConfiguration(ref common.Refe
 	}
 }
 
+func schema_gardener_landscaper_apis_config_HelmChartRepoIndexCacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmChartRepoIndexCacheConfiguration contains the configuration for the cache of helm chart repository indexes. The indexes are kept in memory and, if a path is configured for the oci cache, stored in the directory \"helmchartrepoindex\" below that path, so that they are shared by all processes using the same path.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is the duration for which a cached index is used without contacting the helm chart repository. After the ttl has expired, the index is revalidated with a conditional request. Defaults to 0, i.e. the index is revalidated every time it is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Duration"},
+	}
+}
+
 func schema_gardener_landscaper_apis_config_InstallationsController(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1988,9 +2059,23 @@ func schema_gardener_landscaper_apis_config_OCICacheConfiguration(ref common.Ref
 							Format:      "",
 						},
 					},
+					"shared": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shared configures a cache that is shared by all replicas. Blobs that are not found in the local cache are read from the shared cache before they are fetched from their origin, and blobs that are fetched from their origin are also written to the shared cache.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.SharedOCICacheConfiguration"),
+						},
+					},
+					"helmChartRepoIndex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmChartRepoIndex configures the cache for the indexes of helm chart repositories.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.HelmChartRepoIndexCacheConfiguration"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config.HelmChartRepoIndexCacheConfiguration", "github.com/gardener/landscaper/apis/config.SharedOCICacheConfiguration"},
 	}
 }
 
@@ -2074,6 +2159,133 @@ func schema_gardener_landscaper_apis_config_RegistryConfiguration(ref common.Ref
 	}
 }
 
+func schema_gardener_landscaper_apis_config_SharedOCICacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SharedOCICacheConfiguration contains the configuration for a shared oci cache. Exactly one backend has to be configured.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCI configures an oci repository as shared cache.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.SharedOCIRegistryCacheConfiguration"),
+						},
+					},
+					"s3": {
+						SchemaProps: spec.SchemaProps{
+							Description: "S3 configures an S3 compatible bucket as shared cache.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.SharedS3CacheConfiguration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config.SharedOCIRegistryCacheConfiguration", "github.com/gardener/landscaper/apis/config.SharedS3CacheConfiguration"},
+	}
+}
+
+func schema_gardener_landscaper_apis_config_SharedOCIRegistryCacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SharedOCIRegistryCacheConfiguration configures an oci repository as shared cache. The blobs are stored without manifests in the repository. The credentials are read from the docker config files of the oci configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repository is the oci repository the blobs are stored in, e.g. \"registry.example.com/landscaper/cache\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"allowPlainHttp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPlainHttp allows the fallback to http if https is not supported by the registry.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"insecureSkipVerify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureSkipVerify skips the certificate validation of the registry.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"repository"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_config_SharedS3CacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SharedS3CacheConfiguration configures an S3 compatible bucket as shared cache.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the url of the S3 compatible service, e.g. \"http://minio.minio:9000\". Defaults to the AWS endpoint of the region.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the region of the bucket. Defaults to \"us-east-1\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucket": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bucket is the name of the bucket the blobs are stored in.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is prepended to the keys of all blobs.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usePathStyle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsePathStyle addresses the bucket in the path instead of the host name of the requests. This is required by most S3 compatible services like MinIO.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"credentialsFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsFile is the path to a file in the AWS shared credentials format. If it is not set, the default credential chain of the AWS SDK is used, e.g. the environment variables AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"insecureSkipVerify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureSkipVerify skips the certificate validation of the endpoint.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"bucket"},
+			},
+		},
+	}
+}
+
 func schema_landscaper_apis_config_v1alpha1_AdditionalDeployments(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2493,6 +2705,27 @@ func schema_landscaper_apis_config_v1alpha1_HPAMainConfiguration(ref common.Refe
 	}
 }
 
+func schema_landscaper_apis_config_v1alpha1_HelmChartRepoIndexCacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmChartRepoIndexCacheConfiguration contains the configuration for the cache of helm chart repository indexes. The indexes are kept in memory and, if a path is configured for the oci cache, stored in the directory \"helmchartrepoindex\" below that path, so that they are shared by all processes using the same path.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is the duration for which a cached index is used without contacting the helm chart repository. After the ttl has expired, the index is revalidated with a conditional request. Defaults to 0, i.e. the index is revalidated every time it is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
 func schema_landscaper_apis_config_v1alpha1_InstallationsController(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2736,9 +2969,23 @@ func schema_landscaper_apis_config_v1alpha1_OCICacheConfiguration(ref common.Ref
 							Format:      "",
 						},
 					},
+					"shared": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shared configures a cache that is shared by all replicas. Blobs that are not found in the local cache are read from the shared cache before they are fetched from their origin, and blobs that are fetched from their origin are also written to the shared cache.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.SharedOCICacheConfiguration"),
+						},
+					},
+					"helmChartRepoIndex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmChartRepoIndex configures the cache for the indexes of helm chart repositories.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.HelmChartRepoIndexCacheConfiguration"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config/v1alpha1.HelmChartRepoIndexCacheConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.SharedOCICacheConfiguration"},
 	}
 }
 
@@ -2822,164 +3069,346 @@ func schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref common.Ref
 	}
 }
 
-func schema_gardener_landscaper_apis_core_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated",
-				Type:        core.AnyJSON{}.OpenAPISchemaType(),
-				Format:      core.AnyJSON{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_config_v1alpha1_SharedOCICacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+				Description: "SharedOCICacheConfiguration contains the configuration for a shared oci cache. Exactly one backend has to be configured.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"succeededReconcile": {
+					"oci": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SucceededReconcile"),
+							Description: "OCI configures an oci repository as shared cache.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.SharedOCIRegistryCacheConfiguration"),
 						},
 					},
-					"failedReconcile": {
+					"s3": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.FailedReconcile"),
+							Description: "S3 configures an S3 compatible bucket as shared cache.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.SharedS3CacheConfiguration"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.FailedReconcile", "github.com/gardener/landscaper/apis/core.SucceededReconcile"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.SharedOCIRegistryCacheConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.SharedS3CacheConfiguration"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_config_v1alpha1_SharedOCIRegistryCacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+				Description: "SharedOCIRegistryCacheConfiguration configures an oci repository as shared cache. The blobs are stored without manifests in the repository. The credentials are read from the docker config files of the oci configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"generation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Generation describes the generation of the installation for which the status holds.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"numberOfReconciles": {
+					"repository": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Repository is the oci repository the blobs are stored in, e.g. \"registry.example.com/landscaper/cache\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastReconcileTime": {
+					"allowPlainHttp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "AllowPlainHttp allows the fallback to http if https is not supported by the registry.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"onFailed": {
+					"insecureSkipVerify": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
+							Description: "InsecureSkipVerify skips the certificate validation of the registry.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"repository"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_config_v1alpha1_SharedS3CacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Blueprint contains the configuration of a component",
+				Description: "SharedS3CacheConfiguration configures an S3 compatible bucket as shared cache.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Endpoint is the url of the S3 compatible service, e.g. \"http://minio.minio:9000\". Defaults to the AWS endpoint of the region.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Region is the region of the bucket. Defaults to \"us-east-1\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"annotations": {
+					"bucket": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Bucket is the name of the bucket the blobs are stored in.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"jsonSchemaVersion": {
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
-							Default:     "",
+							Description: "Prefix is prepended to the keys of all blobs.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"localTypes": {
+					"usePathStyle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LocalTypes defines additional blueprint local schemas",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"),
-									},
-								},
-							},
+							Description: "UsePathStyle addresses the bucket in the path instead of the host name of the requests. This is required by most S3 compatible services like MinIO.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"imports": {
+					"credentialsFile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the import values that are needed for the definition and its sub-definitions.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.ImportDefinition"),
-									},
-								},
-							},
+							Description: "CredentialsFile is the path to a file in the AWS shared credentials format. If it is not set, the default credential chain of the AWS SDK is used, e.g. the environment variables AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"exports": {
+					"insecureSkipVerify": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported values of the definition and its sub-definitions",
-							Type:        []string{"array"},
+							Description: "InsecureSkipVerify skips the certificate validation of the endpoint.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"bucket"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_AffectedInstallation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AffectedInstallation describes a component reference of an installation that is replaced by an overwrite.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the installation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"context": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Context is the name of the context of the installation, which references the overwrites.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName is the name of the replaced component, which is referenced by the installation or by one of its transitively referenced components.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the replaced component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"substitution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Substitution is the component reference that is used instead.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentDescriptorReference"),
+						},
+					},
+				},
+				Required: []string{"name", "context", "componentName", "version", "substitution"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.ComponentDescriptorReference"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated",
+				Type:        core.AnyJSON{}.OpenAPISchemaType(),
+				Format:      core.AnyJSON{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"succeededReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SucceededReconcile"),
+						},
+					},
+					"failedReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.FailedReconcile"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.FailedReconcile", "github.com/gardener/landscaper/apis/core.SucceededReconcile"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation describes the generation of the installation for which the status holds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"numberOfReconciles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReconcileTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"onFailed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Blueprint contains the configuration of a component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"jsonSchemaVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"localTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LocalTypes defines additional blueprint local schemas",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"),
+									},
+								},
+							},
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the import values that are needed for the definition and its sub-definitions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ImportDefinition"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported values of the definition and its sub-definitions",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -3207,289 +3636,403 @@ func schema_gardener_landscaper_apis_core_ComponentDescriptorReference(ref commo
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ComponentVersionOverwrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ComponentPrefetch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwrite defines an overwrite for a specific component and/or version of a component.",
+				Description: "ComponentPrefetch defines component versions that are loaded into the caches of the landscaper together with their blueprints and helm charts. The component versions are prefetched whenever the object changes and after every restart of the landscaper.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"source": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source defines the component that should be replaced.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentVersionOverwriteReference"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentPrefetchSpec"),
 						},
 					},
-					"substitution": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Substitution defines the replacement target for the component or version.",
+							Description: "Status contains the status",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentVersionOverwriteReference"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentPrefetchStatus"),
 						},
 					},
 				},
-				Required: []string{"source", "substitution"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.ComponentVersionOverwriteReference"},
+			"github.com/gardener/landscaper/apis/core.ComponentPrefetchSpec", "github.com/gardener/landscaper/apis/core.ComponentPrefetchStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ComponentVersionOverwriteReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ComponentPrefetchList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwriteReference defines a component reference by",
+				Description: "ComponentPrefetchList contains a list of ComponentPrefetch objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
-						},
-					},
-					"componentName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ComponentPrefetch"),
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+			"github.com/gardener/landscaper/apis/core.ComponentPrefetch", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ComponentVersionOverwrites(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ComponentPrefetchSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwrites contain overwrites for specific (versions of) components.",
+				Description: "ComponentPrefetchSpec contains the specification for a ComponentPrefetch.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Context is the name of the context in the namespace of the ComponentPrefetch object that is used to resolve the listed components. It defines the repository context, the registry pull secrets and whether OCM is used. Defaults to the default context.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"overwrites": {
+					"components": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Overwrites defines a list of component overwrites",
+							Description: "Components lists the component versions that are prefetched. If the repository context of a component is not set, the repository context of the context is used.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.ComponentVersionOverwrite"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ComponentDescriptorReference"),
 									},
 								},
 							},
 						},
 					},
+					"installations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Installations defines whether the component versions of all installations in the namespace of the ComponentPrefetch object are prefetched. These component versions are resolved with the context of the respective installation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"includeReferences": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IncludeReferences defines whether the component versions referenced by the prefetched component versions are prefetched transitively.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.ComponentVersionOverwrite", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core.ComponentDescriptorReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ComponentVersionOverwritesList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ComponentPrefetchStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwritesList contains a list of ComponentVersionOverwrites",
+				Description: "ComponentPrefetchStatus contains the status of a ComponentPrefetch.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"apiVersion": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Phase is the current phase of the prefetch.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"total": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Total is the number of component versions that have been found so far.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"items": {
+					"prefetched": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Prefetched is the number of component versions that have been prefetched successfully.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is the number of component versions that could not be prefetched.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"components": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Components contains the state of at most 100 component versions. If there are more, failed component versions take precedence over prefetched ones.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.ComponentVersionOverwrites"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core.PrefetchedComponent"),
 									},
 								},
 							},
 						},
 					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that prevented the component versions from being collected.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the status was updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time a prefetch was completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.ComponentVersionOverwrites", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core.PrefetchedComponent", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ComponentVerification(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Condition holds the information about the state of a resource.",
+				Description: "ComponentVerification defines how the signatures of component versions are verified.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"policies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataType of the Shoot condition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Policies define which component versions are verified with which keys. The first policy whose component name pattern matches the name of a component is applied. Component versions that are not matched by any policy are not verified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ComponentVerificationPolicy"),
+									},
+								},
+							},
 						},
 					},
-					"status": {
+				},
+				Required: []string{"policies"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.ComponentVerificationPolicy"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_ComponentVerificationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentVerificationPolicy defines how the signatures of the component versions with a matching name are verified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentNamePattern": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Default:     "",
+							Description: "ComponentNamePattern selects the components the policy applies to. A \"*\" matches any sequence of characters, e.g. \"github.com/gardener/*\". Defaults to \"*\", which matches all components.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"reason": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Description: "Mode defines whether the signature of a component version is required, optional or not verified.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"signatureName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Default:     "",
+							Description: "SignatureName is the name of the signature that is verified. If it is empty, a component version is valid if any of its signatures is verified by a trusted key.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"codes": {
+					"trustedKeys": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Well-defined error codes in case the condition reports a problem.",
+							Description: "TrustedKeys reference secrets in the namespace of the context that contain PEM encoded public keys or certificates. If no key of a secret is given, all entries of the secret are read.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
+				Required: []string{"mode"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.LocalSecretReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ComponentVersionOverwrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapReference is reference to data in a configmap. The configmap can also be in a different namespace.",
+				Description: "ComponentVersionOverwrite defines an overwrite for a specific component and/or version of a component.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
+							Description: "Source defines the component that should be replaced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentVersionOverwriteReference"),
+						},
+					},
+					"substitution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Substitution defines the replacement target for the component or version.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentVersionOverwriteReference"),
+						},
+					},
+				},
+				Required: []string{"source", "substitution"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.ComponentVersionOverwriteReference"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_ComponentVersionOverwriteReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentVersionOverwriteReference defines a component reference by",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repositoryContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+						},
+					},
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource. In the source of an overwrite, the name may contain the wildcard \"*\", which matches any sequence of characters, e.g. \"github.com/acme/*\".",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
+							Description: "Version defines the version of the component.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"versionConstraint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
-							Default:     "",
+							Description: "VersionConstraint defines a semantic version constraint, e.g. \">= 1.2, < 2\". It is only evaluated in the source of an overwrite, where it matches all versions that satisfy the constraint. It must not be combined with a version.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Context(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ComponentVersionOverwrites(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Context is a resource that contains shared information of installations. This includes information about the repository context like the context itself or secrets to access the oci artifacts. But it can also contain deployer specific config.",
+				Description: "ComponentVersionOverwrites contain overwrites for specific (versions of) components.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3512,131 +4055,40 @@ func schema_gardener_landscaper_apis_core_Context(ref common.ReferenceCallback)
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"repositoryContext": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
-						},
-					},
-					"useOCM": {
-						SchemaProps: spec.SchemaProps{
-							Description: "UseOCM defines whether OCM is used to process installations that reference this context.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"registryPullSecrets": {
+					"overwrites": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Description: "Overwrites defines a list of component overwrites",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ComponentVersionOverwrite"),
 									},
 								},
 							},
 						},
 					},
-					"configurations": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"componentVersionOverwrites": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core.AnyJSON", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_ContextConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"repositoryContext": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
-						},
-					},
-					"useOCM": {
-						SchemaProps: spec.SchemaProps{
-							Description: "UseOCM defines whether OCM is used to process installations that reference this context.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"registryPullSecrets": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
-									},
-								},
-							},
-						},
-					},
-					"configurations": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"componentVersionOverwrites": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Status contains the installations that are currently affected by the overwrites.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentVersionOverwritesStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core.AnyJSON", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/gardener/landscaper/apis/core.ComponentVersionOverwrite", "github.com/gardener/landscaper/apis/core.ComponentVersionOverwritesStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ContextList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ComponentVersionOverwritesList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContextList contains a list of Contexts",
+				Description: "ComponentVersionOverwritesList contains a list of ComponentVersionOverwrites",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3666,7 +4118,7 @@ func schema_gardener_landscaper_apis_core_ContextList(ref common.ReferenceCallba
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Context"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ComponentVersionOverwrites"),
 									},
 								},
 							},
@@ -3677,138 +4129,185 @@ func schema_gardener_landscaper_apis_core_ContextList(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Context", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core.ComponentVersionOverwrites", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_DataExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ComponentVersionOverwritesStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataExport is a data object export.",
+				Description: "ComponentVersionOverwritesStatus contains the installations that are currently affected by the overwrites.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported/exported data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"dataRef": {
+					"affectedInstallations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataRef is the name of the in-cluster data object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AffectedInstallations are the installations in the same namespace whose component reference, or the reference of a transitively referenced component, is currently replaced by the overwrites. An installation is listed once for each replaced component reference.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.AffectedInstallation"),
+									},
+								},
+							},
+						},
+					},
+					"invalidOverwrites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InvalidOverwrites lists the overwrites that are ignored because they are invalid, e.g. because of an invalid version constraint.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the status was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"name", "dataRef"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AffectedInstallation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_DataImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataImport is a data object import.",
+				Description: "Condition holds the information about the state of a resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported/exported data.",
+							Description: "DataType of the Shoot condition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dataRef": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataRef is the name of the in-cluster data object.",
+							Description: "Status of the condition, one of True, False, Unknown.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version specifies the imported data version. defaults to \"v1\"",
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef defines a data reference from a secret. This method is not allowed in installation templates.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+							Description: "A human readable message indicating details about the transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"configMapRef": {
+					"codes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMapRef defines a data reference from a configmap. This method is not allowed in installation templates.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalConfigMapReference"),
+							Description: "Well-defined error codes in case the condition reports a problem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "dataRef"},
+				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.LocalConfigMapReference", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_DataObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataObject are resources that can hold any kind of json or yaml data.",
+				Description: "ConfigMapReference is reference to data in a configmap. The configmap can also be in a different namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"data": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the data of the object as string.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "Key is the name of the key in the configmap that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"data"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_DataObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_Context(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataObjectList contains a list of DataObject",
+				Description: "Context is a resource that contains shared information of installations. This includes information about the repository context like the context itself or secrets to access the oci artifacts. But it can also contain deployer specific config.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3828,166 +4327,174 @@ func schema_gardener_landscaper_apis_core_DataObjectList(ref common.ReferenceCal
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+						},
+					},
+					"useOCM": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UseOCM defines whether OCM is used to process installations that reference this context.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"registryPullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.DataObject"),
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.DataObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_Default(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Default defines a default value (future idea: also reference?).",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"value": {
+					"registryCredentials": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "RegistryCredentials define the credentials for dedicated registries. They are used to pull component descriptors, blueprints and other resources, helm charts from oci registries, and the images of the container deployer. For a registry that is matched by a registry credential, the registry pull secrets are not used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.RegistryCredential"),
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"value"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"configurations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the dependent installation",
+							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"componentVersionOverwrites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"verification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verification defines how the signatures of the components of installations that reference this context are verified. If it is not set, no signatures are verified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentVerification"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.ComponentVerification", "github.com/gardener/landscaper/apis/core.RegistryCredential", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ContextConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItem defines a resource that should be processed by a external deployer",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
 						},
 					},
-					"apiVersion": {
+					"useOCM": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
+							Description: "UseOCM defines whether OCM is used to process installations that reference this context.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core.DeployItemSpec"),
-						},
-					},
-					"status": {
+					"registryPullSecrets": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core.DeployItemStatus"),
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"spec"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.DeployItemSpec", "github.com/gardener/landscaper/apis/core.DeployItemStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_DeployItemCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemCache contains the existing deploy items",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"activeDIs": {
+					"registryCredentials": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "RegistryCredentials define the credentials for dedicated registries. They are used to pull component descriptors, blueprints and other resources, helm charts from oci registries, and the images of the container deployer. For a registry that is matched by a registry credential, the registry pull secrets are not used.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.DiNamePair"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core.RegistryCredential"),
 									},
 								},
 							},
 						},
 					},
-					"orphanedDIs": {
+					"configurations": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
+					"componentVersionOverwrites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"verification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verification defines how the signatures of the components of installations that reference this context are verified. If it is not set, no signatures are verified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentVerification"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.DiNamePair"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.ComponentVerification", "github.com/gardener/landscaper/apis/core.RegistryCredential", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ContextList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemList contains a list of DeployItems",
+				Description: "ContextList contains a list of Contexts",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4017,7 +4524,7 @@ func schema_gardener_landscaper_apis_core_DeployItemList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.DeployItem"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Context"),
 									},
 								},
 							},
@@ -4028,255 +4535,293 @@ func schema_gardener_landscaper_apis_core_DeployItemList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.DeployItem", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core.Context", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_DeployItemSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_DataExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemSpec contains the definition of a deploy item.",
+				Description: "DataExport is a data object export.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the deployer that should handle the item.",
+							Description: "Name the internal name of the imported/exported data.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"dataRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target specifies an optional target of the deploy item. In most cases it contains the secrets to access a evironment. It is also used by the deployers to determine the ownernship.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+							Description: "DataRef is the name of the in-cluster data object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"context": {
+				},
+				Required: []string{"name", "dataRef"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_DataImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataImport is a data object import.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the deployitem.",
+							Description: "Name the internal name of the imported/exported data.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"dataRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the deployer type specific configuration.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "DataRef is the name of the in-cluster data object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"timeout": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+							Description: "Version specifies the imported data version. defaults to \"v1\"",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"updateOnChangeOnly": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "SecretRef defines a data reference from a secret. This method is not allowed in installation templates.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
 						},
 					},
-					"onDelete": {
+					"configMapRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnDelete specifies particular setting when deleting a deploy item",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.OnDeleteConfig"),
+							Description: "ConfigMapRef defines a data reference from a configmap. This method is not allowed in installation templates.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalConfigMapReference"),
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"name", "dataRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Duration", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core.LocalConfigMapReference", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_DeployItemStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_DataObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemStatus contains the status of a deploy item",
+				Description: "DataObject are resources that can hold any kind of json or yaml data.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current phase of the DeployItem",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this DeployItem. It corresponds to the DeployItem generation, which is updated on mutation by the landscaper.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"conditions": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a deploy item",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Condition"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"lastError": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
+							Description: "Data contains the data of the object as string.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 						},
 					},
-					"lastErrors": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ErrorHistory describes the last n errors that occurred since JobID was changed the last time.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.Error"),
-									},
-								},
-							},
+				},
+				Required: []string{"data"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_DataObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataObjectList contains a list of DataObject",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"firstError": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FirstError describes the first error that occurred since JobID was changed the last time.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastReconcileTime": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReconcileTime indicates when the reconciliation of the last change to the deploy item has started",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"deployer": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Deployer describes the deployer that has reconciled the deploy item.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.DeployerInformation"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DataObject"),
+									},
+								},
+							},
 						},
 					},
-					"providerStatus": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.DataObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Default(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Default defines a default value (future idea: also reference?).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderStatus contains the provider specific status",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 						},
 					},
-					"exportRef": {
+				},
+				Required: []string{"value"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportReference is the reference to the object that contains the exported values.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+							Description: "Name is the name of the dependent installation",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"jobID": {
+				},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItem defines a resource that should be processed by a external deployer",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDFinished": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDGenerationTime": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"deployItemPhase": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core.DeployItemSpec"),
 						},
 					},
-					"transitionTimes": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TransitionTimes"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core.DeployItemStatus"),
 						},
 					},
 				},
-				Required: []string{"observedGeneration"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DeployerInformation", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core.DeployItemSpec", "github.com/gardener/landscaper/apis/core.DeployItemStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_DeployItemTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_DeployItemCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemTemplate defines a execution element that is translated into a deploy item.",
+				Description: "DeployItemCache contains the existing deploy items",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the execution.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DataType is the DeployItem type of the execution.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"target": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Target is the object reference to the target that the deploy item should deploy to.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
-						},
-					},
-					"labels": {
+					"activeDIs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is the map of labels to be added to the deploy item.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DiNamePair"),
 									},
 								},
 							},
 						},
 					},
-					"config": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfiguration contains the type specific configuration for the execution.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
-					"dependsOn": {
+					"orphanedDIs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DependsOn lists deploy items that need to be executed before this one",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -4288,364 +4833,211 @@ func schema_gardener_landscaper_apis_core_DeployItemTemplate(ref common.Referenc
 							},
 						},
 					},
-					"timeout": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
-						},
-					},
-					"updateOnChangeOnly": {
-						SchemaProps: spec.SchemaProps{
-							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"onDelete": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OnDelete specifies particular setting when deleting a deploy item",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.OnDeleteConfig"),
-						},
-					},
 				},
-				Required: []string{"name", "type", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Duration", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core.DiNamePair"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
+				Description: "DeployItemList contains a list of DeployItems",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"identity": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identity describes the unique identity of the deployer.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the deployer.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the deployer.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DeployItem"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"identity", "name", "version"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.DeployItem", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_DiNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_DeployItemSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Description: "DeployItemSpec contains the definition of a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"specName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"objectName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Duration is a wrapper for time.Duration that implements JSON marshalling and openapi scheme.",
-				Type:        core.Duration{}.OpenAPISchemaType(),
-				Format:      core.Duration{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_Error(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Error holds information about an error that occurred.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"operation": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Operation describes the operator where the error ocurred.",
+							Description: "Type is the type of the deployer that should handle the item.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"reason": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Target specifies an optional target of the deploy item. In most cases it contains the secrets to access a evironment. It is also used by the deployers to determine the ownernship.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
 						},
 					},
-					"message": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Default:     "",
+							Description: "Context defines the current context of the deployitem.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"codes": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Well-defined error codes in case the condition reports a problem.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Configuration contains the deployer type specific configuration.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
-				},
-				Required: []string{"operation", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Execution contains the configuration of a execution and deploy item",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
 						},
 					},
-					"apiVersion": {
+					"updateOnChangeOnly": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
+							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines a execution and its items",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ExecutionSpec"),
-						},
-					},
-					"status": {
+					"onDelete": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the current status of the execution.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ExecutionStatus"),
+							Description: "OnDelete specifies particular setting when deleting a deploy item",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.OnDeleteConfig"),
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.ExecutionSpec", "github.com/gardener/landscaper/apis/core.ExecutionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core.Duration", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_DeployItemStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionList contains a list of Executions‚",
+				Description: "DeployItemStatus contains the status of a deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Phase is the current phase of the DeployItem",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ObservedGeneration is the most recent generation observed for this DeployItem. It corresponds to the DeployItem generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"items": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Conditions contains the actual condition of a deploy item",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Execution"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Condition"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Execution", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_ExecutionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionSpec defines a execution plan.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"context": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the execution.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
 						},
 					},
-					"deployItems": {
+					"lastErrors": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItems defines all execution items that need to be scheduled.",
+							Description: "ErrorHistory describes the last n errors that occurred since JobID was changed the last time.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.DeployItemTemplate"),
+										Ref: ref("github.com/gardener/landscaper/apis/core.Error"),
 									},
 								},
 							},
 						},
 					},
-					"deployItemsCompressed": {
+					"firstError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItemsCompressed as zipped byte array",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Description: "FirstError describes the first error that occurred since JobID was changed the last time.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.DeployItemTemplate"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_ExecutionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionStatus contains the current status of a execution.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"lastReconcileTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Execution. It corresponds to the Execution generation, which is updated on mutation by the landscaper.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "LastReconcileTime indicates when the reconciliation of the last change to the deploy item has started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"conditions": {
+					"deployer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a execution",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Condition"),
-									},
-								},
-							},
+							Description: "Deployer describes the deployer that has reconciled the deploy item.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.DeployerInformation"),
 						},
 					},
-					"lastError": {
+					"providerStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
+							Description: "ProviderStatus contains the provider specific status",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
 					"exportRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportReference references the object that contains the exported values. only used for operation purpose.",
+							Description: "ExportReference is the reference to the object that contains the exported values.",
 							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
 						},
 					},
-					"deployItemCache": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeployItemCache contains the currently existing deploy item belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.DeployItemCache"),
-						},
-					},
 					"jobID": {
 						SchemaProps: spec.SchemaProps{
 							Description: "JobID is the ID of the current working request.",
@@ -4660,17 +5052,17 @@ func schema_gardener_landscaper_apis_core_ExecutionStatus(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
-					"phase": {
+					"jobIDGenerationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionPhase is the current phase of the execution.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"phaseTransitionTime": {
+					"deployItemPhase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PhaseTransitionTime is the time when the phase last changed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"transitionTimes": {
@@ -4684,218 +5076,251 @@ func schema_gardener_landscaper_apis_core_ExecutionStatus(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DeployItemCache", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DeployerInformation", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ExportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_DeployItemTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExportDefinition defines a exported value",
+				Description: "DeployItemTemplate defines a execution element that is translated into a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Description: "Name is the unique name of the execution.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schema": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"),
+							Description: "DataType is the DeployItem type of the execution.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targetType": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Target is the object reference to the target that the deploy item should deploy to.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
 						},
 					},
-					"type": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies which kind of object is being exported. This field should be set and will likely be mandatory in future.",
-							Type:        []string{"string"},
+							Description: "Labels is the map of labels to be added to the deploy item.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfiguration contains the type specific configuration for the execution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn lists deploy items that need to be executed before this one",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+						},
+					},
+					"updateOnChangeOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"onDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnDelete specifies particular setting when deleting a deploy item",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.OnDeleteConfig"),
+						},
+					},
 				},
-				Required: []string{"name"},
+				Required: []string{"name", "type", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"},
+			"github.com/gardener/landscaper/apis/core.Duration", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations",
+				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"numberOfReconciles": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NumberOfReconciles specifies the maximal number of automatically repeated reconciliations. If not set, no upper limit exists.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Identity describes the unique identity of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"interval": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+							Description: "Name is the name of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"identity", "name", "version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Duration"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_FieldValueDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_DiNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FieldValueDefinition defines a im- or exported field. Either schema or target type have to be defined",
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"schema": {
+					"specName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"targetType": {
+					"objectName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImportDefinition defines a imported value",
+				Description: "Duration is a wrapper for time.Duration that implements JSON marshalling and openapi scheme.",
+				Type:        core.Duration{}.OpenAPISchemaType(),
+				Format:      core.Duration{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Error(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Error holds information about an error that occurred.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"operation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Description: "Operation describes the operator where the error ocurred.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schema": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"),
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"targetType": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Last time the condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"type": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
+							Description: "The reason for the condition's last transition.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"required": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
-							Type:        []string{"boolean"},
+							Description: "A human readable message indicating details about the transition.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"default": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Default sets a default value for the current import that is used if the key is not set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Default"),
-						},
-					},
-					"imports": {
+					"codes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports. todo: maybe restrict only for required=false todo: see if this works with recursion",
+							Description: "Well-defined error codes in case the condition reports a problem.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.ImportDefinition"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"name"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Default", "github.com/gardener/landscaper/apis/core.ImportDefinition", "github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InlineBlueprint defines an inline blueprint with component descriptor and filesystem.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"filesystem": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-						},
-					},
-				},
-				Required: []string{"filesystem"},
+				Required: []string{"operation", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Installation contains the configuration of a installation",
+				Description: "Execution contains the configuration of a execution and deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4920,16 +5345,15 @@ func schema_gardener_landscaper_apis_core_Installation(ref common.ReferenceCallb
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification for a installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationSpec"),
+							Description: "Spec defines a execution and its items",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ExecutionSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status of the installation.",
+							Description: "Status contains the current status of the execution.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ExecutionStatus"),
 						},
 					},
 				},
@@ -4937,101 +5361,15 @@ func schema_gardener_landscaper_apis_core_Installation(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.InstallationSpec", "github.com/gardener/landscaper/apis/core.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core.ExecutionSpec", "github.com/gardener/landscaper/apis/core.ExecutionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationExports defines exports of data objects and targets.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"data": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object exports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.DataExport"),
-									},
-								},
-							},
-						},
-					},
-					"targets": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target exports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetExport"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.DataExport", "github.com/gardener/landscaper/apis/core.TargetExport"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InstallationImports defines import of data objects and targets.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"data": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object imports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.DataImport"),
-									},
-								},
-							},
-						},
-					},
-					"targets": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target imports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetImport"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.DataImport", "github.com/gardener/landscaper/apis/core.TargetImport"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InstallationList contains a list of Components",
+				Description: "ExecutionList contains a list of Executions‚",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -5061,7 +5399,7 @@ func schema_gardener_landscaper_apis_core_InstallationList(ref common.ReferenceC
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Installation"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Execution"),
 									},
 								},
 							},
@@ -5072,110 +5410,63 @@ func schema_gardener_landscaper_apis_core_InstallationList(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core.Execution", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ExecutionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationSpec defines a component installation.",
+				Description: "ExecutionSpec defines a execution plan.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the installation.",
+							Description: "Context defines the current context of the execution.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"componentDescriptor": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition"),
-						},
-					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the definition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.BlueprintDefinition"),
-						},
-					},
-					"imports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
-						},
-					},
-					"importDataMappings": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"exports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
-						},
-					},
-					"exportDataMappings": {
+					"deployItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "DeployItems defines all execution items that need to be scheduled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DeployItemTemplate"),
 									},
 								},
 							},
 						},
 					},
-					"automaticReconcile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcile"),
-						},
-					},
-					"optimization": {
+					"deployItemsCompressed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
+							Description: "DeployItemsCompressed as zipped byte array",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
 				},
-				Required: []string{"blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.AutomaticReconcile", "github.com/gardener/landscaper/apis/core.BlueprintDefinition", "github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.Optimization"},
+			"github.com/gardener/landscaper/apis/core.DeployItemTemplate"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ExecutionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationStatus contains the current status of a Installation.",
+				Description: "ExecutionStatus contains the current status of a execution.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
+							Description: "ObservedGeneration is the most recent generation observed for this Execution. It corresponds to the Execution generation, which is updated on mutation by the landscaper.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
@@ -5183,7 +5474,7 @@ func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.Referenc
 					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a installation",
+							Description: "Conditions contains the actual condition of a execution",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5201,16 +5492,16 @@ func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
 						},
 					},
-					"subInstCache": {
+					"exportRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SubInstCache"),
+							Description: "ExportReference references the object that contains the exported values. only used for operation purpose.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
 						},
 					},
-					"executionRef": {
+					"deployItemCache": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+							Description: "DeployItemCache contains the currently existing deploy item belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.DeployItemCache"),
 						},
 					},
 					"jobID": {
@@ -5229,7 +5520,7 @@ func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.Referenc
 					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InstallationPhase is the current phase of the installation.",
+							Description: "ExecutionPhase is the current phase of the execution.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5240,33 +5531,6 @@ func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.Referenc
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"importsHash": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ImportsHash is the hash of the import data.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"automaticReconcileStatus": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus"),
-						},
-					},
-					"dependentsToTrigger": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DependentsToTrigger lists dependent installations to be triggered",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.DependentToTrigger"),
-									},
-								},
-							},
-						},
-					},
 					"transitionTimes": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TransitionTimes contains timestamps of status transitions",
@@ -5278,162 +5542,106 @@ func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DependentToTrigger", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.SubInstCache", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DeployItemCache", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ExportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Description: "ExportDefinition defines a exported value",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"),
 						},
 					},
-					"name": {
+					"targetType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
-							Default:     "",
+							Description: "TargetType defines the type of the imported target.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
-						},
-					},
-					"imports": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
-						},
-					},
-					"importDataMappings": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"exports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
-						},
-					},
-					"exportDataMappings": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"optimization": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
+							Description: "Type specifies which kind of object is being exported. This field should be set and will likely be mandatory in future.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "blueprint"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
+			"github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
+				Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"numberOfReconciles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NumberOfReconciles specifies the maximal number of automatically repeated reconciliations. If not set, no upper limit exists.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"filesystem": {
+					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JSONSchemaDefinition defines a jsonschema.",
-				Type:        core.JSONSchemaDefinition{}.OpenAPISchemaType(),
-				Format:      core.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
-			},
-		},
+			"github.com/gardener/landscaper/apis/core.Duration"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_FieldValueDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalConfigMapReference is a reference to data in a configmap.",
+				Description: "FieldValueDefinition defines a im- or exported field. Either schema or target type have to be defined",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the configmap",
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
-							Default:     "",
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"),
+						},
+					},
+					"targetType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetType defines the type of the imported target.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5442,102 +5650,110 @@ func schema_gardener_landscaper_apis_core_LocalConfigMapReference(ref common.Ref
 				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalSecretReference is a reference to data in a secret.",
+				Description: "ImportDefinition defines a imported value",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the secret",
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"),
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"targetType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "TargetType defines the type of the imported target.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"required": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"default": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status defines the overall status of the landscaper and its standard deployers.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Default sets a default value for the current import that is used if the key is not set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Default"),
 						},
 					},
-					"lastUpdateTime": {
+					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the check was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports. todo: maybe restrict only for required=false todo: see if this works with recursion",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ImportDefinition"),
+									},
+								},
+							},
 						},
 					},
-					"description": {
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Default", "github.com/gardener/landscaper/apis/core.ImportDefinition", "github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InlineBlueprint defines an inline blueprint with component descriptor and filesystem.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"filesystem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description contains description of the problem(s)",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 						},
 					},
 				},
-				Required: []string{"status", "lastUpdateTime", "description"},
+				Required: []string{"filesystem"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Description: "Installation contains the configuration of a installation",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -5557,185 +5773,2028 @@ func schema_gardener_landscaper_apis_core_LsHealthCheckList(ref common.Reference
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.LsHealthCheck"),
-									},
-								},
-							},
+							Description: "Spec contains the specification for a installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status of the installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationStatus"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core.InstallationSpec", "github.com/gardener/landscaper/apis/core.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamedObjectReference is a named reference to a specific resource.",
+				Description: "InstallationExports defines exports of data objects and targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Data defines all data object exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DataExport"),
+									},
+								},
+							},
 						},
 					},
-					"ref": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+							Description: "Targets defines all target exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetExport"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.ObjectReference"},
+			"github.com/gardener/landscaper/apis/core.DataExport", "github.com/gardener/landscaper/apis/core.TargetExport"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationImports defines import of data objects and targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data defines all data object imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DataImport"),
+									},
+								},
+							},
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets defines all target imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetImport"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.DataImport", "github.com/gardener/landscaper/apis/core.TargetImport"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationList contains a list of Components",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Installation"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationSpec defines a component installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"context": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Context defines the current context of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"componentDescriptor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition"),
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blueprint is the resolved reference to the definition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.BlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"automaticReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcile"),
+						},
+					},
+					"versionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionPolicy enables automatic upgrades of the version of the referenced component. It is only evaluated for installations that reference their component with a component descriptor reference.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.VersionPolicy"),
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
+						},
+					},
+				},
+				Required: []string{"blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.AutomaticReconcile", "github.com/gardener/landscaper/apis/core.BlueprintDefinition", "github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.Optimization", "github.com/gardener/landscaper/apis/core.VersionPolicy"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationStatus contains the current status of a Installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the actual condition of a installation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
+						},
+					},
+					"subInstCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SubInstCache"),
+						},
+					},
+					"executionRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationPhase is the current phase of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phaseTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PhaseTransitionTime is the time when the phase last changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"importsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportsHash is the hash of the import data.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"automaticReconcileStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus"),
+						},
+					},
+					"versionUpgradeStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionUpgradeStatus describes the automatic upgrades of the component version.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.VersionUpgradeStatus"),
+						},
+					},
+					"dependentsToTrigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependentsToTrigger lists dependent installations to be triggered",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DependentToTrigger"),
+									},
+								},
+							},
+						},
+					},
+					"transitionTimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TransitionTimes"),
+						},
+					},
+				},
+				Required: []string{"observedGeneration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DependentToTrigger", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.SubInstCache", "github.com/gardener/landscaper/apis/core.TransitionTimes", "github.com/gardener/landscaper/apis/core.VersionUpgradeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
+						},
+					},
+				},
+				Required: []string{"name", "blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JSONSchemaDefinition defines a jsonschema.",
+				Type:        core.JSONSchemaDefinition{}.OpenAPISchemaType(),
+				Format:      core.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalConfigMapReference is a reference to data in a configmap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the configmap",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the configmap that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalSecretReference is a reference to data in a secret.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status defines the overall status of the landscaper and its standard deployers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime contains last time the check was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description contains description of the problem(s)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"status", "lastUpdateTime", "description"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.LsHealthCheck"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow is a daily time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"begin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Begin is the begin of the time window in the format \"HH:MM\", e.g. \"22:00\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end of the time window in the format \"HH:MM\", e.g. \"04:00\". A time window ends on the next day if the end is before the begin.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Location is the name of the time zone of the begin and end, e.g. \"Europe/Berlin\". Defaults to \"UTC\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"begin", "end"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamedObjectReference is a named reference to a specific resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+						},
+					},
+				},
+				Required: []string{"name", "ref"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.ObjectReference"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Description: "ObjectReference is the reference to a kubernetes object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"skipUninstallIfClusterRemoved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Optimization contains settings to improve execution preformance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hasNoSiblingImports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasNoSiblingExports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_PrefetchedComponent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrefetchedComponent describes the state of a prefetched component version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName is the name of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefetched": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefetched is true if the component version and its resources have been prefetched successfully.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes why the component version could not be prefetched.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"componentName", "version", "prefetched"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_RegistryCredential(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryCredential defines the credentials for the registries that match a pattern.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"registry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Registry is the pattern of the registries the credential is used for. It consists of a host, an optional port and an optional repository prefix, e.g. \"registry.example.com:5000/my-project\". A \"*\" in the host matches any sequence of characters, e.g. \"*.dkr.ecr.*.amazonaws.com\". If several credentials match a registry, the one with the longest repository prefix is used.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references a secret in the namespace of the context. Without a token source, the secret contains either the keys \"username\" and \"password\" for basic authentication, or the key \"token\" for bearer token authentication. With a token source of a given type, the secret is required and contains the input of the token source, e.g. a client secret.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"tokenSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenSource obtains short-lived credentials, e.g. with an OAuth2 token exchange.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.RegistryTokenSource"),
+						},
+					},
+				},
+				Required: []string{"registry"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.RegistryTokenSource", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_RegistryTokenSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryTokenSource defines how short-lived registry credentials are obtained. Either a type or the name of a token source of the landscaper configuration has to be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the token source, e.g. \"oauth2\", \"gcp\" or \"aws\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config contains the type specific configuration of the token source.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name references a token source of the landscaper configuration. Such token sources may use the identity of the landscaper, e.g. its workload identity, and are only available to contexts in the namespaces that are allowed by the operator.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"operator": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"key", "operator"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target contains the original target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Target"),
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "content"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Target"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"componentName", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is a map of labels to select specific secrets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The key of the secret to select from.  Must be a valid secret key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"selector", "key"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticDataSource defines a static data source",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value defined inline a raw data",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+					"valueFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValueFrom defines data from an external resource",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.StaticDataValueFrom"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.StaticDataValueFrom"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key of a secret in the installations's namespace",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"secretLabelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubInstCache contains the existing sub installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"activeSubs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.SubNamePair"),
+									},
+								},
+							},
+						},
+					},
+					"orphanedSubs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.SubNamePair"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"specName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"objectName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File references a subinstallation template stored in another file.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
+						},
+					},
+				},
+				Required: []string{"name", "blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Duration"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "The SyncObject helps to sync access to deploy items.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.SyncObjectSpec", "github.com/gardener/landscaper/apis/core.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncObjectList contains a list of SyncObject objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.SyncObject"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncObjectSpec contains the specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodName describes the name of the pod of the responsible deployer",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind describes the kind of object that is being locked by this SyncObject",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the object that is being locked by this SyncObject",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime contains last time the object was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is the prefix of the name of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncObjectStatus contains the status.",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetExport is a single target export.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name the internal name of the exported target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the name of the in-cluster target object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetImport is either a single target or a target list import.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name the internal name of the imported target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetListRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetMap": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetList contains a list of Targets",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Target"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSelector describes a selector that matches specific targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+									},
+								},
+							},
 						},
 					},
-					"namespace": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
+							Description: "Annotations matches a target based on annotations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels matches a target based on its labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.Requirement"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSpec contains the definition of a target.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+						},
+					},
 				},
-				Required: []string{"name"},
+				Required: []string{"type"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
+				Description: "The TargetSync is created targets from secrets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"skipUninstallIfClusterRemoved": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncStatus"),
+						},
+					},
 				},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.TargetSyncSpec", "github.com/gardener/landscaper/apis/core.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Optimization contains settings to improve execution preformance",
+				Description: "TargetSyncList contains a list of TargetSync objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"hasNoSiblingImports": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hasNoSiblingExports": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSync"),
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Description: "TargetSyncSpec contains the specification for a TargetSync.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resourceName": {
+					"sourceNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+						},
+					},
+					"createTargetToSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"targetToSourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shootNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"tokenRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TokenRotation"),
+						},
+					},
 				},
-				Required: []string{"resourceName"},
+				Required: []string{"sourceNamespace", "secretRef"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.LocalSecretReference", "github.com/gardener/landscaper/apis/core.TokenRotation"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Description: "TargetSyncStatus contains the status of a TargetSync.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"operator": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the status was updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"values": {
+					"lastErrors": {
 						SchemaProps: spec.SchemaProps{
-							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Description: "LastErrors describe the last errors",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5748,83 +7807,50 @@ func schema_gardener_landscaper_apis_core_Requirement(ref common.ReferenceCallba
 							},
 						},
 					},
-				},
-				Required: []string{"key", "operator"},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"target": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Target contains the original target.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Target"),
-						},
-					},
-					"content": {
+					"lastTokenRotationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Last time the token was rotated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"target", "content"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Target"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 						},
 					},
-				},
-				Required: []string{"componentName", "resourceName"},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"selector": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selector is a map of labels to select specific secrets.",
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5838,563 +7864,592 @@ func schema_gardener_landscaper_apis_core_SecretLabelSelectorRef(ref common.Refe
 							},
 						},
 					},
-					"key": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The key of the secret to select from.  Must be a valid secret key.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"selector", "key"},
+				Required: []string{"type"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Description: "TemplateExecutor describes a templating mechanism and configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
+							Description: "Name is the unique name of the template",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
+							Description: "Type describes the templating mechanism.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"file": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
+							Description: "File is the path to the template in the blueprint's content.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+					"libraries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Libraries references template libraries whose named templates and stubs are made available to the template.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.TemplateLibraryReference"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"name"},
+				Required: []string{"name", "type"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.TemplateLibraryReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TemplateLibraryReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataSource defines a static data source",
+				Description: "TemplateLibraryReference references a template library that is defined as a resource of a component.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"value": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "Name is the unique name of the library in the template executor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"valueFrom": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.StaticDataValueFrom"),
+							Description: "Ref references the library resource in the component descriptor of the blueprint, e.g. \"cd://resources/my-library\" or \"cd://componentReferences/lib/resources/my-library\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "ref"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.StaticDataValueFrom"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"secretKeyRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the installations's namespace",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
-						},
-					},
-					"secretLabelSelector": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef"),
+							Description: "Enabled defines if automatic token is executed",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubInstCache contains the existing sub installations",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"activeSubs": {
+					"triggerTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.SubNamePair"),
-									},
-								},
-							},
+							Description: "TriggerTime is the time when the jobID is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"orphanedSubs": {
+					"initTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "InitTime is the time when the Init phase starts.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"waitTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitTime is the time when the work is done.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"finishedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FinishedTime is the time when the finished phase is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SubNamePair"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"specName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"objectName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is the type of resource being referenced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"apiVersion", "kind", "name"},
 			},
 		},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_VersionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Description: "VersionPolicy configures automatic upgrades of the version of the component of an installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"file": {
+					"constraint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File references a subinstallation template stored in another file.",
+							Description: "Constraint defines the versions the installation may be upgraded to. It is either a semantic version constraint, e.g. \"~1.4\" or \">= 1.4, < 2\", or one of \"latest\", \"latest-minor\" and \"latest-patch\", which allow any newer version, newer versions with the same major version, and newer versions with the same major and minor version as the current version.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"kind": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Mode defines whether the newest allowed version is applied to the installation or only proposed in its status. Proposed versions are applied when they are approved with the approve-version-upgrade annotation. Defaults to \"Apply\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Schedule is a standard crontab specification of the times when the available versions are checked. Defaults to \"0 * * * *\", i.e. every hour.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"maintenanceWindow": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaintenanceWindow restricts the times when versions are applied automatically. If not set, versions are applied whenever they are found.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.MaintenanceWindow"),
 						},
 					},
-					"blueprint": {
+				},
+				Required: []string{"constraint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.MaintenanceWindow"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_VersionUpgradeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionUpgradeStatus describes the automatic upgrades of the component version of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastCheckTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+							Description: "LastCheckTime is the time when the available versions were checked the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"imports": {
+					"proposedVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+							Description: "ProposedVersion is the newest version allowed by the version policy that has not been applied yet, because the policy only proposes versions or because the maintenance window has not been reached.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"importDataMappings": {
+					"previousVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
+							Description: "PreviousVersion is the version before the last automatic upgrade. It is used to roll the upgrade back with the rollback-version-upgrade annotation.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"exports": {
+					"lastUpgradeTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
+							Description: "LastUpgradeTime is the time of the last automatic upgrade.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"exportDataMappings": {
+					"rejectedVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
+							Description: "RejectedVersion is the version that has been rolled back. It is not selected again by the version policy.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"optimization": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
+							Description: "LastError describes the last error that prevented the available versions from being checked.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
+				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"interval": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.VersionedObjectReference"),
 						},
 					},
 				},
+				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Duration"},
+			"github.com/gardener/landscaper/apis/core.VersionedObjectReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The SyncObject helps to sync access to deploy items.",
+				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectSpec"),
-						},
-					},
-					"status": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectStatus"),
+							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"name", "observedGeneration"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SyncObjectSpec", "github.com/gardener/landscaper/apis/core.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectList contains a list of SyncObject objects",
+				Description: "VersionedResourceReference defines the reference to a resource with its version.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.SyncObject"),
-									},
-								},
-							},
+							Description: "Version defines the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"componentName", "resourceName", "version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_AffectedInstallation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectSpec contains the specification.",
+				Description: "AffectedInstallation describes a component reference of an installation that is replaced by an overwrite.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName describes the name of the pod of the responsible deployer",
+							Description: "Name is the name of the installation.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"kind": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind describes the kind of object that is being locked by this SyncObject",
+							Description: "Context is the name of the context of the installation, which references the overwrites.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the object that is being locked by this SyncObject",
+							Description: "ComponentName is the name of the replaced component, which is referenced by the installation or by one of its transitively referenced components.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the object was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"prefix": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prefix is the prefix of the name of the object.",
+							Description: "Version is the version of the replaced component.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"substitution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Substitution is the component reference that is used instead.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
+						},
+					},
 				},
-				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
+				Required: []string{"name", "context", "componentName", "version", "substitution"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectStatus contains the status.",
-				Type:        []string{"object"},
+				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated.",
+				Type:        v1alpha1.AnyJSON{}.OpenAPISchemaType(),
+				Format:      v1alpha1.AnyJSON{}.OpenAPISchemaFormat(),
 			},
 		},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
+				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"succeededReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"),
 						},
 					},
-					"spec": {
+					"failedReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSpec"),
+							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile"),
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetExport is a single target export.",
+				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation describes the generation of the installation for which the status holds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"numberOfReconciles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the exported target.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"target": {
+					"lastReconcileTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object.",
-							Type:        []string{"string"},
+							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"onFailed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetImport is either a single target or a target list import.",
+				Description: "Blueprint contains the configuration of a component",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported target.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targets": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -6405,188 +8460,278 @@ func schema_gardener_landscaper_apis_core_TargetImport(ref common.ReferenceCallb
 							},
 						},
 					},
-					"targetListRef": {
+					"jsonSchemaVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetMap": {
+					"localTypes": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "LocalTypes defines additional blueprint local schemas",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
 									},
 								},
 							},
 						},
 					},
-					"targetMapRef": {
+					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Imports define the import values that are needed for the definition and its sub-definitions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TargetList contains a list of Targets",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"importExecutions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ImportExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of errors",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Exports define the exported values of the definition and its sub-definitions",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition"),
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"subinstallations": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Subinstallations defines an optional list of subinstallations (for aggregating blueprints).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate"),
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"subinstallationExecutions": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "SubinstallationExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of installation templates. Both subinstallations and SubinstallationExecutions are valid options and will be merged.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Target"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TargetSelector describes a selector that matches specific targets.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"targets": {
+					"deployExecutions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
+							Description: "DeployExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of deploy item templates.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
 									},
 								},
 							},
 						},
 					},
-					"annotations": {
+					"exportExecutions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations matches a target based on annotations.",
+							Description: "ExportExecutions defines the templating executors that are used to generate the exports.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
 									},
 								},
 							},
 						},
 					},
-					"labels": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_BlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlueprintDefinition defines the blueprint that should be used for the installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a remote reference to a blueprint",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"),
+						},
+					},
+					"inline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inline defines a inline yaml filesystem with a blueprint.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint", "github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlueprintStaticDataSource defines a static data source for a blueprint",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value defined inline a raw data",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+					"valueFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValueFrom defines data from an external resource",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlueprintStaticDataValueFrom defines static data that is read from a external resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"localPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key of a secret in the installations's namespace",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentDescriptorDefinition defines the component descriptor that should be used for the installation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
+						},
+					},
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels matches a target based on its labels.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
-									},
-								},
-							},
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.Requirement"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSpec contains the definition of a target.",
+				Description: "ComponentDescriptorReference is the reference to a component descriptor. given an optional context.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
 						},
 					},
-					"config": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secretRef": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+							Description: "Version defines the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"componentName", "version"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentPrefetch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The TargetSync is created targets from secrets.",
+				Description: "ComponentPrefetch defines component versions that are loaded into the caches of the landscaper together with their blueprints and helm charts. The component versions are prefetched whenever the object changes and after every restart of the landscaper.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6613,14 +8758,14 @@ func schema_gardener_landscaper_apis_core_TargetSync(ref common.ReferenceCallbac
 						SchemaProps: spec.SchemaProps{
 							Description: "Spec contains the specification",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncSpec"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentPrefetchSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Status contains the status",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentPrefetchStatus"),
 						},
 					},
 				},
@@ -6628,15 +8773,15 @@ func schema_gardener_landscaper_apis_core_TargetSync(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.TargetSyncSpec", "github.com/gardener/landscaper/apis/core.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentPrefetchSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentPrefetchStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentPrefetchList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncList contains a list of TargetSync objects",
+				Description: "ComponentPrefetchList contains a list of ComponentPrefetch objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6666,7 +8811,7 @@ func schema_gardener_landscaper_apis_core_TargetSyncList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSync"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentPrefetch"),
 									},
 								},
 							},
@@ -6677,82 +8822,65 @@ func schema_gardener_landscaper_apis_core_TargetSyncList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentPrefetch", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentPrefetchSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncSpec contains the specification for a TargetSync.",
+				Description: "ComponentPrefetchSpec contains the specification for a ComponentPrefetch.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sourceNamespace": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
-							Default:     "",
+							Description: "Context is the name of the context in the namespace of the ComponentPrefetch object that is used to resolve the listed components. It defines the repository context, the registry pull secrets and whether OCM is used. Defaults to the default context.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
+					"components": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+							Description: "Components lists the component versions that are prefetched. If the repository context of a component is not set, the repository context of the context is used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
+									},
+								},
+							},
 						},
 					},
-					"createTargetToSource": {
+					"installations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
+							Description: "Installations defines whether the component versions of all installations in the namespace of the ComponentPrefetch object are prefetched. These component versions are resolved with the context of the respective installation.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"targetToSourceName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"secretNameExpression": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"shootNameExpression": {
+					"includeReferences": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "IncludeReferences defines whether the component versions referenced by the prefetched component versions are prefetched transitively.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"tokenRotation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TokenRotation"),
-						},
-					},
 				},
-				Required: []string{"sourceNamespace", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.LocalSecretReference", "github.com/gardener/landscaper/apis/core.TokenRotation"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentPrefetchStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncStatus contains the status of a TargetSync.",
+				Description: "ComponentPrefetchStatus contains the status of a ComponentPrefetch.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"observedGeneration": {
@@ -6763,30 +8891,67 @@ func schema_gardener_landscaper_apis_core_TargetSyncStatus(ref common.ReferenceC
 							Format:      "int64",
 						},
 					},
-					"lastUpdateTime": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the status was updated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Phase is the current phase of the prefetch.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastErrors": {
+					"total": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastErrors describe the last errors",
+							Description: "Total is the number of component versions that have been found so far.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"prefetched": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefetched is the number of component versions that have been prefetched successfully.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is the number of component versions that could not be prefetched.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"components": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Components contains the state of at most 100 component versions. If there are more, failed component versions take precedence over prefetched ones.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.PrefetchedComponent"),
 									},
 								},
 							},
 						},
 					},
-					"lastTokenRotationTime": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the token was rotated",
+							Description: "LastError describes the last error that prevented the component versions from being collected.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the status was updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time a prefetch was completed",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
@@ -6794,419 +8959,446 @@ func schema_gardener_landscaper_apis_core_TargetSyncStatus(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.PrefetchedComponent", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVerification(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
+				Description: "ComponentVerification defines how the signatures of component versions are verified.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"policies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
-							Default:     "",
+							Description: "Policies define which component versions are verified with which keys. The first policy whose component name pattern matches the name of a component is applied. Component versions that are not matched by any policy are not verified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVerificationPolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"policies"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVerificationPolicy"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ComponentVerificationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentVerificationPolicy defines how the signatures of the component versions with a matching name are verified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentNamePattern": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentNamePattern selects the components the policy applies to. A \"*\" matches any sequence of characters, e.g. \"github.com/gardener/*\". Defaults to \"*\", which matches all components.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "Mode defines whether the signature of a component version is required, optional or not verified.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secretRef": {
+					"signatureName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+							Description: "SignatureName is the name of the signature that is verified. If it is empty, a component version is valid if any of its signatures is verified by a trusted key.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"trustedKeys": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "TrustedKeys reference secrets in the namespace of the context that contain PEM encoded public keys or certificates. If no key of a secret is given, all entries of the secret are read.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 									},
 								},
 							},
 						},
 					},
-					"annotations": {
+				},
+				Required: []string{"mode"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentVersionOverwrite defines an overwrite for a specific component and/or version of a component.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Source defines the component that should be replaced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+						},
+					},
+					"substitution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Substitution defines the replacement target for the component or version.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"source", "substitution"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwriteReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateExecutor describes a templating mechanism and configuration.",
+				Description: "ComponentVersionOverwriteReference defines a component reference by",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the template",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
 						},
 					},
-					"type": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type describes the templating mechanism.",
+							Description: "ComponentName defines the unique of the component containing the resource. In the source of an overwrite, the name may contain the wildcard \"*\", which matches any sequence of characters, e.g. \"github.com/acme/*\".",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"file": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File is the path to the template in the blueprint's content.",
+							Description: "Version defines the version of the component.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"template": {
+					"versionConstraint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "VersionConstraint defines a semantic version constraint, e.g. \">= 1.2, < 2\". It is only evaluated in the source of an overwrite, where it matches all versions that satisfy the constraint. It must not be combined with a version.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ComponentVersionOverwrites contain overwrites for specific (versions of) components.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled defines if automatic token is executed",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"triggerTime": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TriggerTime is the time when the jobID is set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"initTime": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitTime is the time when the Init phase starts.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"waitTime": {
+					"overwrites": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitTime is the time when the work is done.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Overwrites defines a list of component overwrites",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite"),
+									},
+								},
+							},
 						},
 					},
-					"finishedTime": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FinishedTime is the time when the finished phase is set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Status contains the installations that are currently affected by the overwrites.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwritesStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwritesStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
+				Description: "ComponentVersionOverwritesList contains a list of ComponentVersionOverwrites",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiVersion": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"kind": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is the type of resource being referenced",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"namespace": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"apiVersion", "kind", "name"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "ComponentVersionOverwritesStatus contains the installations that are currently affected by the overwrites.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"ref": {
+					"affectedInstallations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.VersionedObjectReference"),
+							Description: "AffectedInstallations are the installations in the same namespace whose component reference, or the reference of a transitively referenced component, is currently replaced by the overwrites. An installation is listed once for each replaced component reference.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.AffectedInstallation"),
+									},
+								},
+							},
+						},
+					},
+					"invalidOverwrites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InvalidOverwrites lists the overwrites that are ignored because they are invalid, e.g. because of an invalid version constraint.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the status was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.VersionedObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AffectedInstallation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "Condition holds the information about the state of a resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
+							Description: "DataType of the Shoot condition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
+							Description: "Status of the condition, one of True, False, Unknown.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-				Required: []string{"name", "observedGeneration"},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "VersionedResourceReference defines the reference to a resource with its version.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"componentName": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Last time the condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"resourceName": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
+							Description: "The reason for the condition's last transition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
+							Description: "A human readable message indicating details about the transition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"componentName", "resourceName", "version"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated.",
-				Type:        v1alpha1.AnyJSON{}.OpenAPISchemaType(),
-				Format:      v1alpha1.AnyJSON{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"succeededReconcile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"),
-						},
-					},
-					"failedReconcile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile"),
+					"codes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Well-defined error codes in case the condition reports a problem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+				Description: "ConfigMapReference is reference to data in a configmap. The configmap can also be in a different namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"generation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Generation describes the generation of the installation for which the status holds.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"numberOfReconciles": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastReconcileTime": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"onFailed": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
-							Type:        []string{"boolean"},
+							Description: "Key is the name of the key in the configmap that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Blueprint contains the configuration of a component",
+				Description: "Context is a resource that contains shared information of installations. This includes information about the repository context like the context itself or secrets to access the oci artifacts. But it can also contain deployer specific config.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7223,361 +9415,306 @@ func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
-					"annotations": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"jsonSchemaVersion": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
 						},
 					},
-					"localTypes": {
+					"useOCM": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LocalTypes defines additional blueprint local schemas",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
-									},
-								},
-							},
+							Description: "UseOCM defines whether OCM is used to process installations that reference this context.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"imports": {
+					"registryPullSecrets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the import values that are needed for the definition and its sub-definitions.",
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
 									},
 								},
 							},
 						},
 					},
-					"importExecutions": {
+					"registryCredentials": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of errors",
+							Description: "RegistryCredentials define the credentials for dedicated registries. They are used to pull component descriptors, blueprints and other resources, helm charts from oci registries, and the images of the container deployer. For a registry that is matched by a registry credential, the registry pull secrets are not used.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.RegistryCredential"),
 									},
 								},
 							},
 						},
 					},
-					"exports": {
+					"configurations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported values of the definition and its sub-definitions",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-					"subinstallations": {
+					"componentVersionOverwrites": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subinstallations defines an optional list of subinstallations (for aggregating blueprints).",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate"),
-									},
-								},
-							},
+							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"subinstallationExecutions": {
+					"verification": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubinstallationExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of installation templates. Both subinstallations and SubinstallationExecutions are valid options and will be merged.",
+							Description: "Verification defines how the signatures of the components of installations that reference this context are verified. If it is not set, no signatures are verified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVerification"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVerification", "github.com/gardener/landscaper/apis/core/v1alpha1.RegistryCredential", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ContextConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repositoryContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+						},
+					},
+					"useOCM": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UseOCM defines whether OCM is used to process installations that reference this context.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"registryPullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
 									},
 								},
 							},
 						},
 					},
-					"deployExecutions": {
+					"registryCredentials": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of deploy item templates.",
+							Description: "RegistryCredentials define the credentials for dedicated registries. They are used to pull component descriptors, blueprints and other resources, helm charts from oci registries, and the images of the container deployer. For a registry that is matched by a registry credential, the registry pull secrets are not used.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.RegistryCredential"),
 									},
 								},
 							},
 						},
 					},
-					"exportExecutions": {
+					"configurations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportExecutions defines the templating executors that are used to generate the exports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_BlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintDefinition defines the blueprint that should be used for the installation.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a remote reference to a blueprint",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"),
-						},
-					},
-					"inline": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Inline defines a inline yaml filesystem with a blueprint.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint", "github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintStaticDataSource defines a static data source for a blueprint",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"value": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-						},
-					},
-					"valueFrom": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintStaticDataValueFrom defines static data that is read from a external resource.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"localPath": {
+					"componentVersionOverwrites": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the installations's namespace",
+							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ComponentDescriptorDefinition defines the component descriptor that should be used for the installation",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptorReference is the reference to a component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
-						},
-					},
-					"inline": {
+					"verification": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InlineDescriptorReference defines an inline component descriptor",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
+							Description: "Verification defines how the signatures of the components of installations that reference this context are verified. If it is not set, no signatures are verified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVerification"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVerification", "github.com/gardener/landscaper/apis/core/v1alpha1.RegistryCredential", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ContextList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentDescriptorReference is the reference to a component descriptor. given an optional context.",
+				Description: "ContextList contains a list of Contexts",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"componentName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Context"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"componentName", "version"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Context", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DataExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwrite defines an overwrite for a specific component and/or version of a component.",
+				Description: "DataExport is a data object export.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"source": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source defines the component that should be replaced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+							Description: "Name the internal name of the imported/exported data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"substitution": {
+					"dataRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Substitution defines the replacement target for the component or version.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+							Description: "DataRef is the name of the in-cluster data object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"source", "substitution"},
+				Required: []string{"name", "dataRef"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwriteReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DataImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwriteReference defines a component reference by",
+				Description: "DataImport is a data object import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Description: "Name the internal name of the imported/exported data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"componentName": {
+					"dataRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
+							Description: "DataRef is the name of the in-cluster data object. The reference can also be a namespaces name. E.g. \"default/mydataref\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
-							Default:     "",
+							Description: "Version specifies the imported data version. defaults to \"v1\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef defines a data reference from a secret. This method is not allowed in installation templates.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef defines a data reference from a configmap. This method is not allowed in installation templates.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference"),
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DataObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwrites contain overwrites for specific (versions of) components.",
+				Description: "DataObject are resources that can hold any kind json or yaml data.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7600,33 +9737,26 @@ func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref common.
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"overwrites": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Overwrites defines a list of component overwrites",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite"),
-									},
-								},
-							},
+							Description: "Data contains the data of the object as string.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 				},
+				Required: []string{"data"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwritesList contains a list of ComponentVersionOverwrites",
+				Description: "DataObjectList contains a list of DataObject",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7656,7 +9786,7 @@ func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref com
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataObject"),
 									},
 								},
 							},
@@ -7667,65 +9797,121 @@ func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref com
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DataObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Default(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Condition holds the information about the state of a resource.",
+				Description: "Default defines a default value (future idea: also reference?).",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataType of the Shoot condition.",
-							Default:     "",
+							Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"value"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the dependent installation",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItem defines a resource that should be processed by a external deployer",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"reason": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec"),
 						},
 					},
-					"message": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemCache contains the existing deploy items",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"activeDIs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"),
+									},
+								},
+							},
 						},
 					},
-					"codes": {
+					"orphanedDIs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Well-defined error codes in case the condition reports a problem.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -7738,554 +9924,542 @@ func schema_landscaper_apis_core_v1alpha1_Condition(ref common.ReferenceCallback
 						},
 					},
 				},
-				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapReference is reference to data in a configmap. The configmap can also be in a different namespace.",
+				Description: "DeployItemList contains a list of DeployItems",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Context is a resource that contains shared information of installations. This includes information about the repository context like the context itself or secrets to access the oci artifacts. But it can also contain deployer specific config.",
+				Description: "DeployItemSpec contains the definition of a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Type is the type of the deployer that should handle the item.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Target specifies an optional target of the deploy item. In most cases it contains the secrets to access a evironment. It is also used by the deployers to determine the ownernship.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"context": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Context defines the current context of the deployitem.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Configuration contains the deployer type specific configuration.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
-					"repositoryContext": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"useOCM": {
+					"updateOnChangeOnly": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UseOCM defines whether OCM is used to process installations that reference this context.",
+							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"registryPullSecrets": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
-									},
-								},
-							},
-						},
-					},
-					"configurations": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"componentVersionOverwrites": {
+					"onDelete": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "OnDelete specifies particular setting when deleting a deploy item",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ContextConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DeployItemStatus contains the status of a deploy item.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Description: "Phase is the current phase of the DeployItem",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"useOCM": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UseOCM defines whether OCM is used to process installations that reference this context.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed for this DeployItem. It corresponds to the DeployItem generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"registryPullSecrets": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Description: "Conditions contains the actual condition of a deploy item",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
 									},
 								},
 							},
 						},
 					},
-					"configurations": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"lastErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorHistory describes the last n errors that occurred since JobID was changed the last time.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
 									},
 								},
 							},
 						},
 					},
-					"componentVersionOverwrites": {
+					"firstError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "FirstError describes the first error that occurred since JobID was changed the last time.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/api/core/v1.LocalObjectReference"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ContextList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ContextList contains a list of Contexts",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"lastReconcileTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastReconcileTime indicates when the reconciliation of the last change to the deploy item has started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"apiVersion": {
+					"deployer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Deployer describes the deployer that has reconciled the deploy item.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation"),
 						},
 					},
-					"metadata": {
+					"providerStatus": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ProviderStatus contains the provider specific status",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
-					"items": {
+					"exportRef": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Context"),
-									},
-								},
-							},
+							Description: "ExportReference is the reference to the object that contains the exported values.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Context", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_DataExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DataExport is a data object export.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDGenerationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deployItemPhase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported/exported data.",
-							Default:     "",
+							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dataRef": {
+					"transitionTimes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataRef is the name of the in-cluster data object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
 						},
 					},
 				},
-				Required: []string{"name", "dataRef"},
+				Required: []string{"observedGeneration"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataImport is a data object import.",
+				Description: "DeployItemTemplate defines a execution element that is translated into a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported/exported data.",
+							Description: "Name is the unique name of the execution.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dataRef": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataRef is the name of the in-cluster data object. The reference can also be a namespaces name. E.g. \"default/mydataref\"",
+							Description: "DataType is the DeployItem type of the execution.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version specifies the imported data version. defaults to \"v1\"",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Target is the object reference to the target that the deploy item should deploy to.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-					"secretRef": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef defines a data reference from a secret. This method is not allowed in installation templates.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+							Description: "Labels is the map of labels to be added to the deploy item.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"configMapRef": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMapRef defines a data reference from a configmap. This method is not allowed in installation templates.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference"),
+							Description: "ProviderConfiguration contains the type specific configuration for the execution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_DataObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DataObject are resources that can hold any kind json or yaml data.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"dependsOn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DependsOn lists deploy items that need to be executed before this one",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"metadata": {
+					"updateOnChangeOnly": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"data": {
+					"onDelete": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the data of the object as string.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "OnDelete specifies particular setting when deleting a deploy item",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
 						},
 					},
 				},
-				Required: []string{"data"},
+				Required: []string{"name", "type", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataObjectList contains a list of DataObject",
+				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Identity describes the unique identity of the deployer.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name is the name of the deployer.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataObject"),
-									},
-								},
-							},
+							Description: "Version is the version of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"identity", "name", "version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DataObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Default(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DiNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Default defines a default value (future idea: also reference?).",
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"value": {
+					"specName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"objectName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"value"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the dependent installation",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
+				Description: "Duration is a wrapper for time.Duration that implements JSON marshalling and openapi scheme.",
+				Type:        v1alpha1.Duration{}.OpenAPISchemaType(),
+				Format:      v1alpha1.Duration{}.OpenAPISchemaFormat(),
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Error(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItem defines a resource that should be processed by a external deployer",
+				Description: "Error holds information about an error that occurred.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"operation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Operation describes the operator where the error occurred.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"metadata": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Last time the condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"spec": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec"),
+							Description: "The reason for the condition's last transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus"),
+							Description: "A human readable message indicating details about the transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"codes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Well-defined error codes in case the condition reports a problem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"operation", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemCache contains the existing deploy items",
+				Description: "Execution contains the configuration of a execution and deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"activeDIs": {
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"orphanedDIs": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines a execution and its items",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the current status of the execution.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemList contains a list of DeployItems",
+				Description: "ExecutionList contains a list of Executions‚",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8315,7 +10489,7 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Execution"),
 									},
 								},
 							},
@@ -8326,89 +10500,63 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Execution", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemSpec contains the definition of a deploy item.",
+				Description: "ExecutionSpec defines a execution plan.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the deployer that should handle the item.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"target": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Target specifies an optional target of the deploy item. In most cases it contains the secrets to access a evironment. It is also used by the deployers to determine the ownernship.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
-						},
-					},
 					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the deployitem.",
+							Description: "Context defines the current context of the execution.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the deployer type specific configuration.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
-					"timeout": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
-						},
-					},
-					"updateOnChangeOnly": {
+					"deployItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "DeployItems defines all execution items that need to be scheduled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
+									},
+								},
+							},
 						},
 					},
-					"onDelete": {
+					"deployItemsCompressed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnDelete specifies particular setting when deleting a deploy item",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
+							Description: "DeployItemsCompressed as zipped byte array",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemStatus contains the status of a deploy item.",
+				Description: "ExecutionStatus contains the current status of a execution.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current phase of the DeployItem",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this DeployItem. It corresponds to the DeployItem generation, which is updated on mutation by the landscaper.",
+							Description: "ObservedGeneration is the most recent generation observed for this Execution. It corresponds to the Execution generation, which is updated on mutation by the landscaper.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
@@ -8416,7 +10564,7 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceC
 					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a deploy item",
+							Description: "Conditions contains the actual condition of a execution",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -8434,333 +10582,403 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
 						},
 					},
-					"lastErrors": {
+					"exportRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ErrorHistory describes the last n errors that occurred since JobID was changed the last time.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
-									},
-								},
-							},
+							Description: "ExportReference references the object that contains the exported values. only used for operation purpose.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-					"firstError": {
+					"deployItemCache": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FirstError describes the first error that occurred since JobID was changed the last time.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+							Description: "DeployItemCache contains the currently existing deploy item belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache"),
 						},
 					},
-					"lastReconcileTime": {
+					"jobID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReconcileTime indicates when the reconciliation of the last change to the deploy item has started",
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecutionPhase is the current phase of the execution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phaseTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PhaseTransitionTime is the time when the phase last changed.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"deployer": {
+					"transitionTimes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Deployer describes the deployer that has reconciled the deploy item.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation"),
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
 						},
 					},
-					"providerStatus": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExportDefinition defines a exported value",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderStatus contains the provider specific status",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"exportRef": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportReference is the reference to the object that contains the exported values.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
 						},
 					},
-					"jobID": {
+					"targetType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
+							Description: "TargetType defines the type of the imported target.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDFinished": {
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies which kind of object is being exported. This field should be set and will likely be mandatory in future.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"numberOfReconciles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NumberOfReconciles specifies the maximal number of automatically repeated reconciliations. If not set, no upper limit exists.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FieldValueDefinition defines a im- or exported field. Either schema or target type have to be defined",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDGenerationTime": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
 						},
 					},
-					"deployItemPhase": {
+					"targetType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
+							Description: "TargetType defines the type of the imported target.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"transitionTimes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
-						},
-					},
 				},
-				Required: []string{"observedGeneration"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemTemplate defines a execution element that is translated into a deploy item.",
+				Description: "ImportDefinition defines a imported value",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the execution.",
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataType is the DeployItem type of the execution.",
-							Default:     "",
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+						},
+					},
+					"targetType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetType defines the type of the imported target.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the object reference to the target that the deploy item should deploy to.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"required": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is the map of labels to be added to the deploy item.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"config": {
+					"default": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfiguration contains the type specific configuration for the execution.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Default sets a default value for the current import that is used if the key is not set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Default"),
 						},
 					},
-					"dependsOn": {
+					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DependsOn lists deploy items that need to be executed before this one",
+							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
 									},
 								},
 							},
 						},
 					},
-					"timeout": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
-						},
-					},
-					"updateOnChangeOnly": {
-						SchemaProps: spec.SchemaProps{
-							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"onDelete": {
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Default", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InlineBlueprint defines a inline blueprint with component descriptor and filesystem.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"filesystem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnDelete specifies particular setting when deleting a deploy item",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
+							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 				},
-				Required: []string{"name", "type", "config"},
+				Required: []string{"filesystem"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
+				Description: "Installation contains the configuration of a component",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"identity": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identity describes the unique identity of the deployer.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the deployer.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the deployer.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification for a installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status of the installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus"),
 						},
 					},
 				},
-				Required: []string{"identity", "name", "version"},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DiNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Description: "InstallationExports defines exports of data objects and targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"specName": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Data defines all data object exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataExport"),
+									},
+								},
+							},
 						},
 					},
-					"objectName": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Targets defines all target exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DataExport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Duration is a wrapper for time.Duration that implements JSON marshalling and openapi scheme.",
-				Type:        v1alpha1.Duration{}.OpenAPISchemaType(),
-				Format:      v1alpha1.Duration{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_Error(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Error holds information about an error that occurred.",
+				Description: "InstallationImports defines import of data objects and targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"operation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Operation describes the operator where the error occurred.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"reason": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"message": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Data defines all data object imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataImport"),
+									},
+								},
+							},
 						},
 					},
-					"codes": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Well-defined error codes in case the condition reports a problem.",
+							Description: "Targets defines all target imports.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"operation", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DataImport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Execution contains the configuration of a execution and deploy item",
+				Description: "InstallationList contains a list of Components",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8780,133 +10998,137 @@ func schema_landscaper_apis_core_v1alpha1_Execution(ref common.ReferenceCallback
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines a execution and its items",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"status": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the current status of the execution.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Installation"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionList contains a list of Executions‚",
+				Description: "InstallationSpec defines a component installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Context defines the current context of the installation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"componentDescriptor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
 						},
 					},
-					"metadata": {
+					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Blueprint is the resolved reference to the definition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
 						},
 					},
-					"items": {
+					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Execution"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Execution", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionSpec defines a execution plan.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"context": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the execution.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
 						},
 					},
-					"deployItems": {
+					"exportDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItems defines all execution items that need to be scheduled.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-					"deployItemsCompressed": {
+					"automaticReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItemsCompressed as zipped byte array",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile"),
+						},
+					},
+					"versionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionPolicy enables automatic upgrades of the version of the referenced component. It is only evaluated for installations that reference their component with a component descriptor reference.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.VersionPolicy"),
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
 						},
 					},
 				},
+				Required: []string{"blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization", "github.com/gardener/landscaper/apis/core/v1alpha1.VersionPolicy"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionStatus contains the current status of a execution.",
+				Description: "InstallationStatus contains the current status of a Installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Execution. It corresponds to the Execution generation, which is updated on mutation by the landscaper.",
+							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
@@ -8914,7 +11136,7 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCa
 					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a execution",
+							Description: "Conditions contains the actual condition of a installation",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -8932,16 +11154,16 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCa
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
 						},
 					},
-					"exportRef": {
+					"subInstCache": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportReference references the object that contains the exported values. only used for operation purpose.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache"),
 						},
 					},
-					"deployItemCache": {
+					"executionRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItemCache contains the currently existing deploy item belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache"),
+							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
 					"jobID": {
@@ -8960,7 +11182,7 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCa
 					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionPhase is the current phase of the execution.",
+							Description: "InstallationPhase is the current phase of the installation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -8971,216 +11193,168 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCa
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"transitionTimes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ExportDefinition defines a exported value",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"schema": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
-						},
-					},
-					"targetType": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"type": {
+					"importsHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies which kind of object is being exported. This field should be set and will likely be mandatory in future.",
+							Description: "ImportsHash is the hash of the import data.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"numberOfReconciles": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NumberOfReconciles specifies the maximal number of automatically repeated reconciliations. If not set, no upper limit exists.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"interval": {
+					"automaticReconcileStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "FieldValueDefinition defines a im- or exported field. Either schema or target type have to be defined",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"versionUpgradeStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "VersionUpgradeStatus describes the automatic upgrades of the component version.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.VersionUpgradeStatus"),
 						},
 					},
-					"schema": {
+					"dependentsToTrigger": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+							Description: "DependentsToTrigger lists dependent installations to be triggered",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger"),
+									},
+								},
+							},
 						},
 					},
-					"targetType": {
+					"transitionTimes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "github.com/gardener/landscaper/apis/core/v1alpha1.VersionUpgradeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImportDefinition defines a imported value",
+				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schema": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targetType": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
+							Description: "Name is the unique name of the step",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
 						},
 					},
-					"required": {
+					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
 						},
 					},
-					"default": {
+					"importDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Default sets a default value for the current import that is used if the key is not set.",
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Default"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
 						},
 					},
-					"imports": {
+					"exportDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
+						},
+					},
 				},
-				Required: []string{"name"},
+				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Default", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InlineBlueprint defines a inline blueprint with component descriptor and filesystem.",
+				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"filesystem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
+							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 				},
-				Required: []string{"filesystem"},
 			},
 		},
 		Dependencies: []string{
@@ -9188,147 +11362,141 @@ func schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref common.ReferenceCa
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Installation contains the configuration of a component",
+				Description: "JSONSchemaDefinition defines a jsonschema.",
+				Type:        v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaType(),
+				Format:      v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalConfigMapReference is a reference to data in a configmap.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the configmap",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Key is the name of the key in the configmap that holds the data.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification for a installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status of the installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus"),
-						},
-					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationExports defines exports of data objects and targets.",
+				Description: "LocalSecretReference is a reference to data in a secret.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"data": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object exports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataExport"),
-									},
-								},
-							},
+							Description: "Name is the name of the secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targets": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target exports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"),
-									},
-								},
-							},
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DataExport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationImports defines import of data objects and targets.",
+				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"data": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object imports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataImport"),
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targets": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target imports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status defines the overall status of the landscaper and its standard deployers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime contains last time the check was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description contains description of the problem(s)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"status", "lastUpdateTime", "description"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DataImport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationList contains a list of Components",
+				Description: "LsHealthCheckList contains a list of LsHealthChecks",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -9358,7 +11526,7 @@ func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceC
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Installation"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck"),
 									},
 								},
 							},
@@ -9369,416 +11537,607 @@ func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationSpec defines a component installation.",
+				Description: "MaintenanceWindow is a daily time window.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"context": {
+					"begin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the installation.",
+							Description: "Begin is the begin of the time window in the format \"HH:MM\", e.g. \"22:00\".",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"componentDescriptor": {
+					"end": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
+							Description: "End is the end of the time window in the format \"HH:MM\", e.g. \"04:00\". A time window ends on the next day if the end is before the begin.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"blueprint": {
+					"location": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the definition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
+							Description: "Location is the name of the time zone of the begin and end, e.g. \"Europe/Berlin\". Defaults to \"UTC\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"imports": {
+				},
+				Required: []string{"begin", "end"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamedObjectReference is a named reference to a specific resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference is the reference to an object.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-					"importDataMappings": {
+				},
+				Required: []string{"name", "ref"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectReference is the reference to a kubernetes object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"exports": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"exportDataMappings": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"skipUninstallIfClusterRemoved": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"automaticReconcile": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Optimization contains settings to improve execution preformance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hasNoSiblingImports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile"),
+							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"optimization": {
+					"hasNoSiblingExports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
+							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"blueprint"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_PrefetchedComponent(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationStatus contains the current status of a Installation.",
+				Description: "PrefetchedComponent describes the state of a prefetched component version.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "ComponentName is the name of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"conditions": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a installation",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
-									},
-								},
-							},
+							Description: "Version is the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastError": {
+					"prefetched": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+							Description: "Prefetched is true if the component version and its resources have been prefetched successfully.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"subInstCache": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache"),
+							Description: "Message describes why the component version could not be prefetched.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"executionRef": {
+				},
+				Required: []string{"componentName", "version", "prefetched"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RegistryCredential(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryCredential defines the credentials for the registries that match a pattern.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"registry": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "Registry is the pattern of the registries the credential is used for. It consists of a host, an optional port and an optional repository prefix, e.g. \"registry.example.com:5000/my-project\". A \"*\" in the host matches any sequence of characters, e.g. \"*.dkr.ecr.*.amazonaws.com\". If several credentials match a registry, the one with the longest repository prefix is used.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"jobID": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
+							Description: "SecretRef references a secret in the namespace of the context. Without a token source, the secret contains either the keys \"username\" and \"password\" for basic authentication, or the key \"token\" for bearer token authentication. With a token source of a given type, the secret is required and contains the input of the token source, e.g. a client secret.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"tokenSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenSource obtains short-lived credentials, e.g. with an OAuth2 token exchange.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RegistryTokenSource"),
+						},
+					},
+				},
+				Required: []string{"registry"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.RegistryTokenSource", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RegistryTokenSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryTokenSource defines how short-lived registry credentials are obtained. Either a type or the name of a token source of the landscaper configuration has to be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the token source, e.g. \"oauth2\", \"gcp\" or \"aws\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDFinished": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
+							Description: "Config contains the type specific configuration of the token source.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name references a token source of the landscaper configuration. Such token sources may use the identity of the landscaper, e.g. its workload identity, and are only available to contexts in the namespaces that are allowed by the operator.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phase": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InstallationPhase is the current phase of the installation.",
+							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phaseTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PhaseTransitionTime is the time when the phase last changed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"importsHash": {
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportsHash is the hash of the import data.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"automaticReconcileStatus": {
+					"operator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"dependentsToTrigger": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DependentsToTrigger lists dependent installations to be triggered",
+							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"transitionTimes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
-						},
-					},
 				},
-				Required: []string{"observedGeneration"},
+				Required: []string{"key", "operator"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Target contains the original target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
 						},
 					},
-					"apiVersion": {
+					"content": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+				},
+				Required: []string{"target", "content"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Target"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
+							Description: "ComponentName defines the unique of the component containing the resource.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
-						},
-					},
-					"imports": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"importDataMappings": {
+				},
+				Required: []string{"componentName", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Description: "Selector is a map of labels to select specific secrets.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"exports": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
+							Description: "The key of the secret to select from.  Must be a valid secret key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"exportDataMappings": {
+				},
+				Required: []string{"selector", "key"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"optimization": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "blueprint"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
+				Description: "StaticDataSource defines a static data source",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Value defined inline a raw data",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
-					"filesystem": {
+					"valueFrom": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "ValueFrom defines data from an external resource",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JSONSchemaDefinition defines a jsonschema.",
-				Type:        v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaType(),
-				Format:      v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
+				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key of a secret in the installations's namespace",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"secretLabelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef"),
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalConfigMapReference is a reference to data in a configmap.",
+				Description: "SubInstCache contains the existing sub installations",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"activeSubs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the configmap",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubNamePair"),
+									},
+								},
+							},
 						},
 					},
-					"key": {
+					"orphanedSubs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.SubNamePair"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalSecretReference is a reference to data in a secret.",
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"specName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the secret",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"key": {
+					"objectName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
+				Description: "SubinstallationTemplate defines a subinstallation template.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File references a subinstallation template stored in another file.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
@@ -9793,478 +12152,360 @@ func schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"status": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status defines the overall status of the landscaper and its standard deployers.",
+							Description: "Name is the unique name of the step",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the check was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"description": {
+					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description contains description of the problem(s)",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
 						},
 					},
-				},
-				Required: []string{"status", "lastUpdateTime", "description"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheckList contains a list of LsHealthChecks",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
 						},
 					},
-					"apiVersion": {
+					"importDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
 						},
 					},
-					"items": {
+					"exportDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamedObjectReference is a named reference to a specific resource.",
+				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"ref": {
+					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ObjectReference is the reference to a kubernetes object.",
+				Description: "The SyncObject helps to sync access to deploy items.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"skipUninstallIfClusterRemoved": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Optimization contains settings to improve execution preformance",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"hasNoSiblingImports": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"hasNoSiblingExports": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectSpec"),
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"resourceName": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectStatus"),
 						},
 					},
 				},
-				Required: []string{"resourceName"},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Description: "SyncObjectList contains a list of SyncObject objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"operator": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"values": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
-							Type:        []string{"array"},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObject"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"key", "operator"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Description: "SyncObjectSpec contains the specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"target": {
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target contains the original target.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
+							Description: "PodName describes the name of the pod of the responsible deployer",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"content": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Description: "Kind describes the kind of object that is being locked by this SyncObject",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"target", "content"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Target"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"componentName": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
+							Description: "Name is the name of the object that is being locked by this SyncObject",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
+							Description: "LastUpdateTime contains last time the object was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is the prefix of the name of the object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"componentName", "resourceName"},
+				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Description: "SyncObjectStatus contains the status.",
 				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"selector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Selector is a map of labels to select specific secrets.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"key": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The key of the secret to select from.  Must be a valid secret key.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"selector", "key"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"namespace": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataSource defines a static data source",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"value": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"valueFrom": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Description: "TargetExport is a single target export.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"secretKeyRef": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the installations's namespace",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Name the internal name of the exported target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secretLabelSelector": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef"),
+							Description: "Target is the name of the in-cluster target object.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubInstCache contains the existing sub installations",
+				Description: "TargetImport is either a single target or a target list import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"activeSubs": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubNamePair"),
-									},
-								},
-							},
+							Description: "Name the internal name of the imported target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"orphanedSubs": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -10276,53 +12517,48 @@ func schema_landscaper_apis_core_v1alpha1_SubInstCache(ref common.ReferenceCallb
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.SubNamePair"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"specName": {
+					"targetListRef": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetMap": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"objectName": {
+					"targetMapRef": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Description: "TargetList contains a list of Targets",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"file": {
-						SchemaProps: spec.SchemaProps{
-							Description: "File references a subinstallation template stored in another file.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
@@ -10337,104 +12573,132 @@ func schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref common.Ref
 							Format:      "",
 						},
 					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
-						},
-					},
-					"imports": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"importDataMappings": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
 									},
 								},
 							},
 						},
 					},
-					"exports": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSelector describes a selector that matches specific targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
+							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
 						},
 					},
-					"exportDataMappings": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Annotations matches a target based on annotations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
 									},
 								},
 							},
 						},
 					},
-					"optimization": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
+							Description: "Labels matches a target based on its labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
+				Description: "TargetSpec contains the definition of a target.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"interval": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The SyncObject helps to sync access to deploy items.",
+				Description: "The TargetSync is created targets from secrets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10461,14 +12725,14 @@ func schema_landscaper_apis_core_v1alpha1_SyncObject(ref common.ReferenceCallbac
 						SchemaProps: spec.SchemaProps{
 							Description: "Spec contains the specification",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectSpec"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Status contains the status",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus"),
 						},
 					},
 				},
@@ -10476,15 +12740,15 @@ func schema_landscaper_apis_core_v1alpha1_SyncObject(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectList contains a list of SyncObject objects",
+				Description: "TargetSyncList contains a list of TargetSync objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10514,7 +12778,7 @@ func schema_landscaper_apis_core_v1alpha1_SyncObjectList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObject"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync"),
 									},
 								},
 							},
@@ -10525,57 +12789,120 @@ func schema_landscaper_apis_core_v1alpha1_SyncObjectList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectSpec contains the specification.",
+				Description: "TargetSyncSpec contains the specification for a TargetSync.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"sourceNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName describes the name of the pod of the responsible deployer",
+							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"kind": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind describes the kind of object that is being locked by this SyncObject",
+							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+					"createTargetToSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"targetToSourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"shootNameExpression": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the object that is being locked by this SyncObject",
+							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"tokenRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"),
+						},
+					},
+				},
+				Required: []string{"sourceNamespace", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncStatus contains the status of a TargetSync.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the object was updated.",
+							Description: "Last time the status was updated",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"prefix": {
+					"lastErrors": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prefix is the prefix of the name of the object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastErrors describe the last errors",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastTokenRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the token was rotated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
 			},
 		},
 		Dependencies: []string{
@@ -10583,551 +12910,609 @@ func schema_landscaper_apis_core_v1alpha1_SyncObjectSpec(ref common.ReferenceCal
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectStatus contains the status.",
-				Type:        []string{"object"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
+				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
-					"metadata": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 						},
 					},
-					"spec": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec"),
+							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetExport is a single target export.",
+				Description: "TemplateExecutor describes a templating mechanism and configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the exported target.",
+							Description: "Name is the unique name of the template",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object.",
+							Description: "Type describes the templating mechanism.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TargetImport is either a single target or a target list import.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"file": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported target.",
-							Default:     "",
+							Description: "File is the path to the template in the blueprint's content.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
-					"targets": {
+					"libraries": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "Libraries references template libraries whose named templates and stubs are made available to the template.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateLibraryReference"),
 									},
 								},
 							},
 						},
 					},
-					"targetListRef": {
+				},
+				Required: []string{"name", "type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.TemplateLibraryReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TemplateLibraryReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateLibraryReference references a template library that is defined as a resource of a component.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "Name is the unique name of the library in the template executor.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetMap": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Ref references the library resource in the component descriptor of the blueprint, e.g. \"cd://resources/my-library\" or \"cd://componentReferences/lib/resources/my-library\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targetMapRef": {
+				},
+				Required: []string{"name", "ref"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Enabled defines if automatic token is executed",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetList contains a list of Targets",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"triggerTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TriggerTime is the time when the jobID is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"apiVersion": {
+					"initTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "InitTime is the time when the Init phase starts.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"metadata": {
+					"waitTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "WaitTime is the time when the work is done.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"items": {
+					"finishedTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
-									},
-								},
-							},
+							Description: "FinishedTime is the time when the finished phase is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSelector describes a selector that matches specific targets.",
+				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"targets": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
-									},
-								},
-							},
+							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"annotations": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations matches a target based on annotations.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
-									},
-								},
-							},
+							Description: "Kind is the type of resource being referenced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels matches a target based on its labels.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
-									},
-								},
-							},
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"apiVersion", "kind", "name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_VersionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSpec contains the definition of a target.",
+				Description: "VersionPolicy configures automatic upgrades of the version of the component of an installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"constraint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Description: "Constraint defines the versions the installation may be upgraded to. It is either a semantic version constraint, e.g. \"~1.4\" or \">= 1.4, < 2\", or one of \"latest\", \"latest-minor\" and \"latest-patch\", which allow any newer version, newer versions with the same major version, and newer versions with the same major and minor version as the current version.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "Mode defines whether the newest allowed version is applied to the installation or only proposed in its status. Proposed versions are applied when they are approved with the approve-version-upgrade annotation. Defaults to \"Apply\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secretRef": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+							Description: "Schedule is a standard crontab specification of the times when the available versions are checked. Defaults to \"0 * * * *\", i.e. every hour.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow restricts the times when versions are applied automatically. If not set, versions are applied whenever they are found.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow"),
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"constraint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_VersionUpgradeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The TargetSync is created targets from secrets.",
+				Description: "VersionUpgradeStatus describes the automatic upgrades of the component version of an installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"lastCheckTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "LastCheckTime is the time when the available versions were checked the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"proposedVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProposedVersion is the newest version allowed by the version policy that has not been applied yet, because the policy only proposes versions or because the maintenance window has not been reached.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"previousVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "PreviousVersion is the version before the last automatic upgrade. It is used to roll the upgrade back with the rollback-version-upgrade annotation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"lastUpgradeTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "LastUpgradeTime is the time of the last automatic upgrade.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"spec": {
+					"rejectedVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec"),
+							Description: "RejectedVersion is the version that has been rolled back. It is not selected again by the version policy.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus"),
+							Description: "LastError describes the last error that prevented the available versions from being checked.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncList contains a list of TargetSync objects",
+				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync"),
-									},
-								},
-							},
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncSpec contains the specification for a TargetSync.",
+				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sourceNamespace": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
+							Description: "Name is the name of the kubernetes object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"createTargetToSource": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"targetToSourceName": {
+				},
+				Required: []string{"name", "observedGeneration"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionedResourceReference defines the reference to a resource with its version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretNameExpression": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
+							Description: "ResourceName defines the name of the resource.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"shootNameExpression": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
+							Description: "Version defines the version of the component.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tokenRotation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"),
-						},
-					},
 				},
-				Required: []string{"sourceNamespace", "secretRef"},
+				Required: []string{"componentName", "resourceName", "version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncStatus contains the status of a TargetSync.",
+				Description: "Configuration is the container deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the status was updated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastErrors": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastErrors describe the last errors",
+							Description: "Identity identity describes the unique identity of the deployer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCI configures the oci client of the controller",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
+						},
+					},
+					"targetSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetSelector describes all selectors the deployer should depend on.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
 									},
 								},
 							},
 						},
 					},
-					"lastTokenRotationTime": {
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace defines the namespace where the pods should be executed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultImage configures the default images that is used if the DeployItem does not specify one.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
+						},
+					},
+					"initContainer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitContainerImage defines the image that is used to init the container. This container bootstraps the necessary directories and files.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
+						},
+					},
+					"waitContainer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarContainerImage defines the image that is used as a sidecar to the defined main container. The sidecar container is responsible to collect the exports and the state of the main container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
+						},
+					},
+					"garbageCollection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GarbageCollection configures the container deployer garbage collector.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.GarbageCollection"),
+						},
+					},
+					"debug": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DebugOptions configure additional debug options.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.DebugOptions"),
+						},
+					},
+					"hpa": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration"),
+						},
+					},
+					"controller": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the token was rotated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Controller contains configuration concerning the controller framework.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.Controller"),
+						},
+					},
+					"useOCMLib": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
 				},
+				Required: []string{"namespace", "defaultImage", "initContainer", "waitContainer", "garbageCollection"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container.Controller", "github.com/gardener/landscaper/apis/deployer/container.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_ContainerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
+				Description: "ContainerSpec defines a container specification",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
-							Default:     "",
+							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-						},
-					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
-						},
-					},
-					"labels": {
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -11138,12 +13523,11 @@ func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCal
 							},
 						},
 					},
-					"annotations": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -11154,271 +13538,444 @@ func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCal
 							},
 						},
 					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n\nPossible enum values:\n - `\"Always\"` means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.\n - `\"IfNotPresent\"` means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.\n - `\"Never\"` means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
+						},
+					},
 				},
-				Required: []string{"type"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateExecutor describes a templating mechanism and configuration.",
+				Description: "ContainerStatus describes the status of a pod with its init, wait and main container.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the template",
-							Default:     "",
+							Description: "A human readable message indicating details about why the pod is in this condition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type describes the templating mechanism.",
+							Description: "A brief CamelCase message indicating details about why the pod is in this state. e.g. 'Evicted'",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Details about the container's current condition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ContainerState"),
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The image the container is running. More info: https://kubernetes.io/docs/concepts/containers/images",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"file": {
+					"imageID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File is the path to the template in the blueprint's content.",
+							Description: "ImageID of the container's image.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"template": {
+					"exitCode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "ExitCode of the main container.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"name", "type"},
+				Required: []string{"image", "imageID"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+			"k8s.io/api/core/v1.ContainerState"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Controller contains configuration concerning the controller framework.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled defines if automatic token is executed",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
 				},
+				Required: []string{"CommonControllerConfig"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DebugOptions defines optional debug options.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"triggerTime": {
+					"keepPod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TriggerTime is the time when the jobID is set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "KeepPod will only remove the finalizer on the pod but will not delete the pod.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"initTime": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_GarbageCollection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GarbageCollection defines the container deployer garbage collection configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitTime is the time when the Init phase starts.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Disable disables the garbage collector and the resources clean-up.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"waitTime": {
+					"worker": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitTime is the time when the work is done.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Worker defines the number of parallel garbage collection routines. Defaults to 5.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"finishedTime": {
+					"requeueTimeSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FinishedTime is the time when the finished phase is set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "RequeueTime specifies the duration after which the object, which is not yet ready to be garbage collected, is requeued. Defaults to 3600.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"disable", "worker", "requeueTimeSeconds"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
+				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiVersion": {
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"kind": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_PlanStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlanStatus describes the status of a plan that has to be approved before it is applied.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jobID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is the type of resource being referenced",
+							Description: "JobID is the job id of the deploy item for which the plan was computed.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"planReference": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "PlanReference is the reference to the secret in the landscaper cluster that contains the plan output.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-					"namespace": {
+					"approved": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "Approved defines whether the plan has been approved.",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"apiVersion", "kind", "name"},
+				Required: []string{"jobID", "approved"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "PodStatus describes the status of a pod with its init, wait and main container",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
+							Description: "PodName is the name of the created pod.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ref": {
+					"lastRun": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
+							Description: "LastRun is the time when the pod was executed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastSuccessfulJobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSuccessfulJobID is set to the current JobID when the pod successfully finished. If the pod has not yet finished, this field will be nil.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"containerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerStatus contains the status of the pod that executes the configured container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+						},
+					},
+					"initContainerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitContainerStatus contains the status of the init container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+						},
+					},
+					"waitContainerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitContainerStatus contains the status of the wait container.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
+				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"},
+			"github.com/gardener/landscaper/apis/deployer/container.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "ProviderConfiguration is the container deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"importValues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportValues contains the import values for the container.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blueprint is the resolved reference to the Blueprint definition",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
+						},
+					},
+					"componentDescriptor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptor is the resolved reference to the ComponentDescriptor defnition",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
+						},
+					},
+					"registryPullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints and component descriptors from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"continuousReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+						},
+					},
+					"requirePlanApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequirePlanApproval enables the two-phase plan/apply workflow. If enabled, the image is first executed with the \"PLAN\" operation and the apply (RECONCILE) is only executed after the plan has been approved with the approve-plan annotation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"exportSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportSchema is an optional json schema the exports of the container are validated against. If the exports do not match the schema, the deploy item fails with a validation error.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
 						},
 					},
 				},
-				Required: []string{"name", "observedGeneration"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedResourceReference defines the reference to a resource with its version.",
+				Description: "ProviderStatus is the container provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"lastOperation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
+							Description: "LastOperation defines the last run operation of the pod. The operation can be either reconcile or deletion.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"podStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodStatus indicated the status of the executed pod.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.PodStatus"),
+						},
+					},
+					"planStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlanStatus contains the status of the last plan if the deploy item requires a plan approval.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.PlanStatus"),
+						},
+					},
 				},
-				Required: []string{"componentName", "resourceName", "version"},
+				Required: []string{"lastOperation"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/container.PlanStatus", "github.com/gardener/landscaper/apis/deployer/container.PodStatus"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -11452,6 +14009,14 @@ func schema_landscaper_apis_deployer_container_Configuration(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
 						},
 					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace defines the namespace where the pods should be executed. Defaults to default",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetSelector describes all selectors the deployer should depend on.",
@@ -11466,59 +14031,63 @@ func schema_landscaper_apis_deployer_container_Configuration(ref common.Referenc
 							},
 						},
 					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace defines the namespace where the pods should be executed.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"defaultImage": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DefaultImage configures the default images that is used if the DeployItem does not specify one.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
 						},
 					},
 					"initContainer": {
 						SchemaProps: spec.SchemaProps{
 							Description: "InitContainerImage defines the image that is used to init the container. This container bootstraps the necessary directories and files.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
 						},
 					},
 					"waitContainer": {
 						SchemaProps: spec.SchemaProps{
 							Description: "SidecarContainerImage defines the image that is used as a sidecar to the defined main container. The sidecar container is responsible to collect the exports and the state of the main container.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
 						},
 					},
 					"garbageCollection": {
 						SchemaProps: spec.SchemaProps{
 							Description: "GarbageCollection configures the container deployer garbage collector.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.GarbageCollection"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection"),
 						},
 					},
 					"debug": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DebugOptions configure additional debug options.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.DebugOptions"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions"),
 						},
 					},
 					"hpa": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration"),
+						},
+					},
+					"runnerPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunnerPool configures the optional runner pool mode. If configured, deploy items are dispatched to long-lived runner pods of their image instead of creating a new pod for every run.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.RunnerPoolConfiguration"),
+						},
+					},
+					"namespaceIsolation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceIsolation configures the optional namespace isolation. If configured, the pods of every deploy item are executed in a dedicated namespace of the host cluster instead of the configured namespace.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.NamespaceIsolationConfiguration"),
 						},
 					},
 					"controller": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Controller contains configuration concerning the controller framework.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.Controller"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller"),
 						},
 					},
 					"useOCMLib": {
@@ -11528,15 +14097,15 @@ func schema_landscaper_apis_deployer_container_Configuration(ref common.Referenc
 						},
 					},
 				},
-				Required: []string{"namespace", "defaultImage", "initContainer", "waitContainer", "garbageCollection"},
+				Required: []string{"defaultImage", "initContainer", "waitContainer", "garbageCollection"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container.Controller", "github.com/gardener/landscaper/apis/deployer/container.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.NamespaceIsolationConfiguration", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.RunnerPoolConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_ContainerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ContainerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -11594,7 +14163,7 @@ func schema_landscaper_apis_deployer_container_ContainerSpec(ref common.Referenc
 	}
 }
 
-func schema_landscaper_apis_deployer_container_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -11654,7 +14223,7 @@ func schema_landscaper_apis_deployer_container_ContainerStatus(ref common.Refere
 	}
 }
 
-func schema_landscaper_apis_deployer_container_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -11676,7 +14245,7 @@ func schema_landscaper_apis_deployer_container_Controller(ref common.ReferenceCa
 	}
 }
 
-func schema_landscaper_apis_deployer_container_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -11696,7 +14265,7 @@ func schema_landscaper_apis_deployer_container_DebugOptions(ref common.Reference
 	}
 }
 
-func schema_landscaper_apis_deployer_container_GarbageCollection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_GarbageCollection(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -11734,7 +14303,7 @@ func schema_landscaper_apis_deployer_container_GarbageCollection(ref common.Refe
 	}
 }
 
-func schema_landscaper_apis_deployer_container_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -11753,7 +14322,86 @@ func schema_landscaper_apis_deployer_container_HPAConfiguration(ref common.Refer
 	}
 }
 
-func schema_landscaper_apis_deployer_container_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_NamespaceIsolationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceIsolationConfiguration contains the configuration of the namespace isolation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namePrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamePrefix is the prefix of the dedicated namespaces. Defaults to \"ls-container-\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"networkPolicies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkPolicies are network policy manifests that are created in every dedicated namespace. The namespace of the manifests is set to the dedicated namespace.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+									},
+								},
+							},
+						},
+					},
+					"resourceQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceQuota is the spec of a resource quota that is created in every dedicated namespace.",
+							Ref:         ref("k8s.io/api/core/v1.ResourceQuotaSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ResourceQuotaSpec", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_PlanStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlanStatus describes the status of a plan that has to be approved before it is applied.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the job id of the deploy item for which the plan was computed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"planReference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlanReference is the reference to the secret in the landscaper cluster that contains the plan output.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"approved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approved defines whether the plan has been approved.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"jobID", "approved"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -11785,21 +14433,21 @@ func schema_landscaper_apis_deployer_container_PodStatus(ref common.ReferenceCal
 						SchemaProps: spec.SchemaProps{
 							Description: "ContainerStatus contains the status of the pod that executes the configured container.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
 						},
 					},
 					"initContainerStatus": {
 						SchemaProps: spec.SchemaProps{
 							Description: "InitContainerStatus contains the status of the init container.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
 						},
 					},
 					"waitContainerStatus": {
 						SchemaProps: spec.SchemaProps{
 							Description: "WaitContainerStatus contains the status of the wait container.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
 						},
 					},
 				},
@@ -11807,11 +14455,11 @@ func schema_landscaper_apis_deployer_container_PodStatus(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -11908,15 +14556,28 @@ func schema_landscaper_apis_deployer_container_ProviderConfiguration(ref common.
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
+					"requirePlanApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequirePlanApproval enables the two-phase plan/apply workflow. If enabled, the image is first executed with the \"PLAN\" operation and the apply (RECONCILE) is only executed after the plan has been approved with the approve-plan annotation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"exportSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportSchema is an optional json schema the exports of the container are validated against. If the exports do not match the schema, the deploy item fails with a validation error.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -11948,7 +14609,13 @@ func schema_landscaper_apis_deployer_container_ProviderStatus(ref common.Referen
 					"podStatus": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PodStatus indicated the status of the executed pod.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.PodStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"),
+						},
+					},
+					"planStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlanStatus contains the status of the last plan if the deploy item requires a plan approval.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PlanStatus"),
 						},
 					},
 				},
@@ -11956,15 +14623,159 @@ func schema_landscaper_apis_deployer_container_ProviderStatus(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container.PodStatus"},
+			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PlanStatus", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_RunnerPoolConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the container deployer configuration that configures the controller",
+				Description: "RunnerPoolConfiguration contains the configuration of the runner pool mode.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size defines the maximum number of runner pods that are kept per namespace of the deploy items and image. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"idleTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleTimeoutSeconds defines the duration after which a runner pod that has not executed any run terminates itself. Defaults to 1800.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArchiveAccess defines the access for a helm chart as compressed archive.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"raw": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Raw defines a compressed tarred helm chart as base64 encoded string.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"remote": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Remote defines the remote access for a helm chart as compressed archive.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.RemoteArchiveAccess"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.RemoteArchiveAccess"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_Auth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Auth contains authentication info for one helm chart repo",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL contains URL of helm chart repo",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"customCAData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomCAData allows you to add a custom CA, which is useful if your server speaks HTTPS with a self- signed certificate. The added certificate must be in PEM format and base64 encoded.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthHeader contains the value that will be set in the \"Authorization\" header when fetching the Chart, e.g. \"Basic dX...3dvcmQ=\". Exactly one of the fields AuthHeader and SecretRef must be set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to a secret containing the AuthHeader Exactly one of the fields AuthHeader and SecretRef must be set.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Chart defines the helm chart to render and apply.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref defines the reference to a helm chart in a oci repository.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fromResource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromResource fetches the chart based on the resource's access method. The resource is defined as part of a component descriptor which is necessary to also handle local artifacts.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference"),
+						},
+					},
+					"archive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Archive defines a compressed tarred helm chart as base64 encoded string.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess"),
+						},
+					},
+					"helmChartRepo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmChartRepo defines a reference to a chart in a helm chart repo.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo"),
+						},
+					},
+					"resourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceKey defines a key that can be given to a corresponding API in order to fetch the content of the resource defined in the blueprint",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Configuration is the helm deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -11994,14 +14805,6 @@ func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
 						},
 					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace defines the namespace where the pods should be executed. Defaults to default",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetSelector describes all selectors the deployer should depend on.",
@@ -12016,118 +14819,94 @@ func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceC
 							},
 						},
 					},
-					"defaultImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DefaultImage configures the default images that is used if the DeployItem does not specify one.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
-						},
-					},
-					"initContainer": {
+					"export": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitContainerImage defines the image that is used to init the container. This container bootstraps the necessary directories and files.",
+							Description: "Export defines the export configuration.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration"),
 						},
 					},
-					"waitContainer": {
+					"hpa": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SidecarContainerImage defines the image that is used as a sidecar to the defined main container. The sidecar container is responsible to collect the exports and the state of the main container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
+							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration"),
 						},
 					},
-					"garbageCollection": {
+					"controller": {
 						SchemaProps: spec.SchemaProps{
-							Description: "GarbageCollection configures the container deployer garbage collector.",
+							Description: "Controller contains configuration concerning the controller framework.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection"),
-						},
-					},
-					"debug": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DebugOptions configure additional debug options.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.Controller"),
 						},
 					},
-					"hpa": {
+					"useOCMLib": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"controller": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm.Controller", "github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Controller contains configuration concerning the controller framework.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Controller contains configuration concerning the controller framework.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
-					"useOCMLib": {
+				},
+				Required: []string{"CommonControllerConfig"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExportConfiguration defines the export configuration for the deployer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"defaultTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
-				Required: []string{"defaultImage", "initContainer", "waitContainer", "garbageCollection"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_ContainerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContainerSpec defines a container specification",
+				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"image": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"command": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"args": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"imagePullPolicy": {
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n\nPossible enum values:\n - `\"Always\"` means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.\n - `\"IfNotPresent\"` means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.\n - `\"Never\"` means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present",
-							Type:        []string{"string"},
-							Format:      "",
-							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 				},
@@ -12136,333 +14915,329 @@ func schema_apis_deployer_container_v1alpha1_ContainerSpec(ref common.ReferenceC
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_HelmChartRepo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContainerStatus describes the status of a pod with its init, wait and main container.",
+				Description: "HelmChartRepo defines a reference to a chart in a helm chart repo",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"message": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about why the pod is in this condition.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"reason": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A brief CamelCase message indicating details about why the pod is in this state. e.g. 'Evicted'",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"state": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Details about the container's current condition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ContainerState"),
-						},
-					},
-					"image": {
+					"helmChartRepoUrl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The image the container is running. More info: https://kubernetes.io/docs/concepts/containers/images",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"imageID": {
+					"helmChartName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImageID of the container's image.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"exitCode": {
+					"helmChartVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExitCode of the main container.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"image", "imageID"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.ContainerState"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_HelmChartRepoCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Controller contains configuration concerning the controller framework.",
+				Description: "HelmChartRepoCredentials contains the credentials to access hepl chart repos",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"CommonControllerConfig": {
+					"auths": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm.Auth"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
+			"github.com/gardener/landscaper/apis/deployer/helm.Auth"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DebugOptions defines optional debug options.",
+				Description: "HelmDeploymentConfiguration defines settings for a helm deployment.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"keepPod": {
+					"install": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KeepPod will only remove the finalizer on the pod but will not delete the pod.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"upgrade": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"uninstall": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_GarbageCollection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GarbageCollection defines the container deployer garbage collection configuration.",
+				Description: "HelmUpgradeConfiguration defines settings for a helm upgrade operation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"disable": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Disable disables the garbage collector and the resources clean-up.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"worker": {
+					"atomic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Worker defines the number of parallel garbage collection routines. Defaults to 5.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"requeueTimeSeconds": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RequeueTime specifies the duration after which the object, which is not yet ready to be garbage collected, is requeued. Defaults to 3600.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
-				Required: []string{"disable", "worker", "requeueTimeSeconds"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"maxReplicas": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PodStatus describes the status of a pod with its init, wait and main container",
+				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName is the name of the created pod.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastRun": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastRun is the time when the pod was executed the last time.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastSuccessfulJobID": {
+					"kubeconfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastSuccessfulJobID is set to the current JobID when the pod successfully finished. If the pod has not yet finished, this field will be nil.",
+							Description: "Kubeconfig is the base64 encoded kubeconfig file. By default the configured target is used to deploy the resources",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"containerStatus": {
+					"readinessChecks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContainerStatus contains the status of the pod that executes the configured container.",
+							Description: "ReadinessChecks configures the readiness checks.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
 						},
 					},
-					"initContainerStatus": {
+					"updateStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitContainerStatus contains the status of the init container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
+							Description: "UpdateStrategy defines the strategy how the manifests are updated in the cluster. Defaults to \"update\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"waitContainerStatus": {
+					"chart": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitContainerStatus contains the status of the wait container.",
+							Description: "Chart defines helm chart to be templated and applied.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.Chart"),
 						},
 					},
-				},
-				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the container deployer configuration that is expected in a DeployItem",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the release name of the chart",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Namespace is the release namespace of the chart",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"image": {
+					"createNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
-							Type:        []string{"string"},
+							Description: "CreateNamespace configures the deployer to create the release namespace if not present. The behavior is similar to the \"helm install --create-namespace\"",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"command": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Values are the values that are used for templating.",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
-					"args": {
+					"exportsFromManifests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export"),
 									},
 								},
 							},
 						},
 					},
-					"importValues": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportValues contains the import values for the container.",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
 						},
 					},
-					"blueprint": {
+					"continuousReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the Blueprint definition",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
-					"componentDescriptor": {
+					"helmDeployment": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is the resolved reference to the ComponentDescriptor defnition",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
+							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"registryPullSecrets": {
+					"helmDeploymentConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints and component descriptors from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration"),
+						},
+					},
+					"deletionGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
 									},
 								},
 							},
 						},
 					},
-					"continuousReconcile": {
+					"deletionGroupsDuringUpdate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+							Description: "DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"chart", "name", "namespace", "createNamespace"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
+			"github.com/gardener/landscaper/apis/deployer/helm.Chart", "github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the container provider specific status",
+				Description: "ProviderStatus is the helm provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -12479,30 +15254,85 @@ func schema_apis_deployer_container_v1alpha1_ProviderStatus(ref common.Reference
 							Format:      "",
 						},
 					},
-					"lastOperation": {
+					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastOperation defines the last run operation of the pod. The operation can be either reconcile or deletion.",
-							Default:     "",
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"podStatus": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodStatus indicated the status of the executed pod.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"),
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
+						},
+					},
+					"inline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"lastOperation"},
+				Required: []string{"resourceName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12519,18 +15349,18 @@ func schema_landscaper_apis_deployer_helm_ArchiveAccess(ref common.ReferenceCall
 					"remote": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Remote defines the remote access for a helm chart as compressed archive.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.RemoteArchiveAccess"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm.RemoteArchiveAccess"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_Auth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Auth(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12572,7 +15402,7 @@ func schema_landscaper_apis_deployer_helm_Auth(ref common.ReferenceCallback) com
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12589,19 +15419,19 @@ func schema_landscaper_apis_deployer_helm_Chart(ref common.ReferenceCallback) co
 					"fromResource": {
 						SchemaProps: spec.SchemaProps{
 							Description: "FromResource fetches the chart based on the resource's access method. The resource is defined as part of a component descriptor which is necessary to also handle local artifacts.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"),
 						},
 					},
 					"archive": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Archive defines a compressed tarred helm chart as base64 encoded string.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess"),
 						},
 					},
 					"helmChartRepo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HelmChartRepo defines a reference to a chart in a helm chart repo.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo"),
 						},
 					},
 					"resourceRef": {
@@ -12615,11 +15445,11 @@ func schema_landscaper_apis_deployer_helm_Chart(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12671,20 +15501,20 @@ func schema_landscaper_apis_deployer_helm_Configuration(ref common.ReferenceCall
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration"),
 						},
 					},
 					"hpa": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration"),
 						},
 					},
 					"controller": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Controller contains configuration concerning the controller framework.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.Controller"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller"),
 						},
 					},
 					"useOCMLib": {
@@ -12697,11 +15527,11 @@ func schema_landscaper_apis_deployer_helm_Configuration(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm.Controller", "github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12723,7 +15553,7 @@ func schema_landscaper_apis_deployer_helm_Controller(ref common.ReferenceCallbac
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12744,7 +15574,7 @@ func schema_landscaper_apis_deployer_helm_ExportConfiguration(ref common.Referen
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12763,7 +15593,7 @@ func schema_landscaper_apis_deployer_helm_HPAConfiguration(ref common.ReferenceC
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HelmChartRepo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmChartRepo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12794,7 +15624,7 @@ func schema_landscaper_apis_deployer_helm_HelmChartRepo(ref common.ReferenceCall
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HelmChartRepoCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmChartRepoCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12808,7 +15638,7 @@ func schema_landscaper_apis_deployer_helm_HelmChartRepoCredentials(ref common.Re
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm.Auth"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"),
 									},
 								},
 							},
@@ -12818,11 +15648,11 @@ func schema_landscaper_apis_deployer_helm_HelmChartRepoCredentials(ref common.Re
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm.Auth"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12836,7 +15666,7 @@ func schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref common
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
@@ -12849,7 +15679,7 @@ func schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref common
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
@@ -12862,7 +15692,7 @@ func schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref common
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
@@ -12872,11 +15702,11 @@ func schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref common
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12903,7 +15733,7 @@ func schema_landscaper_apis_deployer_helm_HelmInstallConfiguration(ref common.Re
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12924,7 +15754,7 @@ func schema_landscaper_apis_deployer_helm_HelmUninstallConfiguration(ref common.
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12953,13 +15783,6 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 							Format:      "",
 						},
 					},
-					"readinessChecks": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ReadinessChecks configures the readiness checks.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
-						},
-					},
 					"updateStrategy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "UpdateStrategy defines the strategy how the manifests are updated in the cluster. Defaults to \"update\".",
@@ -12967,11 +15790,18 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 							Format:      "",
 						},
 					},
+					"readinessChecks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadinessChecks configures the readiness checks.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
+						},
+					},
 					"chart": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Chart defines helm chart to be templated and applied.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.Chart"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart"),
 						},
 					},
 					"name": {
@@ -13041,7 +15871,7 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 					"helmDeploymentConfig": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration"),
 						},
 					},
 					"deletionGroups": {
@@ -13077,11 +15907,11 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm.Chart", "github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -13124,7 +15954,7 @@ func schema_landscaper_apis_deployer_helm_ProviderStatus(ref common.ReferenceCal
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -13144,7 +15974,7 @@ func schema_landscaper_apis_deployer_helm_RemoteArchiveAccess(ref common.Referen
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -13180,128 +16010,29 @@ func schema_landscaper_apis_deployer_helm_RemoteChartReference(ref common.Refere
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ArchiveAccess defines the access for a helm chart as compressed archive.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"raw": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Raw defines a compressed tarred helm chart as base64 encoded string.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"remote": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Remote defines the remote access for a helm chart as compressed archive.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_Auth(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Auth contains authentication info for one helm chart repo",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "URL contains URL of helm chart repo",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"customCAData": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CustomCAData allows you to add a custom CA, which is useful if your server speaks HTTPS with a self- signed certificate. The added certificate must be in PEM format and base64 encoded.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"authHeader": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AuthHeader contains the value that will be set in the \"Authorization\" header when fetching the Chart, e.g. \"Basic dX...3dvcmQ=\". Exactly one of the fields AuthHeader and SecretRef must be set.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the AuthHeader Exactly one of the fields AuthHeader and SecretRef must be set.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ResourceRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Chart defines the helm chart to render and apply.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Ref defines the reference to a helm chart in a oci repository.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"fromResource": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FromResource fetches the chart based on the resource's access method. The resource is defined as part of a component descriptor which is necessary to also handle local artifacts.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"),
-						},
-					},
-					"archive": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Archive defines a compressed tarred helm chart as base64 encoded string.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess"),
-						},
-					},
-					"helmChartRepo": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HelmChartRepo defines a reference to a chart in a helm chart repo.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo"),
-						},
-					},
-					"resourceRef": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceKey defines a key that can be given to a corresponding API in order to fetch the content of the resource defined in the blueprint",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_manifest_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the helm deployer configuration that configures the controller",
+				Description: "Configuration is the manifest deployer configuration that configures the controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -13325,12 +16056,6 @@ func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
-					"oci": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OCI configures the oci client of the controller",
-							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
-						},
-					},
 					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetSelector describes all selectors the deployer should depend on.",
@@ -13349,227 +16074,68 @@ func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallba
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest.ExportConfiguration"),
 						},
 					},
 					"hpa": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration"),
-						},
-					},
-					"controller": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Controller contains configuration concerning the controller framework.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller"),
-						},
-					},
-					"useOCMLib": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Controller contains configuration concerning the controller framework.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"CommonControllerConfig": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
-						},
-					},
-				},
-				Required: []string{"CommonControllerConfig"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ExportConfiguration defines the export configuration for the deployer.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"defaultTimeout": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"maxReplicas": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_HelmChartRepo(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmChartRepo defines a reference to a chart in a helm chart repo",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"helmChartRepoUrl": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"helmChartName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"helmChartVersion": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_HelmChartRepoCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmChartRepoCredentials contains the credentials to access hepl chart repos",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"auths": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"),
-									},
-								},
-							},
+							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest.HPAConfiguration"),
+						},
+					},
+					"controller": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Controller contains configuration concerning the controller framework.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest.Controller"),
+						},
+					},
+					"useOCMLib": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest.Controller", "github.com/gardener/landscaper/apis/deployer/manifest.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest.HPAConfiguration"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_manifest_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmDeploymentConfiguration defines settings for a helm deployment.",
+				Description: "Controller contains configuration concerning the controller framework.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"install": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"upgrade": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"uninstall": {
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
 				},
+				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_manifest_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmUpgradeConfiguration defines settings for a helm upgrade operation.",
+				Description: "ExportConfiguration defines the export configuration for the deployer.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"atomic": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"timeout": {
+					"defaultTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
+							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
@@ -13581,32 +16147,30 @@ func schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref common.Refe
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_manifest_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
+				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"timeout": {
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
+				Description: "ProviderConfiguration is the manifest deployer configuration that is expected in a DeployItem.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -13633,65 +16197,28 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 					},
 					"updateStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateStrategy defines the strategy how the manifests are updated in the cluster. Defaults to \"update\".",
+							Description: "UpdateStrategy defines the strategy how the manifest are updated in the cluster.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"readinessChecks": {
+					"readiness": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ReadinessChecks configures the readiness checks.",
 							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
 						},
 					},
-					"chart": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Chart defines helm chart to be templated and applied.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart"),
-						},
-					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the release name of the chart",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the release namespace of the chart",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"createNamespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CreateNamespace configures the deployer to create the release namespace if not present. The behavior is similar to the \"helm install --create-namespace\"",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"values": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Values are the values that are used for templating.",
-							Type:        []string{"string"},
-							Format:      "byte",
-						},
-					},
-					"exportsFromManifests": {
+					"manifests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
+							Description: "Manifests contains a list of manifests that should be applied in the target cluster",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest"),
 									},
 								},
 							},
@@ -13709,22 +16236,9 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
-					"helmDeployment": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"helmDeploymentConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration"),
-						},
-					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.",
+							Description: "DeletionGroups defines the order in which objects are deleted.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -13751,19 +16265,18 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 						},
 					},
 				},
-				Required: []string{"chart", "name", "namespace", "createNamespace"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_manifest_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the helm provider specific status",
+				Description: "ProviderStatus is the manifest provider specific status.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -13782,7 +16295,7 @@ func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallb
 					},
 					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the deployer.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -13794,93 +16307,51 @@ func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallb
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptorReference is the reference to a component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
-						},
-					},
-					"inline": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InlineDescriptorReference defines an inline component descriptor",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
-						},
-					},
-					"resourceName": {
+					"annotateBeforeCreate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AnnotateBeforeCreate defines annotations that are being set before the manifest is being created.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"resourceName"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_ResourceRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"key": {
+					"annotateBeforeDelete": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "AnnotateBeforeDelete defines annotations that are being set before the manifest is being deleted.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
 	}
 }
 
-func schema_landscaper_apis_deployer_manifest_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the manifest deployer configuration that configures the controller.",
+				Description: "Configuration is the manifest deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -13922,20 +16393,20 @@ func schema_landscaper_apis_deployer_manifest_Configuration(ref common.Reference
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest.ExportConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration"),
 						},
 					},
 					"hpa": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest.HPAConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.HPAConfiguration"),
 						},
 					},
 					"controller": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Controller contains configuration concerning the controller framework.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest.Controller"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller"),
 						},
 					},
 					"useOCMLib": {
@@ -13948,11 +16419,11 @@ func schema_landscaper_apis_deployer_manifest_Configuration(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest.Controller", "github.com/gardener/landscaper/apis/deployer/manifest.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.HPAConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_manifest_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -13974,7 +16445,7 @@ func schema_landscaper_apis_deployer_manifest_Controller(ref common.ReferenceCal
 	}
 }
 
-func schema_landscaper_apis_deployer_manifest_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -13995,7 +16466,7 @@ func schema_landscaper_apis_deployer_manifest_ExportConfiguration(ref common.Ref
 	}
 }
 
-func schema_landscaper_apis_deployer_manifest_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14014,11 +16485,11 @@ func schema_landscaper_apis_deployer_manifest_HPAConfiguration(ref common.Refere
 	}
 }
 
-func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the manifest deployer configuration that is expected in a DeployItem.",
+				Description: "ProviderConfiguration is the manifest deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -14045,13 +16516,12 @@ func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.R
 					},
 					"updateStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateStrategy defines the strategy how the manifest are updated in the cluster.",
-							Default:     "",
+							Description: "UpdateStrategy defines the strategy how the manifest are updated in the cluster. Defaults to \"update\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"readiness": {
+					"readinessChecks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ReadinessChecks configures the readiness checks.",
 							Default:     map[string]interface{}{},
@@ -14065,8 +16535,7 @@ func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.R
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest"),
+										Ref: ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 									},
 								},
 							},
@@ -14078,12 +16547,6 @@ func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.R
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
 						},
 					},
-					"continuousReconcile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
-						},
-					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DeletionGroups defines the order in which objects are deleted.",
@@ -14116,15 +16579,15 @@ func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_landscaper_apis_deployer_manifest_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the manifest provider specific status.",
+				Description: "ProviderStatus is the manifest provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -14143,45 +16606,13 @@ func schema_landscaper_apis_deployer_manifest_ProviderStatus(ref common.Referenc
 					},
 					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the deployer.",
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the manifest deployer.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
-									},
-								},
-							},
-						},
-					},
-					"annotateBeforeCreate": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AnnotateBeforeCreate defines annotations that are being set before the manifest is being created.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"annotateBeforeDelete": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AnnotateBeforeDelete defines annotations that are being set before the manifest is being deleted.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference"),
 									},
 								},
 							},
@@ -14191,15 +16622,15 @@ func schema_landscaper_apis_deployer_manifest_ProviderStatus(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the manifest deployer configuration that configures the controller",
+				Description: "Configuration is the manifest deployer configuration that configures the controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -14241,20 +16672,20 @@ func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCa
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration"),
 						},
 					},
 					"hpa": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.HPAConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.HPAConfiguration"),
 						},
 					},
 					"controller": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Controller contains configuration concerning the controller framework.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller"),
 						},
 					},
 					"useOCMLib": {
@@ -14267,11 +16698,11 @@ func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.HPAConfiguration"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14293,7 +16724,7 @@ func schema_apis_deployer_manifest_v1alpha1_Controller(ref common.ReferenceCallb
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14314,7 +16745,7 @@ func schema_apis_deployer_manifest_v1alpha1_ExportConfiguration(ref common.Refer
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14333,7 +16764,7 @@ func schema_apis_deployer_manifest_v1alpha1_HPAConfiguration(ref common.Referenc
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14383,7 +16814,8 @@ func schema_apis_deployer_manifest_v1alpha1_ProviderConfiguration(ref common.Ref
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest"),
 									},
 								},
 							},
@@ -14395,6 +16827,12 @@ func schema_apis_deployer_manifest_v1alpha1_ProviderConfiguration(ref common.Ref
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
 						},
 					},
+					"continuousReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+						},
+					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DeletionGroups defines the order in which objects are deleted.",
@@ -14427,11 +16865,11 @@ func schema_apis_deployer_manifest_v1alpha1_ProviderConfiguration(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14454,13 +16892,13 @@ func schema_apis_deployer_manifest_v1alpha1_ProviderStatus(ref common.ReferenceC
 					},
 					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the manifest deployer.",
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the deployer.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
 									},
 								},
 							},
@@ -14470,15 +16908,15 @@ func schema_apis_deployer_manifest_v1alpha1_ProviderStatus(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference"},
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha2_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_mock_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the manifest deployer configuration that configures the controller.",
+				Description: "Configuration is the helm deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -14516,107 +16954,95 @@ func schema_apis_deployer_manifest_v1alpha2_Configuration(ref common.ReferenceCa
 							},
 						},
 					},
-					"export": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Export defines the export configuration.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration"),
-						},
-					},
-					"hpa": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.HPAConfiguration"),
-						},
-					},
-					"controller": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Controller contains configuration concerning the controller framework.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller"),
-						},
-					},
-					"useOCMLib": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha2_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_mock_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Controller contains configuration concerning the controller framework.",
+				Description: "ProviderConfiguration is the mock deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"CommonControllerConfig": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"CommonControllerConfig"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
-	}
-}
-
-func schema_apis_deployer_manifest_v1alpha2_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ExportConfiguration defines the export configuration for the deployer.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"defaultTimeout": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
-	}
-}
-
-func schema_apis_deployer_manifest_v1alpha2_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"maxReplicas": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Phase sets the phase of the DeployItem",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"initialPhase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialPhase sets the phase of the DeployItem, but only if it is empty or \"Init\" Additionally, setting it will suppress the DeployItem phase being set to \"Succeeded\" after successful reconciliation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderStatus sets the provider status to the given value",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"export": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Export sets the exported configuration to the given value",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"continuousReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+						},
+					},
+					"scenario": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scenario is a scripted sequence of steps that are executed in subsequent reconciliations of the DeployItem. If set, Phase, InitialPhase, ProviderStatus and Export are ignored.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/mock.ScenarioStep"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/mock.ScenarioStep", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_mock_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the manifest deployer configuration that is expected in a DeployItem",
+				Description: "ProviderStatus is the mock deployer status of a DeployItem. It is only set if a scenario is configured.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -14633,134 +17059,173 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.Ref
 							Format:      "",
 						},
 					},
-					"kubeconfig": {
+					"scenario": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubeconfig is the base64 encoded kubeconfig file. By default the configured target is used to deploy the resources",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Scenario contains the progress of the scenario.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/mock.ScenarioStatus"),
 						},
 					},
-					"updateStrategy": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/mock.ScenarioStatus"},
+	}
+}
+
+func schema_landscaper_apis_deployer_mock_ScenarioError(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScenarioError describes an error that is returned by a scenario step.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateStrategy defines the strategy how the manifest are updated in the cluster. Defaults to \"update\".",
+							Description: "Message is the message of the error.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"readinessChecks": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ReadinessChecks configures the readiness checks.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
-						},
-					},
-					"manifests": {
+					"codes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Manifests contains a list of manifests that should be applied in the target cluster",
+							Description: "Codes are the error codes of the error. Unrecoverable error codes set the DeployItem to phase \"Failed\".",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"exports": {
+				},
+				Required: []string{"message"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_mock_ScenarioStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScenarioStatus describes the progress of a mock scenario.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
+							Description: "ObservedGeneration is the generation of the DeployItem for which the scenario has been started. The scenario is restarted if the DeployItem changes.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"continuousReconcile": {
+					"step": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+							Description: "Step is the index of the current step.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"deletionGroups": {
+					"attempt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletionGroups defines the order in which objects are deleted.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
-									},
-								},
-							},
+							Description: "Attempt is the number of times the current step has already been executed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"deletionGroupsDuringUpdate": {
+					"stepStartTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
-									},
-								},
-							},
+							Description: "StepStartTime is the time when the current execution of the step has been started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the job ID of the DeployItem in which the current execution of the step has been started. A hanging step is finished as soon as the DeployItem has a new job ID.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"observedGeneration", "step", "attempt"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha2_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_mock_ScenarioStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the manifest provider specific status",
+				Description: "ScenarioStep describes a step of a mock scenario.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is an optional name of the step that is used in logs and errors.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Phase sets the phase of the DeployItem after the step has been executed. Defaults to \"Progressing\" if an error is configured and to \"Succeeded\" otherwise.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"managedResources": {
+					"delay": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the deployer.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
-									},
-								},
-							},
+							Description: "Delay is the duration that has to pass before the step is executed. Meanwhile, the DeployItem is in phase \"Progressing\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"times": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Times is the number of reconciliations in which the step is executed before the scenario continues with the next step. Defaults to 1. The last step of a scenario is repeated for all further reconciliations.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"hang": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hang keeps the DeployItem in phase \"Progressing\" until it is interrupted, e.g. by a timeout or by an interruption of its installation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"export": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Export sets the exported configuration to the given value",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error lets the reconciliation fail with the given error.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/mock.ScenarioError"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/mock.ScenarioError"},
 	}
 }
 
-func schema_landscaper_apis_deployer_mock_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_mock_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14810,11 +17275,11 @@ func schema_landscaper_apis_deployer_mock_Configuration(ref common.ReferenceCall
 	}
 }
 
-func schema_landscaper_apis_deployer_mock_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the mock deployer configuration that is expected in a DeployItem",
+				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -14864,19 +17329,33 @@ func schema_landscaper_apis_deployer_mock_ProviderConfiguration(ref common.Refer
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
+					"scenario": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scenario is a scripted sequence of steps that are executed in subsequent reconciliations of the DeployItem. If set, Phase, InitialPhase, ProviderStatus and Export are ignored.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ScenarioStep"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ScenarioStep", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_apis_deployer_mock_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_mock_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the helm deployer configuration that configures the controller",
+				Description: "ProviderStatus is the mock deployer status of a DeployItem. It is only set if a scenario is configured.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -14893,74 +17372,149 @@ func schema_apis_deployer_mock_v1alpha1_Configuration(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
-					"identity": {
+					"scenario": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identity identity describes the unique identity of the deployer.",
+							Description: "Scenario contains the progress of the scenario.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ScenarioStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ScenarioStatus"},
+	}
+}
+
+func schema_apis_deployer_mock_v1alpha1_ScenarioError(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScenarioError describes an error that is returned by a scenario step.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the message of the error.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetSelector": {
+					"codes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Description: "Codes are the error codes of the error. Unrecoverable error codes set the DeployItem to phase \"Failed\".",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"message"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"},
 	}
 }
 
-func schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_mock_v1alpha1_ScenarioStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
+				Description: "ScenarioStatus describes the progress of a mock scenario.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ObservedGeneration is the generation of the DeployItem for which the scenario has been started. The scenario is restarted if the DeployItem changes.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"step": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Step is the index of the current step.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"attempt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempt is the number of times the current step has already been executed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"stepStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StepStartTime is the time when the current execution of the step has been started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the job ID of the DeployItem in which the current execution of the step has been started. A hanging step is finished as soon as the DeployItem has a new job ID.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+				},
+				Required: []string{"observedGeneration", "step", "attempt"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_apis_deployer_mock_v1alpha1_ScenarioStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScenarioStep describes a step of a mock scenario.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name is an optional name of the step that is used in logs and errors.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase sets the phase of the DeployItem",
+							Description: "Phase sets the phase of the DeployItem after the step has been executed. Defaults to \"Progressing\" if an error is configured and to \"Succeeded\" otherwise.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"initialPhase": {
+					"delay": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitialPhase sets the phase of the DeployItem, but only if it is empty or \"Init\" Additionally, setting it will suppress the DeployItem phase being set to \"Succeeded\" after successful reconciliation",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Delay is the duration that has to pass before the step is executed. Meanwhile, the DeployItem is in phase \"Progressing\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"providerStatus": {
+					"times": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderStatus sets the provider status to the given value",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Times is the number of reconciliations in which the step is executed before the scenario continues with the next step. Defaults to 1. The last step of a scenario is repeated for all further reconciliations.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"hang": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hang keeps the DeployItem in phase \"Progressing\" until it is interrupted, e.g. by a timeout or by an interruption of its installation.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 					"export": {
@@ -14970,17 +17524,17 @@ func schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref common.Referen
 							Format:      "byte",
 						},
 					},
-					"continuousReconcile": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+							Description: "Error lets the reconciliation fail with the given error.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ScenarioError"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ScenarioError"},
 	}
 }
 
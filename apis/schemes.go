@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package apis provides the json schemas of the landscaper and deployer apis.
+package apis
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// SchemesDir is the directory of the embedded json schemas.
+const SchemesDir = ".schemes"
+
+// Schemes contains the json schemas of the landscaper and deployer apis that are generated from their openapi definitions.
+// The schemas are named "<group prefix>-<version>-<kind>.json", e.g. "helm-v1alpha1-ProviderConfiguration.json".
+//
+//go:embed .schemes/*.json
+var Schemes embed.FS
+
+// GetSchema returns the json schema of the given kind of an api version.
+// The group prefix is the first segment of the api group, e.g. "helm" for "helm.deployer.landscaper.gardener.cloud".
+func GetSchema(groupPrefix, version, kind string) ([]byte, error) {
+	return fs.ReadFile(Schemes, fmt.Sprintf("%s/%s-%s-%s.json", SchemesDir, groupPrefix, version, kind))
+}
+
+// ListSchemaVersions returns all versions of the given kind of a group for which a json schema is available.
+func ListSchemaVersions(groupPrefix, kind string) ([]string, error) {
+	entries, err := fs.ReadDir(Schemes, SchemesDir)
+	if err != nil {
+		return nil, err
+	}
+	versions := []string{}
+	prefix := groupPrefix + "-"
+	suffix := "-" + kind + ".json"
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) || len(name) <= len(prefix)+len(suffix) {
+			continue
+		}
+		versions = append(versions, strings.TrimSuffix(strings.TrimPrefix(name, prefix), suffix))
+	}
+	return versions, nil
+}
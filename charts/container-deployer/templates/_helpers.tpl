@@ -90,6 +90,14 @@ oci:
   - /app/ls/registry/secrets/{{ $key }}
   {{- end }}
   {{- end }}
+  {{- with .Values.deployer.oci.cache }}
+  cache:
+{{ toYaml . | indent 4 }}
+  {{- end }}
+  {{- with .Values.deployer.oci.tokenSources }}
+  tokenSources:
+{{ toYaml . | indent 2 }}
+  {{- end }}
 {{- end }}
 {{- with .Values.deployer.targetSelector }}
 targetSelector:
@@ -99,6 +107,14 @@ targetSelector:
 hpa:
 {{ .Values.hpa | toYaml | indent 2 }}
 {{- end }}
+{{- with .Values.deployer.runnerPool }}
+runnerPool:
+{{ toYaml . | indent 2 }}
+{{- end }}
+{{- with .Values.deployer.namespaceIsolation }}
+namespaceIsolation:
+{{ toYaml . | indent 2 }}
+{{- end }}
 {{- if .Values.deployer.controller }}
 controller:
 {{ .Values.deployer.controller | toYaml | indent 2 }}
@@ -82,6 +82,14 @@ oci:
   - /app/ls/registry/secrets/{{ $key }}
   {{- end }}
   {{- end }}
+  {{- with .Values.deployer.oci.cache }}
+  cache:
+{{ toYaml . | indent 4 }}
+  {{- end }}
+  {{- with .Values.deployer.oci.tokenSources }}
+  tokenSources:
+{{ toYaml . | indent 2 }}
+  {{- end }}
 {{- end }}
 {{- with .Values.deployer.targetSelector }}
 targetSelector:
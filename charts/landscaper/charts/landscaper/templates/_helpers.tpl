@@ -140,6 +140,26 @@ registry:
       cache:
         path: /app/ls/oci-cache/
         useInMemoryOverlay: {{ .Values.landscaper.registryConfig.cache.useInMemoryOverlay | default false }}
+        {{- if .Values.landscaper.registryConfig.cache.shared }}
+        {{- $shared := deepCopy .Values.landscaper.registryConfig.cache.shared }}
+        {{- if and $shared.s3 .Values.landscaper.registryConfig.cache.sharedS3Credentials }}
+        {{- $_ := set $shared.s3 "credentialsFile" "/app/ls/shared-cache/credentials" }}
+        {{- end }}
+        shared:
+{{ toYaml $shared | indent 10 }}
+        {{- end }}
+        {{- with .Values.landscaper.registryConfig.cache.helmChartRepoIndex }}
+        helmChartRepoIndex:
+{{ toYaml . | indent 10 }}
+        {{- end }}
+      {{- with .Values.landscaper.registryConfig.tokenSources }}
+      tokenSources:
+{{ toYaml . | indent 6 }}
+      {{- end }}
+    {{- if .Values.landscaper.registryConfig.filesystem }}
+    filesystem:
+      rootPath: /app/ls/component-archives
+    {{- end }}
 {{ end }}
 {{- if .Values.landscaper.metrics }}
 metrics:
@@ -170,6 +190,37 @@ deployItemTimeouts:
   {{- end }}
 {{- end }}
 
+{{- if .Values.landscaper.templateLimits }}
+templateLimits:
+{{ toYaml .Values.landscaper.templateLimits | indent 2 }}
+{{- end }}
+
+{{- if .Values.landscaper.targetLookup }}
+targetLookup:
+{{ toYaml .Values.landscaper.targetLookup | indent 2 }}
+{{- end }}
+
+{{- if .Values.landscaper.deployItemValidation }}
+deployItemValidation:
+{{ toYaml .Values.landscaper.deployItemValidation | indent 2 }}
+{{- end }}
+
+{{- with .Values.landscaper.componentIndex }}
+componentIndex:
+  port: {{ .port }}
+  {{- if .refreshInterval }}
+  refreshInterval: {{ .refreshInterval }}
+  {{- end }}
+  {{- if .bindAddress }}
+  bindAddress: {{ .bindAddress | quote }}
+  {{- end }}
+  {{- if .tls }}
+  tls:
+    certFile: /app/ls/component-index-tls/tls.crt
+    keyFile: /app/ls/component-index-tls/tls.key
+  {{- end }}
+{{- end }}
+
 lsDeployments:
   lsController: "{{- include "landscaper.fullname" . }}"
   lsMainController: "{{- include "landscaper.main.fullname" . }}"
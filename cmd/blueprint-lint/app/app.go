@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/utils/landscaper/blueprintlint"
+)
+
+// NewBlueprintLintCommand creates a new command that checks a blueprint for mistakes
+func NewBlueprintLintCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:           "blueprint-lint <blueprint-dir>",
+		Short:         "Checks a blueprint for mistakes and reports them with the line of the blueprint they refer to",
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Complete(args); err != nil {
+				return err
+			}
+			return options.run(ctx, cmd.OutOrStdout())
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context, out io.Writer) error {
+	blueprintFs, err := projectionfs.New(osfs.New(), o.blueprintDir)
+	if err != nil {
+		return fmt.Errorf("unable to read blueprint directory %q: %w", o.blueprintDir, err)
+	}
+	blueprint, err := blueprints.NewFromFs(blueprintFs)
+	if err != nil {
+		return fmt.Errorf("unable to read blueprint: %w", err)
+	}
+
+	lintOptions := blueprintlint.Options{
+		Imports: o.imports,
+	}
+	if o.component != nil {
+		lintOptions.ComponentVersion, lintOptions.ComponentVersions, _, _, err = o.component.Resolve(ctx, "")
+		if err != nil {
+			return err
+		}
+	}
+
+	result := blueprintlint.Lint(ctx, blueprint, lintOptions)
+	for _, d := range result.Diagnostics {
+		fmt.Fprintln(out, d.String())
+	}
+
+	errs, warnings := result.Count(blueprintlint.SeverityError), result.Count(blueprintlint.SeverityWarning)
+	if errs != 0 || (o.strict && warnings != 0) {
+		fmt.Fprintf(out, "FAIL\t%d errors, %d warnings\n", errs, warnings)
+		return fmt.Errorf("blueprint has %d errors and %d warnings", errs, warnings)
+	}
+	fmt.Fprintf(out, "PASS\t%d errors, %d warnings\n", errs, warnings)
+	return nil
+}
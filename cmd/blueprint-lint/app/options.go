@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"os"
+
+	flag "github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/pkg/utils/landscaper/blueprinttest"
+)
+
+// options holds the blueprint lint options
+type options struct {
+	blueprintDir     string
+	componentPath    string
+	componentName    string
+	componentVersion string
+	importsPath      string
+	strict           bool
+
+	component *blueprinttest.ComponentMock
+	imports   map[string]interface{}
+}
+
+// NewOptions returns a new options instance
+func NewOptions() *options {
+	return &options{}
+}
+
+// AddFlags adds flags passed via command line
+func (o *options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.componentPath, "component-path", "", "Specify the directory of the component descriptors. The directory has the same structure as a local registry")
+	fs.StringVar(&o.componentName, "component-name", "", "Specify the name of the component that contains the blueprint")
+	fs.StringVar(&o.componentVersion, "component-version", "", "Specify the version of the component that contains the blueprint")
+	fs.StringVar(&o.importsPath, "imports", "", "Specify a yaml file with import values. If given, the rendered deploy items and subinstallations are checked")
+	fs.BoolVar(&o.strict, "strict", false, "If true the command also fails on warnings")
+}
+
+// Complete initializes the options instance and validates flags
+func (o *options) Complete(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected exactly one argument, the blueprint directory, but got %d", len(args))
+	}
+	o.blueprintDir = args[0]
+
+	if len(o.componentPath) != 0 || len(o.componentName) != 0 || len(o.componentVersion) != 0 {
+		if len(o.componentPath) == 0 || len(o.componentName) == 0 || len(o.componentVersion) == 0 {
+			return fmt.Errorf("the flags --component-path, --component-name and --component-version must be given together")
+		}
+		o.component = &blueprinttest.ComponentMock{
+			Path:    o.componentPath,
+			Name:    o.componentName,
+			Version: o.componentVersion,
+		}
+	}
+
+	if len(o.importsPath) != 0 {
+		data, err := os.ReadFile(o.importsPath)
+		if err != nil {
+			return fmt.Errorf("unable to read imports file %q: %w", o.importsPath, err)
+		}
+		o.imports = map[string]interface{}{}
+		if err := yaml.Unmarshal(data, &o.imports); err != nil {
+			return fmt.Errorf("unable to parse imports file %q: %w", o.importsPath, err)
+		}
+	}
+	return nil
+}
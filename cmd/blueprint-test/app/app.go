@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/landscaper/pkg/utils/landscaper/blueprinttest"
+)
+
+// NewBlueprintTestCommand creates a new command that runs the test cases of a blueprint
+func NewBlueprintTestCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:           "blueprint-test <blueprint-dir>",
+		Short:         "Renders a blueprint for every test case and compares the results with the golden files of the test case",
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Complete(args); err != nil {
+				return err
+			}
+			return options.run(ctx, cmd.OutOrStdout())
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context, out io.Writer) error {
+	testCases, err := blueprinttest.LoadTestCases(o.testDir)
+	if err != nil {
+		return err
+	}
+
+	runner := blueprinttest.NewRunner(o.blueprintDir, o.update)
+	failed, executed := 0, 0
+	for _, testCase := range testCases {
+		if o.runRegexp != nil && !o.runRegexp.MatchString(testCase.Name) {
+			continue
+		}
+		executed++
+
+		res := runner.Run(ctx, testCase)
+		if !res.Failed() {
+			fmt.Fprintf(out, "--- PASS: %s\n", testCase.Name)
+			for _, file := range res.Updated {
+				fmt.Fprintf(out, "    updated %s\n", file)
+			}
+			continue
+		}
+
+		failed++
+		fmt.Fprintf(out, "--- FAIL: %s\n", testCase.Name)
+		if res.Err != nil {
+			fmt.Fprintf(out, "    %s\n", res.Err.Error())
+			continue
+		}
+		files := make([]string, 0, len(res.Diffs))
+		for file := range res.Diffs {
+			files = append(files, file)
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			fmt.Fprintf(out, "    %s differs (- expected, + actual):\n%s", file, res.Diffs[file])
+		}
+	}
+
+	if executed == 0 {
+		fmt.Fprintf(out, "no test cases found in %s\n", o.testDir)
+		return nil
+	}
+	if failed != 0 {
+		fmt.Fprintf(out, "FAIL\t%d of %d test cases failed\n", failed, executed)
+		return fmt.Errorf("%d of %d test cases failed", failed, executed)
+	}
+	fmt.Fprintf(out, "PASS\t%d test cases\n", executed)
+	return nil
+}
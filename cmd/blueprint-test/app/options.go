@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/gardener/landscaper/pkg/utils/landscaper/blueprinttest"
+)
+
+// defaultTestDirName is the directory of the blueprint that contains the test cases if no test directory is given.
+const defaultTestDirName = "tests"
+
+// options holds the blueprint test options
+type options struct {
+	blueprintDir  string
+	testDir       string
+	runExpression string
+	update        bool
+
+	runRegexp *regexp.Regexp
+}
+
+// NewOptions returns a new options instance
+func NewOptions() *options {
+	return &options{}
+}
+
+// AddFlags adds flags passed via command line
+func (o *options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.testDir, "tests", "", "Specify the directory that contains the test cases. Defaults to the \"tests\" directory of the blueprint")
+	fs.StringVar(&o.runExpression, "run", "", "Only run the test cases whose name matches the given regular expression")
+	fs.BoolVar(&o.update, "update", false, fmt.Sprintf("If true the %q directories of the test cases are overwritten with the rendered results", blueprinttest.GoldenDirName))
+}
+
+// Complete initializes the options instance and validates flags
+func (o *options) Complete(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected exactly one argument, the blueprint directory, but got %d", len(args))
+	}
+	o.blueprintDir = args[0]
+	if len(o.testDir) == 0 {
+		o.testDir = filepath.Join(o.blueprintDir, defaultTestDirName)
+	}
+
+	if len(o.runExpression) != 0 {
+		var err error
+		o.runRegexp, err = regexp.Compile(o.runExpression)
+		if err != nil {
+			return fmt.Errorf("invalid run expression %q: %w", o.runExpression, err)
+		}
+	}
+	return nil
+}
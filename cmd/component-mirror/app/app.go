@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gardener/component-cli/ociclient"
+	"github.com/gardener/component-cli/ociclient/cache"
+	"github.com/gardener/component-cli/ociclient/credentials"
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/cnudie/componentresolvers"
+	cnudieutils "github.com/gardener/landscaper/pkg/components/cnudie/utils"
+	"github.com/gardener/landscaper/pkg/components/mirror"
+	"github.com/gardener/landscaper/pkg/components/registries"
+)
+
+// NewComponentMirrorCommand creates a new command that copies a component and its resources into another oci repository
+func NewComponentMirrorCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:           "component-mirror",
+		Short:         "Copies a component version, its referenced component versions and their resources into an oci repository and prints a ComponentVersionOverwrites object pointing to the copies",
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Complete(args); err != nil {
+				return err
+			}
+			return options.run(ctx, cmd.OutOrStdout())
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context, out io.Writer) error {
+	logger, err := logging.NewCliLogger()
+	if err != nil {
+		return fmt.Errorf("unable to create logger: %w", err)
+	}
+	ctx = logging.NewContext(ctx, logger)
+
+	ociConfig := &config.OCIConfiguration{
+		ConfigFiles:    o.registryConfigFiles,
+		AllowPlainHttp: o.allowPlainHttp,
+	}
+
+	cdRef := &lsv1alpha1.ComponentDescriptorReference{
+		ComponentName: o.componentName,
+		Version:       o.componentVersion,
+	}
+	var (
+		localRegistryConfig *config.LocalRegistryConfiguration
+		repositoryContext   cdv2.UnstructuredTypedObject
+	)
+	if len(o.localPath) != 0 {
+		localRegistryConfig = &config.LocalRegistryConfiguration{RootPath: o.localPath}
+		repositoryContext, err = componentresolvers.NewLocalRepositoryContext("")
+	} else {
+		repositoryContext, err = componentresolvers.NewOCIRepositoryContext(o.sourceRepository)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to create repository context: %w", err)
+	}
+	cdRef.RepositoryContext = &repositoryContext
+
+	registryAccess, err := registries.GetFactory(o.useOCM).NewRegistryAccess(ctx, nil, nil, nil, localRegistryConfig, ociConfig, nil)
+	if err != nil {
+		return fmt.Errorf("unable to create registry access: %w", err)
+	}
+
+	ociKeyring, err := credentials.NewBuilder(logger.Logr()).DisableDefaultConfig().
+		FromConfigFiles(o.registryConfigFiles...).
+		Build()
+	if err != nil {
+		return fmt.Errorf("unable to read registry configs: %w", err)
+	}
+	ociClient, err := ociclient.NewClient(logger.Logr(),
+		cnudieutils.WithConfiguration(ociConfig),
+		ociclient.WithKeyring(ociKeyring),
+		ociclient.WithCache(cache.NewInMemoryCache()),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create oci client: %w", err)
+	}
+
+	m, err := mirror.New(logger, registryAccess, ociClient, o.targetRepository)
+	if err != nil {
+		return err
+	}
+	result, err := m.Transfer(ctx, cdRef)
+	if err != nil {
+		return err
+	}
+
+	for _, component := range result.Components {
+		for _, res := range component.Resources {
+			if len(res.Skipped) != 0 {
+				logger.Info("resource not copied", "componentName", component.ComponentName, "version", component.Version,
+					"resource", res.Name, "reason", res.Skipped)
+			}
+		}
+	}
+
+	data, err := yaml.Marshal(result.ComponentVersionOverwrites(o.overwritesName, o.overwritesNamespace))
+	if err != nil {
+		return fmt.Errorf("unable to marshal component version overwrites: %w", err)
+	}
+	if len(o.outputPath) != 0 {
+		if err := os.WriteFile(o.outputPath, data, 0644); err != nil {
+			return fmt.Errorf("unable to write component version overwrites to %q: %w", o.outputPath, err)
+		}
+		return nil
+	}
+	_, err = out.Write(data)
+	return err
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+)
+
+// options holds the component mirror options
+type options struct {
+	componentName       string
+	componentVersion    string
+	sourceRepository    string
+	localPath           string
+	targetRepository    string
+	registryConfigFiles []string
+	allowPlainHttp      bool
+	useOCM              bool
+	overwritesName      string
+	overwritesNamespace string
+	outputPath          string
+}
+
+// NewOptions returns a new options instance
+func NewOptions() *options {
+	return &options{}
+}
+
+// AddFlags adds flags passed via command line
+func (o *options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.componentName, "component-name", "", "Specify the name of the component that is copied")
+	fs.StringVar(&o.componentVersion, "component-version", "", "Specify the version of the component that is copied")
+	fs.StringVar(&o.sourceRepository, "source", "", "Specify the base url of the oci repository that contains the component, e.g. example.com/components")
+	fs.StringVar(&o.localPath, "local-path", "", "Specify a directory with the same structure as a local registry that contains the component. Alternative to --source")
+	fs.StringVar(&o.targetRepository, "target", "", "Specify the base url of the oci repository into which the components and their resources are copied")
+	fs.StringSliceVar(&o.registryConfigFiles, "registry-config", nil, "Specify docker config files with the credentials of the source and target registries")
+	fs.BoolVar(&o.allowPlainHttp, "allow-plain-http", false, "If true the registries may be accessed via plain http")
+	fs.BoolVar(&o.useOCM, "use-ocm", false, "If true the components are read with the ocm library")
+	fs.StringVar(&o.overwritesName, "overwrites-name", "mirror", "Specify the name of the generated ComponentVersionOverwrites object")
+	fs.StringVar(&o.overwritesNamespace, "overwrites-namespace", "", "Specify the namespace of the generated ComponentVersionOverwrites object")
+	fs.StringVarP(&o.outputPath, "output", "o", "", "Specify a file to which the ComponentVersionOverwrites object is written. Defaults to stdout")
+}
+
+// Complete initializes the options instance and validates flags
+func (o *options) Complete(args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("expected no arguments, but got %d", len(args))
+	}
+	if len(o.componentName) == 0 || len(o.componentVersion) == 0 {
+		return errors.New("the flags --component-name and --component-version must be given")
+	}
+	if len(o.sourceRepository) == 0 && len(o.localPath) == 0 {
+		return errors.New("one of the flags --source and --local-path must be given")
+	}
+	if len(o.sourceRepository) != 0 && len(o.localPath) != 0 {
+		return errors.New("the flags --source and --local-path must not be given together")
+	}
+	if len(o.targetRepository) == 0 {
+		return errors.New("the flag --target must be given")
+	}
+	return nil
+}
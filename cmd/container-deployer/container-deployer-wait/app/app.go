@@ -11,8 +11,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 
+	"github.com/gardener/landscaper/pkg/deployer/container/runner"
 	"github.com/gardener/landscaper/pkg/deployer/container/wait"
 	"github.com/gardener/landscaper/pkg/version"
 )
@@ -35,8 +37,74 @@ It waits until the main container has finished, then back-ups the optional state
 		},
 	}
 
-	options.AddFlags(cmd.Flags())
+	options.AddFlags(cmd.PersistentFlags())
 
+	cmd.AddCommand(newRunnerCommand(ctx, options))
+	cmd.AddCommand(newRunExecutorCommand(ctx, options))
+	cmd.AddCommand(newInstallRunnerCommand(options))
+
+	return cmd
+}
+
+// newRunnerCommand creates the command that runs the binary as runner of a runner pod.
+func newRunnerCommand(ctx context.Context, options *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "runner",
+		Short: "Runner prepares and uploads the runs of deploy items that are dispatched to a long-lived runner pod by a Container Deployer.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(); err != nil {
+				fmt.Print(err)
+				os.Exit(1)
+			}
+			options.log.Info("Starting runner for container deployer", lc.KeyVersion, version.Get().GitVersion)
+			if err := runner.Run(logging.NewContext(ctx, options.log)); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+}
+
+// newRunExecutorCommand creates the command that executes the commands of the runs in the main container of a runner pod.
+func newRunExecutorCommand(ctx context.Context, options *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "run-executor",
+		Short: "Run-executor executes the commands that the runner of a long-lived runner pod hands over to the main container.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(); err != nil {
+				fmt.Print(err)
+				os.Exit(1)
+			}
+			options.log.Info("Starting run executor for container deployer", lc.KeyVersion, version.Get().GitVersion)
+			if err := runner.RunExecutor(logging.NewContext(ctx, options.log)); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+}
+
+// newInstallRunnerCommand creates the command that installs the binary into the main container of a runner pod,
+// where it is executed as run executor.
+func newInstallRunnerCommand(options *options) *cobra.Command {
+	var target string
+	cmd := &cobra.Command{
+		Use:   "install-runner",
+		Short: "Install-runner copies the binary to the given target path so that it can be executed as runner.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(); err != nil {
+				fmt.Print(err)
+				os.Exit(1)
+			}
+			options.log.Info("Installing runner", "target", target)
+			if err := runner.Install(target); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&target, "target", "", "path to which the runner binary is installed")
+	_ = cmd.MarkFlagRequired("target")
 	return cmd
 }
 
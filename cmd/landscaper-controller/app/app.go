@@ -13,6 +13,7 @@ import (
 	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -41,6 +42,9 @@ import (
 	"github.com/gardener/landscaper/pkg/version"
 )
 
+// singletonLeaderElectionID is the name of the lease of the leader election of the singleton controllers.
+const singletonLeaderElectionID = "landscaper-main-controller-singletons"
+
 // NewLandscaperControllerCommand creates a new landscaper command that runs the landscaper controller.
 func NewLandscaperControllerCommand(ctx context.Context) *cobra.Command {
 	options := NewOptions()
@@ -104,13 +108,14 @@ func (o *Options) run(ctx context.Context) error {
 	}
 
 	lsMgr := hostMgr
+	lsRestConfig := hostRestConfig
 	if hostAndResourceClusterDifferent {
 		data, err := os.ReadFile(o.landscaperKubeconfigPath)
 		if err != nil {
 			return fmt.Errorf("unable to read landscaper kubeconfig from %s: %w", o.landscaperKubeconfigPath, err)
 		}
 
-		lsRestConfig, err := clientcmd.RESTConfigFromKubeConfig(data)
+		lsRestConfig, err = clientcmd.RESTConfigFromKubeConfig(data)
 		if err != nil {
 			return fmt.Errorf("unable to build landscaper cluster rest client: %w", err)
 		}
@@ -145,14 +150,37 @@ func (o *Options) run(ctx context.Context) error {
 		return o.startCentralLandscaper(ctx, lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 			lsMgr, hostMgr, ctrlLogger, setupLogger)
 	} else {
+		singletonMgr, err := newSingletonManager(lsRestConfig, hostRestConfig, opts)
+		if err != nil {
+			return err
+		}
 		return o.startMainController(ctx, lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
-			lsMgr, hostMgr, ctrlLogger, setupLogger)
+			lsMgr, hostMgr, singletonMgr, ctrlLogger, setupLogger)
 	}
 }
 
+// newSingletonManager creates the manager of the controllers of the landscaper cluster that must only run in one replica.
+// The lease of the leader election is stored in the namespace of the pod in the host cluster.
+// Leader election is disabled if the landscaper does not run in a pod, e.g. during local development.
+func newSingletonManager(lsRestConfig, hostRestConfig *rest.Config, opts manager.Options) (manager.Manager, error) {
+	opts.Metrics = metricsserver.Options{BindAddress: "0"}
+	if namespace := lsutils.GetCurrentPodNamespace(); namespace != lsutils.NoPodnamespace {
+		opts.LeaderElection = true
+		opts.LeaderElectionID = singletonLeaderElectionID
+		opts.LeaderElectionNamespace = namespace
+		opts.LeaderElectionConfig = hostRestConfig
+		opts.LeaderElectionReleaseOnCancel = true
+	}
+	mgr, err := ctrl.NewManager(lsRestConfig, opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to setup singleton manager: %w", err)
+	}
+	return mgr, nil
+}
+
 func (o *Options) startMainController(ctx context.Context,
 	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
-	lsMgr, hostMgr manager.Manager, ctrlLogger, setupLogger logging.Logger) error {
+	lsMgr, hostMgr, singletonMgr manager.Manager, ctrlLogger, setupLogger logging.Logger) error {
 
 	store, err := blueprint.NewStore(o.Log.WithName("blueprintStore"), osfs.New(), o.Config.BlueprintStore)
 	if err != nil {
@@ -161,7 +189,7 @@ func (o *Options) startMainController(ctx context.Context,
 	blueprint.SetStore(store)
 
 	if err := installationsctrl.AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
-		ctrlLogger, lsMgr, o.Config, "installations"); err != nil {
+		ctrlLogger, lsMgr, singletonMgr, o.Config, "installations"); err != nil {
 		return fmt.Errorf("unable to setup installation controller: %w", err)
 	}
 
@@ -192,6 +220,12 @@ func (o *Options) startMainController(ctx context.Context,
 		}
 		return nil
 	})
+	eg.Go(func() error {
+		if err := singletonMgr.Start(ctx); err != nil {
+			return fmt.Errorf("error while running singleton manager: %w", err)
+		}
+		return nil
+	})
 	return eg.Wait()
 }
 
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gardener/component-cli/ociclient/cache"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// operationTimeout is the maximal duration of a single read or write of the shared store.
+const operationTimeout = 10 * time.Minute
+
+// sharedCache is a read-through cache that combines a local cache with a store that is shared by all replicas.
+// Blobs are always served from the local cache. Blobs that are missing in the local cache are copied from the
+// shared store, and blobs that are added to the local cache are uploaded to the shared store in the background.
+// Errors of the shared store are only logged, as the cache is just an optimization.
+type sharedCache struct {
+	log   logging.Logger
+	local cache.Cache
+	store Store
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	// uploads contains the digests of the blobs that are currently uploaded.
+	uploads      sets.Set[string]
+	uploadsMutex sync.Mutex
+	uploadsWg    sync.WaitGroup
+}
+
+var _ cache.Cache = &sharedCache{}
+var _ cache.InfoInterface = &sharedCache{}
+var _ cache.PruneInterface = &sharedCache{}
+
+// NewCache creates a new cache that uses the given local cache and shares its blobs via the given store.
+func NewCache(log logging.Logger, local cache.Cache, store Store) cache.Cache {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &sharedCache{
+		log:     log,
+		local:   local,
+		store:   store,
+		ctx:     ctx,
+		cancel:  cancel,
+		uploads: sets.New[string](),
+	}
+}
+
+// Close cancels all running uploads and closes the local cache.
+func (c *sharedCache) Close() error {
+	c.uploadsMutex.Lock()
+	c.cancel()
+	c.uploadsMutex.Unlock()
+
+	c.uploadsWg.Wait()
+	return c.local.Close()
+}
+
+// Get returns the blob from the local cache.
+// If the blob is not cached locally, it is copied from the shared store into the local cache first.
+func (c *sharedCache) Get(desc ocispecv1.Descriptor) (io.ReadCloser, error) {
+	reader, err := c.local.Get(desc)
+	if !errors.Is(err, cache.ErrNotFound) {
+		return reader, err
+	}
+	if !c.download(desc) {
+		return nil, cache.ErrNotFound
+	}
+	// the local cache verifies the digest of the blob and removes it if the shared store returned invalid content
+	return c.local.Get(desc)
+}
+
+// Add adds the blob to the local cache and uploads it to the shared store in the background.
+func (c *sharedCache) Add(desc ocispecv1.Descriptor, reader io.ReadCloser) error {
+	if err := c.local.Add(desc, reader); err != nil {
+		return err
+	}
+
+	if !c.startUpload(desc) {
+		return nil
+	}
+	go func() {
+		defer c.finishUpload(desc)
+		c.upload(desc)
+	}()
+	return nil
+}
+
+// Info returns the information of the local cache.
+func (c *sharedCache) Info() (cache.Info, error) {
+	if infoCache, ok := c.local.(cache.InfoInterface); ok {
+		return infoCache.Info()
+	}
+	return cache.Info{}, nil
+}
+
+// Prune prunes the local cache. The shared store is not changed.
+func (c *sharedCache) Prune() error {
+	if pruneCache, ok := c.local.(cache.PruneInterface); ok {
+		return pruneCache.Prune()
+	}
+	return nil
+}
+
+// download copies a blob from the shared store into the local cache and returns whether this succeeded.
+func (c *sharedCache) download(desc ocispecv1.Descriptor) bool {
+	ctx, cancel := context.WithTimeout(c.ctx, operationTimeout)
+	defer cancel()
+
+	blob, err := c.store.Get(ctx, desc)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			SharedCacheMisses.Inc()
+		} else {
+			SharedCacheErrors.Inc()
+			c.log.Info("unable to read blob from shared cache", "digest", desc.Digest.String(), "error", err.Error())
+		}
+		return false
+	}
+
+	if err := c.local.Add(desc, blob); err != nil {
+		SharedCacheErrors.Inc()
+		c.log.Info("unable to copy blob from shared cache", "digest", desc.Digest.String(), "error", err.Error())
+		return false
+	}
+	SharedCacheHits.Inc()
+	return true
+}
+
+// upload copies a blob from the local cache into the shared store.
+func (c *sharedCache) upload(desc ocispecv1.Descriptor) {
+	ctx, cancel := context.WithTimeout(c.ctx, operationTimeout)
+	defer cancel()
+
+	blob, err := c.local.Get(desc)
+	if err != nil {
+		c.log.Debug("unable to read blob from local cache for upload", "digest", desc.Digest.String(), "error", err.Error())
+		return
+	}
+	defer blob.Close()
+
+	if err := c.store.Put(ctx, desc, blob); err != nil {
+		SharedCacheErrors.Inc()
+		c.log.Info("unable to write blob to shared cache", "digest", desc.Digest.String(), "error", err.Error())
+		return
+	}
+	SharedCacheUploads.Inc()
+}
+
+// startUpload registers an upload and returns false if the blob is already being uploaded or the cache is closed.
+func (c *sharedCache) startUpload(desc ocispecv1.Descriptor) bool {
+	c.uploadsMutex.Lock()
+	defer c.uploadsMutex.Unlock()
+	if c.ctx.Err() != nil || c.uploads.Has(desc.Digest.String()) {
+		return false
+	}
+	c.uploads.Insert(desc.Digest.String())
+	c.uploadsWg.Add(1)
+	return true
+}
+
+func (c *sharedCache) finishUpload(desc ocispecv1.Descriptor) {
+	c.uploadsMutex.Lock()
+	defer c.uploadsMutex.Unlock()
+	c.uploads.Delete(desc.Digest.String())
+	c.uploadsWg.Done()
+}
@@ -0,0 +1,299 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gardener/component-cli/ociclient/cache"
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/cache/shared"
+)
+
+var _ = Describe("Shared Cache", func() {
+
+	var (
+		ctx  context.Context
+		blob []byte
+		desc ocispecv1.Descriptor
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		blob = []byte("a helm chart")
+		desc = ocispecv1.Descriptor{
+			MediaType: "application/octet-stream",
+			Digest:    digest.FromBytes(blob),
+			Size:      int64(len(blob)),
+		}
+	})
+
+	newLocalCache := func() cache.Cache {
+		c, err := cache.NewCache(logr.Discard(), cache.WithBasePath(GinkgoT().TempDir()))
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	readAll := func(reader io.ReadCloser) []byte {
+		defer reader.Close()
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		return data
+	}
+
+	Context("Cache", func() {
+
+		It("should share blobs between caches", func() {
+			store := newMemoryStore()
+			replica1 := shared.NewCache(logging.Discard(), newLocalCache(), store)
+			replica2 := shared.NewCache(logging.Discard(), newLocalCache(), store)
+
+			_, err := replica2.Get(desc)
+			Expect(err).To(MatchError(cache.ErrNotFound))
+
+			Expect(replica1.Add(desc, io.NopCloser(bytes.NewReader(blob)))).To(Succeed())
+			Expect(readAll(must(replica1.Get(desc)))).To(Equal(blob))
+			Eventually(store.has).WithArguments(desc).Should(BeTrue())
+
+			Expect(readAll(must(replica2.Get(desc)))).To(Equal(blob))
+
+			// the blob is now served from the local cache of the second replica
+			store.clear()
+			Expect(readAll(must(replica2.Get(desc)))).To(Equal(blob))
+
+			Expect(replica1.Close()).To(Succeed())
+			Expect(replica2.Close()).To(Succeed())
+		})
+
+		It("should ignore blobs of the shared store with an invalid digest", func() {
+			store := newMemoryStore()
+			Expect(store.Put(ctx, desc, strings.NewReader("a manipulated chart"))).To(Succeed())
+
+			c := shared.NewCache(logging.Discard(), newLocalCache(), store)
+			_, err := c.Get(desc)
+			Expect(err).To(MatchError(cache.ErrNotFound))
+		})
+
+		It("should ignore errors of the shared store", func() {
+			store := newMemoryStore()
+			store.err = fmt.Errorf("store unavailable")
+
+			c := shared.NewCache(logging.Discard(), newLocalCache(), store)
+			_, err := c.Get(desc)
+			Expect(err).To(MatchError(cache.ErrNotFound))
+
+			Expect(c.Add(desc, io.NopCloser(bytes.NewReader(blob)))).To(Succeed())
+			Expect(readAll(must(c.Get(desc)))).To(Equal(blob))
+			Expect(c.Close()).To(Succeed())
+		})
+	})
+
+	Context("OCI Store", func() {
+
+		It("should store blobs in an oci repository", func() {
+			server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+			defer server.Close()
+
+			store, err := shared.NewStore(ctx, &config.SharedOCICacheConfiguration{
+				OCI: &config.SharedOCIRegistryCacheConfiguration{
+					Repository:     strings.TrimPrefix(server.URL, "http://") + "/landscaper/cache",
+					AllowPlainHttp: true,
+				},
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = store.Get(ctx, desc)
+			Expect(err).To(MatchError(shared.ErrNotFound))
+
+			Expect(store.Put(ctx, desc, bytes.NewReader(blob))).To(Succeed())
+			Expect(store.Put(ctx, desc, bytes.NewReader(blob))).To(Succeed())
+			Expect(readAll(must(store.Get(ctx, desc)))).To(Equal(blob))
+		})
+
+		It("should reference the blobs by a tagged manifest, so that they are not garbage collected", func() {
+			server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+			defer server.Close()
+			repository := strings.TrimPrefix(server.URL, "http://") + "/landscaper/cache"
+
+			store, err := shared.NewStore(ctx, &config.SharedOCICacheConfiguration{
+				OCI: &config.SharedOCIRegistryCacheConfiguration{
+					Repository:     repository,
+					AllowPlainHttp: true,
+				},
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(store.Put(ctx, desc, bytes.NewReader(blob))).To(Succeed())
+
+			ref, err := name.ParseReference(repository+":sha256-"+desc.Digest.Encoded(), name.Insecure)
+			Expect(err).ToNot(HaveOccurred())
+			manifestDesc, err := remote.Get(ref)
+			Expect(err).ToNot(HaveOccurred())
+			manifest := &ocispecv1.Manifest{}
+			Expect(json.Unmarshal(manifestDesc.Manifest, manifest)).To(Succeed())
+			Expect(manifest.Layers).To(HaveLen(1))
+			Expect(manifest.Layers[0].Digest).To(Equal(desc.Digest))
+			Expect(manifest.Layers[0].Size).To(Equal(desc.Size))
+		})
+	})
+
+	Context("S3 Store", func() {
+
+		It("should store blobs in an S3 bucket", func() {
+			s3 := newFakeS3()
+			server := httptest.NewServer(s3)
+			defer server.Close()
+
+			credentialsFile := filepath.Join(GinkgoT().TempDir(), "credentials")
+			Expect(os.WriteFile(credentialsFile, []byte("[default]\naws_access_key_id = key\naws_secret_access_key = secret\n"), 0600)).To(Succeed())
+
+			store, err := shared.NewStore(ctx, &config.SharedOCICacheConfiguration{
+				S3: &config.SharedS3CacheConfiguration{
+					Endpoint:        server.URL,
+					Bucket:          "landscaper",
+					Prefix:          "cache",
+					UsePathStyle:    true,
+					CredentialsFile: credentialsFile,
+				},
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = store.Get(ctx, desc)
+			Expect(err).To(MatchError(shared.ErrNotFound))
+
+			Expect(store.Put(ctx, desc, bytes.NewReader(blob))).To(Succeed())
+			Expect(s3.objects).To(HaveKey("/landscaper/cache/sha256/" + desc.Digest.Encoded()))
+			Expect(readAll(must(store.Get(ctx, desc)))).To(Equal(blob))
+		})
+	})
+
+	It("should reject a configuration with multiple backends", func() {
+		_, err := shared.NewStore(ctx, &config.SharedOCICacheConfiguration{
+			OCI: &config.SharedOCIRegistryCacheConfiguration{Repository: "example.com/cache"},
+			S3:  &config.SharedS3CacheConfiguration{Bucket: "cache"},
+		}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+})
+
+func must(reader io.ReadCloser, err error) io.ReadCloser {
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	return reader
+}
+
+// memoryStore is a shared store that keeps the blobs in memory.
+type memoryStore struct {
+	mutex sync.Mutex
+	blobs map[digest.Digest][]byte
+	err   error
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{blobs: map[digest.Digest][]byte{}}
+}
+
+func (s *memoryStore) Get(_ context.Context, desc ocispecv1.Descriptor) (io.ReadCloser, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	data, ok := s.blobs[desc.Digest]
+	if !ok {
+		return nil, shared.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *memoryStore) Put(_ context.Context, desc ocispecv1.Descriptor, blob io.Reader) error {
+	data, err := io.ReadAll(blob)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.blobs[desc.Digest] = data
+	return nil
+}
+
+func (s *memoryStore) has(desc ocispecv1.Descriptor) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, ok := s.blobs[desc.Digest]
+	return ok
+}
+
+func (s *memoryStore) clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.blobs = map[digest.Digest][]byte{}
+}
+
+// fakeS3 implements the object requests of the S3 api with path style addressing.
+type fakeS3 struct {
+	mutex   sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string][]byte{}}
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.objects[r.URL.Path] = data
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet, http.MethodHead:
+		data, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+			}
+			return
+		}
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const sharedCacheSubsystemName = "sharedCache"
+
+var (
+	// SharedCacheHits discloses the number of blobs that have been read from the shared cache.
+	SharedCacheHits = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: sharedCacheSubsystemName,
+			Name:      "hits_total",
+			Help:      "Total number of blobs that have been read from the shared cache.",
+		},
+	)
+
+	// SharedCacheMisses discloses the number of blobs that have not been found in the shared cache.
+	SharedCacheMisses = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: sharedCacheSubsystemName,
+			Name:      "misses_total",
+			Help:      "Total number of blobs that have not been found in the shared cache.",
+		},
+	)
+
+	// SharedCacheUploads discloses the number of blobs that have been written to the shared cache.
+	SharedCacheUploads = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: sharedCacheSubsystemName,
+			Name:      "uploads_total",
+			Help:      "Total number of blobs that have been written to the shared cache.",
+		},
+	)
+
+	// SharedCacheErrors discloses the number of failed reads and writes of the shared cache.
+	SharedCacheErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: sharedCacheSubsystemName,
+			Name:      "errors_total",
+			Help:      "Total number of failed reads and writes of the shared cache.",
+		},
+	)
+)
+
+// RegisterMetrics allows to register the shared cache metrics
+func RegisterMetrics(reg prometheus.Registerer) {
+	reg.MustRegister(SharedCacheHits, SharedCacheMisses, SharedCacheUploads, SharedCacheErrors)
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gardener/component-cli/ociclient/credentials"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+const (
+	// ociCacheArtifactType is the artifact type of the manifests that reference the blobs of the shared cache.
+	ociCacheArtifactType = "application/vnd.gardener.landscaper.cache.blob.v1"
+	// emptyConfig is the content of the config of the manifests that reference the blobs of the shared cache.
+	emptyConfig = "{}"
+)
+
+// ociStore stores blobs in an oci repository.
+// Every blob is the layer of an artifact that is tagged with the digest of the blob,
+// so that the blobs are not removed by a garbage collection of the registry.
+type ociStore struct {
+	repository name.Repository
+	keychain   credentials.OCIKeyring
+	transport  http.RoundTripper
+}
+
+// NewOCIStore creates a store that keeps the blobs in an oci repository.
+// The credentials are read from the docker config files of the given oci configuration.
+func NewOCIStore(ctx context.Context, cfg *config.SharedOCIRegistryCacheConfiguration, ociConfig *config.OCIConfiguration) (Store, error) {
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+
+	if len(cfg.Repository) == 0 {
+		return nil, errors.New("the repository of the shared oci cache must not be empty")
+	}
+	nameOptions := []name.Option{}
+	if cfg.AllowPlainHttp {
+		nameOptions = append(nameOptions, name.Insecure)
+	}
+	repository, err := name.NewRepository(cfg.Repository, nameOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository %q of the shared oci cache: %w", cfg.Repository, err)
+	}
+
+	configFiles := []string{}
+	if ociConfig != nil {
+		configFiles = ociConfig.ConfigFiles
+	}
+	keychain, err := credentials.NewBuilder(logger.WithName("ociKeyring").Logr()).
+		WithFS(osfs.New()).
+		FromConfigFiles(configFiles...).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read credentials of the shared oci cache: %w", err)
+	}
+
+	return &ociStore{
+		repository: repository,
+		keychain:   keychain,
+		transport:  newTransport(cfg.InsecureSkipVerify),
+	}, nil
+}
+
+func (s *ociStore) Get(ctx context.Context, desc ocispecv1.Descriptor) (io.ReadCloser, error) {
+	layer, err := remote.Layer(s.repository.Digest(desc.Digest.String()), s.options(ctx)...)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := layer.Compressed()
+	if err != nil {
+		var transportErr *transport.Error
+		if errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return blob, nil
+}
+
+func (s *ociStore) Put(ctx context.Context, desc ocispecv1.Descriptor, blob io.Reader) error {
+	layer, err := partial.CompressedToLayer(&blobLayer{desc: desc, blob: blob})
+	if err != nil {
+		return err
+	}
+	// existing blobs are not uploaded again
+	if err := remote.WriteLayer(s.repository, layer, s.options(ctx)...); err != nil {
+		return err
+	}
+
+	config := static.NewLayer([]byte(emptyConfig), types.MediaType(ocispecv1.MediaTypeEmptyJSON))
+	if err := remote.WriteLayer(s.repository, config, s.options(ctx)...); err != nil {
+		return fmt.Errorf("unable to upload config of blob %s: %w", desc.Digest, err)
+	}
+	manifest, err := newBlobManifest(desc)
+	if err != nil {
+		return err
+	}
+	if err := remote.Put(s.repository.Tag(blobTag(desc)), manifest, s.options(ctx)...); err != nil {
+		return fmt.Errorf("unable to upload manifest of blob %s: %w", desc.Digest, err)
+	}
+	return nil
+}
+
+func (s *ociStore) options(ctx context.Context) []remote.Option {
+	return []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuthFromKeychain(s.keychain),
+		remote.WithTransport(s.transport),
+	}
+}
+
+// blobTag returns the tag of the artifact that references a blob, e.g. "sha256-<hex>".
+func blobTag(desc ocispecv1.Descriptor) string {
+	return desc.Digest.Algorithm().String() + "-" + desc.Digest.Encoded()
+}
+
+// blobManifest is the manifest of an artifact that references a blob of the shared cache as its only layer.
+type blobManifest struct {
+	raw []byte
+}
+
+func newBlobManifest(desc ocispecv1.Descriptor) (*blobManifest, error) {
+	layer := ocispecv1.Descriptor{
+		MediaType: desc.MediaType,
+		Digest:    desc.Digest,
+		Size:      desc.Size,
+	}
+	if len(layer.MediaType) == 0 {
+		layer.MediaType = string(types.OCILayer)
+	}
+	manifest := ocispecv1.Manifest{
+		Versioned:    specs.Versioned{SchemaVersion: 2},
+		MediaType:    ocispecv1.MediaTypeImageManifest,
+		ArtifactType: ociCacheArtifactType,
+		Config:       ocispecv1.DescriptorEmptyJSON,
+		Layers:       []ocispecv1.Descriptor{layer},
+	}
+	raw, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode manifest of blob %s: %w", desc.Digest, err)
+	}
+	return &blobManifest{raw: raw}, nil
+}
+
+func (m *blobManifest) RawManifest() ([]byte, error) {
+	return m.raw, nil
+}
+
+func (m *blobManifest) MediaType() (types.MediaType, error) {
+	return types.OCIManifestSchema1, nil
+}
+
+// blobLayer describes a blob that is uploaded as layer.
+type blobLayer struct {
+	desc ocispecv1.Descriptor
+	blob io.Reader
+}
+
+var _ partial.CompressedLayer = &blobLayer{}
+
+func (l *blobLayer) Digest() (v1.Hash, error) {
+	return v1.NewHash(l.desc.Digest.String())
+}
+
+func (l *blobLayer) Compressed() (io.ReadCloser, error) {
+	return io.NopCloser(l.blob), nil
+}
+
+func (l *blobLayer) Size() (int64, error) {
+	return l.desc.Size, nil
+}
+
+func (l *blobLayer) MediaType() (types.MediaType, error) {
+	if len(l.desc.MediaType) == 0 {
+		return types.OCILayer, nil
+	}
+	return types.MediaType(l.desc.MediaType), nil
+}
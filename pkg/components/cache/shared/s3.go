@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+// defaultS3Region is the region that is used if no region is configured.
+const defaultS3Region = "us-east-1"
+
+// s3Store stores blobs in an S3 compatible bucket.
+// The key of a blob is "<prefix>/<digest algorithm>/<encoded digest>".
+type s3Store struct {
+	client   *s3.Client
+	uploader *manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+// NewS3Store creates a store that keeps the blobs in an S3 compatible bucket.
+func NewS3Store(ctx context.Context, cfg *config.SharedS3CacheConfiguration) (Store, error) {
+	if len(cfg.Bucket) == 0 {
+		return nil, errors.New("the bucket of the shared s3 cache must not be empty")
+	}
+
+	region := cfg.Region
+	if len(region) == 0 {
+		region = defaultS3Region
+	}
+	loadOptions := []func(*awsconfig.LoadOptions) error{
+		awsconfig.WithRegion(region),
+		awsconfig.WithHTTPClient(awshttp.NewBuildableClient().WithTransportOptions(func(transport *http.Transport) {
+			if cfg.InsecureSkipVerify {
+				transport.TLSClientConfig = &tls.Config{
+					InsecureSkipVerify: true,
+				}
+			}
+		})),
+	}
+	if len(cfg.CredentialsFile) != 0 {
+		loadOptions = append(loadOptions, awsconfig.WithSharedCredentialsFiles([]string{cfg.CredentialsFile}))
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, loadOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load configuration of the shared s3 cache: %w", err)
+	}
+
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if len(cfg.Endpoint) != 0 {
+			o.BaseEndpoint = aws.String(cfg.Endpoint)
+		}
+		o.UsePathStyle = cfg.UsePathStyle
+	})
+	return &s3Store{
+		client:   client,
+		uploader: manager.NewUploader(client),
+		bucket:   cfg.Bucket,
+		prefix:   cfg.Prefix,
+	}, nil
+}
+
+func (s *s3Store) Get(ctx context.Context, desc ocispecv1.Descriptor) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(desc)),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, desc ocispecv1.Descriptor, blob io.Reader) error {
+	_, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(desc)),
+	})
+	if err == nil {
+		// the blob is already stored
+		return nil
+	}
+	if !isNotFound(err) {
+		return err
+	}
+
+	_, err = s.uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(s.key(desc)),
+		Body:        blob,
+		ContentType: aws.String(desc.MediaType),
+	})
+	return err
+}
+
+func (s *s3Store) key(desc ocispecv1.Descriptor) string {
+	return path.Join(s.prefix, desc.Digest.Algorithm().String(), desc.Digest.Encoded())
+}
+
+// isNotFound returns whether the error is caused by a missing object.
+func isNotFound(err error) bool {
+	var responseErr *awshttp.ResponseError
+	return errors.As(err, &responseErr) && responseErr.HTTPStatusCode() == http.StatusNotFound
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gardener/component-cli/ociclient/cache"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// ErrNotFound is returned by a store if it does not contain the requested blob.
+var ErrNotFound = errors.New("blob not found in shared store")
+
+// Store is a blob store that is shared by all replicas.
+type Store interface {
+	// Get returns the content of the blob.
+	// ErrNotFound is returned if the store does not contain the blob.
+	Get(ctx context.Context, desc ocispecv1.Descriptor) (io.ReadCloser, error)
+	// Put stores the content of the blob. Blobs that are already stored are not written again.
+	Put(ctx context.Context, desc ocispecv1.Descriptor, blob io.Reader) error
+}
+
+// NewStore creates the store defined by the given configuration.
+// The oci configuration provides the credentials of an oci store.
+func NewStore(ctx context.Context, cfg *config.SharedOCICacheConfiguration, ociConfig *config.OCIConfiguration) (Store, error) {
+	switch {
+	case cfg.OCI != nil && cfg.S3 != nil:
+		return nil, errors.New("only one backend of the shared cache may be configured")
+	case cfg.OCI != nil:
+		return NewOCIStore(ctx, cfg.OCI, ociConfig)
+	case cfg.S3 != nil:
+		return NewS3Store(ctx, cfg.S3)
+	default:
+		return nil, errors.New("no backend of the shared cache is configured")
+	}
+}
+
+// NewCacheFromConfiguration wraps the given local cache in a shared cache if a shared cache is configured.
+// Otherwise, the local cache is returned.
+func NewCacheFromConfiguration(ctx context.Context, log logging.Logger, local cache.Cache, ociConfig *config.OCIConfiguration) (cache.Cache, error) {
+	if ociConfig == nil || ociConfig.Cache == nil || ociConfig.Cache.Shared == nil {
+		return local, nil
+	}
+	store, err := NewStore(ctx, ociConfig.Cache.Shared, ociConfig)
+	if err != nil {
+		return nil, err
+	}
+	return NewCache(log.WithName("sharedCache"), local, store), nil
+}
+
+// newTransport returns the http transport used to access a store.
+func newTransport(insecureSkipVerify bool) http.RoundTripper {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true,
+		}
+	}
+	return transport
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentresolvers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/gardener/landscaper/pkg/components/model/filesystemrepository"
+)
+
+// filesystemClient is a component descriptor repository implementation that resolves component references
+// from repository contexts of type "filesystem".
+// Every referenced directory or archive is searched in the same way as the root path of a local registry.
+type filesystemClient struct {
+	rootFs vfs.FileSystem
+
+	// repositories contains the opened directories and archives by their path
+	repositories      map[string]*localClient
+	repositoriesMutex sync.Mutex
+}
+
+// NewFilesystemClient creates a new registry for repository contexts of type "filesystem",
+// whose paths are resolved in the given root filesystem.
+func NewFilesystemClient(rootFs vfs.FileSystem) TypedRegistry {
+	return &filesystemClient{
+		rootFs:       rootFs,
+		repositories: map[string]*localClient{},
+	}
+}
+
+// Type returns the repository context type that can be handled by this client.
+func (c *filesystemClient) Type() string {
+	return filesystemrepository.Type
+}
+
+// Resolve resolves a reference and returns the component descriptor.
+func (c *filesystemClient) Resolve(ctx context.Context, repoCtx cdv2.Repository, name, version string) (*cdv2.ComponentDescriptor, error) {
+	cd, _, err := c.ResolveWithBlobResolver(ctx, repoCtx, name, version)
+	return cd, err
+}
+
+// ResolveWithBlobResolver resolves a reference and returns the component descriptor.
+func (c *filesystemClient) ResolveWithBlobResolver(ctx context.Context, repoCtx cdv2.Repository, name, version string) (*cdv2.ComponentDescriptor, ctf.BlobResolver, error) {
+	repository, err := c.getRepository(ctx, repoCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return repository.resolveWithBlobResolver(ctx, name, version)
+}
+
+func (c *filesystemClient) getRepository(ctx context.Context, repoCtx cdv2.Repository) (*localClient, error) {
+	data, err := json.Marshal(repoCtx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal repository context: %w", err)
+	}
+	spec, err := filesystemrepository.ParseRepositorySpec(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.repositoriesMutex.Lock()
+	defer c.repositoriesMutex.Unlock()
+	if repository, ok := c.repositories[spec.Path]; ok {
+		return repository, nil
+	}
+	fs, err := filesystemrepository.Open(ctx, c.rootFs, spec.Path)
+	if err != nil {
+		return nil, err
+	}
+	repository := &localClient{fs: fs}
+	c.repositories[spec.Path] = repository
+	return repository, nil
+}
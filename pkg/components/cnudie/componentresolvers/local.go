@@ -139,6 +139,10 @@ func (c *localClient) ResolveWithBlobResolver(ctx context.Context, repoCtx cdv2.
 		return nil, nil, fmt.Errorf("unsupported type %s expected %s or %s", repoCtx.GetType(), LocalRepositoryType, ComponentArchiveRepositoryType)
 	}
 
+	return c.resolveWithBlobResolver(ctx, name, version)
+}
+
+func (c *localClient) resolveWithBlobResolver(ctx context.Context, name, version string) (*cdv2.ComponentDescriptor, ctf.BlobResolver, error) {
 	cd, localFilesystemBlobResolver, err := c.searchInFs(ctx, name, version)
 	if err != nil {
 		return nil, nil, err
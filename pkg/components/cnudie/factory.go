@@ -26,6 +26,7 @@ import (
 	"github.com/gardener/landscaper/pkg/components/cnudie/helmrepo"
 	cnudieutils "github.com/gardener/landscaper/pkg/components/cnudie/utils"
 	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
 	"github.com/gardener/landscaper/pkg/components/model/types"
 )
 
@@ -80,10 +81,12 @@ func (*Factory) NewRegistryAccess(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	// the keyring is wrapped so that per-registry credentials can be set later
+	keyring := registrycredentials.NewKeyring(ociKeyring, nil)
 
 	ociClient, err := ociclient.NewClient(logger.Logr(),
 		cnudieutils.WithConfiguration(ociRegistryConfig),
-		ociclient.WithKeyring(ociKeyring),
+		ociclient.WithKeyring(keyring),
 		ociclient.WithCache(sharedCache),
 	)
 	if err != nil {
@@ -100,6 +103,8 @@ func (*Factory) NewRegistryAccess(ctx context.Context,
 
 	return &RegistryAccess{
 		componentResolver:       compResolver,
+		ociClient:               ociClient,
+		keyring:                 keyring,
 		additionalBlobResolvers: additionalBlobResolvers,
 	}, nil
 }
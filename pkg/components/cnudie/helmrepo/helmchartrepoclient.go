@@ -20,6 +20,7 @@ import (
 
 	"github.com/gardener/landscaper/pkg/components/common"
 
+	"github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
 	"golang.org/x/oauth2/google"
 	"helm.sh/helm/v3/pkg/repo"
@@ -81,23 +82,67 @@ func NewHelmChartRepoClient(context *lsv1alpha1.Context, lsClient client.Client)
 	}, nil
 }
 
-// fetchRepoCatalog returns the catalog of a helm chart repository
+// fetchRepoCatalog returns the catalog of a helm chart repository.
+// The catalog is read from the index cache, which fetches it only if it is not cached for the credentials of the
+// client or outdated.
 func (c *HelmChartRepoClient) fetchRepoCatalog(ctx context.Context, repoURL string) (*repo.IndexFile, error) {
-	data, err := c.executeGetRequest(ctx, repoURL)
+	authIdentity, err := c.getAuthIdentity(ctx, repoURL)
 	if err != nil {
 		return nil, err
 	}
+	return getIndexCache().Get(ctx, repoURL, authIdentity, c.fetchIndex)
+}
 
-	index, sha := getCatalogCache().getCatalogFromCache(repoURL, data)
-	if index == nil {
-		// index not found in the cache, parse it
-		index, err = getCatalogCache().parseCatalog(data)
-		if err != nil {
-			return nil, err
-		}
-		getCatalogCache().storeCatalogInCache(repoURL, index, sha)
+// getAuthIdentity returns a hash of the credentials that are sent to the given URL,
+// or an empty string if no credentials are sent.
+func (c *HelmChartRepoClient) getAuthIdentity(ctx context.Context, rawURL string) (string, error) {
+	authData := c.getAuthData(rawURL)
+	if authData == nil {
+		return "", nil
+	}
+
+	authHeader, err := common.GetAuthHeader(ctx, authData, c.lsClient, c.contextNamespace)
+	if err != nil {
+		return "", lserrors.NewWrappedError(err, "getAuthIdentity", "GetAuthHeader", "could not get auth header")
 	}
-	return index, nil
+	return digest.FromString(authHeader + "\n" + authData.CustomCAData).Encoded(), nil
+}
+
+// fetchIndex returns the index of a helm chart repository.
+// If validators of a cached index are given, a conditional request is sent, and the response states whether the
+// index has been modified.
+func (c *HelmChartRepoClient) fetchIndex(ctx context.Context, repoURL string, validators indexValidators) (*indexResponse, error) {
+	header := http.Header{}
+	if len(validators.ETag) != 0 {
+		header.Set("If-None-Match", validators.ETag)
+	}
+	if len(validators.LastModified) != 0 {
+		header.Set("If-Modified-Since", validators.LastModified)
+	}
+
+	res, err := c.doGetRequest(ctx, repoURL, header)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &indexResponse{
+		Validators: indexValidators{
+			ETag:         res.Header.Get("ETag"),
+			LastModified: res.Header.Get("Last-Modified"),
+		},
+	}
+
+	if res.StatusCode == http.StatusNotModified && (len(validators.ETag) != 0 || len(validators.LastModified) != 0) {
+		_ = res.Body.Close()
+		response.NotModified = true
+		return response, nil
+	}
+
+	response.Data, err = c.readResponseBody(ctx, res)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 // fetchChart returns the helm chart with the given URL
@@ -106,6 +151,21 @@ func (c *HelmChartRepoClient) fetchChart(ctx context.Context, chartURL string) (
 }
 
 func (c *HelmChartRepoClient) executeGetRequest(ctx context.Context, rawURL string) ([]byte, error) {
+	res, err := c.doGetRequest(ctx, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.readResponseBody(ctx, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, err
+}
+
+// doGetRequest sends a get request with the given additional header and returns the response.
+func (c *HelmChartRepoClient) doGetRequest(ctx context.Context, rawURL string, header http.Header) (*http.Response, error) {
 	authData := c.getAuthData(rawURL)
 
 	httpClient, err := c.getHttpClient(authData)
@@ -117,18 +177,16 @@ func (c *HelmChartRepoClient) executeGetRequest(ctx context.Context, rawURL stri
 	if err != nil {
 		return nil, err
 	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
 
 	res, err := (httpClient).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
-	data, err := c.readResponseBody(ctx, res)
-	if err != nil {
-		return nil, err
-	}
-
-	return data, err
+	return res, nil
 }
 
 func (c *HelmChartRepoClient) getHttpClient(authData *helmv1alpha1.Auth) (*http.Client, error) {
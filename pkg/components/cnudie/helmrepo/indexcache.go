@@ -0,0 +1,469 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helmrepo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+	"helm.sh/helm/v3/pkg/repo"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+const (
+	// IndexCacheDirName is the name of the directory below the path of the oci cache
+	// in which the indexes of helm chart repositories are stored.
+	IndexCacheDirName = "helmchartrepoindex"
+
+	indexFileName    = "index.yaml"
+	metadataFileName = "metadata.json"
+
+	// defaultIndexCacheMaxSize is the default maximal total size of the indexes that are kept in memory.
+	defaultIndexCacheMaxSize = 256 * 1024 * 1024
+)
+
+// nolint
+var (
+	indexCache      = NewIndexCache(0, defaultIndexCacheMaxSize, nil)
+	indexCacheMutex sync.RWMutex
+)
+
+// SetIndexCache sets the cache that is used for the indexes of helm chart repositories.
+func SetIndexCache(c *IndexCache) {
+	indexCacheMutex.Lock()
+	defer indexCacheMutex.Unlock()
+	indexCache = c
+}
+
+func getIndexCache() *IndexCache {
+	indexCacheMutex.RLock()
+	defer indexCacheMutex.RUnlock()
+	return indexCache
+}
+
+// IndexCache caches the parsed indexes of helm chart repositories.
+// A cached index is used without contacting the repository until its ttl has expired. Afterwards, it is revalidated
+// with a conditional request, so that an unchanged index is neither downloaded nor parsed again.
+// Indexes are cached per repository and auth identity, i.e. a hash of the credentials that are sent to the repository,
+// so that an index that has been fetched with the credentials of one context is not served to other contexts.
+// If the total size of the cached indexes exceeds the maximal size, the least recently used indexes are evicted,
+// together with their files on the filesystem. Entries of indexes that could not be fetched are not kept.
+// If a filesystem is given, the indexes are also stored there, so that they survive restarts and can be shared by
+// all processes that use the same directory.
+type IndexCache struct {
+	ttl     time.Duration
+	maxSize int64
+	fs      vfs.FileSystem
+	now     func() time.Time
+
+	mux     sync.Mutex
+	entries map[string]*indexCacheEntry
+	// size is the total size of the cached indexes
+	size int64
+	// uses counts the uses of entries to determine the least recently used one
+	uses uint64
+}
+
+type indexCacheEntry struct {
+	key      string
+	url      string
+	dir      string
+	mux      sync.Mutex
+	index    *repo.IndexFile
+	metadata *indexMetadata
+
+	// size and lastUse are protected by the mutex of the cache
+	size    int64
+	lastUse uint64
+}
+
+// indexMetadata describes a cached index. It is stored next to the index on the filesystem.
+type indexMetadata struct {
+	URL          string    `json:"url"`
+	AuthIdentity string    `json:"authIdentity,omitempty"`
+	Checksum     string    `json:"checksum"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	FetchedAt    time.Time `json:"fetchedAt"`
+}
+
+// indexValidators are the values that are used to revalidate a cached index with a conditional request.
+type indexValidators struct {
+	ETag         string
+	LastModified string
+}
+
+// indexResponse is the result of fetching the index of a helm chart repository.
+type indexResponse struct {
+	// NotModified is true if the index has not changed since it was fetched with the sent validators.
+	NotModified bool
+	Data        []byte
+	Validators  indexValidators
+}
+
+// indexFetchFunc fetches the index of a helm chart repository.
+// If validators are given, the index is only returned if it has been modified.
+type indexFetchFunc func(ctx context.Context, repoURL string, validators indexValidators) (*indexResponse, error)
+
+// NewIndexCache creates a cache for the indexes of helm chart repositories, which are used for the given ttl
+// without being revalidated. A max size of 0 disables the eviction of indexes. The filesystem is optional.
+func NewIndexCache(ttl time.Duration, maxSize int64, fs vfs.FileSystem) *IndexCache {
+	return &IndexCache{
+		ttl:     ttl,
+		maxSize: maxSize,
+		fs:      fs,
+		now:     time.Now,
+		entries: map[string]*indexCacheEntry{},
+	}
+}
+
+// NewIndexCacheFromConfiguration creates a cache for the indexes of helm chart repositories from the oci configuration.
+// The indexes are stored on the filesystem if a path is configured for the oci cache.
+func NewIndexCacheFromConfiguration(ociConfig *config.OCIConfiguration) (*IndexCache, error) {
+	if ociConfig == nil || ociConfig.Cache == nil {
+		return NewIndexCache(0, defaultIndexCacheMaxSize, nil), nil
+	}
+
+	var ttl time.Duration
+	var maxSize int64 = defaultIndexCacheMaxSize
+	if cfg := ociConfig.Cache.HelmChartRepoIndex; cfg != nil {
+		if cfg.TTL != nil {
+			ttl = cfg.TTL.Duration
+		}
+		if len(cfg.MaxSize) != 0 {
+			size, err := resource.ParseQuantity(cfg.MaxSize)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse max size of the helm chart repository index cache: %w", err)
+			}
+			maxSize = size.Value()
+		}
+	}
+	if len(ociConfig.Cache.Path) == 0 {
+		return NewIndexCache(ttl, maxSize, nil), nil
+	}
+
+	path := filepath.Join(ociConfig.Cache.Path, IndexCacheDirName)
+	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("unable to create directory %s for helm chart repository indexes: %w", path, err)
+	}
+	fs, err := projectionfs.New(osfs.New(), path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create filesystem for helm chart repository indexes: %w", err)
+	}
+	return NewIndexCache(ttl, maxSize, fs), nil
+}
+
+// Get returns the index of the given helm chart repository for the given auth identity.
+// The index is fetched with the given function if it is not cached or if its ttl has expired. The function has to
+// send the credentials of the auth identity, which is empty for requests without credentials.
+func (c *IndexCache) Get(ctx context.Context, repoURL, authIdentity string, fetch indexFetchFunc) (*repo.IndexFile, error) {
+	entry := c.getEntry(repoURL, authIdentity)
+	entry.mux.Lock()
+	defer entry.mux.Unlock()
+	defer func() {
+		if entry.index == nil {
+			// the index could not be fetched, so the entry would only occupy memory
+			c.removeEntry(entry)
+		}
+	}()
+
+	if !c.isFresh(entry) {
+		// the index might have been fetched in the meantime by another process that uses the same filesystem
+		c.load(ctx, repoURL, authIdentity, entry)
+	}
+	if c.isFresh(entry) {
+		IndexCacheHits.Inc()
+		return entry.index, nil
+	}
+
+	validators := indexValidators{}
+	if entry.index != nil {
+		validators.ETag = entry.metadata.ETag
+		validators.LastModified = entry.metadata.LastModified
+	}
+
+	start := time.Now()
+	res, err := fetch(ctx, repoURL, validators)
+	if err != nil {
+		IndexFetchDuration.WithLabelValues(fetchResultError).Observe(time.Since(start).Seconds())
+		return nil, err
+	}
+
+	metadata := &indexMetadata{
+		URL:          repoURL,
+		AuthIdentity: authIdentity,
+		ETag:         res.Validators.ETag,
+		LastModified: res.Validators.LastModified,
+		FetchedAt:    c.now(),
+	}
+
+	if res.NotModified {
+		IndexFetchDuration.WithLabelValues(fetchResultNotModified).Observe(time.Since(start).Seconds())
+		if entry.index == nil {
+			return nil, fmt.Errorf("unexpected response for index %s: index not modified although it is not cached", repoURL)
+		}
+		metadata.Checksum = entry.metadata.Checksum
+		if len(metadata.ETag) == 0 && len(metadata.LastModified) == 0 {
+			metadata.ETag = entry.metadata.ETag
+			metadata.LastModified = entry.metadata.LastModified
+		}
+		entry.metadata = metadata
+		c.store(ctx, entry, metadata, nil)
+		return entry.index, nil
+	}
+
+	IndexFetchDuration.WithLabelValues(fetchResultModified).Observe(time.Since(start).Seconds())
+	IndexFetchedBytes.Add(float64(len(res.Data)))
+
+	metadata.Checksum = digest.FromBytes(res.Data).String()
+	if entry.index == nil || entry.metadata.Checksum != metadata.Checksum {
+		// parsing the index is expensive, so it is only done if the content has changed
+		index, err := parseIndex(res.Data)
+		if err != nil {
+			return nil, err
+		}
+		entry.index = index
+		c.setSize(ctx, entry, int64(len(res.Data)))
+	}
+	entry.metadata = metadata
+	c.store(ctx, entry, metadata, res.Data)
+	return entry.index, nil
+}
+
+func (c *IndexCache) getEntry(repoURL, authIdentity string) *indexCacheEntry {
+	key := indexKey(repoURL, authIdentity)
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &indexCacheEntry{key: key, url: repoURL, dir: indexDir(repoURL, authIdentity)}
+		c.entries[key] = entry
+	}
+	c.uses++
+	entry.lastUse = c.uses
+	return entry
+}
+
+// setSize sets the size of the index of the entry. If the total size exceeds the maximal size afterwards,
+// the least recently used entries are evicted and their files are removed. The given entry itself is never evicted.
+func (c *IndexCache) setSize(ctx context.Context, entry *indexCacheEntry, size int64) {
+	for _, evicted := range c.resize(entry, size) {
+		c.remove(ctx, evicted)
+	}
+}
+
+// resize sets the size of the index of the entry and returns the entries that have been evicted.
+func (c *IndexCache) resize(entry *indexCacheEntry, size int64) []*indexCacheEntry {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if c.entries[entry.key] != entry {
+		// the entry has been evicted in the meantime
+		return nil
+	}
+	c.size += size - entry.size
+	entry.size = size
+
+	var evicted []*indexCacheEntry
+	for c.maxSize > 0 && c.size > c.maxSize {
+		var oldest *indexCacheEntry
+		for _, e := range c.entries {
+			if e != entry && (oldest == nil || e.lastUse < oldest.lastUse) {
+				oldest = e
+			}
+		}
+		if oldest == nil {
+			break
+		}
+		delete(c.entries, oldest.key)
+		c.size -= oldest.size
+		if oldest.size > 0 {
+			IndexCacheEvictions.Inc()
+			evicted = append(evicted, oldest)
+		}
+	}
+	return evicted
+}
+
+// removeEntry removes the entry from the cache unless it has been replaced in the meantime.
+func (c *IndexCache) removeEntry(entry *indexCacheEntry) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if c.entries[entry.key] == entry {
+		delete(c.entries, entry.key)
+		c.size -= entry.size
+	}
+}
+
+// isCached returns whether the entry is still part of the cache.
+func (c *IndexCache) isCached(entry *indexCacheEntry) bool {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.entries[entry.key] == entry
+}
+
+// isFresh returns whether the entry contains an index whose ttl has not expired.
+func (c *IndexCache) isFresh(entry *indexCacheEntry) bool {
+	return entry.index != nil && c.now().Sub(entry.metadata.FetchedAt) < c.ttl
+}
+
+// load updates the entry with the index from the filesystem if that one has been fetched more recently.
+// Errors are only logged, since the index can always be fetched from the repository.
+func (c *IndexCache) load(ctx context.Context, repoURL, authIdentity string, entry *indexCacheEntry) {
+	if c.fs == nil {
+		return
+	}
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "loadHelmChartRepoIndex"})
+
+	dir := entry.dir
+	data, err := vfs.ReadFile(c.fs, filepath.Join(dir, metadataFileName))
+	if err != nil {
+		if !vfs.IsNotExist(err) {
+			logger.Error(err, "unable to read metadata of cached helm chart repository index", "url", repoURL)
+		}
+		return
+	}
+	metadata := &indexMetadata{}
+	if err := json.Unmarshal(data, metadata); err != nil {
+		logger.Error(err, "unable to parse metadata of cached helm chart repository index", "url", repoURL)
+		return
+	}
+	if metadata.URL != repoURL || metadata.AuthIdentity != authIdentity {
+		return
+	}
+	if entry.index != nil && !metadata.FetchedAt.After(entry.metadata.FetchedAt) {
+		return
+	}
+	if entry.index != nil && entry.metadata.Checksum == metadata.Checksum {
+		entry.metadata = metadata
+		return
+	}
+
+	data, err = vfs.ReadFile(c.fs, filepath.Join(dir, indexFileName))
+	if err != nil {
+		logger.Error(err, "unable to read cached helm chart repository index", "url", repoURL)
+		return
+	}
+	if digest.FromBytes(data).String() != metadata.Checksum {
+		// the index is currently being replaced by another process
+		return
+	}
+	index, err := parseIndex(data)
+	if err != nil {
+		logger.Error(err, "unable to parse cached helm chart repository index", "url", repoURL)
+		return
+	}
+	entry.index = index
+	entry.metadata = metadata
+	c.setSize(ctx, entry, int64(len(data)))
+}
+
+// store writes the metadata and, if given, the index of the entry to the filesystem.
+// Errors are only logged, since the index is still cached in memory.
+func (c *IndexCache) store(ctx context.Context, entry *indexCacheEntry, metadata *indexMetadata, data []byte) {
+	if c.fs == nil {
+		return
+	}
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "storeHelmChartRepoIndex"})
+	defer func() {
+		if !c.isCached(entry) {
+			// the entry has been evicted while its files were written, which must not outlive it
+			c.remove(ctx, entry)
+		}
+	}()
+
+	dir := entry.dir
+	if err := c.fs.MkdirAll(dir, os.ModePerm); err != nil {
+		logger.Error(err, "unable to create directory for helm chart repository index", "url", metadata.URL)
+		return
+	}
+	if data != nil {
+		if err := c.writeFile(filepath.Join(dir, indexFileName), data); err != nil {
+			logger.Error(err, "unable to store helm chart repository index", "url", metadata.URL)
+			return
+		}
+	}
+	metadataBytes, err := json.Marshal(metadata)
+	if err != nil {
+		logger.Error(err, "unable to marshal metadata of helm chart repository index", "url", metadata.URL)
+		return
+	}
+	if err := c.writeFile(filepath.Join(dir, metadataFileName), metadataBytes); err != nil {
+		logger.Error(err, "unable to store metadata of helm chart repository index", "url", metadata.URL)
+	}
+}
+
+// remove deletes the files of the entry from the filesystem.
+// Errors are only logged, since the files are replaced when the index is fetched again.
+func (c *IndexCache) remove(ctx context.Context, entry *indexCacheEntry) {
+	if c.fs == nil {
+		return
+	}
+	if err := c.fs.RemoveAll(entry.dir); err != nil {
+		logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "removeHelmChartRepoIndex"})
+		logger.Error(err, "unable to remove cached helm chart repository index", "url", entry.url)
+	}
+}
+
+// writeFile replaces the file atomically, so that other processes never read a partially written file.
+func (c *IndexCache) writeFile(path string, data []byte) error {
+	tmp, err := vfs.TempFile(c.fs, filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = c.fs.Rename(tmp.Name(), path)
+		if vfs.IsErrExist(err) {
+			// not all filesystems replace existing files when renaming
+			if err = c.fs.Remove(path); err == nil {
+				err = c.fs.Rename(tmp.Name(), path)
+			}
+		}
+	}
+	if err != nil {
+		_ = c.fs.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// indexKey returns the key of the index of a helm chart repository that is fetched with the given auth identity.
+func indexKey(repoURL, authIdentity string) string {
+	return repoURL + "\n" + authIdentity
+}
+
+// indexDir returns the directory of the filesystem in which the index of a helm chart repository is stored,
+// that has been fetched with the given auth identity.
+func indexDir(repoURL, authIdentity string) string {
+	return digest.FromString(indexKey(repoURL, authIdentity)).Encoded()
+}
+
+// parseIndex parses the index of a helm chart repository.
+func parseIndex(data []byte) (*repo.IndexFile, error) {
+	index := &repo.IndexFile{}
+	if err := yaml.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("could not unmarshall helm chart repo index: %w", err)
+	}
+	index.SortEntries()
+	return index, nil
+}
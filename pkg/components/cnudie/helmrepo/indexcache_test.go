@@ -0,0 +1,367 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helmrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/gardener/landscaper/apis/config"
+	lscore "github.com/gardener/landscaper/apis/core"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+const testIndex = `apiVersion: v1
+entries:
+  test-chart:
+  - name: test-chart
+    version: %s
+    urls:
+    - test-chart-%s.tgz
+`
+
+// testRepo is a helm chart repository that serves an index and supports conditional requests.
+type testRepo struct {
+	mux          sync.Mutex
+	version      string
+	useETag      bool
+	requests     int
+	fullRequests int
+}
+
+func (r *testRepo) setVersion(version string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.version = version
+}
+
+func (r *testRepo) counts() (int, int) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.requests, r.fullRequests
+}
+
+func (r *testRepo) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.requests++
+
+	lastModified := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Format(http.TimeFormat)
+	if r.useETag {
+		etag := fmt.Sprintf("%q", r.version)
+		w.Header().Set("ETag", etag)
+		if req.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	} else {
+		w.Header().Set("Last-Modified", lastModified)
+		if req.Header.Get("If-Modified-Since") == lastModified+r.version {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		// the version is part of the modification date, so that changes are detected
+		w.Header().Set("Last-Modified", lastModified+r.version)
+	}
+
+	r.fullRequests++
+	_, _ = fmt.Fprintf(w, testIndex, r.version, r.version)
+}
+
+var _ = Describe("Index Cache", func() {
+
+	var (
+		ctx    context.Context
+		repo   *testRepo
+		server *httptest.Server
+		client *HelmChartRepoClient
+		now    time.Time
+	)
+
+	newCache := func(ttl time.Duration, fs vfs.FileSystem) *IndexCache {
+		c := NewIndexCache(ttl, 0, fs)
+		c.now = func() time.Time { return now }
+		return c
+	}
+
+	chartVersion := func(c *IndexCache) string {
+		index, err := c.Get(ctx, server.URL+"/index.yaml", "", client.fetchIndex)
+		Expect(err).ToNot(HaveOccurred())
+		chartVersion, err := index.Get("test-chart", "")
+		Expect(err).ToNot(HaveOccurred())
+		return chartVersion.Version
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+		repo = &testRepo{version: "1.0.0", useETag: true}
+		server = httptest.NewServer(repo)
+		now = time.Now()
+
+		var err error
+		client, err = NewHelmChartRepoClient(nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should revalidate the index with the etag", func() {
+		c := newCache(0, nil)
+
+		Expect(chartVersion(c)).To(Equal("1.0.0"))
+		Expect(chartVersion(c)).To(Equal("1.0.0"))
+		requests, fullRequests := repo.counts()
+		Expect(requests).To(Equal(2))
+		Expect(fullRequests).To(Equal(1))
+
+		repo.setVersion("1.1.0")
+		Expect(chartVersion(c)).To(Equal("1.1.0"))
+		requests, fullRequests = repo.counts()
+		Expect(requests).To(Equal(3))
+		Expect(fullRequests).To(Equal(2))
+	})
+
+	It("should revalidate the index with the modification date", func() {
+		repo.useETag = false
+		c := newCache(0, nil)
+
+		Expect(chartVersion(c)).To(Equal("1.0.0"))
+		Expect(chartVersion(c)).To(Equal("1.0.0"))
+		requests, fullRequests := repo.counts()
+		Expect(requests).To(Equal(2))
+		Expect(fullRequests).To(Equal(1))
+
+		repo.setVersion("1.1.0")
+		Expect(chartVersion(c)).To(Equal("1.1.0"))
+	})
+
+	It("should use the index without contacting the repository until the ttl has expired", func() {
+		c := newCache(time.Minute, nil)
+		hits := testutil.ToFloat64(IndexCacheHits)
+
+		Expect(chartVersion(c)).To(Equal("1.0.0"))
+		repo.setVersion("1.1.0")
+		now = now.Add(30 * time.Second)
+		Expect(chartVersion(c)).To(Equal("1.0.0"))
+		requests, _ := repo.counts()
+		Expect(requests).To(Equal(1))
+		Expect(testutil.ToFloat64(IndexCacheHits)).To(Equal(hits + 1))
+
+		now = now.Add(time.Minute)
+		Expect(chartVersion(c)).To(Equal("1.1.0"))
+		requests, _ = repo.counts()
+		Expect(requests).To(Equal(2))
+	})
+
+	It("should share the index via the filesystem", func() {
+		fs := memoryfs.New()
+		c1 := newCache(time.Minute, fs)
+		c2 := newCache(time.Minute, fs)
+
+		Expect(chartVersion(c1)).To(Equal("1.0.0"))
+		Expect(chartVersion(c2)).To(Equal("1.0.0"))
+		requests, _ := repo.counts()
+		Expect(requests).To(Equal(1))
+
+		// an index that is fetched by one cache is picked up by the other one once its own copy has expired
+		repo.setVersion("1.1.0")
+		now = now.Add(2 * time.Minute)
+		Expect(chartVersion(c1)).To(Equal("1.1.0"))
+		Expect(chartVersion(c2)).To(Equal("1.1.0"))
+		requests, _ = repo.counts()
+		Expect(requests).To(Equal(2))
+
+		// a new cache revalidates the stored index after the ttl without downloading it
+		now = now.Add(2 * time.Minute)
+		Expect(chartVersion(newCache(time.Minute, fs))).To(Equal("1.1.0"))
+		requests, fullRequests := repo.counts()
+		Expect(requests).To(Equal(3))
+		Expect(fullRequests).To(Equal(2))
+	})
+
+	It("should cache the indexes of different auth identities separately", func() {
+		fs := memoryfs.New()
+		c1 := newCache(time.Minute, fs)
+		c2 := newCache(time.Minute, fs)
+
+		_, err := c1.Get(ctx, server.URL+"/index.yaml", "tenant-a", client.fetchIndex)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = c1.Get(ctx, server.URL+"/index.yaml", "tenant-a", client.fetchIndex)
+		Expect(err).ToNot(HaveOccurred())
+		requests, _ := repo.counts()
+		Expect(requests).To(Equal(1))
+
+		// neither the in-memory nor the stored index of another auth identity is used
+		Expect(chartVersion(c1)).To(Equal("1.0.0"))
+		Expect(chartVersion(c2)).To(Equal("1.0.0"))
+		requests, _ = repo.counts()
+		Expect(requests).To(Equal(2))
+
+		_, err = c2.Get(ctx, server.URL+"/index.yaml", "tenant-b", client.fetchIndex)
+		Expect(err).ToNot(HaveOccurred())
+		requests, _ = repo.counts()
+		Expect(requests).To(Equal(3))
+	})
+
+	It("should derive the auth identity from the credentials of the client", func() {
+		defer SetIndexCache(getIndexCache())
+		SetIndexCache(newCache(time.Minute, nil))
+
+		clientA := &HelmChartRepoClient{auths: []helmv1alpha1.Auth{{URL: server.URL, AuthHeader: "Bearer a"}}}
+		clientB := &HelmChartRepoClient{auths: []helmv1alpha1.Auth{{URL: server.URL, AuthHeader: "Bearer b"}}}
+
+		identityA, err := clientA.getAuthIdentity(ctx, server.URL+"/index.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		identityB, err := clientB.getAuthIdentity(ctx, server.URL+"/index.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		identity, err := client.getAuthIdentity(ctx, server.URL+"/index.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(identityA).ToNot(BeEmpty())
+		Expect(identityA).ToNot(Equal(identityB))
+		Expect(identity).To(BeEmpty())
+
+		for _, c := range []*HelmChartRepoClient{clientA, clientA, clientB, client} {
+			_, err := c.fetchRepoCatalog(ctx, server.URL+"/index.yaml")
+			Expect(err).ToNot(HaveOccurred())
+		}
+		requests, _ := repo.counts()
+		Expect(requests).To(Equal(3))
+	})
+
+	It("should evict the least recently used indexes if the maximal size is exceeded", func() {
+		indexSize := int64(len(fmt.Sprintf(testIndex, "1.0.0", "1.0.0")))
+		c := NewIndexCache(time.Minute, indexSize*5/2, nil)
+		evictions := testutil.ToFloat64(IndexCacheEvictions)
+
+		get := func(path string) {
+			_, err := c.Get(ctx, server.URL+path, "", client.fetchIndex)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		get("/a/index.yaml")
+		get("/b/index.yaml")
+		get("/a/index.yaml")
+		get("/c/index.yaml")
+		Expect(c.entries).To(HaveLen(2))
+		Expect(c.entries).To(HaveKey(indexKey(server.URL+"/a/index.yaml", "")))
+		Expect(c.entries).To(HaveKey(indexKey(server.URL+"/c/index.yaml", "")))
+		Expect(c.size).To(Equal(2 * indexSize))
+		Expect(testutil.ToFloat64(IndexCacheEvictions)).To(Equal(evictions + 1))
+
+		get("/b/index.yaml")
+		requests, _ := repo.counts()
+		Expect(requests).To(Equal(4))
+	})
+
+	It("should remove the files of evicted indexes", func() {
+		indexSize := int64(len(fmt.Sprintf(testIndex, "1.0.0", "1.0.0")))
+		fs := memoryfs.New()
+		c := NewIndexCache(time.Minute, indexSize*3/2, fs)
+
+		_, err := c.Get(ctx, server.URL+"/a/index.yaml", "", client.fetchIndex)
+		Expect(err).ToNot(HaveOccurred())
+		ok, err := vfs.DirExists(fs, indexDir(server.URL+"/a/index.yaml", ""))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeTrue())
+
+		_, err = c.Get(ctx, server.URL+"/b/index.yaml", "", client.fetchIndex)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.entries).To(HaveLen(1))
+		ok, err = vfs.Exists(fs, indexDir(server.URL+"/a/index.yaml", ""))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeFalse())
+		ok, err = vfs.DirExists(fs, indexDir(server.URL+"/b/index.yaml", ""))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should return an error if the index cannot be fetched", func() {
+		c := newCache(0, nil)
+		_, err := c.Get(ctx, server.URL+"/missing/index.yaml", "", func(ctx context.Context, repoURL string, _ indexValidators) (*indexResponse, error) {
+			return nil, fmt.Errorf("not found")
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(c.entries).To(BeEmpty())
+	})
+
+	It("should keep a cached index if it cannot be revalidated", func() {
+		c := newCache(0, nil)
+		Expect(chartVersion(c)).To(Equal("1.0.0"))
+
+		_, err := c.Get(ctx, server.URL+"/index.yaml", "", func(ctx context.Context, repoURL string, _ indexValidators) (*indexResponse, error) {
+			return nil, fmt.Errorf("unavailable")
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(c.entries).To(HaveLen(1))
+		Expect(chartVersion(c)).To(Equal("1.0.0"))
+	})
+
+	It("should store the indexes below the path of the oci cache", func() {
+		dir, err := os.MkdirTemp("", "helmrepo-")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		c, err := NewIndexCacheFromConfiguration(&config.OCIConfiguration{
+			Cache: &config.OCICacheConfiguration{
+				Path: dir,
+				HelmChartRepoIndex: &config.HelmChartRepoIndexCacheConfiguration{
+					TTL: &lscore.Duration{Duration: time.Minute},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		defer SetIndexCache(getIndexCache())
+		SetIndexCache(c)
+
+		index, err := client.fetchRepoCatalog(ctx, server.URL+"/index.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(index.Entries).To(HaveKey("test-chart"))
+
+		Expect(c.maxSize).To(Equal(int64(defaultIndexCacheMaxSize)))
+
+		entries, err := os.ReadDir(filepath.Join(dir, IndexCacheDirName))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		files, err := os.ReadDir(filepath.Join(dir, IndexCacheDirName, entries[0].Name()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(2))
+	})
+	It("should parse the maximal size of the configuration", func() {
+		c, err := NewIndexCacheFromConfiguration(&config.OCIConfiguration{
+			Cache: &config.OCICacheConfiguration{
+				HelmChartRepoIndex: &config.HelmChartRepoIndexCacheConfiguration{
+					MaxSize: "64Mi",
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.maxSize).To(Equal(int64(64 * 1024 * 1024)))
+
+		_, err = NewIndexCacheFromConfiguration(&config.OCIConfiguration{
+			Cache: &config.OCICacheConfiguration{
+				HelmChartRepoIndex: &config.HelmChartRepoIndexCacheConfiguration{
+					MaxSize: "invalid",
+				},
+			},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helmrepo
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const (
+	indexSubsystemName = "helmChartRepoIndex"
+
+	// fetch results of the index fetch duration metric
+	fetchResultModified    = "modified"
+	fetchResultNotModified = "not_modified"
+	fetchResultError       = "error"
+)
+
+var (
+	// IndexFetchDuration discloses the duration of requests for the index of a helm chart repository.
+	IndexFetchDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: indexSubsystemName,
+			Name:      "fetch_duration_seconds",
+			Help:      "Duration of requests for the index of a helm chart repository.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
+		},
+		[]string{"result"},
+	)
+
+	// IndexFetchedBytes discloses the number of bytes that have been downloaded for indexes of helm chart repositories.
+	IndexFetchedBytes = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: indexSubsystemName,
+			Name:      "fetched_bytes_total",
+			Help:      "Total number of bytes that have been downloaded for indexes of helm chart repositories.",
+		},
+	)
+
+	// IndexCacheHits discloses the number of indexes that have been read from the cache without contacting the
+	// helm chart repository.
+	IndexCacheHits = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: indexSubsystemName,
+			Name:      "cache_hits_total",
+			Help:      "Total number of indexes that have been read from the cache without contacting the helm chart repository.",
+		},
+	)
+
+	// IndexCacheEvictions discloses the number of indexes that have been evicted from the cache, because the total
+	// size of the cached indexes exceeded the maximal size.
+	IndexCacheEvictions = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: indexSubsystemName,
+			Name:      "cache_evictions_total",
+			Help:      "Total number of indexes that have been evicted from the cache because its maximal size was exceeded.",
+		},
+	)
+)
+
+// RegisterMetrics allows to register the helm chart repository metrics
+func RegisterMetrics(reg prometheus.Registerer) {
+	reg.MustRegister(IndexFetchDuration, IndexFetchedBytes, IndexCacheHits, IndexCacheEvictions)
+}
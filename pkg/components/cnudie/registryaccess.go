@@ -8,19 +8,39 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 
+	"github.com/gardener/component-cli/ociclient"
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 	"github.com/gardener/component-spec/bindings-go/ctf"
+	cdoci "github.com/gardener/component-spec/bindings-go/oci"
+	"github.com/mandelsoft/vfs/pkg/vfs"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/cnudie/componentresolvers"
 	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/model/verification"
 )
 
 type RegistryAccess struct {
 	componentResolver       ctf.ComponentResolver
+	ociClient               ociclient.Client
+	keyring                 *registrycredentials.Keyring
 	additionalBlobResolvers []ctf.TypedBlobResolver
+
+	verificationPolicies *verification.Policies
+	verified             map[string]*cdv2.DigestSpec
+	referenced           map[string]*cdv2.DigestSpec
+	verifiedMutex        sync.Mutex
 }
 
-var _ model.RegistryAccess = &RegistryAccess{}
+var _ model.VerifyingRegistryAccess = &RegistryAccess{}
+var _ model.VersionListingRegistryAccess = &RegistryAccess{}
+var _ model.FilesystemRepositoryRegistryAccess = &RegistryAccess{}
+var _ model.RegistryCredentialsRegistryAccess = &RegistryAccess{}
 
 func (r *RegistryAccess) GetComponentVersion(ctx context.Context, cdRef *lsv1alpha1.ComponentDescriptorReference) (model.ComponentVersion, error) {
 	if cdRef == nil {
@@ -43,5 +63,58 @@ func (r *RegistryAccess) GetComponentVersion(ctx context.Context, cdRef *lsv1alp
 		}
 	}
 
+	cd, blobResolver, err = r.verify(ctx, cdRef.RepositoryContext, cd, blobResolver)
+	if err != nil {
+		return nil, err
+	}
+
 	return newComponentVersion(r, cd, blobResolver), nil
 }
+
+// ListComponentVersions returns the versions of a component that are available in a repository.
+// Only repositories of type ociRegistry are supported; the versions are the tags of the component descriptor artifact.
+func (r *RegistryAccess) ListComponentVersions(ctx context.Context, repositoryContext *types.UnstructuredTypedObject, componentName string) ([]string, error) {
+	if repositoryContext == nil {
+		return nil, errors.New("repository context cannot be nil")
+	}
+	if repositoryContext.GetType() != cdv2.OCIRegistryType {
+		return nil, fmt.Errorf("listing component versions is not supported for repositories of type %s", repositoryContext.GetType())
+	}
+	ociClient, ok := r.ociClient.(ociclient.ExtendedClient)
+	if !ok {
+		return nil, fmt.Errorf("the oci client of type %T does not support the listing of tags", r.ociClient)
+	}
+
+	repository := cdv2.OCIRegistryRepository{}
+	if err := repositoryContext.DecodeInto(&repository); err != nil {
+		return nil, fmt.Errorf("unable to decode repository context: %w", err)
+	}
+	ref, err := cdoci.OCIRef(repository, componentName, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get oci reference of component %s: %w", componentName, err)
+	}
+	versions, err := ociClient.ListTags(ctx, strings.TrimSuffix(ref, ":"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list versions of component %s: %w", componentName, err)
+	}
+	return versions, nil
+}
+
+// SetFilesystemRepositoryRoot enables repository contexts of type "filesystem", whose paths are resolved in the given
+// root filesystem.
+func (r *RegistryAccess) SetFilesystemRepositoryRoot(rootFs vfs.FileSystem) error {
+	manager, ok := r.componentResolver.(*componentresolvers.Manager)
+	if !ok {
+		return fmt.Errorf("the component resolver of type %T does not support additional repository types", r.componentResolver)
+	}
+	return manager.Set(componentresolvers.NewFilesystemClient(rootFs))
+}
+
+// SetRegistryCredentials sets the credentials that are used for matching registries instead of the pull secrets.
+func (r *RegistryAccess) SetRegistryCredentials(registryCredentials *registrycredentials.Credentials) error {
+	if r.keyring == nil {
+		return errors.New("the registry access has no keyring")
+	}
+	r.keyring.SetCredentials(registryCredentials)
+	return nil
+}
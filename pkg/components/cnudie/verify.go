@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cnudie
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/ctf"
+
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/model/verification"
+)
+
+// ociArtifactAccessType is the ocm name of the access type of oci artifacts.
+const ociArtifactAccessType = "ociArtifact"
+
+// SetVerificationPolicies sets the policies that define which component versions are verified with which keys.
+func (r *RegistryAccess) SetVerificationPolicies(policies *verification.Policies) {
+	r.verificationPolicies = policies
+}
+
+// verify verifies a component descriptor and returns the component descriptor and blob resolver of its component version.
+// A component descriptor is trusted if its signature is verified according to its policy or if a trusted component
+// descriptor references it with a digest. The digests of the resources and references of trusted component descriptors
+// are verified, and the digests of the references are kept to verify the referenced component descriptors when they
+// are resolved. A trusted component descriptor that is resolved again must match the digest that has been verified.
+// The blobs of the resources of trusted component descriptors are verified again when they are fetched, and their oci
+// artifacts are pinned to the verified digest. The referenced component descriptors are resolved in the given
+// repository context.
+func (r *RegistryAccess) verify(ctx context.Context, repositoryContext *types.UnstructuredTypedObject, cd *types.ComponentDescriptor, blobResolver ctf.BlobResolver) (*types.ComponentDescriptor, ctf.BlobResolver, error) {
+	key := fmt.Sprintf("%s:%s", cd.GetName(), cd.GetVersion())
+	verified, referenced := r.trustedDigests(key)
+	switch {
+	case verified != nil:
+		if err := verification.VerifyComponentDescriptorDigest(cd, verified); err != nil {
+			return nil, nil, verification.NewError(cd.GetName(), cd.GetVersion(), fmt.Errorf("the component descriptor has changed since its verification: %w", err))
+		}
+		return pinResources(cd, blobResolver)
+	case referenced != nil:
+		if err := verification.VerifyComponentDescriptorDigest(cd, referenced); err != nil {
+			return nil, nil, verification.NewError(cd.GetName(), cd.GetVersion(), fmt.Errorf("the component descriptor does not match the digest of its reference: %w", err))
+		}
+	default:
+		policy := r.verificationPolicies.ForComponent(cd.GetName())
+		if policy == nil {
+			return cd, blobResolver, nil
+		}
+		signed, err := verification.VerifySignature(cd, policy)
+		if err != nil {
+			return nil, nil, verification.NewError(cd.GetName(), cd.GetVersion(), err)
+		}
+		if !signed {
+			return cd, blobResolver, nil
+		}
+	}
+
+	if err := r.verifyContent(ctx, repositoryContext, cd, blobResolver); err != nil {
+		return nil, nil, verification.NewError(cd.GetName(), cd.GetVersion(), err)
+	}
+	digest, err := verification.ComponentDescriptorDigest(cd)
+	if err != nil {
+		return nil, nil, verification.NewError(cd.GetName(), cd.GetVersion(), err)
+	}
+
+	r.verifiedMutex.Lock()
+	if r.verified == nil {
+		r.verified = map[string]*cdv2.DigestSpec{}
+	}
+	r.verified[key] = digest
+	r.verifiedMutex.Unlock()
+	return pinResources(cd, blobResolver)
+}
+
+// trustedDigests returns the verified digest of the component version with the given key,
+// and the digest with which a trusted component version references it.
+func (r *RegistryAccess) trustedDigests(key string) (verified, referenced *cdv2.DigestSpec) {
+	r.verifiedMutex.Lock()
+	defer r.verifiedMutex.Unlock()
+	return r.verified[key], r.referenced[key]
+}
+
+// verifyContent verifies the digests of the resources and references of a trusted component descriptor.
+func (r *RegistryAccess) verifyContent(ctx context.Context, repositoryContext *types.UnstructuredTypedObject, cd *types.ComponentDescriptor, blobResolver ctf.BlobResolver) error {
+	for i := range cd.Resources {
+		if err := r.verifyResource(ctx, &cd.Resources[i], blobResolver); err != nil {
+			return err
+		}
+	}
+	for i := range cd.ComponentReferences {
+		if err := r.verifyReference(ctx, repositoryContext, &cd.ComponentReferences[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyReference verifies that the referenced component descriptor matches the digest of the reference.
+// The digest is kept to verify the referenced component descriptor when it is resolved.
+func (r *RegistryAccess) verifyReference(ctx context.Context, repositoryContext *types.UnstructuredTypedObject, ref *types.ComponentReference) error {
+	if ref.Digest == nil {
+		return fmt.Errorf("reference %q has no digest", ref.GetName())
+	}
+	refCd, err := r.componentResolver.Resolve(ctx, repositoryContext, ref.ComponentName, ref.Version)
+	if err != nil {
+		return fmt.Errorf("unable to resolve component descriptor of reference %q: %w", ref.GetName(), err)
+	}
+	if err := verification.VerifyComponentDescriptorDigest(refCd, ref.Digest); err != nil {
+		return fmt.Errorf("the component descriptor of reference %q does not match its digest: %w", ref.GetName(), err)
+	}
+
+	r.verifiedMutex.Lock()
+	defer r.verifiedMutex.Unlock()
+	if r.referenced == nil {
+		r.referenced = map[string]*cdv2.DigestSpec{}
+	}
+	r.referenced[fmt.Sprintf("%s:%s", ref.ComponentName, ref.Version)] = ref.Digest.DeepCopy()
+	return nil
+}
+
+// verifyResource verifies the digest of a resource. Resources with other digests are only covered by the signature.
+func (r *RegistryAccess) verifyResource(ctx context.Context, res *types.Resource, blobResolver ctf.BlobResolver) error {
+	switch {
+	case verification.HasBlobDigest(res):
+		hash := sha256.New()
+		if _, err := blobResolver.Resolve(ctx, *res, hash); err != nil {
+			return fmt.Errorf("unable to resolve blob of resource %q: %w", res.GetName(), err)
+		}
+		return verification.VerifyBlobDigest(res, hash.Sum(nil))
+	case verification.HasOCIArtifactDigest(res):
+		if res.Access == nil || (res.Access.GetType() != cdv2.OCIRegistryType && res.Access.GetType() != ociArtifactAccessType) {
+			return fmt.Errorf("resource %q has an oci artifact digest but no oci access", res.GetName())
+		}
+		ociAccess := &cdv2.OCIRegistryAccess{}
+		if err := res.Access.DecodeInto(ociAccess); err != nil {
+			return fmt.Errorf("unable to decode access of resource %q: %w", res.GetName(), err)
+		}
+		if r.ociClient == nil {
+			return fmt.Errorf("unable to verify oci artifact of resource %q: no oci client configured", res.GetName())
+		}
+		_, manifest, err := r.ociClient.GetRawManifest(ctx, ociAccess.ImageReference)
+		if err != nil {
+			return fmt.Errorf("unable to get manifest of resource %q: %w", res.GetName(), err)
+		}
+		return verification.VerifyOCIArtifactDigest(res, manifest)
+	}
+	return nil
+}
+
+// pinResources returns a copy of a trusted component descriptor whose oci artifacts are pinned to their verified
+// digest, and a blob resolver that verifies the blobs of its resources when they are fetched.
+func pinResources(cd *types.ComponentDescriptor, blobResolver ctf.BlobResolver) (*types.ComponentDescriptor, ctf.BlobResolver, error) {
+	pinned := cd.DeepCopy()
+	for i := range pinned.Resources {
+		res := &pinned.Resources[i]
+		if !verification.HasOCIArtifactDigest(res) || res.Access == nil {
+			continue
+		}
+		ociAccess := &cdv2.OCIRegistryAccess{}
+		if err := res.Access.DecodeInto(ociAccess); err != nil {
+			return nil, nil, verification.NewError(cd.GetName(), cd.GetVersion(), fmt.Errorf("unable to decode access of resource %q: %w", res.GetName(), err))
+		}
+		ociAccess.ImageReference = verification.PinImageReference(ociAccess.ImageReference, res.Digest.Value)
+		access, err := cdv2.NewUnstructured(ociAccess)
+		if err != nil {
+			return nil, nil, verification.NewError(cd.GetName(), cd.GetVersion(), fmt.Errorf("unable to encode access of resource %q: %w", res.GetName(), err))
+		}
+		res.Access = &access
+	}
+	return pinned, &verifyingBlobResolver{blobResolver: blobResolver}, nil
+}
+
+// verifyingBlobResolver verifies the blobs of resources with a blob digest when they are fetched.
+// The blob is written to the writer while it is read, so a blob that does not match its digest must be discarded
+// when an error is returned.
+type verifyingBlobResolver struct {
+	blobResolver ctf.BlobResolver
+}
+
+func (v *verifyingBlobResolver) Info(ctx context.Context, res types.Resource) (*ctf.BlobInfo, error) {
+	return v.blobResolver.Info(ctx, res)
+}
+
+func (v *verifyingBlobResolver) Resolve(ctx context.Context, res types.Resource, writer io.Writer) (*ctf.BlobInfo, error) {
+	if !verification.HasBlobDigest(&res) {
+		return v.blobResolver.Resolve(ctx, res, writer)
+	}
+	hash := sha256.New()
+	info, err := v.blobResolver.Resolve(ctx, res, io.MultiWriter(writer, hash))
+	if err != nil {
+		return nil, err
+	}
+	if err := verification.VerifyBlobDigest(&res, hash.Sum(nil)); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
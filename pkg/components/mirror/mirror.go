@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/component-cli/ociclient"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/cnudie/componentresolvers"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+)
+
+// Mirror copies component versions together with their resources from the repositories of a registry access into
+// a target oci repository, e.g. to make them available in an air-gapped environment.
+type Mirror struct {
+	log            logging.Logger
+	registryAccess model.RegistryAccess
+	ociClient      ociclient.Client
+
+	// targetRepository is the base url of the oci repository into which the component versions are copied.
+	targetRepository string
+	// targetRepositoryContext is the repository context of the target repository.
+	targetRepositoryContext *types.UnstructuredTypedObject
+}
+
+// New creates a new mirror that reads component versions with the given registry access and writes them with the
+// given oci client into the target repository, e.g. "registry.example.com/mirror".
+func New(log logging.Logger, registryAccess model.RegistryAccess, ociClient ociclient.Client, targetRepository string) (*Mirror, error) {
+	targetRepository = strings.TrimSuffix(targetRepository, "/")
+	if len(targetRepository) == 0 {
+		return nil, errors.New("no target repository defined")
+	}
+	targetRepositoryContext, err := componentresolvers.NewOCIRepositoryContext(targetRepository)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create repository context for target repository %q: %w", targetRepository, err)
+	}
+	return &Mirror{
+		log:                     log,
+		registryAccess:          registryAccess,
+		ociClient:               ociClient,
+		targetRepository:        targetRepository,
+		targetRepositoryContext: &targetRepositoryContext,
+	}, nil
+}
+
+// Result describes the component versions that have been copied into the target repository.
+type Result struct {
+	// RepositoryContext is the repository context of the target repository.
+	RepositoryContext *types.UnstructuredTypedObject
+	// Components are the copied component versions. Referenced component versions precede the component versions
+	// that reference them.
+	Components []Component
+}
+
+// Component describes a component version that has been copied into the target repository.
+type Component struct {
+	ComponentName string
+	Version       string
+	// Reference is the oci reference of the copied component descriptor.
+	Reference string
+	Resources []Resource
+}
+
+// Resource describes how a resource of a component version has been handled.
+type Resource struct {
+	Name string
+	Type string
+	// Source is the location of the resource before the copy, i.e. an oci reference or the digest of a local blob.
+	Source string
+	// Target is the location of the copied resource. It is empty if the resource has not been copied.
+	Target string
+	// Skipped is the reason why the resource has not been copied.
+	// The access of skipped resources is not changed, so they still have to be accessible from the target environment.
+	Skipped string
+}
+
+// Transfer copies the referenced component version and all transitively referenced component versions into the
+// target repository.
+// The resources of type ociImage, helm.io/chart and blueprint that are stored in oci registries are copied into
+// the target repository and their access is rewritten to point to the copies. Local blobs are copied together with
+// the component descriptors.
+func (m *Mirror) Transfer(ctx context.Context, cdRef *lsv1alpha1.ComponentDescriptorReference) (*Result, error) {
+	if cdRef == nil {
+		return nil, errors.New("no component descriptor reference defined")
+	}
+
+	cv, err := m.registryAccess.GetComponentVersion(ctx, cdRef)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get component version %s:%s: %w", cdRef.ComponentName, cdRef.Version, err)
+	}
+
+	result := &Result{
+		RepositoryContext: m.targetRepositoryContext,
+	}
+	visited := map[string]bool{}
+	if err := m.transferRecursively(ctx, cv, cdRef.RepositoryContext, visited, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// transferRecursively copies the referenced component versions before the given component version, so that a
+// component version is only available in the target repository if all its references are.
+func (m *Mirror) transferRecursively(ctx context.Context, cv model.ComponentVersion, repositoryContext *types.UnstructuredTypedObject,
+	visited map[string]bool, result *Result) error {
+
+	id := cv.GetName() + ":" + cv.GetVersion()
+	if visited[id] {
+		return nil
+	}
+	visited[id] = true
+
+	for _, ref := range cv.GetComponentReferences() {
+		refCv, err := cv.GetReferencedComponentVersion(ctx, &ref, repositoryContext, nil)
+		if err != nil {
+			return fmt.Errorf("unable to resolve component reference %s of component version %s: %w", ref.Name, id, err)
+		}
+		if err := m.transferRecursively(ctx, refCv, repositoryContext, visited, result); err != nil {
+			return err
+		}
+	}
+
+	m.log.Info("copying component version", "componentName", cv.GetName(), "version", cv.GetVersion())
+	component, err := m.transferComponentVersion(ctx, cv)
+	if err != nil {
+		return fmt.Errorf("unable to copy component version %s: %w", id, err)
+	}
+	result.Components = append(result.Components, *component)
+	return nil
+}
+
+// ComponentVersionOverwrites returns a ComponentVersionOverwrites object that redirects all copied component versions
+// to the target repository. Referencing it in a Context lets the installations of the context use the copies.
+func (r *Result) ComponentVersionOverwrites(name, namespace string) *lsv1alpha1.ComponentVersionOverwrites {
+	cvo := &lsv1alpha1.ComponentVersionOverwrites{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ComponentVersionOverwrites",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	for _, component := range r.Components {
+		cvo.Overwrites = append(cvo.Overwrites, lsv1alpha1.ComponentVersionOverwrite{
+			Source: lsv1alpha1.ComponentVersionOverwriteReference{
+				ComponentName: component.ComponentName,
+				Version:       component.Version,
+			},
+			Substitution: lsv1alpha1.ComponentVersionOverwriteReference{
+				RepositoryContext: r.RepositoryContext.DeepCopy(),
+			},
+		})
+	}
+	return cvo
+}
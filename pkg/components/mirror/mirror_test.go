@@ -0,0 +1,290 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mirror_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/gardener/component-cli/ociclient"
+	"github.com/gardener/component-cli/ociclient/cache"
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/cnudie/componentresolvers"
+	"github.com/gardener/landscaper/pkg/components/mirror"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/componentoverwrites"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+)
+
+const rootComponentDescriptor = `
+meta:
+  schemaVersion: v2
+component:
+  name: example.com/root
+  version: 1.0.0
+  provider: internal
+  repositoryContexts:
+  - type: ociRegistry
+    baseUrl: "/"
+  sources: []
+  resources:
+  - name: blueprint
+    type: landscaper.gardener.cloud/blueprint
+    version: 1.0.0
+    relation: local
+    access:
+      type: localFilesystemBlob
+      filename: blueprint
+      mediaType: application/vnd.gardener.landscaper.blueprint.layer.v1.tar+gzip
+  - name: image
+    type: ociImage
+    version: 1.0.0
+    relation: external
+    access:
+      type: ociRegistry
+      imageReference: REGISTRY/source/images/app:1.0.0
+  - name: docs
+    type: generic
+    version: 1.0.0
+    relation: external
+    access:
+      type: web
+      url: https://example.com/docs
+  componentReferences:
+  - name: child
+    componentName: example.com/child
+    version: 1.0.0
+`
+
+const childComponentDescriptor = `
+meta:
+  schemaVersion: v2
+component:
+  name: example.com/child
+  version: 1.0.0
+  provider: internal
+  repositoryContexts:
+  - type: ociRegistry
+    baseUrl: "/"
+  sources: []
+  resources:
+  - name: chart
+    type: helm.io/chart
+    version: 1.0.0
+    relation: external
+    access:
+      type: ociRegistry
+      imageReference: REGISTRY/source/charts/app:1.0.0
+  - name: other-image
+    type: custom-image
+    version: 1.0.0
+    relation: external
+    access:
+      type: ociRegistry
+      imageReference: REGISTRY/source/images/other:1.0.0
+  componentReferences: []
+`
+
+const blueprint = `
+apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+`
+
+var _ = Describe("Mirror", func() {
+
+	var (
+		ctx       context.Context
+		logger    logging.Logger
+		server    *httptest.Server
+		host      string
+		ociClient ociclient.Client
+		source    model.RegistryAccess
+		sourceRef *lsv1alpha1.ComponentDescriptorReference
+	)
+
+	pushImage := func(ref string) {
+		img, err := random.Image(1024, 1)
+		Expect(err).ToNot(HaveOccurred())
+		parsedRef, err := name.ParseReference(ref)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remote.Write(parsedRef, img)).To(Succeed())
+	}
+
+	writeComponent := func(fs vfs.FileSystem, dir, cd string) {
+		Expect(fs.MkdirAll(dir+"/blobs/blueprint", os.ModePerm)).To(Succeed())
+		Expect(vfs.WriteFile(fs, dir+"/component-descriptor.yaml", []byte(strings.ReplaceAll(cd, "REGISTRY", host)), os.ModePerm)).To(Succeed())
+		Expect(vfs.WriteFile(fs, dir+"/blobs/blueprint/blueprint.yaml", []byte(blueprint), os.ModePerm)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger = logging.Discard()
+
+		server = httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+		host = strings.TrimPrefix(server.URL, "http://")
+		pushImage(host + "/source/images/app:1.0.0")
+		pushImage(host + "/source/charts/app:1.0.0")
+		pushImage(host + "/source/images/other:1.0.0")
+
+		var err error
+		ociClient, err = ociclient.NewClient(logger.Logr(), ociclient.AllowPlainHttp(true), ociclient.WithCache(cache.NewInMemoryCache()))
+		Expect(err).ToNot(HaveOccurred())
+
+		fs := memoryfs.New()
+		writeComponent(fs, "/components/root", rootComponentDescriptor)
+		writeComponent(fs, "/components/child", childComponentDescriptor)
+		source, err = registries.GetFactory().NewRegistryAccess(ctx, fs, nil, nil,
+			&config.LocalRegistryConfiguration{RootPath: "/components"}, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		sourceRef = &lsv1alpha1.ComponentDescriptorReference{
+			RepositoryContext: &cdv2.UnstructuredTypedObject{},
+			ComponentName:     "example.com/root",
+			Version:           "1.0.0",
+		}
+		Expect(sourceRef.RepositoryContext.UnmarshalJSON([]byte(`{"type":"local"}`))).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should copy a component version and its references into the target repository", func() {
+		m, err := mirror.New(logger, source, ociClient, host+"/mirror")
+		Expect(err).ToNot(HaveOccurred())
+
+		result, err := m.Transfer(ctx, sourceRef)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Components).To(HaveLen(2))
+		Expect(result.Components[0].ComponentName).To(Equal("example.com/child"))
+		Expect(result.Components[0].Reference).To(Equal(host + "/mirror/component-descriptors/example.com/child:1.0.0"))
+		Expect(result.Components[1].ComponentName).To(Equal("example.com/root"))
+
+		rootResources := result.Components[1].Resources
+		Expect(rootResources).To(HaveLen(3))
+		Expect(rootResources[0].Target).To(HavePrefix("sha256:"))
+		Expect(rootResources[1].Target).To(Equal(host + "/mirror/source/images/app:1.0.0"))
+		Expect(rootResources[2].Skipped).To(ContainSubstring("access type web"))
+
+		childResources := result.Components[0].Resources
+		Expect(childResources[0].Target).To(Equal(host + "/mirror/source/charts/app:1.0.0"))
+		Expect(childResources[1].Skipped).To(ContainSubstring("type custom-image"))
+		Expect(childResources[1].Target).To(BeEmpty())
+
+		for _, ref := range []string{host + "/mirror/source/images/app:1.0.0", host + "/mirror/source/charts/app:1.0.0"} {
+			parsedRef, err := name.ParseReference(ref)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = remote.Head(parsedRef)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("artifact %s should have been copied", ref))
+		}
+	})
+
+	It("should upload component versions that can be resolved from the target repository", func() {
+		m, err := mirror.New(logger, source, ociClient, host+"/mirror")
+		Expect(err).ToNot(HaveOccurred())
+		result, err := m.Transfer(ctx, sourceRef)
+		Expect(err).ToNot(HaveOccurred())
+
+		target, err := registries.GetFactory().NewRegistryAccess(ctx, nil, nil, nil, nil,
+			&config.OCIConfiguration{AllowPlainHttp: true}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		cv, err := target.GetComponentVersion(ctx, &lsv1alpha1.ComponentDescriptorReference{
+			RepositoryContext: result.RepositoryContext,
+			ComponentName:     "example.com/root",
+			Version:           "1.0.0",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		repositoryContext, err := componentresolvers.NewOCIRepositoryContext(host + "/mirror")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cv.GetRepositoryContext().Raw).To(MatchJSON(repositoryContext.Raw))
+
+		image, err := cv.GetResource("image", nil)
+		Expect(err).ToNot(HaveOccurred())
+		imageResource, err := image.GetResource()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(imageResource.Access.Object).To(HaveKeyWithValue("imageReference", host+"/mirror/source/images/app:1.0.0"))
+
+		blueprintResource, err := cv.GetResource("blueprint", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(blueprintResource.GetAccessType()).To(Equal("localOciBlob"))
+		content, err := blueprintResource.GetTypedContent(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(content.Resource).To(BeAssignableToTypeOf(&blueprints.Blueprint{}))
+
+		child, err := cv.GetReferencedComponentVersion(ctx, cv.GetComponentReference("child"), result.RepositoryContext, nil)
+		Expect(err).ToNot(HaveOccurred())
+		chart, err := child.GetResource("chart", nil)
+		Expect(err).ToNot(HaveOccurred())
+		chartResource, err := chart.GetResource()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(chartResource.Access.Object).To(HaveKeyWithValue("imageReference", host+"/mirror/source/charts/app:1.0.0"))
+	})
+
+	It("should create overwrites that point the copied component versions to the target repository", func() {
+		m, err := mirror.New(logger, source, ociClient, host+"/mirror")
+		Expect(err).ToNot(HaveOccurred())
+		result, err := m.Transfer(ctx, sourceRef)
+		Expect(err).ToNot(HaveOccurred())
+
+		cvo := result.ComponentVersionOverwrites("mirror", "example")
+		Expect(cvo.Name).To(Equal("mirror"))
+		Expect(cvo.Namespace).To(Equal("example"))
+		Expect(cvo.Kind).To(Equal("ComponentVersionOverwrites"))
+		Expect(cvo.Overwrites).To(HaveLen(2))
+
+		ref := sourceRef.DeepCopy()
+		Expect(componentoverwrites.NewSubstitutions(cvo.Overwrites).Replace(ref)).To(BeTrue())
+		Expect(ref.RepositoryContext).To(Equal(result.RepositoryContext))
+		Expect(ref.ComponentName).To(Equal("example.com/root"))
+		Expect(ref.Version).To(Equal("1.0.0"))
+
+		other := &lsv1alpha1.ComponentDescriptorReference{ComponentName: "example.com/other", Version: "1.0.0"}
+		Expect(componentoverwrites.NewSubstitutions(cvo.Overwrites).Replace(other)).To(BeFalse())
+	})
+
+	It("should fail if a referenced component version cannot be resolved", func() {
+		fs := memoryfs.New()
+		writeComponent(fs, "/components/root", rootComponentDescriptor)
+		var err error
+		source, err = registries.GetFactory().NewRegistryAccess(ctx, fs, nil, nil,
+			&config.LocalRegistryConfiguration{RootPath: "/components"}, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		m, err := mirror.New(logger, source, ociClient, host+"/mirror")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = m.Transfer(ctx, sourceRef)
+		Expect(err).To(MatchError(ContainSubstring("unable to resolve component reference child")))
+
+		parsedRef, err := name.ParseReference(host + "/mirror/component-descriptors/example.com/root:1.0.0")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = remote.Head(parsedRef)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject an empty target repository", func() {
+		_, err := mirror.New(logger, source, ociClient, "")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/gardener/component-cli/ociclient"
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	cdoci "github.com/gardener/component-spec/bindings-go/oci"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/landscaper/apis/mediatype"
+	"github.com/gardener/landscaper/pkg/components/cnudie"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/ocmlib"
+)
+
+const (
+	// ociArtifactAccessType is the ocm name of the ociRegistry access type.
+	ociArtifactAccessType = "ociArtifact"
+	// localBlobAccessType is the ocm name of the localOciBlob access type.
+	localBlobAccessType = "localBlob"
+)
+
+// mirroredResourceTypes are the types of the resources that are copied if they are stored in an oci registry.
+var mirroredResourceTypes = sets.New[string](
+	cdv2.OCIImageType,
+	types.HelmChartResourceType,
+	types.OldHelmResourceType,
+	mediatype.BlueprintType,
+	mediatype.OldBlueprintType,
+)
+
+// transferComponentVersion copies the resources of a component version and uploads its modified component
+// descriptor into the target repository.
+func (m *Mirror) transferComponentVersion(ctx context.Context, cv model.ComponentVersion) (*Component, error) {
+	cd := cv.GetComponentDescriptor().DeepCopy()
+
+	// local blobs are collected in the blob directory of a component archive,
+	// from where they are added as layers to the component descriptor artifact
+	blobFs := memoryfs.New()
+	if err := blobFs.MkdirAll(ctf.BlobsDirectoryName, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("unable to create blob directory: %w", err)
+	}
+
+	component := &Component{
+		ComponentName: cd.GetName(),
+		Version:       cd.GetVersion(),
+	}
+	for i := range cd.Resources {
+		resource, err := m.transferResource(ctx, cv, i, &cd.Resources[i], blobFs)
+		if err != nil {
+			return nil, fmt.Errorf("unable to copy resource %s: %w", cd.Resources[i].GetName(), err)
+		}
+		component.Resources = append(component.Resources, *resource)
+	}
+
+	cd.RepositoryContexts = append(cd.RepositoryContexts, m.targetRepositoryContext.DeepCopy())
+
+	ref, err := m.uploadComponentDescriptor(ctx, cd, blobFs)
+	if err != nil {
+		return nil, err
+	}
+	component.Reference = ref
+	return component, nil
+}
+
+// transferResource copies a resource and rewrites its access.
+// The resource with the given index is read from the source component version, its copy in the component descriptor
+// is modified.
+func (m *Mirror) transferResource(ctx context.Context, cv model.ComponentVersion, index int, res *types.Resource, blobFs vfs.FileSystem) (*Resource, error) {
+	resource := &Resource{
+		Name: res.GetName(),
+		Type: res.GetType(),
+	}
+	if res.Access == nil {
+		resource.Skipped = "the resource has no access"
+		return resource, nil
+	}
+
+	switch res.Access.GetType() {
+	case cdv2.LocalOCIBlobType, cdv2.LocalFilesystemBlobType, localBlobAccessType:
+		// local blobs are part of the component version and are always copied
+		data, mediaType, err := readLocalBlob(ctx, cv, index, *res)
+		if err != nil {
+			return nil, err
+		}
+		dig := digest.FromBytes(data)
+		if err := vfs.WriteFile(blobFs, ctf.BlobPath(dig.Encoded()), data, os.ModePerm); err != nil {
+			return nil, fmt.Errorf("unable to write blob: %w", err)
+		}
+		access, err := cdv2.NewUnstructured(cdv2.NewLocalFilesystemBlobAccess(dig.Encoded(), mediaType))
+		if err != nil {
+			return nil, err
+		}
+		resource.Source = res.Access.GetType()
+		resource.Target = dig.String()
+		res.Access = &access
+
+	case cdv2.OCIRegistryType, ociArtifactAccessType:
+		ociAccess := &cdv2.OCIRegistryAccess{}
+		if err := res.Access.DecodeInto(ociAccess); err != nil {
+			return nil, fmt.Errorf("unable to decode access of type %s: %w", res.Access.GetType(), err)
+		}
+		resource.Source = ociAccess.ImageReference
+		if !mirroredResourceTypes.Has(res.GetType()) {
+			resource.Skipped = fmt.Sprintf("resources of type %s are not copied", res.GetType())
+			return resource, nil
+		}
+
+		targetRef, err := m.targetImageReference(ociAccess.ImageReference)
+		if err != nil {
+			return nil, err
+		}
+		m.log.Debug("copying oci artifact", "source", ociAccess.ImageReference, "target", targetRef)
+		if err := ociclient.Copy(ctx, m.ociClient, ociAccess.ImageReference, targetRef); err != nil {
+			return nil, fmt.Errorf("unable to copy oci artifact %s to %s: %w", ociAccess.ImageReference, targetRef, err)
+		}
+		access, err := cdv2.NewUnstructured(cdv2.NewOCIRegistryAccess(targetRef))
+		if err != nil {
+			return nil, err
+		}
+		resource.Target = targetRef
+		res.Access = &access
+
+	default:
+		resource.Skipped = fmt.Sprintf("resources with access type %s are not copied", res.Access.GetType())
+	}
+	return resource, nil
+}
+
+// targetImageReference returns the reference of the copy of an oci artifact.
+// The repository of the artifact is appended to the target repository,
+// e.g. "example.com/images/nginx:1.0.0" becomes "<target repository>/images/nginx:1.0.0".
+func (m *Mirror) targetImageReference(ref string) (string, error) {
+	parsedRef, err := name.ParseReference(ref)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse oci reference %q: %w", ref, err)
+	}
+	repository := path.Join(m.targetRepository, parsedRef.Context().RepositoryStr())
+	if digestRef, ok := parsedRef.(name.Digest); ok {
+		return repository + "@" + digestRef.DigestStr(), nil
+	}
+	return repository + ":" + parsedRef.Identifier(), nil
+}
+
+// readLocalBlob reads a blob that is stored together with the component version.
+func readLocalBlob(ctx context.Context, cv model.ComponentVersion, index int, res types.Resource) ([]byte, string, error) {
+	switch componentVersion := cv.(type) {
+	case *cnudie.ComponentVersion:
+		blobResolver, err := componentVersion.GetBlobResolver()
+		if err != nil {
+			return nil, "", err
+		}
+		var data bytes.Buffer
+		info, err := blobResolver.Resolve(ctx, res, &data)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to resolve blob: %w", err)
+		}
+		return data.Bytes(), info.MediaType, nil
+
+	case *ocmlib.ComponentVersion:
+		resourceAccess, err := componentVersion.GetOCMObject().GetResourceByIndex(index)
+		if err != nil {
+			return nil, "", err
+		}
+		accessMethod, err := resourceAccess.AccessMethod()
+		if err != nil {
+			return nil, "", err
+		}
+		defer accessMethod.Close()
+		data, err := accessMethod.Get()
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to read blob: %w", err)
+		}
+		return data, accessMethod.MimeType(), nil
+
+	default:
+		return nil, "", fmt.Errorf("reading local blobs of component versions of type %T is not supported", cv)
+	}
+}
+
+// uploadComponentDescriptor uploads a component descriptor together with the local blobs in the given filesystem
+// into the target repository and returns its oci reference.
+func (m *Mirror) uploadComponentDescriptor(ctx context.Context, cd *types.ComponentDescriptor, blobFs vfs.FileSystem) (string, error) {
+	ref, err := cdoci.OCIRef(*cdv2.NewOCIRegistryRepository(m.targetRepository, ""), cd.GetName(), cd.GetVersion())
+	if err != nil {
+		return "", fmt.Errorf("unable to get oci reference of component descriptor: %w", err)
+	}
+
+	store := blobStore{}
+	manifest, err := cdoci.NewManifestBuilder(store, ctf.NewComponentArchive(cd, blobFs)).Build(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to build manifest of component descriptor: %w", err)
+	}
+	if err := m.ociClient.PushManifest(ctx, ref, manifest, ociclient.WithStore(store)); err != nil {
+		return "", fmt.Errorf("unable to upload component descriptor to %s: %w", ref, err)
+	}
+	return ref, nil
+}
+
+// blobStore is an in-memory store for the blobs of a component descriptor artifact.
+type blobStore map[digest.Digest][]byte
+
+func (s blobStore) Add(desc ocispecv1.Descriptor, reader io.ReadCloser) error {
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	s[desc.Digest] = data
+	return nil
+}
+
+func (s blobStore) Get(desc ocispecv1.Descriptor) (io.ReadCloser, error) {
+	data, ok := s[desc.Digest]
+	if !ok {
+		return nil, fmt.Errorf("blob %s not found", desc.Digest)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
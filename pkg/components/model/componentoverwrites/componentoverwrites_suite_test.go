@@ -149,6 +149,59 @@ var _ = Describe("ComponentVersionOverwrites", func() {
 			Expect(subs.Replace(cdRef)).To(BeTrue())
 		})
 
+		It("should match component names with a wildcard", func() {
+			subs := componentoverwrites.NewSubstitutions([]lsv1alpha1.ComponentVersionOverwrite{
+				{
+					Source: lsv1alpha1.ComponentVersionOverwriteReference{
+						ComponentName: "component.*.com",
+					},
+					Substitution: lsv1alpha1.ComponentVersionOverwriteReference{},
+				},
+			})
+			Expect(subs.Replace(cdRef)).To(BeTrue())
+
+			cdRef.ComponentName = "component.example.org"
+			Expect(subs.Replace(cdRef)).To(BeFalse())
+		})
+
+		It("should match versions that satisfy the version constraint", func() {
+			subs := componentoverwrites.NewSubstitutions([]lsv1alpha1.ComponentVersionOverwrite{
+				{
+					Source: lsv1alpha1.ComponentVersionOverwriteReference{
+						ComponentName:     "component.*",
+						VersionConstraint: ">= 1.0, < 2",
+					},
+					Substitution: lsv1alpha1.ComponentVersionOverwriteReference{},
+				},
+			})
+			Expect(subs.Replace(cdRef)).To(BeTrue())
+
+			cdRef.Version = "v2.0.0"
+			Expect(subs.Replace(cdRef)).To(BeFalse())
+			cdRef.Version = "latest"
+			Expect(subs.Replace(cdRef)).To(BeFalse())
+		})
+
+		It("should not match if the version constraint is invalid", func() {
+			overwrites := []lsv1alpha1.ComponentVersionOverwrite{
+				{
+					Source: lsv1alpha1.ComponentVersionOverwriteReference{
+						VersionConstraint: "~> one",
+					},
+					Substitution: lsv1alpha1.ComponentVersionOverwriteReference{},
+				},
+				{
+					Source: lsv1alpha1.ComponentVersionOverwriteReference{
+						Version:           cdRef.Version,
+						VersionConstraint: ">= 1.0",
+					},
+					Substitution: lsv1alpha1.ComponentVersionOverwriteReference{},
+				},
+			}
+			Expect(componentoverwrites.NewSubstitutions(overwrites).Replace(cdRef)).To(BeFalse())
+			Expect(componentoverwrites.Validate(overwrites)).To(HaveLen(2))
+		})
+
 	})
 
 	Context("Overwriter", func() {
@@ -225,6 +278,27 @@ var _ = Describe("ComponentVersionOverwrites", func() {
 			})))
 		})
 
+		It("should replace the repository context of all components that match a rule and keep their versions", func() {
+			repoCtx := testutils.DefaultRepositoryContext("mirror.example.com")
+			subs := componentoverwrites.NewSubstitutions([]lsv1alpha1.ComponentVersionOverwrite{
+				{
+					Source: lsv1alpha1.ComponentVersionOverwriteReference{
+						ComponentName:     "*.example.com",
+						VersionConstraint: ">= 1.0, < 2",
+					},
+					Substitution: lsv1alpha1.ComponentVersionOverwriteReference{
+						RepositoryContext: repoCtx,
+					},
+				},
+			})
+			Expect(subs.Replace(cdRef)).To(BeTrue())
+			Expect(cdRef).To(PointTo(Equal(lsv1alpha1.ComponentDescriptorReference{
+				RepositoryContext: repoCtx,
+				ComponentName:     "component.example.com",
+				Version:           "v1.0.0",
+			})))
+		})
+
 	})
 
 })
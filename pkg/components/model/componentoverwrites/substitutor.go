@@ -5,6 +5,11 @@
 package componentoverwrites
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
 	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -18,27 +23,117 @@ type Overwriter interface {
 // Substitutions handles overwrites and implements the Substitutor interface.
 type Substitutions struct {
 	Substitutions []lsv1alpha1.ComponentVersionOverwrite
+
+	// matchers contains the compiled source references of the substitutions.
+	matchers []matcher
 }
 
 func NewSubstitutions(subs []lsv1alpha1.ComponentVersionOverwrite) *Substitutions {
 	return &Substitutions{
 		Substitutions: subs,
+		matchers:      newMatchers(subs),
 	}
 }
 
-func matches(matchRef *lsv1alpha1.ComponentVersionOverwriteReference, obj *lsv1alpha1.ComponentDescriptorReference) bool {
-	if len(matchRef.ComponentName) != 0 && matchRef.ComponentName != obj.ComponentName {
+// matcher is the compiled form of a source reference of an overwrite.
+type matcher struct {
+	ref *lsv1alpha1.ComponentVersionOverwriteReference
+	// componentName is the compiled component name pattern, if the component name contains the wildcard "*".
+	componentName *regexp.Regexp
+	// versionConstraint is the parsed version constraint, if the reference defines a valid one.
+	versionConstraint *semver.Constraints
+	// invalid is true if the reference can never match, because its version constraint is invalid.
+	invalid bool
+}
+
+func newMatchers(subs []lsv1alpha1.ComponentVersionOverwrite) []matcher {
+	matchers := make([]matcher, len(subs))
+	for i := range subs {
+		matchers[i] = newMatcher(&subs[i].Source)
+	}
+	return matchers
+}
+
+func newMatcher(ref *lsv1alpha1.ComponentVersionOverwriteReference) matcher {
+	m := matcher{ref: ref}
+	if strings.Contains(ref.ComponentName, "*") {
+		m.componentName = compileComponentNamePattern(ref.ComponentName)
+	}
+	if len(ref.VersionConstraint) != 0 {
+		// a version constraint must not be combined with a version
+		c, err := semver.NewConstraint(ref.VersionConstraint)
+		if err != nil || len(ref.Version) != 0 {
+			m.invalid = true
+		}
+		m.versionConstraint = c
+	}
+	return m
+}
+
+func (m *matcher) matches(obj *lsv1alpha1.ComponentDescriptorReference) bool {
+	if m.invalid {
+		return false
+	}
+	if len(m.ref.ComponentName) != 0 && !m.matchesComponentName(obj.ComponentName) {
+		return false
+	}
+	if len(m.ref.Version) != 0 && m.ref.Version != obj.Version {
 		return false
 	}
-	if len(matchRef.Version) != 0 && matchRef.Version != obj.Version {
+	if m.versionConstraint != nil && !matchesVersionConstraint(m.versionConstraint, obj.Version) {
 		return false
 	}
-	if matchRef.RepositoryContext != nil && !cdv2.UnstructuredTypesEqual(matchRef.RepositoryContext, obj.RepositoryContext) {
+	if m.ref.RepositoryContext != nil && !cdv2.UnstructuredTypesEqual(m.ref.RepositoryContext, obj.RepositoryContext) {
 		return false
 	}
 	return true
 }
 
+// matchesComponentName checks whether the component name matches the name of the reference, which may contain the wildcard "*".
+func (m *matcher) matchesComponentName(componentName string) bool {
+	if m.componentName == nil {
+		return m.ref.ComponentName == componentName
+	}
+	return m.componentName.MatchString(componentName)
+}
+
+// compileComponentNamePattern compiles a component name pattern with the wildcard "*" to a regular expression.
+func compileComponentNamePattern(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
+
+// matchesVersionConstraint checks whether the version satisfies the semantic version constraint.
+// Versions that are no semantic versions never match.
+func matchesVersionConstraint(constraint *semver.Constraints, version string) bool {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return constraint.Check(v)
+}
+
+// Validate checks the overwrites and returns an error for each overwrite that is ignored because it is invalid.
+func Validate(overwrites []lsv1alpha1.ComponentVersionOverwrite) []error {
+	var errs []error
+	for i, overwrite := range overwrites {
+		if len(overwrite.Source.VersionConstraint) == 0 {
+			continue
+		}
+		if len(overwrite.Source.Version) != 0 {
+			errs = append(errs, fmt.Errorf("overwrite %d: the source must not define both a version and a version constraint", i))
+			continue
+		}
+		if _, err := semver.NewConstraint(overwrite.Source.VersionConstraint); err != nil {
+			errs = append(errs, fmt.Errorf("overwrite %d: invalid version constraint %q: %w", i, overwrite.Source.VersionConstraint, err))
+		}
+	}
+	return errs
+}
+
 func mergeCDReference(mergeRef *lsv1alpha1.ComponentVersionOverwriteReference, obj *lsv1alpha1.ComponentDescriptorReference) {
 	// don't merge any field if we cannot merge all which are provided
 	if (len(mergeRef.ComponentName) != 0 && len(obj.ComponentName) != 0) ||
@@ -60,10 +155,16 @@ func mergeCDReference(mergeRef *lsv1alpha1.ComponentVersionOverwriteReference, o
 }
 
 func (sm *Substitutions) Replace(ref *lsv1alpha1.ComponentDescriptorReference) bool {
+	matchers := sm.matchers
+	if len(matchers) != len(sm.Substitutions) {
+		// the substitutions were not created with NewSubstitutions
+		matchers = newMatchers(sm.Substitutions)
+	}
+
 	merge := &lsv1alpha1.ComponentDescriptorReference{}
 	changed := false
-	for _, subs := range sm.Substitutions {
-		if matches(&subs.Source, ref) {
+	for i, subs := range sm.Substitutions {
+		if matchers[i].matches(ref) {
 			changed = true
 			mergeCDReference(&subs.Substitution, merge)
 			if merge.RepositoryContext != nil && len(merge.ComponentName) != 0 && len(merge.Version) != 0 {
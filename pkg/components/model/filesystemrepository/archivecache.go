@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystemrepository
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+)
+
+// archiveCache contains extracted archives by the name of their root filesystem and their path.
+// An entry is only valid as long as the modification time and the size of the archive are unchanged.
+// If the cache is full, the least recently used entry is evicted.
+type archiveCache struct {
+	maxEntries int
+	entries    map[string]*cachedArchive
+	mutex      sync.Mutex
+}
+
+type cachedArchive struct {
+	modTime  time.Time
+	size     int64
+	fs       vfs.FileSystem
+	lastUsed time.Time
+}
+
+func newArchiveCache(maxEntries int) *archiveCache {
+	return &archiveCache{
+		maxEntries: maxEntries,
+		entries:    map[string]*cachedArchive{},
+	}
+}
+
+// get returns the extracted archive or nil if the archive is not cached or has been modified.
+func (c *archiveCache) get(key string, info os.FileInfo) vfs.FileSystem {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	if !entry.modTime.Equal(info.ModTime()) || entry.size != info.Size() {
+		delete(c.entries, key)
+		return nil
+	}
+	entry.lastUsed = time.Now()
+	return entry.fs
+}
+
+// add adds an extracted archive and evicts the least recently used entry if the cache is full.
+func (c *archiveCache) add(key string, info os.FileInfo, fs vfs.FileSystem) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		var oldestKey string
+		var oldest *cachedArchive
+		for k, entry := range c.entries {
+			if oldest == nil || entry.lastUsed.Before(oldest.lastUsed) {
+				oldestKey, oldest = k, entry
+			}
+		}
+		delete(c.entries, oldestKey)
+	}
+	c.entries[key] = &cachedArchive{
+		modTime:  info.ModTime(),
+		size:     info.Size(),
+		fs:       fs,
+		lastUsed: time.Now(),
+	}
+}
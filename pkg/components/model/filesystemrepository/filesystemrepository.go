@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package filesystemrepository implements the repository context type "filesystem", which references component
+// archives in the filesystem of the landscaper. It is used by the component-cli and the ocmlib backed registry accesses.
+//
+// The type is meant for development and air-gapped test setups: every user who can create installations may read all
+// archives below the configured root directory, independent of the namespace of the installation.
+package filesystemrepository
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/gardener/landscaper/pkg/components/model/tar"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+)
+
+// Type is the type of repository contexts that reference a directory or an archive in the filesystem of the landscaper.
+const Type = "filesystem"
+
+// maxExtractedSize is the maximal number of bytes that are extracted from an archive, including its nested archives.
+var maxExtractedSize int64 = 512 * 1024 * 1024
+
+// maxCachedArchives is the maximal number of extracted archives that are kept in memory.
+const maxCachedArchives = 8
+
+// archives contains the extracted archives, so that they are not extracted again for every registry access.
+var archives = newArchiveCache(maxCachedArchives)
+
+// RepositorySpec describes a repository context of type "filesystem".
+type RepositorySpec struct {
+	cdv2.ObjectType `json:",inline"`
+	// Path is the path of a directory or a tar or tar.gz archive relative to the configured root directory.
+	// The directory or archive contains component archives, or a common transport format (ctf) archive whose entries
+	// are component archives.
+	Path string `json:"path"`
+}
+
+// NewRepositoryContext creates a repository context of type "filesystem" with the given path.
+func NewRepositoryContext(path string) (types.UnstructuredTypedObject, error) {
+	return cdv2.NewUnstructured(&RepositorySpec{
+		ObjectType: cdv2.ObjectType{Type: Type},
+		Path:       path,
+	})
+}
+
+// ParseRepositorySpec parses a repository context of type "filesystem".
+func ParseRepositorySpec(data []byte) (*RepositorySpec, error) {
+	spec := &RepositorySpec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, fmt.Errorf("unable to parse repository context of type %q: %w", Type, err)
+	}
+	if spec.GetType() != Type {
+		return nil, fmt.Errorf("unsupported repository context type %q, expected %q", spec.GetType(), Type)
+	}
+	if len(spec.Path) == 0 {
+		return nil, fmt.Errorf("repository context of type %q has no path", Type)
+	}
+	return spec, nil
+}
+
+// Open returns a filesystem that contains the component archives referenced by the given path.
+// The path is resolved below the root filesystem and cannot leave it.
+// Directories are returned as they are, archives are extracted into memory. Archive entries that are archives
+// themselves, as the component archives in a ctf archive, are extracted into a directory with the name of the entry.
+// Extracted archives are cached until the archive is modified, and must not be modified by the caller.
+func Open(ctx context.Context, rootFs vfs.FileSystem, repoPath string) (vfs.FileSystem, error) {
+	if rootFs == nil {
+		return nil, fmt.Errorf("repository contexts of type %q are not enabled", Type)
+	}
+	repoPath = path.Clean("/" + repoPath)
+
+	info, err := rootFs.Stat(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %q: %w", repoPath, err)
+	}
+	if info.IsDir() {
+		return projectionfs.New(rootFs, repoPath)
+	}
+
+	key := rootFs.Name() + ":" + repoPath
+	if fs := archives.get(key, info); fs != nil {
+		return fs, nil
+	}
+	fs, err := openArchive(ctx, rootFs, repoPath)
+	if err != nil {
+		return nil, err
+	}
+	archives.add(key, info, fs)
+	return fs, nil
+}
+
+// openArchive extracts the archive with the given path into memory.
+func openArchive(ctx context.Context, rootFs vfs.FileSystem, repoPath string) (vfs.FileSystem, error) {
+	file, err := rootFs.Open(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open %q: %w", repoPath, err)
+	}
+	defer file.Close()
+
+	limit := &sizeLimit{remaining: maxExtractedSize}
+	fs := memoryfs.New()
+	if err := extractArchive(ctx, file, fs, "/", limit); err != nil {
+		return nil, fmt.Errorf("unable to extract archive %q: %w", repoPath, err)
+	}
+
+	entries, err := vfs.ReadDir(fs, "/")
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if err := extractNestedArchive(ctx, fs, path.Join("/", entry.Name()), limit); err != nil {
+			return nil, err
+		}
+	}
+	return fs, nil
+}
+
+// extractNestedArchive replaces the given file by a directory with its content, if the file is an archive.
+func extractNestedArchive(ctx context.Context, fs vfs.FileSystem, filePath string, limit *sizeLimit) error {
+	data, err := vfs.ReadFile(fs, filePath)
+	if err != nil {
+		return err
+	}
+	if !isArchive(data) {
+		return nil
+	}
+	if err := fs.Remove(filePath); err != nil {
+		return err
+	}
+	if err := fs.MkdirAll(filePath, os.ModePerm); err != nil {
+		return err
+	}
+	if err := extractArchive(ctx, bytes.NewReader(data), fs, filePath, limit); err != nil {
+		return fmt.Errorf("unable to extract archive %q: %w", filePath, err)
+	}
+	return nil
+}
+
+// extractArchive extracts a tar or tar.gz archive into the given directory of the filesystem.
+// The extraction fails if the uncompressed archive exceeds the size limit.
+func extractArchive(ctx context.Context, in io.Reader, fs vfs.FileSystem, dir string, limit *sizeLimit) error {
+	reader := bufio.NewReader(in)
+	header, err := reader.Peek(2)
+	if err == nil && isGzip(header) {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		return tar.ExtractTar(ctx, &limitedReader{reader: gzipReader, limit: limit}, fs, tar.ToPath(dir))
+	}
+	return tar.ExtractTar(ctx, &limitedReader{reader: reader, limit: limit}, fs, tar.ToPath(dir))
+}
+
+// sizeLimit is the number of bytes that may still be extracted.
+type sizeLimit struct {
+	remaining int64
+}
+
+// limitedReader is a reader that fails if more bytes are read than allowed by the size limit.
+type limitedReader struct {
+	reader io.Reader
+	limit  *sizeLimit
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.limit.remaining -= int64(n)
+	if r.limit.remaining < 0 {
+		return n, fmt.Errorf("the extracted archive exceeds the maximal size of %d bytes", maxExtractedSize)
+	}
+	return n, err
+}
+
+func isGzip(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b
+}
+
+// isArchive checks whether the data is a tar or tar.gz archive.
+func isArchive(data []byte) bool {
+	if isGzip(data) {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return false
+		}
+		header := make([]byte, 512)
+		n, _ := io.ReadFull(reader, header)
+		data = header[:n]
+	}
+	// tar headers contain the magic "ustar" at offset 257
+	return len(data) >= 262 && string(data[257:262]) == "ustar"
+}
+
+// IsComponentDescriptorFile checks whether the file with the given name is a component descriptor.
+func IsComponentDescriptorFile(name string) bool {
+	return strings.Contains(name, "component-descriptor")
+}
+
+// ComponentArchive is a component descriptor file in a filesystem repository.
+type ComponentArchive struct {
+	// DescriptorPath is the path of the component descriptor file.
+	DescriptorPath string
+	// Dir is the directory of the component archive. Local blobs are located in its subdirectory "blobs".
+	Dir string
+}
+
+// FindComponentArchives returns all component descriptor files of the filesystem.
+func FindComponentArchives(fs vfs.FileSystem) ([]ComponentArchive, error) {
+	result := []ComponentArchive{}
+	err := vfs.Walk(fs, "/", func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !IsComponentDescriptorFile(info.Name()) {
+			return nil
+		}
+		result = append(result, ComponentArchive{
+			DescriptorPath: filePath,
+			Dir:            path.Dir(filePath),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
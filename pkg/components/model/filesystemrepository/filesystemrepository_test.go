@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystemrepository
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"os"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Open", func() {
+
+	var (
+		ctx    context.Context
+		rootFs vfs.FileSystem
+	)
+
+	writeArchive := func(path string, files map[string]string) {
+		buf := bytes.Buffer{}
+		tw := tar.NewWriter(&buf)
+		for name, content := range files {
+			Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})).To(Succeed())
+			_, err := tw.Write([]byte(content))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(tw.Close()).To(Succeed())
+		Expect(vfs.WriteFile(rootFs, path, buf.Bytes(), os.ModePerm)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		rootFs = memoryfs.New()
+		archives = newArchiveCache(maxCachedArchives)
+	})
+
+	It("should fail if the extracted archive exceeds the maximal size", func() {
+		defer func(size int64) { maxExtractedSize = size }(maxExtractedSize)
+		maxExtractedSize = 4096
+
+		writeArchive("/small.tar", map[string]string{"component-descriptor.yaml": "small"})
+		_, err := Open(ctx, rootFs, "/small.tar")
+		Expect(err).ToNot(HaveOccurred())
+
+		writeArchive("/large.tar", map[string]string{"component-descriptor.yaml": string(make([]byte, 8192))})
+		_, err = Open(ctx, rootFs, "/large.tar")
+		Expect(err).To(MatchError(ContainSubstring("exceeds the maximal size")))
+	})
+
+	It("should reuse an extracted archive until it is modified", func() {
+		writeArchive("/archive.tar", map[string]string{"component-descriptor.yaml": "v1"})
+		first, err := Open(ctx, rootFs, "/archive.tar")
+		Expect(err).ToNot(HaveOccurred())
+		second, err := Open(ctx, rootFs, "/archive.tar")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second).To(BeIdenticalTo(first))
+
+		writeArchive("/archive.tar", map[string]string{"component-descriptor.yaml": "version 2"})
+		Expect(rootFs.Chtimes("/archive.tar", time.Now(), time.Now().Add(time.Minute))).To(Succeed())
+		third, err := Open(ctx, rootFs, "/archive.tar")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(third).ToNot(BeIdenticalTo(first))
+		Expect(vfs.ReadFile(third, "/component-descriptor.yaml")).To(BeEquivalentTo("version 2"))
+	})
+
+	It("should evict the least recently used archive if the cache is full", func() {
+		archives = newArchiveCache(2)
+		writeArchive("/a.tar", map[string]string{"component-descriptor.yaml": "a"})
+		writeArchive("/b.tar", map[string]string{"component-descriptor.yaml": "b"})
+		writeArchive("/c.tar", map[string]string{"component-descriptor.yaml": "c"})
+
+		a, err := Open(ctx, rootFs, "/a.tar")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = Open(ctx, rootFs, "/b.tar")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = Open(ctx, rootFs, "/a.tar")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = Open(ctx, rootFs, "/c.tar")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(archives.entries).To(HaveLen(2))
+		Expect(archives.entries).To(HaveKey(rootFs.Name() + ":/a.tar"))
+		Expect(archives.entries).To(HaveKey(rootFs.Name() + ":/c.tar"))
+		again, err := Open(ctx, rootFs, "/a.tar")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(again).To(BeIdenticalTo(a))
+	})
+})
@@ -6,15 +6,107 @@ package model
 
 import (
 	"context"
+	"fmt"
+	"io"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/pkg/components/model/componentoverwrites"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/model/verification"
 )
 
 type RegistryAccess interface {
 	GetComponentVersion(ctx context.Context, cdRef *lsv1alpha1.ComponentDescriptorReference) (ComponentVersion, error)
 }
 
+// VerifyingRegistryAccess is a RegistryAccess that verifies the signatures and resource digests of component versions
+// before they are returned.
+type VerifyingRegistryAccess interface {
+	RegistryAccess
+	// SetVerificationPolicies sets the policies that define which component versions are verified with which keys.
+	SetVerificationPolicies(policies *verification.Policies)
+}
+
+// SetVerificationPolicies sets the verification policies of a registry access.
+// An error is returned if the registry access does not support the verification of component versions.
+func SetVerificationPolicies(registryAccess RegistryAccess, policies *verification.Policies) error {
+	verifyingRegistryAccess, ok := registryAccess.(VerifyingRegistryAccess)
+	if !ok {
+		return fmt.Errorf("the registry access of type %T does not support the verification of component versions", registryAccess)
+	}
+	verifyingRegistryAccess.SetVerificationPolicies(policies)
+	return nil
+}
+
+// VersionListingRegistryAccess is a RegistryAccess that can list the available versions of a component.
+type VersionListingRegistryAccess interface {
+	RegistryAccess
+	// ListComponentVersions returns the versions of a component that are available in a repository.
+	ListComponentVersions(ctx context.Context, repositoryContext *types.UnstructuredTypedObject, componentName string) ([]string, error)
+}
+
+// ListComponentVersions returns the versions of a component that are available in a repository.
+// An error is returned if the registry access does not support the listing of component versions.
+func ListComponentVersions(ctx context.Context,
+	registryAccess RegistryAccess,
+	repositoryContext *types.UnstructuredTypedObject,
+	componentName string) ([]string, error) {
+
+	versionListingRegistryAccess, ok := registryAccess.(VersionListingRegistryAccess)
+	if !ok {
+		return nil, fmt.Errorf("the registry access of type %T does not support the listing of component versions", registryAccess)
+	}
+	return versionListingRegistryAccess.ListComponentVersions(ctx, repositoryContext, componentName)
+}
+
+// FilesystemRepositoryRegistryAccess is a RegistryAccess that can resolve repository contexts of type "filesystem".
+type FilesystemRepositoryRegistryAccess interface {
+	RegistryAccess
+	// SetFilesystemRepositoryRoot sets the filesystem in which the paths of repository contexts of type "filesystem"
+	// are resolved.
+	SetFilesystemRepositoryRoot(rootFs vfs.FileSystem) error
+}
+
+// SetFilesystemRepositoryRoot enables repository contexts of type "filesystem" for a registry access.
+// Their paths are resolved in the given root filesystem.
+// An error is returned if the registry access does not support repository contexts of type "filesystem".
+func SetFilesystemRepositoryRoot(registryAccess RegistryAccess, rootFs vfs.FileSystem) error {
+	filesystemRegistryAccess, ok := registryAccess.(FilesystemRepositoryRegistryAccess)
+	if !ok {
+		return fmt.Errorf("the registry access of type %T does not support repository contexts of type filesystem", registryAccess)
+	}
+	return filesystemRegistryAccess.SetFilesystemRepositoryRoot(rootFs)
+}
+
+// RegistryCredentialsRegistryAccess is a RegistryAccess that can use per-registry credentials.
+type RegistryCredentialsRegistryAccess interface {
+	RegistryAccess
+	// SetRegistryCredentials sets the credentials that are used for matching registries instead of the pull secrets.
+	SetRegistryCredentials(registryCredentials *registrycredentials.Credentials) error
+}
+
+// SetRegistryCredentials sets the per-registry credentials of a registry access.
+// An error is returned if the registry access does not support per-registry credentials.
+func SetRegistryCredentials(registryAccess RegistryAccess, registryCredentials *registrycredentials.Credentials) error {
+	credentialsRegistryAccess, ok := registryAccess.(RegistryCredentialsRegistryAccess)
+	if !ok {
+		return fmt.Errorf("the registry access of type %T does not support registry credentials", registryAccess)
+	}
+	return credentialsRegistryAccess.SetRegistryCredentials(registryCredentials)
+}
+
+// CloseRegistryAccess releases the resources of a registry access, if it holds any.
+func CloseRegistryAccess(registryAccess RegistryAccess) error {
+	closer, ok := registryAccess.(io.Closer)
+	if !ok {
+		return nil
+	}
+	return closer.Close()
+}
+
 // GetComponentVersionWithOverwriter is like registryAccess.GetComponentVersion, but applies the given overwrites first.
 func GetComponentVersionWithOverwriter(ctx context.Context,
 	registryAccess RegistryAccess,
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registrycredentials
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	awscredentials "github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+)
+
+// AWSTokenSourceType is the type of token sources that obtain an authorization token for the Amazon Elastic Container
+// Registry.
+const AWSTokenSourceType = "aws"
+
+// Keys of the secret of an aws token source.
+const (
+	AccessKeyIDKey     = "accessKeyID"
+	SecretAccessKeyKey = "secretAccessKey"
+	SessionTokenKey    = "sessionToken"
+)
+
+// AWSConfig is the configuration of an aws token source.
+type AWSConfig struct {
+	// Region is the region of the registry.
+	// If it is not set, the region is taken from the environment of the landscaper.
+	Region string `json:"region,omitempty"`
+}
+
+func init() {
+	RegisterTokenSourceType(AWSTokenSourceType, NewAWSTokenSource)
+}
+
+// NewAWSTokenSource creates a token source that obtains ECR authorization tokens with the access key in the keys
+// "accessKeyID" and "secretAccessKey" of the secret. Without a secret, i.e. for token sources of the landscaper
+// configuration, the default credentials of the landscaper are used, e.g. its IAM role for service accounts.
+func NewAWSTokenSource(ctx context.Context, rawConfig json.RawMessage, secretData map[string][]byte) (TokenSource, error) {
+	config := AWSConfig{}
+	if len(rawConfig) != 0 {
+		if err := json.Unmarshal(rawConfig, &config); err != nil {
+			return nil, fmt.Errorf("unable to parse aws token source configuration: %w", err)
+		}
+	}
+
+	loadOptions := []func(*awsconfig.LoadOptions) error{}
+	if len(config.Region) != 0 {
+		loadOptions = append(loadOptions, awsconfig.WithRegion(config.Region))
+	}
+	if secretData != nil {
+		accessKeyID := string(secretData[AccessKeyIDKey])
+		secretAccessKey := string(secretData[SecretAccessKeyKey])
+		if len(accessKeyID) == 0 || len(secretAccessKey) == 0 {
+			return nil, fmt.Errorf("the secret of the aws token source must contain the keys %q and %q", AccessKeyIDKey, SecretAccessKeyKey)
+		}
+		loadOptions = append(loadOptions, awsconfig.WithCredentialsProvider(
+			awscredentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, string(secretData[SessionTokenKey]))))
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, loadOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load aws configuration: %w", err)
+	}
+	return &awsTokenSource{client: ecr.NewFromConfig(awsCfg)}, nil
+}
+
+type awsTokenSource struct {
+	client *ecr.Client
+}
+
+func (s *awsTokenSource) Token(ctx context.Context) (*Token, error) {
+	output, err := s.client.GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get ecr authorization token: %w", err)
+	}
+	if len(output.AuthorizationData) == 0 {
+		return nil, fmt.Errorf("the ecr response contains no authorization data")
+	}
+	authData := output.AuthorizationData[0]
+
+	decoded, err := base64.StdEncoding.DecodeString(aws.ToString(authData.AuthorizationToken))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode ecr authorization token: %w", err)
+	}
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return nil, fmt.Errorf("the ecr authorization token has an invalid format")
+	}
+
+	token := &Token{
+		Auth: Auth{
+			Username: username,
+			Password: password,
+		},
+	}
+	if authData.ExpiresAt != nil {
+		token.Expiry = *authData.ExpiresAt
+	}
+	return token, nil
+}
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package registrycredentials implements the per-registry credentials of contexts.
+// It matches registry references against the registry patterns of the context and resolves the credentials of the
+// best matching entry, either from a secret or from a token source.
+// Token sources of contexts obtain their input from a secret. Token sources that use the identity of the landscaper
+// are only defined in the landscaper configuration, and are referenced by name from contexts in allowed namespaces.
+package registrycredentials
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	dockerreference "github.com/containerd/containerd/reference/docker"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// Keys of the secrets of registry credentials without a token source.
+const (
+	UsernameKey = "username"
+	PasswordKey = "password"
+	TokenKey    = "token"
+)
+
+const (
+	dockerHubDomain       = "docker.io"
+	dockerHubLegacyDomain = "index.docker.io"
+)
+
+// Auth contains the credentials for a registry.
+type Auth struct {
+	// Username is the username for basic authentication.
+	Username string
+	// Password is the password for basic authentication.
+	Password string
+	// Token is a bearer token. It is only used if no username is set.
+	Token string
+}
+
+// IsEmpty checks whether the auth contains no credentials.
+func (a *Auth) IsEmpty() bool {
+	return a == nil || (len(a.Username) == 0 && len(a.Password) == 0 && len(a.Token) == 0)
+}
+
+// Credentials contains the registry credentials of a context.
+type Credentials struct {
+	entries []entry
+}
+
+type entry struct {
+	// registry is the configured registry pattern
+	registry string
+	host     *regexp.Regexp
+	// wildcards is the number of wildcards in the host pattern
+	wildcards  int
+	repoPrefix string
+
+	auth        *Auth
+	tokenSource TokenSource
+}
+
+// NewCredentials reads the secrets of the given registry credentials from the namespace of the context.
+// The token sources are only created; tokens are requested when the credentials of a matching registry are needed.
+func NewCredentials(ctx context.Context, kubeClient client.Client, namespace string, configs []lsv1alpha1.RegistryCredential) (*Credentials, error) {
+	credentials := &Credentials{}
+	for i, config := range configs {
+		host, repoPrefix := splitRegistry(config.Registry)
+		if len(host) == 0 {
+			return nil, fmt.Errorf("registry credential %d has no registry", i)
+		}
+
+		var secretData map[string][]byte
+		if config.SecretRef != nil {
+			secret := &corev1.Secret{}
+			if err := kubeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: config.SecretRef.Name}, secret); err != nil {
+				return nil, fmt.Errorf("unable to get secret %s/%s of registry credential %d: %w", namespace, config.SecretRef.Name, i, err)
+			}
+			secretData = secret.Data
+			if secretData == nil {
+				secretData = map[string][]byte{}
+			}
+		}
+
+		e := entry{
+			registry:   config.Registry,
+			host:       patternToRegexp(host),
+			wildcards:  strings.Count(host, "*"),
+			repoPrefix: repoPrefix,
+		}
+		if config.TokenSource != nil {
+			tokenSource, err := newTokenSourceOfCredential(ctx, namespace, config, secretData)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create token source of registry credential %d: %w", i, err)
+			}
+			e.tokenSource = tokenSource
+		} else {
+			auth, err := authFromSecretData(secretData)
+			if err != nil {
+				return nil, fmt.Errorf("invalid secret of registry credential %d: %w", i, err)
+			}
+			e.auth = auth
+		}
+		credentials.entries = append(credentials.entries, e)
+	}
+
+	// the most specific entry comes first: the longest repository prefix wins, then the host pattern with the fewest
+	// wildcards. Otherwise, the order of the configuration is kept.
+	sort.SliceStable(credentials.entries, func(i, j int) bool {
+		a, b := credentials.entries[i], credentials.entries[j]
+		if len(a.repoPrefix) != len(b.repoPrefix) {
+			return len(a.repoPrefix) > len(b.repoPrefix)
+		}
+		return a.wildcards < b.wildcards
+	})
+	return credentials, nil
+}
+
+// newTokenSourceOfCredential creates the token source of a registry credential.
+// A token source of the landscaper configuration is only created if contexts in the given namespace may use it for the
+// registry of the credential.
+// A token source that is defined in the context requires a secret, so that it cannot use the identity of the landscaper.
+func newTokenSourceOfCredential(ctx context.Context, namespace string, config lsv1alpha1.RegistryCredential, secretData map[string][]byte) (TokenSource, error) {
+	if len(config.TokenSource.Name) != 0 {
+		if len(config.TokenSource.Type) != 0 || config.TokenSource.Config != nil || config.SecretRef != nil {
+			return nil, fmt.Errorf("a token source of the landscaper configuration must not be combined with a type, a configuration or a secret")
+		}
+		operatorTokenSource, err := getOperatorTokenSource(config.TokenSource.Name, namespace, config.Registry)
+		if err != nil {
+			return nil, err
+		}
+		var tokenSourceConfig []byte
+		if operatorTokenSource.Config != nil {
+			tokenSourceConfig = operatorTokenSource.Config.RawMessage
+		}
+		return NewTokenSource(ctx, operatorTokenSource.Type, tokenSourceConfig, nil)
+	}
+
+	if secretData == nil {
+		return nil, fmt.Errorf("a token source of type %q requires a secret", config.TokenSource.Type)
+	}
+	var tokenSourceConfig []byte
+	if config.TokenSource.Config != nil {
+		tokenSourceConfig = config.TokenSource.Config.RawMessage
+	}
+	return NewTokenSource(ctx, config.TokenSource.Type, tokenSourceConfig, secretData)
+}
+
+// authFromSecretData reads basic auth or bearer token credentials from the data of a secret.
+func authFromSecretData(data map[string][]byte) (*Auth, error) {
+	if data == nil {
+		return nil, fmt.Errorf("a secret is required if no token source is defined")
+	}
+	auth := &Auth{
+		Username: string(data[UsernameKey]),
+		Password: string(data[PasswordKey]),
+		Token:    string(data[TokenKey]),
+	}
+	if len(auth.Token) == 0 && (len(auth.Username) == 0 || len(auth.Password) == 0) {
+		return nil, fmt.Errorf("the secret must contain either the keys %q and %q, or the key %q", UsernameKey, PasswordKey, TokenKey)
+	}
+	return auth, nil
+}
+
+// IsEmpty checks whether no registry credentials are defined.
+func (c *Credentials) IsEmpty() bool {
+	return c == nil || len(c.entries) == 0
+}
+
+// Matches checks whether a registry credential matches the given reference.
+func (c *Credentials) Matches(ref string) bool {
+	return c.match(ref) != nil
+}
+
+// Get returns the credentials of the most specific registry credential that matches the given reference.
+// The reference is an oci reference, a repository or a registry host, optionally with a port.
+// Nil is returned if no registry credential matches.
+func (c *Credentials) Get(ctx context.Context, ref string) (*Auth, error) {
+	e := c.match(ref)
+	if e == nil {
+		return nil, nil
+	}
+	if e.tokenSource == nil {
+		return e.auth, nil
+	}
+	token, err := e.tokenSource.Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get token for registry %q: %w", e.registry, err)
+	}
+	return &token.Auth, nil
+}
+
+func (c *Credentials) match(ref string) *entry {
+	if c == nil {
+		return nil
+	}
+	host, repo := splitReference(ref)
+	for i := range c.entries {
+		e := &c.entries[i]
+		if e.host.MatchString(host) && hasRepoPrefix(repo, e.repoPrefix) {
+			return e
+		}
+	}
+	return nil
+}
+
+// hasRepoPrefix checks whether the repository is the given prefix or a sub-repository of it.
+func hasRepoPrefix(repo, prefix string) bool {
+	return len(prefix) == 0 || repo == prefix || strings.HasPrefix(repo, prefix+"/")
+}
+
+// splitRegistry splits a registry pattern into the host with the optional port and the repository prefix.
+func splitRegistry(registry string) (string, string) {
+	registry = stripScheme(registry)
+	host, repoPrefix, _ := strings.Cut(registry, "/")
+	return normalizeHost(host), strings.Trim(repoPrefix, "/")
+}
+
+// splitReference splits a reference into the host with the optional port and the repository.
+// Tags and digests are removed, and references to docker hub are normalized.
+func splitReference(ref string) (string, string) {
+	ref = stripScheme(ref)
+	if !strings.Contains(ref, "/") && isHost(ref) {
+		// a registry host without repository
+		return normalizeHost(ref), ""
+	}
+	if named, err := dockerreference.ParseNormalizedNamed(ref); err == nil {
+		return normalizeHost(dockerreference.Domain(named)), dockerreference.Path(named)
+	}
+	host, repo, _ := strings.Cut(ref, "/")
+	return normalizeHost(host), strings.Trim(repo, "/")
+}
+
+// isHost checks whether a reference without repository is a registry host with an optional port rather than an
+// image of docker hub with an optional tag, e.g. "ubuntu:22.04".
+func isHost(ref string) bool {
+	host, _, _ := strings.Cut(ref, ":")
+	return strings.Contains(host, ".") || host == "localhost"
+}
+
+func stripScheme(ref string) string {
+	if _, rest, ok := strings.Cut(ref, "://"); ok {
+		return rest
+	}
+	return ref
+}
+
+func normalizeHost(host string) string {
+	if host == dockerHubLegacyDomain {
+		return dockerHubDomain
+	}
+	return host
+}
+
+// patternToRegexp converts a host pattern, where "*" matches any sequence of characters, to a regular expression.
+func patternToRegexp(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
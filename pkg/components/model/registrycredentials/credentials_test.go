@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registrycredentials_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gardener/component-cli/ociclient/credentials"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
+)
+
+var _ = Describe("Credentials", func() {
+
+	const namespace = "test"
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+	)
+
+	newSecret := func(name string, data map[string]string) *corev1.Secret {
+		secret := &corev1.Secret{}
+		secret.Name = name
+		secret.Namespace = namespace
+		secret.Data = map[string][]byte{}
+		for key, value := range data {
+			secret.Data[key] = []byte(value)
+		}
+		return secret
+	}
+
+	secretRef := func(name string) *corev1.LocalObjectReference {
+		return &corev1.LocalObjectReference{Name: name}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		kubeClient = fake.NewClientBuilder().WithObjects(
+			newSecret("host", map[string]string{"username": "host-user", "password": "host-password"}),
+			newSecret("team-a", map[string]string{"token": "team-a-token"}),
+			newSecret("wildcard", map[string]string{"username": "wildcard-user", "password": "wildcard-password"}),
+			newSecret("port", map[string]string{"username": "port-user", "password": "port-password"}),
+			newSecret("invalid", map[string]string{"username": "user"}),
+		).Build()
+	})
+
+	Context("Get", func() {
+
+		var registryCredentials *registrycredentials.Credentials
+
+		BeforeEach(func() {
+			var err error
+			registryCredentials, err = registrycredentials.NewCredentials(ctx, kubeClient, namespace, []lsv1alpha1.RegistryCredential{
+				{Registry: "*.example.com", SecretRef: secretRef("wildcard")},
+				{Registry: "registry.example.com", SecretRef: secretRef("host")},
+				{Registry: "registry.example.com/team-a", SecretRef: secretRef("team-a")},
+				{Registry: "localhost:5000", SecretRef: secretRef("port")},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		DescribeTable("should return the credentials of the most specific registry credential",
+			func(ref string, expected *registrycredentials.Auth) {
+				auth, err := registryCredentials.Get(ctx, ref)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(auth).To(Equal(expected))
+				Expect(registryCredentials.Matches(ref)).To(Equal(expected != nil))
+			},
+			Entry("host", "registry.example.com/app:1.0.0",
+				&registrycredentials.Auth{Username: "host-user", Password: "host-password"}),
+			Entry("host without repository", "registry.example.com",
+				&registrycredentials.Auth{Username: "host-user", Password: "host-password"}),
+			Entry("host with scheme", "https://registry.example.com/app",
+				&registrycredentials.Auth{Username: "host-user", Password: "host-password"}),
+			Entry("repository prefix", "registry.example.com/team-a/app@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+				&registrycredentials.Auth{Token: "team-a-token"}),
+			Entry("repository prefix itself", "registry.example.com/team-a",
+				&registrycredentials.Auth{Token: "team-a-token"}),
+			Entry("repository with the prefix as name prefix", "registry.example.com/team-ab/app",
+				&registrycredentials.Auth{Username: "host-user", Password: "host-password"}),
+			Entry("wildcard", "other.example.com/app:1.0.0",
+				&registrycredentials.Auth{Username: "wildcard-user", Password: "wildcard-password"}),
+			Entry("port", "localhost:5000/app:1.0.0",
+				&registrycredentials.Auth{Username: "port-user", Password: "port-password"}),
+			Entry("other port", "registry.example.com:5000/app", nil),
+			Entry("other host", "example.com/app", nil),
+			Entry("docker hub", "ubuntu:22.04", nil),
+		)
+	})
+
+	Context("Matching", func() {
+
+		DescribeTable("should normalize references to docker hub",
+			func(registry, ref string, matches bool) {
+				registryCredentials, err := registrycredentials.NewCredentials(ctx, kubeClient, namespace, []lsv1alpha1.RegistryCredential{
+					{Registry: registry, SecretRef: secretRef("host")},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(registryCredentials.Matches(ref)).To(Equal(matches))
+			},
+			Entry("official image", "docker.io", "ubuntu:22.04", true),
+			Entry("user image", "docker.io", "user/app:1.0.0", true),
+			Entry("legacy domain in the reference", "docker.io", "index.docker.io/library/ubuntu", true),
+			Entry("legacy domain in the pattern", "index.docker.io", "docker.io/library/ubuntu", true),
+			Entry("library prefix of official images", "docker.io/library", "ubuntu", true),
+			Entry("user prefix", "docker.io/user", "user/app", true),
+			Entry("other user", "docker.io/user", "other/app", false),
+			Entry("registry host without repository", "docker.io", "index.docker.io", true),
+		)
+
+		DescribeTable("should prefer the most specific pattern",
+			func(configs []lsv1alpha1.RegistryCredential, ref string, expected *registrycredentials.Auth) {
+				registryCredentials, err := registrycredentials.NewCredentials(ctx, kubeClient, namespace, configs)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(registryCredentials.Get(ctx, ref)).To(Equal(expected))
+			},
+			Entry("fewer wildcards",
+				[]lsv1alpha1.RegistryCredential{
+					{Registry: "*.*.example.com", SecretRef: secretRef("wildcard")},
+					{Registry: "*.eu.example.com", SecretRef: secretRef("host")},
+				},
+				"registry.eu.example.com/app",
+				&registrycredentials.Auth{Username: "host-user", Password: "host-password"}),
+			Entry("repository prefix before fewer wildcards",
+				[]lsv1alpha1.RegistryCredential{
+					{Registry: "registry.example.com", SecretRef: secretRef("host")},
+					{Registry: "*.example.com/team-a", SecretRef: secretRef("team-a")},
+				},
+				"registry.example.com/team-a/app",
+				&registrycredentials.Auth{Token: "team-a-token"}),
+			Entry("order of the configuration for equally specific patterns",
+				[]lsv1alpha1.RegistryCredential{
+					{Registry: "registry.*.com", SecretRef: secretRef("wildcard")},
+					{Registry: "*.example.com", SecretRef: secretRef("host")},
+				},
+				"registry.example.com/app",
+				&registrycredentials.Auth{Username: "wildcard-user", Password: "wildcard-password"}),
+		)
+
+		DescribeTable("should match ports exactly",
+			func(registry, ref string, matches bool) {
+				registryCredentials, err := registrycredentials.NewCredentials(ctx, kubeClient, namespace, []lsv1alpha1.RegistryCredential{
+					{Registry: registry, SecretRef: secretRef("port")},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(registryCredentials.Matches(ref)).To(Equal(matches))
+			},
+			Entry("same port", "registry.example.com:5000", "registry.example.com:5000/app:1.0.0", true),
+			Entry("same port with digest", "registry.example.com:5000/team-a",
+				"registry.example.com:5000/team-a/app@sha256:0000000000000000000000000000000000000000000000000000000000000000", true),
+			Entry("host with port without repository", "registry.example.com:5000", "registry.example.com:5000", true),
+			Entry("other port", "registry.example.com:5000", "registry.example.com:5001/app", false),
+			Entry("no port", "registry.example.com:5000", "registry.example.com/app", false),
+			Entry("pattern without port", "registry.example.com", "registry.example.com:5000/app", false),
+			Entry("wildcard pattern without port", "*.example.com", "registry.example.com:5000/app", false),
+			Entry("localhost without port", "localhost", "localhost/app", true),
+			Entry("localhost with other port", "localhost", "localhost:5000/app", false),
+		)
+	})
+
+	It("should fail if a secret contains no credentials", func() {
+		_, err := registrycredentials.NewCredentials(ctx, kubeClient, namespace, []lsv1alpha1.RegistryCredential{
+			{Registry: "registry.example.com", SecretRef: secretRef("invalid")},
+		})
+		Expect(err).To(HaveOccurred())
+
+		_, err = registrycredentials.NewCredentials(ctx, kubeClient, namespace, []lsv1alpha1.RegistryCredential{
+			{Registry: "registry.example.com"},
+		})
+		Expect(err).To(HaveOccurred())
+
+		_, err = registrycredentials.NewCredentials(ctx, kubeClient, namespace, []lsv1alpha1.RegistryCredential{
+			{Registry: "registry.example.com", SecretRef: secretRef("missing")},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail for unknown token source types", func() {
+		_, err := registrycredentials.NewCredentials(ctx, kubeClient, namespace, []lsv1alpha1.RegistryCredential{
+			{Registry: "registry.example.com", SecretRef: secretRef("host"), TokenSource: &lsv1alpha1.RegistryTokenSource{Type: "unknown"}},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("Keyring", func() {
+
+		It("should use the registry credentials for matching references and the base keyring otherwise", func() {
+			registryCredentials, err := registrycredentials.NewCredentials(ctx, kubeClient, namespace, []lsv1alpha1.RegistryCredential{
+				{Registry: "registry.example.com/team-a", SecretRef: secretRef("team-a")},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			base := credentials.New()
+			Expect(base.AddAuthConfig("registry.example.com", credentials.AuthConfig{Username: "base-user", Password: "base-password"})).To(Succeed())
+			keyring := registrycredentials.NewKeyring(base, registryCredentials)
+
+			Expect(keyring.Get("registry.example.com/team-a/app:1.0.0")).To(Equal(credentials.AuthConfig{RegistryToken: "team-a-token"}))
+			Expect(keyring.Get("registry.example.com/team-b/app:1.0.0").GetUsername()).To(Equal("base-user"))
+
+			repo, err := name.NewRepository("registry.example.com/team-a/app")
+			Expect(err).ToNot(HaveOccurred())
+			authenticator, err := keyring.ResolveWithContext(ctx, repo)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authenticator.Authorization()).To(Equal(&authn.AuthConfig{RegistryToken: "team-a-token"}))
+
+			repo, err = name.NewRepository("registry.example.com/team-b/app")
+			Expect(err).ToNot(HaveOccurred())
+			authenticator, err = keyring.ResolveWithContext(ctx, repo)
+			Expect(err).ToNot(HaveOccurred())
+			authConfig, err := authenticator.Authorization()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authConfig.Username).To(Equal("base-user"))
+		})
+	})
+
+	Context("PullSecret", func() {
+
+		It("should create a pull secret for the repository of a matching reference", func() {
+			registryCredentials, err := registrycredentials.NewCredentials(ctx, kubeClient, namespace, []lsv1alpha1.RegistryCredential{
+				{Registry: "registry.example.com", SecretRef: secretRef("host")},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			secret, err := registryCredentials.PullSecret(ctx, "registry.example.com/charts/app:1.0.0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret.Type).To(Equal(corev1.SecretTypeDockerConfigJson))
+
+			dockerConfig := map[string]map[string]map[string]string{}
+			Expect(json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &dockerConfig)).To(Succeed())
+			Expect(dockerConfig["auths"]).To(HaveKey("registry.example.com/charts/app"))
+			Expect(dockerConfig["auths"]["registry.example.com/charts/app"]).To(HaveKeyWithValue("username", "host-user"))
+			Expect(dockerConfig["auths"]["registry.example.com/charts/app"]).To(HaveKeyWithValue("password", "host-password"))
+
+			secret, err = registryCredentials.PullSecret(ctx, "other.example.com/charts/app:1.0.0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registrycredentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/oauth2/google"
+)
+
+// GCPTokenSourceType is the type of token sources that obtain an access token for the Google Artifact Registry.
+const GCPTokenSourceType = "gcp"
+
+// GCPCredentialsKey is the key of the secret that contains the service account key or the workload identity
+// federation configuration.
+const GCPCredentialsKey = "credentials.json"
+
+// gcpUsername is the username for access tokens of the Google Artifact Registry and Container Registry.
+const gcpUsername = "oauth2accesstoken"
+
+// defaultGCPScope is the read-only scope, which suffices to pull from the Google Artifact Registry and Container Registry.
+const defaultGCPScope = "https://www.googleapis.com/auth/cloud-platform.read-only"
+
+// Types of google credentials that are supported in secrets.
+const (
+	gcpServiceAccountCredentialsType = "service_account"
+	gcpUserCredentialsType           = "authorized_user"
+)
+
+// GCPConfig is the configuration of a gcp token source.
+type GCPConfig struct {
+	// Scopes are the requested scopes. Defaults to "https://www.googleapis.com/auth/cloud-platform.read-only".
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+func init() {
+	RegisterTokenSourceType(GCPTokenSourceType, NewGCPTokenSource)
+}
+
+// NewGCPTokenSource creates a token source that obtains access tokens with the google credentials in the key
+// "credentials.json" of the secret. Without a secret, i.e. for token sources of the landscaper configuration,
+// the application default credentials of the landscaper are used, e.g. its workload identity.
+func NewGCPTokenSource(ctx context.Context, rawConfig json.RawMessage, secretData map[string][]byte) (TokenSource, error) {
+	config := GCPConfig{}
+	if len(rawConfig) != 0 {
+		if err := json.Unmarshal(rawConfig, &config); err != nil {
+			return nil, fmt.Errorf("unable to parse gcp token source configuration: %w", err)
+		}
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{defaultGCPScope}
+	}
+
+	var (
+		credentials *google.Credentials
+		err         error
+	)
+	if secretData != nil {
+		data, ok := secretData[GCPCredentialsKey]
+		if !ok {
+			return nil, fmt.Errorf("the secret of the gcp token source must contain the key %q", GCPCredentialsKey)
+		}
+		if err := checkGCPCredentialsType(data); err != nil {
+			return nil, err
+		}
+		credentials, err = google.CredentialsFromJSON(ctx, data, config.Scopes...)
+	} else {
+		credentials, err = google.FindDefaultCredentials(ctx, config.Scopes...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to load gcp credentials: %w", err)
+	}
+	return &gcpTokenSource{credentials: credentials}, nil
+}
+
+// checkGCPCredentialsType checks that the google credentials of a secret are a service account key or the
+// credentials of a user. Other types, e.g. workload identity federation configurations, may read files or the
+// metadata of the environment of the landscaper, so they are only supported in the landscaper configuration.
+func checkGCPCredentialsType(data []byte) error {
+	credentials := struct {
+		Type string `json:"type"`
+	}{}
+	if err := json.Unmarshal(data, &credentials); err != nil {
+		return fmt.Errorf("unable to parse gcp credentials: %w", err)
+	}
+	switch credentials.Type {
+	case gcpServiceAccountCredentialsType, gcpUserCredentialsType:
+		return nil
+	default:
+		return fmt.Errorf("the gcp credentials of type %q are only supported in the landscaper configuration", credentials.Type)
+	}
+}
+
+type gcpTokenSource struct {
+	credentials *google.Credentials
+}
+
+func (s *gcpTokenSource) Token(_ context.Context) (*Token, error) {
+	token, err := s.credentials.TokenSource.Token()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get gcp access token: %w", err)
+	}
+	return &Token{
+		Auth: Auth{
+			Username: gcpUsername,
+			Password: token.AccessToken,
+		},
+		Expiry: token.Expiry,
+	}, nil
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registrycredentials
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gardener/component-cli/ociclient/credentials"
+	"github.com/google/go-containerregistry/pkg/authn"
+)
+
+// Keyring is an oci keyring that returns the registry credentials for matching references.
+// For all other references, the base keyring is used.
+type Keyring struct {
+	base credentials.OCIKeyring
+
+	credentials      *Credentials
+	credentialsMutex sync.RWMutex
+}
+
+var _ credentials.OCIKeyring = &Keyring{}
+
+// NewKeyring creates a keyring that returns the given registry credentials for matching references, and otherwise
+// the credentials of the base keyring.
+func NewKeyring(base credentials.OCIKeyring, registryCredentials *Credentials) *Keyring {
+	return &Keyring{
+		base:        base,
+		credentials: registryCredentials,
+	}
+}
+
+// SetCredentials sets the registry credentials of the keyring.
+func (k *Keyring) SetCredentials(registryCredentials *Credentials) {
+	k.credentialsMutex.Lock()
+	defer k.credentialsMutex.Unlock()
+	k.credentials = registryCredentials
+}
+
+func (k *Keyring) getCredentials() *Credentials {
+	k.credentialsMutex.RLock()
+	defer k.credentialsMutex.RUnlock()
+	return k.credentials
+}
+
+// get returns the auth config of a matching registry credential.
+// The second return value is false if no registry credential matches.
+func (k *Keyring) get(ctx context.Context, ref string) (credentials.Auth, bool, error) {
+	registryCredentials := k.getCredentials()
+	if !registryCredentials.Matches(ref) {
+		return nil, false, nil
+	}
+	auth, err := registryCredentials.Get(ctx, ref)
+	if err != nil {
+		return nil, true, err
+	}
+	return ToAuthConfig(auth), true, nil
+}
+
+// Get returns the credentials for the given resource url.
+// If the credentials of a matching registry credential cannot be obtained, no credentials are returned.
+func (k *Keyring) Get(resourceURl string) credentials.Auth {
+	auth, matched, err := k.get(context.Background(), resourceURl)
+	if !matched {
+		return k.base.Get(resourceURl)
+	}
+	if err != nil {
+		return nil
+	}
+	return auth
+}
+
+// GetCredentials returns the username and password for a hostname.
+func (k *Keyring) GetCredentials(hostname string) (string, string, error) {
+	auth, matched, err := k.get(context.Background(), hostname)
+	if !matched {
+		return k.base.GetCredentials(hostname)
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return auth.GetUsername(), auth.GetPassword(), nil
+}
+
+// Resolve implements the google container registry auth interface.
+func (k *Keyring) Resolve(resource authn.Resource) (authn.Authenticator, error) {
+	return k.ResolveWithContext(context.TODO(), resource)
+}
+
+// ResolveWithContext implements the google container registry auth interface.
+func (k *Keyring) ResolveWithContext(ctx context.Context, resource authn.Resource) (authn.Authenticator, error) {
+	auth, matched, err := k.get(ctx, resource.String())
+	if !matched {
+		return k.base.ResolveWithContext(ctx, resource)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return authn.FromConfig(authn.AuthConfig{
+		Username:      auth.GetUsername(),
+		Password:      auth.GetPassword(),
+		RegistryToken: auth.GetRegistryToken(),
+	}), nil
+}
+
+// ToAuthConfig converts registry credentials to the auth config of an oci keyring.
+// A bearer token is converted to a registry token.
+func ToAuthConfig(auth *Auth) credentials.AuthConfig {
+	if auth == nil {
+		return credentials.AuthConfig{}
+	}
+	if len(auth.Username) != 0 {
+		return credentials.AuthConfig{
+			Username: auth.Username,
+			Password: auth.Password,
+		}
+	}
+	return credentials.AuthConfig{
+		RegistryToken: auth.Token,
+	}
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registrycredentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// OAuth2TokenSourceType is the type of token sources that obtain an access token from an OAuth2 token endpoint.
+const OAuth2TokenSourceType = "oauth2"
+
+// Grant types of the OAuth2 token source.
+const (
+	ClientCredentialsGrantType = "client_credentials"
+	TokenExchangeGrantType     = "urn:ietf:params:oauth:grant-type:token-exchange"
+)
+
+// Keys of the secret of an OAuth2 token source.
+const (
+	ClientSecretKey = "clientSecret"
+	SubjectTokenKey = "subjectToken"
+)
+
+const defaultSubjectTokenType = "urn:ietf:params:oauth:token-type:jwt"
+
+// OAuth2Config is the configuration of an OAuth2 token source.
+type OAuth2Config struct {
+	// TokenURL is the url of the token endpoint.
+	TokenURL string `json:"tokenUrl"`
+	// GrantType is either "client_credentials" or "urn:ietf:params:oauth:grant-type:token-exchange".
+	// Defaults to "client_credentials".
+	GrantType string `json:"grantType,omitempty"`
+	// ClientID is the id of the client. The client secret is read from the key "clientSecret" of the secret.
+	ClientID string `json:"clientId,omitempty"`
+	// Scopes are the requested scopes.
+	Scopes []string `json:"scopes,omitempty"`
+	// Audience is the requested audience.
+	Audience string `json:"audience,omitempty"`
+	// SubjectTokenFile is the file that contains the subject token of a token exchange,
+	// e.g. a projected service account token of the landscaper.
+	// It is only supported for token sources of the landscaper configuration. Token sources of contexts read the
+	// subject token from the key "subjectToken" of the secret.
+	SubjectTokenFile string `json:"subjectTokenFile,omitempty"`
+	// SubjectTokenType is the type of the subject token. Defaults to "urn:ietf:params:oauth:token-type:jwt".
+	SubjectTokenType string `json:"subjectTokenType,omitempty"`
+	// Username is used together with the access token as password for basic authentication.
+	// If it is not set, the access token is used as bearer token.
+	Username string `json:"username,omitempty"`
+}
+
+func init() {
+	RegisterTokenSourceType(OAuth2TokenSourceType, NewOAuth2TokenSource)
+}
+
+// NewOAuth2TokenSource creates a token source that obtains an access token from an OAuth2 token endpoint,
+// either with client credentials or with a token exchange.
+func NewOAuth2TokenSource(_ context.Context, rawConfig json.RawMessage, secretData map[string][]byte) (TokenSource, error) {
+	config := OAuth2Config{}
+	if len(rawConfig) != 0 {
+		if err := json.Unmarshal(rawConfig, &config); err != nil {
+			return nil, fmt.Errorf("unable to parse oauth2 token source configuration: %w", err)
+		}
+	}
+	if len(config.TokenURL) == 0 {
+		return nil, fmt.Errorf("the oauth2 token source requires a token url")
+	}
+	if len(config.GrantType) == 0 {
+		config.GrantType = ClientCredentialsGrantType
+	}
+	if len(config.SubjectTokenType) == 0 {
+		config.SubjectTokenType = defaultSubjectTokenType
+	}
+	if len(config.SubjectTokenFile) != 0 && secretData != nil {
+		return nil, fmt.Errorf("the subject token file of the oauth2 token source is only supported in the landscaper configuration")
+	}
+
+	switch config.GrantType {
+	case ClientCredentialsGrantType:
+		if len(config.ClientID) == 0 {
+			return nil, fmt.Errorf("the oauth2 token source requires a client id for grant type %q", config.GrantType)
+		}
+	case TokenExchangeGrantType:
+		if len(config.SubjectTokenFile) == 0 && len(secretData[SubjectTokenKey]) == 0 {
+			return nil, fmt.Errorf("the oauth2 token source requires a subject token file or a secret with the key %q for grant type %q",
+				SubjectTokenKey, config.GrantType)
+		}
+	default:
+		return nil, fmt.Errorf("the oauth2 token source does not support the grant type %q", config.GrantType)
+	}
+
+	return &oauth2TokenSource{
+		config:       config,
+		clientSecret: string(secretData[ClientSecretKey]),
+		subjectToken: string(secretData[SubjectTokenKey]),
+	}, nil
+}
+
+type oauth2TokenSource struct {
+	config       OAuth2Config
+	clientSecret string
+	subjectToken string
+}
+
+func (s *oauth2TokenSource) Token(ctx context.Context) (*Token, error) {
+	var (
+		accessToken string
+		expiry      time.Time
+		err         error
+	)
+	if s.config.GrantType == ClientCredentialsGrantType {
+		accessToken, expiry, err = s.clientCredentials(ctx)
+	} else {
+		accessToken, expiry, err = s.exchangeToken(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token := &Token{Expiry: expiry}
+	if len(s.config.Username) != 0 {
+		token.Username = s.config.Username
+		token.Password = accessToken
+	} else {
+		token.Token = accessToken
+	}
+	return token, nil
+}
+
+func (s *oauth2TokenSource) clientCredentials(ctx context.Context) (string, time.Time, error) {
+	config := clientcredentials.Config{
+		ClientID:     s.config.ClientID,
+		ClientSecret: s.clientSecret,
+		TokenURL:     s.config.TokenURL,
+		Scopes:       s.config.Scopes,
+	}
+	if len(s.config.Audience) != 0 {
+		config.EndpointParams = url.Values{"audience": {s.config.Audience}}
+	}
+	token, err := config.Token(ctx)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("unable to get access token from %q: %w", s.config.TokenURL, err)
+	}
+	return token.AccessToken, token.Expiry, nil
+}
+
+// exchangeToken exchanges the subject token for an access token as defined in RFC 8693.
+func (s *oauth2TokenSource) exchangeToken(ctx context.Context) (string, time.Time, error) {
+	subjectToken := s.subjectToken
+	if len(s.config.SubjectTokenFile) != 0 {
+		// the file is read for every exchange, because projected tokens are rotated
+		data, err := os.ReadFile(s.config.SubjectTokenFile)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("unable to read subject token: %w", err)
+		}
+		subjectToken = strings.TrimSpace(string(data))
+	}
+
+	form := url.Values{
+		"grant_type":         {TokenExchangeGrantType},
+		"subject_token":      {subjectToken},
+		"subject_token_type": {s.config.SubjectTokenType},
+	}
+	if len(s.config.Audience) != 0 {
+		form.Set("audience", s.config.Audience)
+	}
+	if len(s.config.Scopes) != 0 {
+		form.Set("scope", strings.Join(s.config.Scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if len(s.config.ClientID) != 0 {
+		req.SetBasicAuth(url.QueryEscape(s.config.ClientID), url.QueryEscape(s.clientSecret))
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("unable to exchange token at %q: %w", s.config.TokenURL, err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("unable to read token exchange response from %q: %w", s.config.TokenURL, err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("unable to exchange token at %q: %s: %s", s.config.TokenURL, res.Status, string(body))
+	}
+
+	tokenResponse := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}{}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil {
+		return "", time.Time{}, fmt.Errorf("unable to parse token exchange response from %q: %w", s.config.TokenURL, err)
+	}
+	if len(tokenResponse.AccessToken) == 0 {
+		return "", time.Time{}, fmt.Errorf("the token exchange response from %q contains no access token", s.config.TokenURL)
+	}
+
+	var expiry time.Time
+	if tokenResponse.ExpiresIn > 0 {
+		expiry = time.Now().Add(time.Duration(tokenResponse.ExpiresIn) * time.Second)
+	}
+	return tokenResponse.AccessToken, expiry, nil
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registrycredentials
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+// allNamespaces is the entry of the namespaces of an operator token source that allows all namespaces.
+const allNamespaces = "*"
+
+// OperatorTokenSources contains the token sources of the landscaper configuration by their name.
+// In contrast to the token sources of contexts, they may use the identity of the landscaper.
+type OperatorTokenSources map[string]config.OperatorTokenSource
+
+var (
+	operatorTokenSources      = OperatorTokenSources{}
+	operatorTokenSourcesMutex sync.RWMutex
+)
+
+// OperatorTokenSourcesFromConfiguration validates and returns the token sources of the oci configuration.
+func OperatorTokenSourcesFromConfiguration(ociConfig *config.OCIConfiguration) (OperatorTokenSources, error) {
+	sources := OperatorTokenSources{}
+	if ociConfig == nil {
+		return sources, nil
+	}
+	for i, source := range ociConfig.TokenSources {
+		if len(source.Name) == 0 {
+			return nil, fmt.Errorf("token source %d has no name", i)
+		}
+		if _, ok := sources[source.Name]; ok {
+			return nil, fmt.Errorf("duplicate token source %q", source.Name)
+		}
+		if _, ok := getTokenSourceFactory(source.Type); !ok {
+			return nil, fmt.Errorf("token source %q has the unknown type %q", source.Name, source.Type)
+		}
+		if len(source.Registries) == 0 {
+			return nil, fmt.Errorf("token source %q allows no registries", source.Name)
+		}
+		sources[source.Name] = source
+	}
+	return sources, nil
+}
+
+// SetOperatorTokenSources sets the token sources that can be referenced by the registry credentials of contexts.
+func SetOperatorTokenSources(sources OperatorTokenSources) {
+	operatorTokenSourcesMutex.Lock()
+	defer operatorTokenSourcesMutex.Unlock()
+	operatorTokenSources = sources
+}
+
+// getOperatorTokenSource returns the token source of the landscaper configuration with the given name,
+// if contexts in the given namespace may use it for the given registry.
+func getOperatorTokenSource(name, namespace, registry string) (*config.OperatorTokenSource, error) {
+	operatorTokenSourcesMutex.RLock()
+	source, ok := operatorTokenSources[name]
+	operatorTokenSourcesMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("the landscaper configuration contains no token source %q", name)
+	}
+	if !namespaceAllowed(source.Namespaces, namespace) {
+		return nil, fmt.Errorf("contexts in namespace %q must not use the token source %q", namespace, name)
+	}
+	if !registryAllowed(source.Registries, registry) {
+		return nil, fmt.Errorf("the token source %q must not be used for registry %q", name, registry)
+	}
+	return &source, nil
+}
+
+func namespaceAllowed(allowed []string, namespace string) bool {
+	for _, ns := range allowed {
+		if ns == allNamespaces || ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// registryAllowed checks whether the registry pattern of a registry credential is covered by one of the allowed
+// registry patterns, i.e. every registry that the pattern matches is also matched by an allowed pattern.
+// A wildcard of the registry pattern is only covered by a wildcard of an allowed pattern, so the registry pattern
+// is matched literally.
+func registryAllowed(allowed []string, registry string) bool {
+	host, repoPrefix := splitRegistry(registry)
+	for _, pattern := range allowed {
+		allowedHost, allowedRepoPrefix := splitRegistry(pattern)
+		if patternToRegexp(allowedHost).MatchString(host) && hasRepoPrefix(repoPrefix, allowedRepoPrefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registrycredentials
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	dockerconfigfile "github.com/docker/cli/cli/config/configfile"
+	"github.com/docker/cli/cli/config/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PullSecret returns a pull secret of type dockerconfigjson with the credentials of the registry credential that
+// matches the given reference. The credentials are only defined for the repository of the reference.
+// Nil is returned if no registry credential matches.
+func (c *Credentials) PullSecret(ctx context.Context, ref string) (*corev1.Secret, error) {
+	auth, err := c.Get(ctx, ref)
+	if err != nil || auth == nil {
+		return nil, err
+	}
+
+	host, repo := splitReference(ref)
+	address := path.Join(host, repo)
+	dockerConfig := &dockerconfigfile.ConfigFile{
+		AuthConfigs: map[string]types.AuthConfig{
+			address: ToDockerAuthConfig(auth),
+		},
+	}
+	data, err := json.Marshal(dockerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create docker config for %s: %w", address, err)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Type = corev1.SecretTypeDockerConfigJson
+	secret.Data = map[string][]byte{
+		corev1.DockerConfigJsonKey: data,
+	}
+	return secret, nil
+}
+
+// ToDockerAuthConfig converts registry credentials to the auth config of a docker config file.
+// A bearer token is converted to a registry token.
+func ToDockerAuthConfig(auth *Auth) types.AuthConfig {
+	if auth == nil {
+		return types.AuthConfig{}
+	}
+	if len(auth.Username) != 0 {
+		return types.AuthConfig{
+			Username: auth.Username,
+			Password: auth.Password,
+			Auth:     base64.StdEncoding.EncodeToString([]byte(auth.Username + ":" + auth.Password)),
+		}
+	}
+	return types.AuthConfig{
+		RegistryToken: auth.Token,
+	}
+}
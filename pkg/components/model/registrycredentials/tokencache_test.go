@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registrycredentials
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Token Cache", func() {
+
+	It("should evict expired and unused tokens", func() {
+		c := newTokenCache()
+		now := time.Now()
+
+		c.get("valid").token = &Token{Expiry: now.Add(time.Hour)}
+		c.get("expired").token = &Token{Expiry: now.Add(time.Second)}
+		c.get("failed")
+		c.get("unused").token = &Token{}
+		c.entries["unused"].lastUsed = now.Add(-2 * maxUnusedTime)
+
+		c.evict(now)
+		Expect(c.entries).To(HaveLen(1))
+		Expect(c.entries).To(HaveKey("valid"))
+	})
+
+	It("should keep tokens that are currently requested", func() {
+		c := newTokenCache()
+		entry := c.get("requested")
+		entry.mutex.Lock()
+		defer entry.mutex.Unlock()
+
+		c.evict(time.Now())
+		Expect(c.entries).To(HaveKey("requested"))
+	})
+
+	It("should evict on access after the eviction interval", func() {
+		c := newTokenCache()
+		c.get("expired").token = &Token{Expiry: time.Now().Add(-time.Minute)}
+		c.lastEviction = time.Now().Add(-2 * evictionInterval)
+
+		c.get("other")
+		Expect(c.entries).To(HaveLen(1))
+		Expect(c.entries).To(HaveKey("other"))
+	})
+})
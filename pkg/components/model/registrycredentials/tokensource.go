@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registrycredentials
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// expiryDelta is the time before the expiry of a token when it is refreshed.
+const expiryDelta = time.Minute
+
+const (
+	// evictionInterval is the minimal time between two evictions of the token cache.
+	evictionInterval = 10 * time.Minute
+	// maxUnusedTime is the time after which unused tokens are evicted, even if they do not expire.
+	maxUnusedTime = time.Hour
+)
+
+// Token contains short-lived credentials obtained by a token source.
+type Token struct {
+	Auth
+	// Expiry is the time when the credentials expire. A zero value means that they do not expire.
+	Expiry time.Time
+}
+
+// valid checks whether the token is not expired.
+func (t *Token) valid() bool {
+	return t.validAt(time.Now())
+}
+
+// validAt checks whether the token is not expired at the given time.
+func (t *Token) validAt(now time.Time) bool {
+	return t != nil && (t.Expiry.IsZero() || now.Add(expiryDelta).Before(t.Expiry))
+}
+
+// TokenSource obtains short-lived registry credentials.
+type TokenSource interface {
+	// Token returns new credentials.
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenSourceFactory creates a token source from the type specific configuration and the data of the referenced secret.
+// The secret data is nil for the token sources of the landscaper configuration. Only these may use the identity of
+// the landscaper, e.g. its default credentials or files of its filesystem. Token sources of contexts always have
+// secret data, and must not use the identity of the landscaper.
+type TokenSourceFactory func(ctx context.Context, config json.RawMessage, secretData map[string][]byte) (TokenSource, error)
+
+var (
+	tokenSourceTypes      = map[string]TokenSourceFactory{}
+	tokenSourceTypesMutex sync.RWMutex
+)
+
+// RegisterTokenSourceType registers a factory for token sources of the given type.
+// An already registered factory of the type is replaced.
+func RegisterTokenSourceType(typ string, factory TokenSourceFactory) {
+	tokenSourceTypesMutex.Lock()
+	defer tokenSourceTypesMutex.Unlock()
+	tokenSourceTypes[typ] = factory
+}
+
+// NewTokenSource creates a token source of the given type.
+// The tokens of the returned token source are cached until they expire. The cache is shared by all token sources
+// with the same type, configuration and secret data.
+func NewTokenSource(ctx context.Context, typ string, config json.RawMessage, secretData map[string][]byte) (TokenSource, error) {
+	factory, ok := getTokenSourceFactory(typ)
+	if !ok {
+		return nil, fmt.Errorf("unknown token source type %q", typ)
+	}
+
+	tokenSource, err := factory(ctx, config, secretData)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedTokenSource{
+		key:         cacheKey(typ, config, secretData),
+		tokenSource: tokenSource,
+	}, nil
+}
+
+func getTokenSourceFactory(typ string) (TokenSourceFactory, bool) {
+	tokenSourceTypesMutex.RLock()
+	defer tokenSourceTypesMutex.RUnlock()
+	factory, ok := tokenSourceTypes[typ]
+	return factory, ok
+}
+
+// cachedTokens contains the tokens of all token sources by their cache key.
+var cachedTokens = newTokenCache()
+
+// tokenCache caches tokens by the cache key of their token source.
+// Expired tokens and tokens that have not been used for some time are evicted, so that the entries of outdated
+// configurations and rotated secrets are removed.
+type tokenCache struct {
+	entries      map[string]*tokenCacheEntry
+	lastEviction time.Time
+	mutex        sync.Mutex
+}
+
+type tokenCacheEntry struct {
+	token *Token
+	// lastUsed is protected by the mutex of the cache
+	lastUsed time.Time
+	// mutex ensures that only one token is requested at a time for the same key
+	mutex sync.Mutex
+}
+
+func newTokenCache() *tokenCache {
+	return &tokenCache{
+		entries: map[string]*tokenCacheEntry{},
+	}
+}
+
+// get returns the entry of the given key and creates it if it does not exist.
+func (c *tokenCache) get(key string) *tokenCacheEntry {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastEviction) > evictionInterval {
+		c.evict(now)
+	}
+
+	cacheEntry, ok := c.entries[key]
+	if !ok {
+		cacheEntry = &tokenCacheEntry{}
+		c.entries[key] = cacheEntry
+	}
+	cacheEntry.lastUsed = now
+	return cacheEntry
+}
+
+// evict removes the entries that have not been used within the maximal unused time or whose token is expired.
+// Entries whose token is currently requested are kept. The mutex of the cache must be held by the caller.
+func (c *tokenCache) evict(now time.Time) {
+	c.lastEviction = now
+	for key, cacheEntry := range c.entries {
+		if now.Sub(cacheEntry.lastUsed) > maxUnusedTime {
+			delete(c.entries, key)
+			continue
+		}
+		if !cacheEntry.mutex.TryLock() {
+			continue
+		}
+		if !cacheEntry.token.validAt(now) {
+			delete(c.entries, key)
+		}
+		cacheEntry.mutex.Unlock()
+	}
+}
+
+// cachedTokenSource returns the cached token until it expires.
+type cachedTokenSource struct {
+	key         string
+	tokenSource TokenSource
+}
+
+func (s *cachedTokenSource) Token(ctx context.Context) (*Token, error) {
+	cacheEntry := cachedTokens.get(s.key)
+	cacheEntry.mutex.Lock()
+	defer cacheEntry.mutex.Unlock()
+	if cacheEntry.token.valid() {
+		return cacheEntry.token, nil
+	}
+	token, err := s.tokenSource.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cacheEntry.token = token
+	return token, nil
+}
+
+// cacheKey computes a hash of the type, the configuration and the secret data of a token source.
+// Token sources of the landscaper configuration, which have no secret data, never share the key of a token source
+// of a context.
+func cacheKey(typ string, config json.RawMessage, secretData map[string][]byte) string {
+	keys := make([]string, 0, len(secretData))
+	for key := range secretData {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	writeField := func(data []byte) {
+		_, _ = fmt.Fprintf(h, "%d:", len(data))
+		_, _ = h.Write(data)
+	}
+	writeField([]byte(typ))
+	writeField([]byte(strconv.FormatBool(secretData == nil)))
+	writeField(config)
+	for _, key := range keys {
+		writeField([]byte(key))
+		writeField(secretData[key])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,323 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registrycredentials_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/landscaper/apis/config"
+	lscore "github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
+)
+
+// countingTokenSource returns a new token with the given lifetime on every call.
+type countingTokenSource struct {
+	calls    *int
+	lifetime time.Duration
+}
+
+func (s *countingTokenSource) Token(_ context.Context) (*registrycredentials.Token, error) {
+	*s.calls++
+	return &registrycredentials.Token{
+		Auth:   registrycredentials.Auth{Token: fmt.Sprintf("token-%d", *s.calls)},
+		Expiry: time.Now().Add(s.lifetime),
+	}, nil
+}
+
+var _ = Describe("Token Sources", func() {
+
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should cache tokens until shortly before they expire", func() {
+		calls := 0
+		registrycredentials.RegisterTokenSourceType("counting", func(_ context.Context, config json.RawMessage, _ map[string][]byte) (registrycredentials.TokenSource, error) {
+			lifetime, err := time.ParseDuration(string(config))
+			if err != nil {
+				return nil, err
+			}
+			return &countingTokenSource{calls: &calls, lifetime: lifetime}, nil
+		})
+
+		longLived, err := registrycredentials.NewTokenSource(ctx, "counting", []byte("1h"), nil)
+		Expect(err).ToNot(HaveOccurred())
+		token, err := longLived.Token(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.Token).To(Equal("token-1"))
+
+		// the cache is shared by token sources with the same configuration
+		longLived, err = registrycredentials.NewTokenSource(ctx, "counting", []byte("1h"), nil)
+		Expect(err).ToNot(HaveOccurred())
+		token, err = longLived.Token(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.Token).To(Equal("token-1"))
+
+		shortLived, err := registrycredentials.NewTokenSource(ctx, "counting", []byte("30s"), nil)
+		Expect(err).ToNot(HaveOccurred())
+		token, err = shortLived.Token(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.Token).To(Equal("token-2"))
+		token, err = shortLived.Token(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.Token).To(Equal("token-3"))
+	})
+
+	Context("oauth2", func() {
+
+		var (
+			server   *httptest.Server
+			requests []*http.Request
+		)
+
+		BeforeEach(func() {
+			requests = nil
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.ParseForm()).To(Succeed())
+				requests = append(requests, r)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"access_token":"access-%d","token_type":"Bearer","expires_in":3600}`, len(requests))
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		newCredentials := func(config string, secretData map[string]string) *registrycredentials.Credentials {
+			secret := &corev1.Secret{}
+			secret.Name = "oauth2"
+			secret.Namespace = "test"
+			secret.Data = map[string][]byte{}
+			for key, value := range secretData {
+				secret.Data[key] = []byte(value)
+			}
+			kubeClient := fake.NewClientBuilder().WithObjects(secret).Build()
+
+			registryCredentials, err := registrycredentials.NewCredentials(ctx, kubeClient, "test", []lsv1alpha1.RegistryCredential{{
+				Registry:  "registry.example.com",
+				SecretRef: &corev1.LocalObjectReference{Name: secret.Name},
+				TokenSource: &lsv1alpha1.RegistryTokenSource{
+					Type:   registrycredentials.OAuth2TokenSourceType,
+					Config: lsv1alpha1.NewAnyJSONPointer([]byte(fmt.Sprintf(config, server.URL))),
+				},
+			}})
+			Expect(err).ToNot(HaveOccurred())
+			return registryCredentials
+		}
+
+		It("should obtain a bearer token with client credentials", func() {
+			registryCredentials := newCredentials(`{"tokenUrl":"%s","clientId":"client","scopes":["registry:pull"]}`,
+				map[string]string{"clientSecret": "secret"})
+
+			auth, err := registryCredentials.Get(ctx, "registry.example.com/app:1.0.0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(auth).To(Equal(&registrycredentials.Auth{Token: "access-1"}))
+
+			// the token is cached
+			auth, err = registryCredentials.Get(ctx, "registry.example.com/other:1.0.0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(auth).To(Equal(&registrycredentials.Auth{Token: "access-1"}))
+
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].PostForm.Get("grant_type")).To(Equal("client_credentials"))
+			Expect(requests[0].PostForm.Get("scope")).To(Equal("registry:pull"))
+		})
+
+		It("should exchange a subject token", func() {
+			registryCredentials := newCredentials(`{"tokenUrl":"%s","grantType":"urn:ietf:params:oauth:grant-type:token-exchange",`+
+				`"audience":"registry.example.com","username":"oauth2"}`,
+				map[string]string{"subjectToken": "subject"})
+
+			auth, err := registryCredentials.Get(ctx, "registry.example.com/app:1.0.0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(auth).To(Equal(&registrycredentials.Auth{Username: "oauth2", Password: "access-1"}))
+
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].PostForm.Get("grant_type")).To(Equal(registrycredentials.TokenExchangeGrantType))
+			Expect(requests[0].PostForm.Get("subject_token")).To(Equal("subject"))
+			Expect(requests[0].PostForm.Get("audience")).To(Equal("registry.example.com"))
+		})
+
+		It("should reject a subject token file in token sources of contexts", func() {
+			secret := &corev1.Secret{}
+			secret.Name = "oauth2"
+			secret.Namespace = "test"
+			kubeClient := fake.NewClientBuilder().WithObjects(secret).Build()
+
+			_, err := registrycredentials.NewCredentials(ctx, kubeClient, "test", []lsv1alpha1.RegistryCredential{{
+				Registry:  "registry.example.com",
+				SecretRef: &corev1.LocalObjectReference{Name: secret.Name},
+				TokenSource: &lsv1alpha1.RegistryTokenSource{
+					Type: registrycredentials.OAuth2TokenSourceType,
+					Config: lsv1alpha1.NewAnyJSONPointer([]byte(fmt.Sprintf(`{"tokenUrl":"%s","grantType":"%s","subjectTokenFile":"/etc/hostname"}`,
+						server.URL, registrycredentials.TokenExchangeGrantType))),
+				},
+			}})
+			Expect(err).To(HaveOccurred())
+			Expect(requests).To(BeEmpty())
+		})
+
+		Context("token sources of the landscaper configuration", func() {
+
+			BeforeEach(func() {
+				subjectTokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+				Expect(os.WriteFile(subjectTokenFile, []byte("landscaper-subject\n"), 0600)).To(Succeed())
+
+				operatorTokenSources, err := registrycredentials.OperatorTokenSourcesFromConfiguration(&config.OCIConfiguration{
+					TokenSources: []config.OperatorTokenSource{{
+						Name: "landscaper",
+						Type: registrycredentials.OAuth2TokenSourceType,
+						Config: lscore.NewAnyJSONPointer([]byte(fmt.Sprintf(`{"tokenUrl":"%s","grantType":"%s","subjectTokenFile":"%s"}`,
+							server.URL, registrycredentials.TokenExchangeGrantType, subjectTokenFile))),
+						Namespaces: []string{"test"},
+						Registries: []string{"registry.example.com/landscaper", "*.eu.example.com"},
+					}},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				registrycredentials.SetOperatorTokenSources(operatorTokenSources)
+			})
+
+			AfterEach(func() {
+				registrycredentials.SetOperatorTokenSources(registrycredentials.OperatorTokenSources{})
+			})
+
+			newCredentialsForRegistry := func(namespace, registry string, tokenSource *lsv1alpha1.RegistryTokenSource) (*registrycredentials.Credentials, error) {
+				return registrycredentials.NewCredentials(ctx, fake.NewClientBuilder().Build(), namespace, []lsv1alpha1.RegistryCredential{{
+					Registry:    registry,
+					TokenSource: tokenSource,
+				}})
+			}
+
+			newCredentials := func(namespace string, tokenSource *lsv1alpha1.RegistryTokenSource) (*registrycredentials.Credentials, error) {
+				return newCredentialsForRegistry(namespace, "registry.example.com/landscaper", tokenSource)
+			}
+
+			It("should exchange the subject token file for contexts in allowed namespaces", func() {
+				registryCredentials, err := newCredentials("test", &lsv1alpha1.RegistryTokenSource{Name: "landscaper"})
+				Expect(err).ToNot(HaveOccurred())
+
+				auth, err := registryCredentials.Get(ctx, "registry.example.com/landscaper/app:1.0.0")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(auth).To(Equal(&registrycredentials.Auth{Token: "access-1"}))
+				Expect(requests).To(HaveLen(1))
+				Expect(requests[0].PostForm.Get("subject_token")).To(Equal("landscaper-subject"))
+			})
+
+			It("should reject contexts in other namespaces", func() {
+				_, err := newCredentials("other", &lsv1alpha1.RegistryTokenSource{Name: "landscaper"})
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should accept registries that are covered by the allowed registries", func() {
+				for _, registry := range []string{"registry.example.com/landscaper/apps", "a.eu.example.com", "*.b.eu.example.com/apps"} {
+					_, err := newCredentialsForRegistry("test", registry, &lsv1alpha1.RegistryTokenSource{Name: "landscaper"})
+					Expect(err).ToNot(HaveOccurred(), registry)
+				}
+			})
+
+			It("should reject registries that are not covered by the allowed registries", func() {
+				for _, registry := range []string{"other.example.com", "registry.example.com", "registry.example.com/other",
+					"*.example.com", "registry.example.com:8443/landscaper"} {
+					_, err := newCredentialsForRegistry("test", registry, &lsv1alpha1.RegistryTokenSource{Name: "landscaper"})
+					Expect(err).To(HaveOccurred(), registry)
+				}
+				Expect(requests).To(BeEmpty())
+			})
+
+			It("should reject unknown token sources and token sources with a type", func() {
+				_, err := newCredentials("test", &lsv1alpha1.RegistryTokenSource{Name: "unknown"})
+				Expect(err).To(HaveOccurred())
+
+				_, err = newCredentials("test", &lsv1alpha1.RegistryTokenSource{Name: "landscaper", Type: registrycredentials.OAuth2TokenSourceType})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("OperatorTokenSourcesFromConfiguration", func() {
+
+		It("should reject token sources without name, with duplicate names, with unknown types or without registries", func() {
+			registries := []string{"registry.example.com"}
+			_, err := registrycredentials.OperatorTokenSourcesFromConfiguration(&config.OCIConfiguration{
+				TokenSources: []config.OperatorTokenSource{{Type: registrycredentials.AWSTokenSourceType, Registries: registries}},
+			})
+			Expect(err).To(HaveOccurred())
+
+			_, err = registrycredentials.OperatorTokenSourcesFromConfiguration(&config.OCIConfiguration{
+				TokenSources: []config.OperatorTokenSource{
+					{Name: "a", Type: registrycredentials.AWSTokenSourceType, Registries: registries},
+					{Name: "a", Type: registrycredentials.GCPTokenSourceType, Registries: registries},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+
+			_, err = registrycredentials.OperatorTokenSourcesFromConfiguration(&config.OCIConfiguration{
+				TokenSources: []config.OperatorTokenSource{{Name: "a", Type: "unknown", Registries: registries}},
+			})
+			Expect(err).To(HaveOccurred())
+
+			_, err = registrycredentials.OperatorTokenSourcesFromConfiguration(&config.OCIConfiguration{
+				TokenSources: []config.OperatorTokenSource{{Name: "a", Type: registrycredentials.AWSTokenSourceType}},
+			})
+			Expect(err).To(HaveOccurred())
+
+			_, err = registrycredentials.OperatorTokenSourcesFromConfiguration(&config.OCIConfiguration{
+				TokenSources: []config.OperatorTokenSource{{Name: "a", Type: registrycredentials.AWSTokenSourceType, Registries: registries}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("tenant token sources", func() {
+
+		var kubeClient client.Client
+
+		BeforeEach(func() {
+			secret := &corev1.Secret{}
+			secret.Name = "gcp"
+			secret.Namespace = "test"
+			secret.Data = map[string][]byte{
+				registrycredentials.GCPCredentialsKey: []byte(`{"type":"external_account","audience":"example","subject_token_type":"urn:ietf:params:oauth:token-type:jwt",` +
+					`"token_url":"https://sts.googleapis.com/v1/token","credential_source":{"file":"/var/run/secrets/kubernetes.io/serviceaccount/token"}}`),
+			}
+			kubeClient = fake.NewClientBuilder().WithObjects(secret).Build()
+		})
+
+		It("should require a secret", func() {
+			_, err := registrycredentials.NewCredentials(ctx, kubeClient, "test", []lsv1alpha1.RegistryCredential{{
+				Registry:    "registry.example.com",
+				TokenSource: &lsv1alpha1.RegistryTokenSource{Type: registrycredentials.GCPTokenSourceType},
+			}})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject gcp credentials that may use the environment of the landscaper", func() {
+			_, err := registrycredentials.NewCredentials(ctx, kubeClient, "test", []lsv1alpha1.RegistryCredential{{
+				Registry:    "registry.example.com",
+				SecretRef:   &corev1.LocalObjectReference{Name: "gcp"},
+				TokenSource: &lsv1alpha1.RegistryTokenSource{Type: registrycredentials.GCPTokenSourceType},
+			}})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("external_account"))
+		})
+	})
+})
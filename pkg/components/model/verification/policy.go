@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package verification
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// Policy defines how the signature of a component version is verified.
+type Policy struct {
+	// Mode defines whether a signature is required.
+	Mode lsv1alpha1.VerificationMode
+	// SignatureName is the name of the verified signature.
+	// If it is empty, any signature of the component version is verified.
+	SignatureName string
+	// PublicKeys are the trusted public keys.
+	PublicKeys []crypto.PublicKey
+}
+
+// Policies contains the verification policies of a context.
+type Policies struct {
+	policies []componentPolicy
+}
+
+type componentPolicy struct {
+	pattern *regexp.Regexp
+	policy  *Policy
+}
+
+// NewPolicies reads the trusted keys of the given verification configuration from the secrets in the namespace of the context.
+func NewPolicies(ctx context.Context, kubeClient client.Client, namespace string, config *lsv1alpha1.ComponentVerification) (*Policies, error) {
+	policies := &Policies{}
+	if config == nil {
+		return policies, nil
+	}
+
+	for i, policyConfig := range config.Policies {
+		switch policyConfig.Mode {
+		case lsv1alpha1.VerificationModeRequired, lsv1alpha1.VerificationModeOptional, lsv1alpha1.VerificationModeDisabled:
+		default:
+			return nil, fmt.Errorf("verification policy %d has the unknown mode %q", i, policyConfig.Mode)
+		}
+
+		pattern := policyConfig.ComponentNamePattern
+		if len(pattern) == 0 {
+			pattern = "*"
+		}
+		policy := &Policy{
+			Mode:          policyConfig.Mode,
+			SignatureName: policyConfig.SignatureName,
+		}
+		for _, ref := range policyConfig.TrustedKeys {
+			keys, err := readPublicKeys(ctx, kubeClient, namespace, ref)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read trusted keys of verification policy %d: %w", i, err)
+			}
+			policy.PublicKeys = append(policy.PublicKeys, keys...)
+		}
+		if policy.Mode != lsv1alpha1.VerificationModeDisabled && len(policy.PublicKeys) == 0 {
+			return nil, fmt.Errorf("verification policy %d has no trusted keys", i)
+		}
+
+		policies.policies = append(policies.policies, componentPolicy{
+			pattern: patternToRegexp(pattern),
+			policy:  policy,
+		})
+	}
+	return policies, nil
+}
+
+// ForComponent returns the policy of the component with the given name.
+// Nil is returned if the component is not verified.
+func (p *Policies) ForComponent(componentName string) *Policy {
+	if p == nil {
+		return nil
+	}
+	for _, policy := range p.policies {
+		if !policy.pattern.MatchString(componentName) {
+			continue
+		}
+		if policy.policy.Mode == lsv1alpha1.VerificationModeDisabled {
+			return nil
+		}
+		return policy.policy
+	}
+	return nil
+}
+
+// readPublicKeys reads the public keys of the referenced secret.
+func readPublicKeys(ctx context.Context, kubeClient client.Client, namespace string, ref lsv1alpha1.LocalSecretReference) ([]crypto.PublicKey, error) {
+	secret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return nil, fmt.Errorf("unable to get secret %s/%s: %w", namespace, ref.Name, err)
+	}
+
+	if len(ref.Key) != 0 {
+		data, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("secret %s/%s has no key %q", namespace, ref.Name, ref.Key)
+		}
+		return ParsePublicKeys(data)
+	}
+
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	res := make([]crypto.PublicKey, 0)
+	for _, key := range keys {
+		publicKeys, err := ParsePublicKeys(secret.Data[key])
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse key %q of secret %s/%s: %w", key, namespace, ref.Name, err)
+		}
+		res = append(res, publicKeys...)
+	}
+	return res, nil
+}
+
+// ParsePublicKeys parses all PEM encoded public keys and certificates of the given data.
+// For certificates, the public key of the certificate is returned.
+func ParsePublicKeys(data []byte) ([]crypto.PublicKey, error) {
+	res := make([]crypto.PublicKey, 0)
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse public key: %w", err)
+			}
+			res = append(res, key)
+		case "RSA PUBLIC KEY":
+			key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse rsa public key: %w", err)
+			}
+			res = append(res, key)
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse certificate: %w", err)
+			}
+			res = append(res, cert.PublicKey)
+		}
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no PEM encoded public key or certificate found")
+	}
+	return res, nil
+}
+
+// patternToRegexp converts a component name pattern, where "*" matches any sequence of characters, to a regular expression.
+func patternToRegexp(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package verification
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/apis/v2/signatures"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+)
+
+const (
+	// genericBlobDigestV1 is the normalisation algorithm of resources whose digest is the digest of their blob.
+	genericBlobDigestV1 = "genericBlobDigest/v1"
+	// ociArtifactDigestV1 is the normalisation algorithm of resources whose digest is the digest of their oci manifest.
+	ociArtifactDigestV1 = string(cdv2.OciArtifactDigestV1)
+)
+
+// NewError returns a landscaper error with the verification failed error code.
+func NewError(componentName, version string, err error) lserrors.LsError {
+	msg := fmt.Sprintf("unable to verify component version %s:%s", componentName, version)
+	return lserrors.NewWrappedError(err, "VerifyComponentVersion", "VerificationFailed", msg, lsv1alpha1.ErrorVerificationFailed)
+}
+
+// SignatureNames returns the names of the signatures of the component descriptor that are verified by the policy.
+func SignatureNames(signatures []string, policy *Policy) []string {
+	res := make([]string, 0, len(signatures))
+	for _, name := range signatures {
+		if len(policy.SignatureName) == 0 || name == policy.SignatureName {
+			res = append(res, name)
+		}
+	}
+	return res
+}
+
+// ErrUnsigned returns the error for a component version without a signature that is verified by the policy.
+func ErrUnsigned(policy *Policy) error {
+	if len(policy.SignatureName) != 0 {
+		return fmt.Errorf("the component version has no signature with name %q", policy.SignatureName)
+	}
+	return errors.New("the component version is not signed")
+}
+
+// VerifySignature verifies the signature of a component descriptor with the trusted keys of the policy.
+// It returns whether the component descriptor is signed.
+// A component descriptor without a signature is only valid if the signature is optional.
+func VerifySignature(cd *types.ComponentDescriptor, policy *Policy) (bool, error) {
+	names := make([]string, 0, len(cd.Signatures))
+	for _, signature := range cd.Signatures {
+		names = append(names, signature.Name)
+	}
+	names = SignatureNames(names, policy)
+	if len(names) == 0 {
+		if policy.Mode == lsv1alpha1.VerificationModeOptional {
+			return false, nil
+		}
+		return false, ErrUnsigned(policy)
+	}
+
+	errs := make([]error, 0)
+	for _, name := range names {
+		signature, err := signatures.GetSignatureByName(cd, name)
+		if err != nil {
+			return true, err
+		}
+		if err := verifyDigest(cd, signature); err != nil {
+			errs = append(errs, fmt.Errorf("signature %q: %w", name, err))
+			continue
+		}
+		for _, key := range policy.PublicKeys {
+			err := verifySignature(signature, key)
+			if err == nil {
+				return true, nil
+			}
+			errs = append(errs, fmt.Errorf("signature %q: %w", name, err))
+		}
+	}
+	return true, utilerrors.NewAggregate(errs)
+}
+
+// verifyDigest verifies that the digest of the signature is the digest of the normalised component descriptor.
+func verifyDigest(cd *types.ComponentDescriptor, signature *cdv2.Signature) error {
+	return VerifyComponentDescriptorDigest(cd, &signature.Digest)
+}
+
+// ComponentDescriptorDigest returns the sha256 digest of the normalised component descriptor.
+func ComponentDescriptorDigest(cd *types.ComponentDescriptor) (*cdv2.DigestSpec, error) {
+	digest, err := signatures.HashForComponentDescriptor(*cd, signatures.Hasher{
+		HashFunction:  sha256.New(),
+		AlgorithmName: signatures.SHA256,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to hash component descriptor: %w", err)
+	}
+	return digest, nil
+}
+
+// VerifyComponentDescriptorDigest verifies that a digest, e.g. the digest of a reference, is the digest of the
+// normalised component descriptor.
+func VerifyComponentDescriptorDigest(cd *types.ComponentDescriptor, digest *cdv2.DigestSpec) error {
+	if digest.NormalisationAlgorithm != string(cdv2.JsonNormalisationV1) {
+		return fmt.Errorf("unsupported normalisation algorithm %q", digest.NormalisationAlgorithm)
+	}
+	if !isSHA256(digest.HashAlgorithm) {
+		return fmt.Errorf("unsupported hash algorithm %q", digest.HashAlgorithm)
+	}
+	actual, err := ComponentDescriptorDigest(cd)
+	if err != nil {
+		return err
+	}
+	if actual.Value != digest.Value {
+		return errors.New("the normalised component descriptor does not match its digest")
+	}
+	return nil
+}
+
+// verifySignature verifies the signed digest of the signature with a public key.
+func verifySignature(signature *cdv2.Signature, key crypto.PublicKey) error {
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("unsupported public key of type %T", key)
+	}
+
+	var signatureBytes []byte
+	switch signature.Signature.MediaType {
+	case cdv2.MediaTypeRSASignature:
+		var err error
+		signatureBytes, err = hex.DecodeString(signature.Signature.Value)
+		if err != nil {
+			return fmt.Errorf("unable to decode signature: %w", err)
+		}
+	case cdv2.MediaTypePEM:
+		blocks, err := signatures.GetSignaturePEMBlocks([]byte(signature.Signature.Value))
+		if err != nil {
+			return fmt.Errorf("unable to decode signature: %w", err)
+		}
+		if len(blocks) != 1 {
+			return fmt.Errorf("expected 1 signature pem block, but found %d", len(blocks))
+		}
+		signatureBytes = blocks[0].Bytes
+	default:
+		return fmt.Errorf("unsupported signature media type %q", signature.Signature.MediaType)
+	}
+
+	digest, err := hex.DecodeString(signature.Digest.Value)
+	if err != nil {
+		return fmt.Errorf("unable to decode digest: %w", err)
+	}
+	return rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest, signatureBytes)
+}
+
+// HasBlobDigest returns whether the digest of the resource is the digest of its blob.
+// The digests of other resources, e.g. oci artifacts, cannot be verified by their blob.
+func HasBlobDigest(res *types.Resource) bool {
+	return res.Digest != nil && res.Digest.NormalisationAlgorithm == genericBlobDigestV1 && isSHA256(res.Digest.HashAlgorithm)
+}
+
+// VerifyBlobDigest verifies that the sha256 sum of the blob of a resource matches its digest.
+func VerifyBlobDigest(res *types.Resource, sum []byte) error {
+	if !HasBlobDigest(res) {
+		return fmt.Errorf("resource %q has no blob digest", res.Name)
+	}
+	if hex.EncodeToString(sum) != res.Digest.Value {
+		return fmt.Errorf("the blob of resource %q does not match its digest", res.Name)
+	}
+	return nil
+}
+
+// HasOCIArtifactDigest returns whether the digest of the resource is the digest of the manifest of its oci artifact.
+func HasOCIArtifactDigest(res *types.Resource) bool {
+	return res.Digest != nil && res.Digest.NormalisationAlgorithm == ociArtifactDigestV1 && isSHA256(res.Digest.HashAlgorithm)
+}
+
+// VerifyOCIArtifactDigest verifies that the manifest of the oci artifact of a resource matches its digest.
+func VerifyOCIArtifactDigest(res *types.Resource, manifest []byte) error {
+	if !HasOCIArtifactDigest(res) {
+		return fmt.Errorf("resource %q has no oci artifact digest", res.Name)
+	}
+	sum := sha256.Sum256(manifest)
+	if hex.EncodeToString(sum[:]) != strings.TrimPrefix(res.Digest.Value, "sha256:") {
+		return fmt.Errorf("the oci artifact of resource %q does not match its digest", res.Name)
+	}
+	return nil
+}
+
+// PinImageReference replaces the tag of an image reference by a digest.
+// References that already contain a digest are returned unchanged, as their digest has been verified.
+func PinImageReference(ref, digest string) string {
+	if strings.Contains(ref, "@") {
+		return ref
+	}
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		ref = ref[:i]
+	}
+	return ref + "@sha256:" + strings.TrimPrefix(digest, "sha256:")
+}
+
+// isSHA256 returns whether the hash algorithm is sha256, which is named "sha256" by the component spec and "SHA-256" by ocm.
+func isSHA256(algorithm string) bool {
+	return strings.ReplaceAll(strings.ToLower(algorithm), "-", "") == "sha256"
+}
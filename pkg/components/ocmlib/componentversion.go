@@ -22,6 +22,7 @@ type ComponentVersion struct {
 	registryAccess         *RegistryAccess
 	componentVersionAccess ocm.ComponentVersionAccess
 	componentDescriptorV2  cdv2.ComponentDescriptor
+	trusted                bool
 }
 
 var _ model.ComponentVersion = &ComponentVersion{}
@@ -90,6 +91,9 @@ func (c *ComponentVersion) GetResource(name string, identity map[string]string)
 		return nil, fmt.Errorf("there is more than one resource with name %s and extra identities %v", name, identity)
 	}
 
+	if c.trusted {
+		return NewResource(&verifyingResourceAccess{ResourceAccess: resources[0]}), nil
+	}
 	return NewResource(resources[0]), nil
 }
 
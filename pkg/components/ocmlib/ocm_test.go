@@ -16,7 +16,10 @@ import (
 	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/mandelsoft/vfs/pkg/vfs"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
 	"github.com/gardener/landscaper/pkg/components/model/types"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 
@@ -106,6 +109,15 @@ var _ = Describe("ocm-lib facade implementation", func() {
 		Expect(cv).NotTo(BeNil())
 	})
 
+	It("list the versions of a component (from local repository)", func() {
+		cdref := &v1alpha1.ComponentDescriptorReference{}
+		MustBeSuccessful(runtime.DefaultYAMLEncoding.Unmarshal([]byte(componentReference), &cdref))
+		r := Must(factory.NewRegistryAccess(ctx, nil, nil, nil, &config.LocalRegistryConfiguration{RootPath: LOCALCNUDIEREPOPATH}, nil, nil, nil))
+
+		versions := Must(model.ListComponentVersions(ctx, r, cdref.RepositoryContext, cdref.ComponentName))
+		Expect(versions).To(ConsistOf("1.0.0"))
+	})
+
 	It("get component descriptor with v2 as input", func() {
 		// check that the component descriptor is not altered by the ocmlib-facade
 		compdesc := &types.ComponentDescriptor{}
@@ -181,6 +193,33 @@ var _ = Describe("ocm-lib facade implementation", func() {
 		Expect(props["password"]).To(Equal(PASSWORD))
 	})
 
+	It("registry credentials take precedence over dockerconfig credentials from secrets", func() {
+		secrets := []corev1.Secret{{
+			Data: map[string][]byte{corev1.DockerConfigJsonKey: dockerconfigdata},
+		}}
+		registryCredentialsSecret := &corev1.Secret{}
+		registryCredentialsSecret.Name = "registry-credentials"
+		registryCredentialsSecret.Namespace = "test"
+		registryCredentialsSecret.Data = map[string][]byte{"username": []byte("testuser2"), "password": []byte("testpassword2")}
+		kubeClient := fake.NewClientBuilder().WithObjects(registryCredentialsSecret).Build()
+
+		registryCredentials := Must(registrycredentials.NewCredentials(ctx, kubeClient, "test", []v1alpha1.RegistryCredential{{
+			Registry:  HOSTNAME1 + "/test",
+			SecretRef: &corev1.LocalObjectReference{Name: registryCredentialsSecret.Name},
+		}}))
+		r := Must(factory.NewRegistryAccess(ctx, nil, secrets, nil, nil, nil, nil)).(*RegistryAccess)
+		MustBeSuccessful(model.SetRegistryCredentials(r, registryCredentials))
+
+		props := Must(ociid.GetCredentials(r.octx, HOSTNAME1, "/test/repo")).Properties()
+		Expect(props["username"]).To(Equal("testuser2"))
+		Expect(props["password"]).To(Equal("testpassword2"))
+
+		// registries that are not matched by a registry credential still use the pull secrets
+		props = Must(ociid.GetCredentials(r.octx, HOSTNAME1, "/other/repo")).Properties()
+		Expect(props["username"]).To(Equal(USERNAME))
+		Expect(props["password"]).To(Equal(PASSWORD))
+	})
+
 	It("oci helm resource - dockerconfig credentials from filesystem", func() {
 		// Prepare memory test filesystem with dockerconfig credentials
 		fs := memoryfs.New()
@@ -9,9 +9,12 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sync"
 
 	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/mandelsoft/vfs/pkg/vfs"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 
 	"github.com/gardener/landscaper/pkg/components/model/types"
 
@@ -20,6 +23,9 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
+	"github.com/gardener/landscaper/pkg/components/model/verification"
+	"github.com/gardener/landscaper/pkg/components/ocmlib/repository/filesystem"
 	_ "github.com/gardener/landscaper/pkg/components/ocmlib/repository/inline"
 	_ "github.com/gardener/landscaper/pkg/components/ocmlib/repository/local"
 )
@@ -30,11 +36,25 @@ type RegistryAccess struct {
 	inlineSpec       ocm.RepositorySpec
 	inlineRepository ocm.Repository
 	resolver         ocm.ComponentVersionResolver
+
+	verificationPolicies *verification.Policies
+	verified             map[string]*metav1.DigestSpec
+	referenced           map[string]*metav1.DigestSpec
+	verifiedMutex        sync.Mutex
 }
 
-var _ model.RegistryAccess = (*RegistryAccess)(nil)
+var _ model.VerifyingRegistryAccess = (*RegistryAccess)(nil)
+var _ model.VersionListingRegistryAccess = (*RegistryAccess)(nil)
+var _ model.FilesystemRepositoryRegistryAccess = (*RegistryAccess)(nil)
+var _ model.RegistryCredentialsRegistryAccess = (*RegistryAccess)(nil)
 
 func (r *RegistryAccess) NewComponentVersion(cv ocm.ComponentVersionAccess) (model.ComponentVersion, error) {
+	return r.newComponentVersion(cv, false)
+}
+
+// newComponentVersion creates a component version whose resources are verified when they are accessed if the component
+// version is trusted.
+func (r *RegistryAccess) newComponentVersion(cv ocm.ComponentVersionAccess, trusted bool) (model.ComponentVersion, error) {
 	if cv == nil {
 		return nil, errors.New("component version access cannot be nil during facade component version creation")
 	}
@@ -63,6 +83,7 @@ func (r *RegistryAccess) NewComponentVersion(cv ocm.ComponentVersionAccess) (mod
 		registryAccess:         r,
 		componentVersionAccess: cv,
 		componentDescriptorV2:  lscd,
+		trusted:                trusted,
 	}, nil
 }
 
@@ -80,10 +101,12 @@ func (r *RegistryAccess) GetComponentVersion(ctx context.Context, cdRef *lsv1alp
 	}
 
 	var cv ocm.ComponentVersionAccess
+	var resolver ocm.ComponentVersionResolver
 	// check if repository context from inline component descriptor should be used
 	if r.inlineRepository != nil && reflect.DeepEqual(spec, r.inlineSpec) {
 		// in this case, resolver knows an inline repository as well as the repository specified by the repository
 		// context of the inline component descriptor
+		resolver = r.resolver
 		cv, err = r.session.LookupComponentVersion(r.resolver, cdRef.ComponentName, cdRef.Version)
 	} else {
 		// if there is no inline repository or the repository context is different from the one specified in the inline
@@ -94,12 +117,41 @@ func (r *RegistryAccess) GetComponentVersion(ctx context.Context, cdRef *lsv1alp
 			return nil, err
 		}
 
+		resolver = repo
 		cv, err = r.session.LookupComponentVersion(repo, cdRef.ComponentName, cdRef.Version)
 	}
 	if err != nil {
 		return nil, err
 	}
-	return r.NewComponentVersion(cv)
+	trusted, err := r.verify(cv, resolver)
+	if err != nil {
+		return nil, err
+	}
+	return r.newComponentVersion(cv, trusted)
+}
+
+func (r *RegistryAccess) ListComponentVersions(ctx context.Context, repositoryContext *types.UnstructuredTypedObject, componentName string) ([]string, error) {
+	if repositoryContext == nil {
+		return nil, errors.New("repository context cannot be nil")
+	}
+
+	spec, err := r.octx.RepositorySpecForConfig(repositoryContext.Raw, runtime.DefaultYAMLEncoding)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := r.session.LookupRepository(r.octx, spec)
+	if err != nil {
+		return nil, err
+	}
+	component, err := r.session.LookupComponent(repo, componentName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up component %s: %w", componentName, err)
+	}
+	versions, err := component.ListVersions()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list versions of component %s: %w", componentName, err)
+	}
+	return versions, nil
 }
 
 func (r *RegistryAccess) Close() error {
@@ -109,3 +161,16 @@ func (r *RegistryAccess) Close() error {
 	}
 	return nil
 }
+
+// SetFilesystemRepositoryRoot enables repository contexts of type "filesystem", whose paths are resolved in the given
+// root filesystem.
+func (r *RegistryAccess) SetFilesystemRepositoryRoot(rootFs vfs.FileSystem) error {
+	filesystem.Register(r.octx, rootFs)
+	return nil
+}
+
+// SetRegistryCredentials sets the credentials that are used for matching registries instead of the pull secrets.
+func (r *RegistryAccess) SetRegistryCredentials(registryCredentials *registrycredentials.Credentials) error {
+	AddRegistryCredentialsToCredContext(registryCredentials, r.octx)
+	return nil
+}
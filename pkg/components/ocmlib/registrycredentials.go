@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocmlib
+
+import (
+	"context"
+	"path"
+
+	ocmcommon "github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	ociid "github.com/open-component-model/ocm/pkg/contexts/credentials/builtin/oci/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/finalizer"
+
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
+)
+
+var registryCredentialsProviderIdentity = finalizer.NewObjectIdentity("landscaper.registrycredentials")
+
+// AddRegistryCredentialsToCredContext registers the per-registry credentials at the credentials context.
+// For oci registries that are matched by a registry credential, they take precedence over all other credentials of
+// the context, e.g. the ones from pull secrets.
+func AddRegistryCredentialsToCredContext(registryCredentials *registrycredentials.Credentials, provider credentials.ContextProvider) {
+	provider.CredentialsContext().RegisterConsumerProvider(registryCredentialsProviderIdentity, &registryCredentialsProvider{
+		credentials: registryCredentials,
+	})
+}
+
+// registryCredentialsProvider is a consumer provider for oci registries that are matched by a registry credential.
+type registryCredentialsProvider struct {
+	credentials *registrycredentials.Credentials
+}
+
+var _ cpi.ConsumerProvider = &registryCredentialsProvider{}
+
+func (p *registryCredentialsProvider) Unregister(_ cpi.ProviderIdentity) {}
+
+func (p *registryCredentialsProvider) Get(id cpi.ConsumerIdentity) (cpi.CredentialsSource, bool) {
+	ref, ok := referenceForConsumer(id)
+	if !ok || !p.credentials.Matches(ref) {
+		return nil, false
+	}
+	return &registryCredentialsSource{credentials: p.credentials, ref: ref}, true
+}
+
+// Match returns the credentials of a matching registry credential. The requested identity is returned as best match,
+// so that the credentials cannot be overruled by other providers.
+func (p *registryCredentialsProvider) Match(id cpi.ConsumerIdentity, cur cpi.ConsumerIdentity, _ cpi.IdentityMatcher) (cpi.CredentialsSource, cpi.ConsumerIdentity) {
+	src, ok := p.Get(id)
+	if !ok {
+		return nil, cur
+	}
+	return src, id
+}
+
+// referenceForConsumer converts the identity of an oci registry consumer to a repository reference.
+func referenceForConsumer(id cpi.ConsumerIdentity) (string, bool) {
+	if id.Type() != ociid.CONSUMER_TYPE || len(id[ociid.ID_HOSTNAME]) == 0 {
+		return "", false
+	}
+	host := id[ociid.ID_HOSTNAME]
+	if port := id[ociid.ID_PORT]; len(port) != 0 {
+		host = host + ":" + port
+	}
+	return path.Join(host, id[ociid.ID_PATHPREFIX]), true
+}
+
+type registryCredentialsSource struct {
+	credentials *registrycredentials.Credentials
+	ref         string
+}
+
+// Credentials returns the credentials of the matching registry credential.
+// A bearer token is returned as identity token.
+func (s *registryCredentialsSource) Credentials(_ cpi.Context, _ ...cpi.CredentialsSource) (cpi.Credentials, error) {
+	auth, err := s.credentials.Get(context.Background(), s.ref)
+	if err != nil {
+		return nil, err
+	}
+	props := ocmcommon.Properties{}
+	if auth != nil {
+		if len(auth.Username) != 0 {
+			props[ociid.ATTR_USERNAME] = auth.Username
+			props[ociid.ATTR_PASSWORD] = auth.Password
+		} else {
+			props[ociid.ATTR_IDENTITY_TOKEN] = auth.Token
+		}
+	}
+	return cpi.NewCredentials(props), nil
+}
@@ -0,0 +1,11 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package filesystem defines a repository type "filesystem", that references a directory or an archive below the
+// filesystem root configured for the landscaper. The directory or archive may contain component archives or a common
+// transport format (ctf) archive whose entries are component archives.
+//
+// The repository type is registered globally only for serialization purposes. Repositories can only be accessed in
+// ocm contexts for which the root filesystem has been registered with Register.
+package filesystem
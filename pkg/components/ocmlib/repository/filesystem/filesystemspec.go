@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mandelsoft/filepath/pkg/filepath"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	. "github.com/open-component-model/ocm/pkg/exception"
+	"github.com/open-component-model/ocm/pkg/runtime"
+
+	"github.com/gardener/landscaper/pkg/components/model/filesystemrepository"
+	"github.com/gardener/landscaper/pkg/components/ocmlib/repository"
+)
+
+const (
+	Type   = filesystemrepository.Type
+	TypeV1 = Type + runtime.VersionSeparator + "v1"
+)
+
+var versions = cpi.NewRepositoryTypeVersionScheme(Type)
+
+func init() {
+	Must(versions.Register(cpi.NewRepositoryTypeByConverter[*RepositorySpec, *RepositorySpecV1](Type, &converterV1{}, nil)))
+	Must(versions.Register(cpi.NewRepositoryTypeByConverter[*RepositorySpec, *RepositorySpecV1](TypeV1, &converterV1{}, nil)))
+	cpi.RegisterRepositoryTypeVersions(versions)
+}
+
+// Register enables repositories of type "filesystem" in the given ocm context. Their paths are resolved in the
+// given root filesystem.
+func Register(octx cpi.Context, rootFs vfs.FileSystem) {
+	octx.RepositoryTypes().Register(cpi.NewRepositoryTypeByConverter[*RepositorySpec, *RepositorySpecV1](Type, &converterV1{rootFs: rootFs}, nil))
+	octx.RepositoryTypes().Register(cpi.NewRepositoryTypeByConverter[*RepositorySpec, *RepositorySpecV1](TypeV1, &converterV1{rootFs: rootFs}, nil))
+}
+
+type RepositorySpecV1 struct {
+	runtime.ObjectVersionedType `json:",inline"`
+	Path                        string `json:"path"`
+}
+
+type RepositorySpec struct {
+	runtime.InternalVersionedTypedObject[cpi.RepositorySpec]
+	Path   string
+	rootFs vfs.FileSystem
+}
+
+func NewRepositorySpec(path string) *RepositorySpec {
+	return &RepositorySpec{
+		InternalVersionedTypedObject: runtime.NewInternalVersionedTypedObject[cpi.RepositorySpec](versions, Type),
+		Path:                         path,
+	}
+}
+
+func (r RepositorySpec) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalVersionedTypedObject(&r)
+}
+
+func (r *RepositorySpec) Key() (string, error) {
+	data, err := json.Marshal(&struct {
+		Type   string `json:"type"`
+		Path   string `json:"path"`
+		RootFs string `json:"rootFs"`
+	}{
+		Type:   r.GetType(),
+		Path:   r.Path,
+		RootFs: fmt.Sprintf("%p", r.rootFs),
+	})
+	return string(data), err
+}
+
+func (r *RepositorySpec) Repository(ctx cpi.Context, _ credentials.Credentials) (cpi.Repository, error) {
+	fs, err := filesystemrepository.Open(context.Background(), r.rootFs, r.Path)
+	if err != nil {
+		return nil, err
+	}
+	return repository.NewRepository(ctx, &archiveProvider{fs: fs}, nil)
+}
+
+func (r *RepositorySpec) AsUniformSpec(_ cpi.Context) *cpi.UniformRepositorySpec {
+	return nil
+}
+
+type converterV1 struct {
+	rootFs vfs.FileSystem
+}
+
+func (_ converterV1) ConvertFrom(in *RepositorySpec) (*RepositorySpecV1, error) {
+	return &RepositorySpecV1{
+		ObjectVersionedType: runtime.NewVersionedObjectType(in.Type),
+		Path:                in.Path,
+	}, nil
+}
+
+func (c converterV1) ConvertTo(in *RepositorySpecV1) (*RepositorySpec, error) {
+	return &RepositorySpec{
+		InternalVersionedTypedObject: runtime.NewInternalVersionedTypedObject[cpi.RepositorySpec](versions, in.Type),
+		Path:                         in.Path,
+		rootFs:                       c.rootFs,
+	}, nil
+}
+
+// archiveProvider provides the component archives of an opened filesystem repository.
+type archiveProvider struct {
+	fs vfs.FileSystem
+}
+
+var _ repository.ComponentArchiveProvider = &archiveProvider{}
+
+func (p *archiveProvider) List() ([]*compdesc.ComponentDescriptor, error) {
+	archives, err := p.ListArchives()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*compdesc.ComponentDescriptor, 0, len(archives))
+	for _, archive := range archives {
+		result = append(result, archive.Descriptor)
+	}
+	return result, nil
+}
+
+func (p *archiveProvider) ListArchives() ([]repository.ComponentArchive, error) {
+	archives, err := filesystemrepository.FindComponentArchives(p.fs)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]repository.ComponentArchive, 0, len(archives))
+	for _, archive := range archives {
+		data, err := vfs.ReadFile(p.fs, archive.DescriptorPath)
+		if err != nil {
+			return nil, err
+		}
+		cd, err := compdesc.Decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode component descriptor %q: %w", archive.DescriptorPath, err)
+		}
+
+		var blobFs vfs.FileSystem
+		blobDir := filepath.Join(archive.Dir, "blobs")
+		if exists, err := vfs.DirExists(p.fs, blobDir); err != nil {
+			return nil, err
+		} else if exists {
+			if blobFs, err = projectionfs.New(p.fs, blobDir); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, repository.ComponentArchive{Descriptor: cd, BlobFs: blobFs})
+	}
+	return result, nil
+}
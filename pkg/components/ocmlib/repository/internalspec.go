@@ -28,6 +28,18 @@ type ComponentDescriptorProvider interface {
 	List() ([]*compdesc.ComponentDescriptor, error)
 }
 
+// ComponentArchive is a component descriptor together with the filesystem that contains its local blobs.
+type ComponentArchive struct {
+	Descriptor *compdesc.ComponentDescriptor
+	BlobFs     vfs.FileSystem
+}
+
+// ComponentArchiveProvider is a ComponentDescriptorProvider whose component descriptors have separate blob filesystems.
+type ComponentArchiveProvider interface {
+	ComponentDescriptorProvider
+	ListArchives() ([]ComponentArchive, error)
+}
+
 type RepositorySpec struct {
 	runtime.InternalVersionedTypedObject[cpi.RepositorySpec]
 	FileSystem      vfs.FileSystem
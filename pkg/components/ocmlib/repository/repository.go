@@ -57,6 +57,20 @@ func (a *ComponentAccess) Index() error {
 
 	a.index = virtual.NewIndex[any]()
 
+	// component archives contain their own blobs, which are stored as info in the index
+	if archiveProvider, ok := a.descriptorProvider.(ComponentArchiveProvider); ok {
+		archives, err := archiveProvider.ListArchives()
+		if err != nil {
+			return fmt.Errorf("error indexing repository: %w", err)
+		}
+		for _, archive := range archives {
+			if err := a.index.Add(archive.Descriptor, archive.BlobFs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	entries, err := a.descriptorProvider.List()
 	if err != nil {
 		return fmt.Errorf("error indexing repository: %w", err)
@@ -94,6 +108,7 @@ func (a *ComponentAccess) ListVersions(comp string) ([]string, error) {
 
 func (a *ComponentAccess) GetComponentVersion(comp, version string) (virtual.VersionAccess, error) {
 	var cd *compdesc.ComponentDescriptor
+	blobsFs := a.blobsFs
 
 	a.lock.Lock()
 	defer a.lock.Unlock()
@@ -103,8 +118,11 @@ func (a *ComponentAccess) GetComponentVersion(comp, version string) (virtual.Ver
 		return nil, errors.ErrNotFound(cpi.KIND_COMPONENTVERSION, common.NewNameVersion(comp, version).String())
 	} else {
 		cd = i.CD()
+		if archiveBlobsFs, ok := i.Info().(vfs.FileSystem); ok && archiveBlobsFs != nil {
+			blobsFs = archiveBlobsFs
+		}
 	}
-	return &ComponentVersionAccess{a, cd.GetName(), cd.GetVersion(), cd.Copy()}, nil
+	return &ComponentVersionAccess{a, cd.GetName(), cd.GetVersion(), cd.Copy(), blobsFs}, nil
 }
 
 func (a *ComponentAccess) IsReadOnly() bool {
@@ -118,10 +136,11 @@ func (a *ComponentAccess) Close() error {
 var _ virtual.Access = (*ComponentAccess)(nil)
 
 type ComponentVersionAccess struct {
-	access *ComponentAccess
-	comp   string
-	vers   string
-	desc   *compdesc.ComponentDescriptor
+	access  *ComponentAccess
+	comp    string
+	vers    string
+	desc    *compdesc.ComponentDescriptor
+	blobsFs vfs.FileSystem
 }
 
 func (v *ComponentVersionAccess) GetDescriptor() *compdesc.ComponentDescriptor {
@@ -129,18 +148,18 @@ func (v *ComponentVersionAccess) GetDescriptor() *compdesc.ComponentDescriptor {
 }
 
 func (v *ComponentVersionAccess) GetBlob(name string) (cpi.DataAccess, error) {
-	if v.access.blobsFs == nil {
+	if v.blobsFs == nil {
 		return nil, vfs.ErrNotExist
 	}
 
 	filepath := path.Join("/", name)
 
-	if ok, err := vfs.IsDir(v.access.blobsFs, filepath); ok {
+	if ok, err := vfs.IsDir(v.blobsFs, filepath); ok {
 		tempfile, err := blobaccess.NewTempFile(os.TempDir(), "TEMP_BLOB_DATA")
 		if err != nil {
 			return nil, err
 		}
-		err = tarutils.PackFsIntoTar(v.access.blobsFs, filepath, tempfile.Writer(), tarutils.TarFileSystemOptions{})
+		err = tarutils.PackFsIntoTar(v.blobsFs, filepath, tempfile.Writer(), tarutils.TarFileSystemOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -150,8 +169,8 @@ func (v *ComponentVersionAccess) GetBlob(name string) (cpi.DataAccess, error) {
 			return nil, err
 		}
 
-		if ok, err := vfs.FileExists(v.access.blobsFs, filepath); ok {
-			return blobaccess.DataAccessForFile(v.access.blobsFs, filepath), nil
+		if ok, err := vfs.FileExists(v.blobsFs, filepath); ok {
+			return blobaccess.DataAccessForFile(v.blobsFs, filepath), nil
 		} else {
 			if err != nil {
 				return nil, err
@@ -26,6 +26,7 @@ import (
 func init() {
 	registries.Registry.Register(mediatype.BlueprintType, New())
 	registries.Registry.Register(mediatype.OldBlueprintType, New())
+	registries.Registry.Register(mediatype.BlueprintLibraryType, New())
 }
 
 type BlueprintHandler struct {
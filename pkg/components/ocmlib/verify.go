@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocmlib
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartifact"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/model/verification"
+)
+
+// SetVerificationPolicies sets the policies that define which component versions are verified with which keys.
+func (r *RegistryAccess) SetVerificationPolicies(policies *verification.Policies) {
+	r.verificationPolicies = policies
+}
+
+// verify verifies a component version and returns whether it is trusted.
+// A component version is trusted if its signature is verified according to its policy, which includes the digests of
+// its resources and references, or if a trusted component version references it with a digest. The digests of the
+// references are kept to verify the referenced component versions when they are resolved. A trusted component version
+// that is resolved again must match the digest that has been verified.
+// The referenced component versions are resolved with the given resolver.
+func (r *RegistryAccess) verify(cv ocm.ComponentVersionAccess, resolver ocm.ComponentVersionResolver) (bool, error) {
+	key := fmt.Sprintf("%s:%s", cv.GetName(), cv.GetVersion())
+	verified, referenced := r.trustedDigests(key)
+	var digest *metav1.DigestSpec
+	switch {
+	case verified != nil:
+		if err := verifyDigest(cv, verified); err != nil {
+			return false, verification.NewError(cv.GetName(), cv.GetVersion(), fmt.Errorf("the component descriptor has changed since its verification: %w", err))
+		}
+		return true, nil
+	case referenced != nil:
+		if err := verifyDigest(cv, referenced); err != nil {
+			return false, verification.NewError(cv.GetName(), cv.GetVersion(), fmt.Errorf("the component descriptor does not match the digest of its reference: %w", err))
+		}
+		digest = referenced
+	default:
+		policy := r.verificationPolicies.ForComponent(cv.GetName())
+		if policy == nil {
+			return false, nil
+		}
+
+		names := make([]string, 0)
+		for _, signature := range cv.GetDescriptor().Signatures {
+			names = append(names, signature.Name)
+		}
+		names = verification.SignatureNames(names, policy)
+		if len(names) == 0 {
+			if policy.Mode == lsv1alpha1.VerificationModeOptional {
+				return false, nil
+			}
+			return false, verification.NewError(cv.GetName(), cv.GetVersion(), verification.ErrUnsigned(policy))
+		}
+
+		var errs []error
+	signatures:
+		for _, name := range names {
+			for _, publicKey := range policy.PublicKeys {
+				var err error
+				digest, err = signing.VerifyComponentVersion(cv, name, signing.PublicKey(name, publicKey), signing.Resolver(resolver))
+				if err == nil {
+					break signatures
+				}
+				errs = append(errs, fmt.Errorf("signature %q: %w", name, err))
+			}
+		}
+		if digest == nil {
+			return false, verification.NewError(cv.GetName(), cv.GetVersion(), utilerrors.NewAggregate(errs))
+		}
+	}
+
+	r.verifiedMutex.Lock()
+	defer r.verifiedMutex.Unlock()
+	if r.verified == nil {
+		r.verified = map[string]*metav1.DigestSpec{}
+	}
+	if r.referenced == nil {
+		r.referenced = map[string]*metav1.DigestSpec{}
+	}
+	r.verified[key] = digest
+	for _, ref := range cv.GetDescriptor().References {
+		if ref.Digest != nil {
+			r.referenced[fmt.Sprintf("%s:%s", ref.ComponentName, ref.Version)] = ref.Digest.Copy()
+		}
+	}
+	return true, nil
+}
+
+// trustedDigests returns the verified digest of the component version with the given key,
+// and the digest with which a trusted component version references it.
+func (r *RegistryAccess) trustedDigests(key string) (verified, referenced *metav1.DigestSpec) {
+	r.verifiedMutex.Lock()
+	defer r.verifiedMutex.Unlock()
+	return r.verified[key], r.referenced[key]
+}
+
+// verifyDigest verifies that the normalised component descriptor of a component version matches a digest.
+func verifyDigest(cv ocm.ComponentVersionAccess, digest *metav1.DigestSpec) error {
+	hasher := signingattr.Get(cv.GetContext()).GetHasher(digest.HashAlgorithm)
+	if hasher == nil {
+		return fmt.Errorf("unsupported hash algorithm %q", digest.HashAlgorithm)
+	}
+	value, err := compdesc.Hash(cv.GetDescriptor(), digest.NormalisationAlgorithm, hasher.Create())
+	if err != nil {
+		return err
+	}
+	if value != digest.Value {
+		return errors.New("the normalised component descriptor does not match its digest")
+	}
+	return nil
+}
+
+// verifyingResourceAccess gives access to a resource of a trusted component version that is verified when it is
+// accessed. Blobs with a blob digest are verified when they are read, and oci artifacts are pinned to their digest.
+type verifyingResourceAccess struct {
+	ocm.ResourceAccess
+}
+
+func (a *verifyingResourceAccess) Access() (ocm.AccessSpec, error) {
+	spec, err := a.ResourceAccess.Access()
+	if err != nil {
+		return nil, err
+	}
+	res := a.digestResource()
+	ociSpec, ok := spec.(*ociartifact.AccessSpec)
+	if !ok || !verification.HasOCIArtifactDigest(res) {
+		return spec, nil
+	}
+	pinned := *ociSpec
+	pinned.ImageReference = verification.PinImageReference(ociSpec.ImageReference, res.Digest.Value)
+	return &pinned, nil
+}
+
+func (a *verifyingResourceAccess) AccessMethod() (ocm.AccessMethod, error) {
+	res := a.digestResource()
+	switch {
+	case verification.HasBlobDigest(res):
+		m, err := a.ResourceAccess.AccessMethod()
+		if err != nil {
+			return nil, err
+		}
+		return &verifyingAccessMethod{AccessMethod: m, resource: res}, nil
+	case verification.HasOCIArtifactDigest(res):
+		spec, err := a.Access()
+		if err != nil {
+			return nil, err
+		}
+		cv, err := a.GetComponentVersion()
+		if err != nil {
+			return nil, err
+		}
+		defer cv.Close()
+		return cv.AccessMethod(spec)
+	}
+	return a.ResourceAccess.AccessMethod()
+}
+
+// digestResource returns a resource with the name and digest of the resource, which is used for the verification.
+func (a *verifyingResourceAccess) digestResource() *types.Resource {
+	meta := a.Meta()
+	res := &types.Resource{IdentityObjectMeta: cdv2.IdentityObjectMeta{Name: meta.GetName()}}
+	if meta.Digest != nil {
+		res.Digest = &cdv2.DigestSpec{
+			HashAlgorithm:          meta.Digest.HashAlgorithm,
+			NormalisationAlgorithm: meta.Digest.NormalisationAlgorithm,
+			Value:                  meta.Digest.Value,
+		}
+	}
+	return res
+}
+
+// verifyingAccessMethod verifies the blob of a resource against its blob digest when it is read.
+type verifyingAccessMethod struct {
+	ocm.AccessMethod
+	resource *types.Resource
+}
+
+func (m *verifyingAccessMethod) Dup() (ocm.AccessMethod, error) {
+	dup, err := m.AccessMethod.Dup()
+	if err != nil {
+		return nil, err
+	}
+	return &verifyingAccessMethod{AccessMethod: dup, resource: m.resource}, nil
+}
+
+func (m *verifyingAccessMethod) Get() ([]byte, error) {
+	data, err := m.AccessMethod.Get()
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	if err := verification.VerifyBlobDigest(m.resource, sum[:]); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (m *verifyingAccessMethod) Reader() (io.ReadCloser, error) {
+	reader, err := m.AccessMethod.Reader()
+	if err != nil {
+		return nil, err
+	}
+	return &verifyingReader{reader: reader, hash: sha256.New(), resource: m.resource}, nil
+}
+
+// verifyingReader verifies a blob against the digest of its resource when it has been read completely.
+// The verification fails on the end of the blob, or when the reader is closed; the remaining blob is read on close,
+// because consumers like tar readers do not necessarily read a blob to its end.
+type verifyingReader struct {
+	reader   io.ReadCloser
+	hash     hash.Hash
+	resource *types.Resource
+	err      error
+	done     bool
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	n, err := v.reader.Read(p)
+	v.hash.Write(p[:n])
+	if err == io.EOF {
+		if verr := v.verify(); verr != nil {
+			return n, verr
+		}
+	}
+	return n, err
+}
+
+func (v *verifyingReader) Close() error {
+	if !v.done {
+		if _, err := io.Copy(v.hash, v.reader); err != nil {
+			v.done, v.err = true, err
+		}
+	}
+	return utilerrors.NewAggregate([]error{v.verify(), v.reader.Close()})
+}
+
+// verify verifies the digest of the blob that has been read once.
+func (v *verifyingReader) verify() error {
+	if !v.done {
+		v.done = true
+		v.err = verification.VerifyBlobDigest(v.resource, v.hash.Sum(nil))
+	}
+	return v.err
+}
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registries
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/filesystemrepository"
+)
+
+// tarDirectory creates a tar archive with the content of the given directory.
+func tarDirectory(dir string) []byte {
+	buf := bytes.Buffer{}
+	tw := tar.NewWriter(&buf)
+	Expect(filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		Expect(err).ToNot(HaveOccurred())
+		rel, err := filepath.Rel(dir, path)
+		Expect(err).ToNot(HaveOccurred())
+		if rel == "." {
+			return nil
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		Expect(err).ToNot(HaveOccurred())
+		header.Name = filepath.ToSlash(rel)
+		Expect(tw.WriteHeader(header)).To(Succeed())
+		if !info.IsDir() {
+			data, err := os.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = tw.Write(data)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		return nil
+	})).To(Succeed())
+	Expect(tw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+// tarFiles creates a tar archive with the given files.
+func tarFiles(files map[string][]byte) []byte {
+	buf := bytes.Buffer{}
+	tw := tar.NewWriter(&buf)
+	for name, data := range files {
+		Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tw.Write(data)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func gzipData(data []byte) []byte {
+	buf := bytes.Buffer{}
+	gw := gzip.NewWriter(&buf)
+	_, err := gw.Write(data)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(gw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+var _ = Describe("Filesystem Repository", func() {
+
+	var rootFs vfs.FileSystem
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ocmLibraryMode = nil
+
+		// the root filesystem contains the test archives as directory, as tgz archive and as ctf archive
+		rootFs = memoryfs.New()
+		Expect(vfs.CopyDir(osfs.New(), "./testdata/archives", rootFs, "/archives")).To(Succeed())
+		Expect(vfs.WriteFile(rootFs, "/archives.tgz", gzipData(tarDirectory("./testdata/archives")), os.ModePerm)).To(Succeed())
+		Expect(vfs.WriteFile(rootFs, "/ctf.tar", tarFiles(map[string][]byte{
+			"root.tar":  tarDirectory("./testdata/archives/root"),
+			"child.tgz": gzipData(tarDirectory("./testdata/archives/child")),
+		}), os.ModePerm)).To(Succeed())
+	})
+
+	newRegistryAccess := func(useOCM bool) model.RegistryAccess {
+		registryAccess, err := GetFactory(useOCM).NewRegistryAccess(ctx, nil, nil, nil, nil, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(model.SetFilesystemRepositoryRoot(registryAccess, rootFs)).To(Succeed())
+		return registryAccess
+	}
+
+	getComponentVersion := func(registryAccess model.RegistryAccess, path, name string) (model.ComponentVersion, error) {
+		repositoryContext, err := filesystemrepository.NewRepositoryContext(path)
+		Expect(err).ToNot(HaveOccurred())
+		return registryAccess.GetComponentVersion(ctx, &lsv1alpha1.ComponentDescriptorReference{
+			RepositoryContext: &repositoryContext,
+			ComponentName:     name,
+			Version:           "1.0.0",
+		})
+	}
+
+	expectSchema := func(cv model.ComponentVersion, description string) {
+		resource, err := cv.GetResource("schema", nil)
+		Expect(err).ToNot(HaveOccurred())
+		content, err := resource.GetTypedContent(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(content.Resource).To(MatchJSON(`{"type":"string","description":"` + description + `"}`))
+	}
+
+	for _, useOCM := range []bool{false, true} {
+		useOCM := useOCM
+
+		Context(fmt.Sprintf("useOCM: %t", useOCM), func() {
+
+			DescribeTable("should resolve components and their local blobs", func(path string) {
+				registryAccess := newRegistryAccess(useOCM)
+
+				root, err := getComponentVersion(registryAccess, path, "example.com/root")
+				Expect(err).ToNot(HaveOccurred())
+				expectSchema(root, "root")
+
+				child, err := getComponentVersion(registryAccess, path, "example.com/child")
+				Expect(err).ToNot(HaveOccurred())
+				expectSchema(child, "child")
+			},
+				Entry("directory", "/archives"),
+				Entry("tgz archive", "archives.tgz"),
+				Entry("ctf archive", "/ctf.tar"),
+			)
+
+			It("should not resolve paths outside of the root filesystem", func() {
+				registryAccess := newRegistryAccess(useOCM)
+
+				root, err := getComponentVersion(registryAccess, "/../../archives/root", "example.com/root")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(root.GetName()).To(Equal("example.com/root"))
+
+				_, err = getComponentVersion(registryAccess, "/missing", "example.com/root")
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail if repository contexts of type filesystem are not enabled", func() {
+				registryAccess, err := GetFactory(useOCM).NewRegistryAccess(ctx, nil, nil, nil, nil, nil, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = getComponentVersion(registryAccess, "/archives", "example.com/root")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	}
+})
@@ -0,0 +1,439 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package components_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/apis/v2/signatures"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/apis/mediatype"
+	"github.com/gardener/landscaper/pkg/components/cnudie"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/model/verification"
+	"github.com/gardener/landscaper/pkg/components/ocmlib"
+)
+
+var _ = Describe("component signature verification", func() {
+
+	Context("cnudie", func() {
+		verificationSpecs(&cnudie.Factory{})
+	})
+
+	Context("ocmlib", func() {
+		verificationSpecs(&ocmlib.Factory{})
+	})
+
+})
+
+// verificationSpecs defines the specs of the verification of component versions that are resolved with the factory.
+func verificationSpecs(factory model.Factory) {
+
+	const (
+		namespace      = "test"
+		componentName  = "example.com/signed-component"
+		referencedName = "other.com/referenced-component"
+		blob           = "signed content"
+	)
+
+	var (
+		ctx        context.Context
+		repoDir    string
+		privateKey *rsa.PrivateKey
+		cdRef      *lsv1alpha1.ComponentDescriptorReference
+		resources  []cdv2.Resource
+		references []cdv2.ComponentReference
+	)
+
+	publicKeyPEM := func(key *rsa.PrivateKey) []byte {
+		data, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		Expect(err).ToNot(HaveOccurred())
+		return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: data})
+	}
+
+	// writeComponent writes a component descriptor with one local blob resource to the local repository.
+	// The component descriptor is signed with the private key if sign is true.
+	writeComponent := func(sign bool) {
+		sum := sha256.Sum256([]byte(blob))
+		cd := &cdv2.ComponentDescriptor{}
+		cd.Metadata.Version = cdv2.SchemaVersion
+		cd.Name = componentName
+		cd.Version = "1.0.0"
+		cd.Provider = "internal"
+		cd.RepositoryContexts = []*cdv2.UnstructuredTypedObject{}
+		cd.Sources = []cdv2.Source{}
+		cd.ComponentReferences = append([]cdv2.ComponentReference{}, references...)
+		Expect(cdv2.InjectRepositoryContext(cd, cdv2.NewOCIRegistryRepository("/", ""))).To(Succeed())
+		cd.Resources = []cdv2.Resource{
+			{
+				IdentityObjectMeta: cdv2.IdentityObjectMeta{Name: "config", Version: "1.0.0", Type: "plainText"},
+				Relation:           cdv2.LocalRelation,
+				Access:             cdv2.NewUnstructuredType(cdv2.LocalFilesystemBlobType, map[string]interface{}{"filename": "config", "mediaType": "text/plain"}),
+				Digest: &cdv2.DigestSpec{
+					HashAlgorithm:          signatures.SHA256,
+					NormalisationAlgorithm: "genericBlobDigest/v1",
+					Value:                  hex.EncodeToString(sum[:]),
+				},
+			},
+		}
+		cd.Resources = append(cd.Resources, resources...)
+
+		if sign {
+			keyData, err := x509.MarshalPKCS8PrivateKey(privateKey)
+			Expect(err).ToNot(HaveOccurred())
+			keyFile := filepath.Join(GinkgoT().TempDir(), "key.pem")
+			Expect(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyData}), 0600)).To(Succeed())
+			signer, err := signatures.CreateRSASignerFromKeyFile(keyFile, cdv2.MediaTypeRSASignature)
+			Expect(err).ToNot(HaveOccurred())
+			hasher, err := signatures.HasherForName(signatures.SHA256)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(signatures.SignComponentDescriptor(cd, signer, *hasher, "release")).To(Succeed())
+		}
+
+		data, err := yaml.Marshal(cd)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(repoDir, "component-descriptor.yaml"), data, 0600)).To(Succeed())
+	}
+
+	// writeReferencedComponent writes an unsigned component descriptor without a verification policy to the local
+	// repository and returns its digest.
+	writeReferencedComponent := func(provider string) *cdv2.DigestSpec {
+		cd := &cdv2.ComponentDescriptor{}
+		cd.Metadata.Version = cdv2.SchemaVersion
+		cd.Name = referencedName
+		cd.Version = "1.0.0"
+		cd.Provider = cdv2.ProviderType(provider)
+		cd.RepositoryContexts = []*cdv2.UnstructuredTypedObject{}
+		cd.Sources = []cdv2.Source{}
+		cd.ComponentReferences = []cdv2.ComponentReference{}
+		cd.Resources = []cdv2.Resource{}
+		Expect(cdv2.InjectRepositoryContext(cd, cdv2.NewOCIRegistryRepository("/", ""))).To(Succeed())
+
+		hasher, err := signatures.HasherForName(signatures.SHA256)
+		Expect(err).ToNot(HaveOccurred())
+		digest, err := signatures.HashForComponentDescriptor(*cd, *hasher)
+		Expect(err).ToNot(HaveOccurred())
+
+		data, err := yaml.Marshal(cd)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(repoDir, "referenced-component-descriptor.yaml"), data, 0600)).To(Succeed())
+		return digest
+	}
+
+	newPolicies := func(mode lsv1alpha1.VerificationMode, keys ...*rsa.PrivateKey) *verification.Policies {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "trusted-keys", Namespace: namespace},
+			Data:       map[string][]byte{},
+		}
+		for i, key := range keys {
+			secret.Data[string(rune('a'+i))+".pem"] = publicKeyPEM(key)
+		}
+		kubeClient := fake.NewClientBuilder().WithObjects(secret).Build()
+		policies, err := verification.NewPolicies(ctx, kubeClient, namespace, &lsv1alpha1.ComponentVerification{
+			Policies: []lsv1alpha1.ComponentVerificationPolicy{
+				{
+					ComponentNamePattern: "example.com/unsigned-*",
+					Mode:                 lsv1alpha1.VerificationModeDisabled,
+				},
+				{
+					ComponentNamePattern: "example.com/*",
+					Mode:                 mode,
+					TrustedKeys:          []lsv1alpha1.LocalSecretReference{{Name: "trusted-keys"}},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return policies
+	}
+
+	newRegistryAccess := func(policies *verification.Policies) model.RegistryAccess {
+		registryAccess, err := factory.NewRegistryAccess(ctx, nil, nil, nil, &config.LocalRegistryConfiguration{RootPath: repoDir}, &config.OCIConfiguration{AllowPlainHttp: true}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(model.SetVerificationPolicies(registryAccess, policies)).To(Succeed())
+		return registryAccess
+	}
+
+	getComponentVersion := func(policies *verification.Policies) error {
+		_, err := newRegistryAccess(policies).GetComponentVersion(ctx, cdRef)
+		return err
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		repoDir = GinkgoT().TempDir()
+		resources = nil
+		references = nil
+		Expect(os.MkdirAll(filepath.Join(repoDir, "blobs"), 0700)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(repoDir, "blobs", "config"), []byte(blob), 0600)).To(Succeed())
+
+		var err error
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		repoCtx := &types.UnstructuredTypedObject{}
+		Expect(repoCtx.UnmarshalJSON([]byte(`{"type":"local"}`))).To(Succeed())
+		cdRef = &lsv1alpha1.ComponentDescriptorReference{
+			RepositoryContext: repoCtx,
+			ComponentName:     componentName,
+			Version:           "1.0.0",
+		}
+	})
+
+	It("should select the policy by the component name", func() {
+		policies := newPolicies(lsv1alpha1.VerificationModeRequired, privateKey)
+		Expect(policies.ForComponent(componentName)).ToNot(BeNil())
+		Expect(policies.ForComponent(componentName).PublicKeys).To(HaveLen(1))
+		Expect(policies.ForComponent("example.com/unsigned-component")).To(BeNil())
+		Expect(policies.ForComponent("other.com/component")).To(BeNil())
+	})
+
+	It("should return a component version with a valid signature of a trusted key", func() {
+		writeComponent(true)
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getComponentVersion(newPolicies(lsv1alpha1.VerificationModeRequired, otherKey, privateKey))).To(Succeed())
+	})
+
+	It("should fail if the signature is not made by a trusted key", func() {
+		writeComponent(true)
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		err = getComponentVersion(newPolicies(lsv1alpha1.VerificationModeRequired, otherKey))
+		Expect(err).To(HaveOccurred())
+		Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorVerificationFailed)).To(BeTrue())
+	})
+
+	It("should fail if the blob of a resource does not match its digest", func() {
+		writeComponent(true)
+		Expect(os.WriteFile(filepath.Join(repoDir, "blobs", "config"), []byte("modified content"), 0600)).To(Succeed())
+		err := getComponentVersion(newPolicies(lsv1alpha1.VerificationModeRequired, privateKey))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(MatchRegexp("does not match its digest|mismatches existing digest"))
+		Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorVerificationFailed)).To(BeTrue())
+	})
+
+	Context("resources", func() {
+
+		const schema = `{"type": "string"}`
+
+		var policies *verification.Policies
+
+		BeforeEach(func() {
+			sum := sha256.Sum256([]byte(schema))
+			Expect(os.WriteFile(filepath.Join(repoDir, "blobs", "schema"), []byte(schema), 0600)).To(Succeed())
+			resources = []cdv2.Resource{
+				{
+					IdentityObjectMeta: cdv2.IdentityObjectMeta{Name: "schema", Version: "1.0.0", Type: mediatype.JSONSchemaType},
+					Relation:           cdv2.LocalRelation,
+					Access:             cdv2.NewUnstructuredType(cdv2.LocalFilesystemBlobType, map[string]interface{}{"filename": "schema", "mediaType": mediatype.JSONSchemaArtifactsMediaTypeV1}),
+					Digest: &cdv2.DigestSpec{
+						HashAlgorithm:          signatures.SHA256,
+						NormalisationAlgorithm: "genericBlobDigest/v1",
+						Value:                  hex.EncodeToString(sum[:]),
+					},
+				},
+			}
+			writeComponent(true)
+			policies = newPolicies(lsv1alpha1.VerificationModeRequired, privateKey)
+		})
+
+		getSchema := func(cv model.ComponentVersion) (*model.TypedResourceContent, error) {
+			res, err := cv.GetResource("schema", nil)
+			Expect(err).ToNot(HaveOccurred())
+			return res.GetTypedContent(ctx)
+		}
+
+		It("should return the content of a resource that matches its digest", func() {
+			cv, err := newRegistryAccess(policies).GetComponentVersion(ctx, cdRef)
+			Expect(err).ToNot(HaveOccurred())
+			content, err := getSchema(cv)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(content.Resource).To(BeEquivalentTo(schema))
+		})
+
+		It("should verify the blob of a resource again when it is fetched", func() {
+			cv, err := newRegistryAccess(policies).GetComponentVersion(ctx, cdRef)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.WriteFile(filepath.Join(repoDir, "blobs", "schema"), []byte(`{"type": "object"}`), 0600)).To(Succeed())
+			_, err = getSchema(cv)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the blob of resource \"schema\" does not match its digest"))
+		})
+
+	})
+
+	Context("references", func() {
+
+		var (
+			policies *verification.Policies
+			refCdRef *lsv1alpha1.ComponentDescriptorReference
+		)
+
+		BeforeEach(func() {
+			policies = newPolicies(lsv1alpha1.VerificationModeRequired, privateKey)
+			refCdRef = cdRef.DeepCopy()
+			refCdRef.ComponentName = referencedName
+		})
+
+		reference := func(digest *cdv2.DigestSpec) cdv2.ComponentReference {
+			return cdv2.ComponentReference{
+				Name:          "referenced",
+				ComponentName: referencedName,
+				Version:       "1.0.0",
+				Digest:        digest,
+			}
+		}
+
+		It("should verify a referenced component version by the digest of its reference", func() {
+			references = []cdv2.ComponentReference{reference(writeReferencedComponent("internal"))}
+			writeComponent(true)
+			registryAccess := newRegistryAccess(policies)
+			_, err := registryAccess.GetComponentVersion(ctx, cdRef)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = registryAccess.GetComponentVersion(ctx, refCdRef)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail if a referenced component version does not match the digest of its reference", func() {
+			references = []cdv2.ComponentReference{reference(writeReferencedComponent("internal"))}
+			writeComponent(true)
+			writeReferencedComponent("modified")
+			err := getComponentVersion(policies)
+			Expect(err).To(HaveOccurred())
+			Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorVerificationFailed)).To(BeTrue())
+		})
+
+		It("should fail if a referenced component version changes after the verification of the reference", func() {
+			if _, ok := factory.(*ocmlib.Factory); ok {
+				Skip("the ocm session keeps the component versions that it has resolved and verified")
+			}
+			references = []cdv2.ComponentReference{reference(writeReferencedComponent("internal"))}
+			writeComponent(true)
+			registryAccess := newRegistryAccess(policies)
+			_, err := registryAccess.GetComponentVersion(ctx, cdRef)
+			Expect(err).ToNot(HaveOccurred())
+			writeReferencedComponent("modified")
+			_, err = registryAccess.GetComponentVersion(ctx, refCdRef)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does not match the digest of its reference"))
+			Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorVerificationFailed)).To(BeTrue())
+		})
+
+	})
+
+	Context("oci artifacts", func() {
+		if _, ok := factory.(*ocmlib.Factory); ok {
+			// the ocm library verifies the digests of oci artifacts itself, and accesses the test registry only via https
+			return
+		}
+
+		var (
+			server   *httptest.Server
+			imageRef string
+			digest   string
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+			imageRef = strings.TrimPrefix(server.URL, "http://") + "/images/app:1.0.0"
+			img, err := random.Image(1024, 1)
+			Expect(err).ToNot(HaveOccurred())
+			ref, err := name.ParseReference(imageRef)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(remote.Write(ref, img)).To(Succeed())
+			imgDigest, err := img.Digest()
+			Expect(err).ToNot(HaveOccurred())
+			digest = imgDigest.Hex
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		ociResource := func(digest string) cdv2.Resource {
+			return cdv2.Resource{
+				IdentityObjectMeta: cdv2.IdentityObjectMeta{Name: "image", Version: "1.0.0", Type: cdv2.OCIImageType},
+				Relation:           cdv2.ExternalRelation,
+				Access:             cdv2.NewUnstructuredType(cdv2.OCIRegistryType, map[string]interface{}{"imageReference": imageRef}),
+				Digest: &cdv2.DigestSpec{
+					HashAlgorithm:          signatures.SHA256,
+					NormalisationAlgorithm: string(cdv2.OciArtifactDigestV1),
+					Value:                  digest,
+				},
+			}
+		}
+
+		It("should accept an oci artifact that matches its digest", func() {
+			resources = []cdv2.Resource{ociResource(digest)}
+			writeComponent(true)
+			Expect(getComponentVersion(newPolicies(lsv1alpha1.VerificationModeRequired, privateKey))).To(Succeed())
+		})
+
+		It("should pin the image reference of an oci artifact to its verified digest", func() {
+			resources = []cdv2.Resource{ociResource(digest)}
+			writeComponent(true)
+			cv, err := newRegistryAccess(newPolicies(lsv1alpha1.VerificationModeRequired, privateKey)).GetComponentVersion(ctx, cdRef)
+			Expect(err).ToNot(HaveOccurred())
+			res, err := cv.GetResource("image", nil)
+			Expect(err).ToNot(HaveOccurred())
+			spec, err := res.GetResource()
+			Expect(err).ToNot(HaveOccurred())
+			ociAccess := &cdv2.OCIRegistryAccess{}
+			Expect(spec.Access.DecodeInto(ociAccess)).To(Succeed())
+			Expect(ociAccess.ImageReference).To(Equal(strings.TrimSuffix(imageRef, ":1.0.0") + "@sha256:" + digest))
+		})
+
+		It("should fail if the manifest of an oci artifact does not match its digest", func() {
+			sum := sha256.Sum256([]byte("other manifest"))
+			resources = []cdv2.Resource{ociResource(hex.EncodeToString(sum[:]))}
+			writeComponent(true)
+			err := getComponentVersion(newPolicies(lsv1alpha1.VerificationModeRequired, privateKey))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the oci artifact of resource \"image\" does not match its digest"))
+			Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorVerificationFailed)).To(BeTrue())
+		})
+
+	})
+
+	It("should fail for an unsigned component version if the signature is required", func() {
+		writeComponent(false)
+		err := getComponentVersion(newPolicies(lsv1alpha1.VerificationModeRequired, privateKey))
+		Expect(err).To(HaveOccurred())
+		Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorVerificationFailed)).To(BeTrue())
+	})
+
+	It("should accept an unsigned component version if the signature is optional", func() {
+		writeComponent(false)
+		Expect(getComponentVersion(newPolicies(lsv1alpha1.VerificationModeOptional, privateKey))).To(Succeed())
+	})
+
+}
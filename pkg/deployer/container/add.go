@@ -73,7 +73,7 @@ func AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient
 		config.Identity,
 		config.Namespace,
 		config.GarbageCollection,
-		keepPods)
+		keepPods).WithNamespaceIsolation(config.NamespaceIsolation != nil)
 
 	return gc, nil
 }
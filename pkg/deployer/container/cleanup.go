@@ -101,9 +101,43 @@ func CleanupRBAC(ctx context.Context, deployItem *lsv1alpha1.DeployItem, hostCli
 // CleanupDeployItem deletes all secrets from a host cluster which belong to a deploy item.
 func CleanupDeployItem(ctx context.Context, deployItem *lsv1alpha1.DeployItem, lsClient, hostClient client.Client, hostNamespace string) error {
 	log := logging.FromContextOrDiscard(ctx)
+	if err := cleanupHostSecrets(ctx, deployItem, hostClient, hostNamespace); err != nil {
+		return err
+	}
+
+	// cleanup state
+	if err := state.CleanupState(ctx,
+		log,
+		hostClient,
+		hostNamespace,
+		lsv1alpha1helper.ObjectReferenceFromObject(deployItem)); err != nil {
+		return err
+	}
+
+	for _, secretName := range []string{DeployItemExportSecretName(deployItem.Name), DeployItemPlanSecretName(deployItem.Name)} {
+		secret := &corev1.Secret{}
+		secret.Name = secretName
+		secret.Namespace = deployItem.Namespace
+		if err := lsClient.Delete(ctx, secret); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	controllerutil.RemoveFinalizer(deployItem, lsv1alpha1.LandscaperFinalizer)
+	writer := read_write_layer.NewWriter(lsClient)
+	return writer.UpdateDeployItem(ctx, read_write_layer.W000038, deployItem)
+}
+
+// cleanupHostSecrets deletes the secrets of a deploy item that the deployer creates in the host namespace.
+// The state secrets are not deleted.
+func cleanupHostSecrets(ctx context.Context, deployItem *lsv1alpha1.DeployItem, hostClient client.Client, hostNamespace string) error {
 	secrets := []string{
 		ConfigurationSecretName(deployItem.Namespace, deployItem.Name),
+		TargetSecretName(deployItem.Namespace, deployItem.Name),
 		ExportSecretName(deployItem.Namespace, deployItem.Name),
+		PlanSecretName(deployItem.Namespace, deployItem.Name),
 		ImagePullSecretName(deployItem.Namespace, deployItem.Name),
 		ComponentDescriptorPullSecretName(deployItem.Namespace, deployItem.Name),
 		BluePrintPullSecretName(deployItem.Namespace, deployItem.Name),
@@ -119,26 +153,5 @@ func CleanupDeployItem(ctx context.Context, deployItem *lsv1alpha1.DeployItem, l
 			}
 		}
 	}
-
-	// cleanup state
-	if err := state.CleanupState(ctx,
-		log,
-		hostClient,
-		hostNamespace,
-		lsv1alpha1helper.ObjectReferenceFromObject(deployItem)); err != nil {
-		return err
-	}
-
-	secret := &corev1.Secret{}
-	secret.Name = DeployItemExportSecretName(deployItem.Name)
-	secret.Namespace = deployItem.Namespace
-	if err := lsClient.Delete(ctx, secret); err != nil {
-		if !apierrors.IsNotFound(err) {
-			return err
-		}
-	}
-
-	controllerutil.RemoveFinalizer(deployItem, lsv1alpha1.LandscaperFinalizer)
-	writer := read_write_layer.NewWriter(lsClient)
-	return writer.UpdateDeployItem(ctx, read_write_layer.W000038, deployItem)
+	return nil
 }
@@ -13,6 +13,7 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 
 	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/utils"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -84,6 +85,13 @@ func New(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient
 			currOp, "ValidateProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 	}
 
+	if providerConfig.ExportSchema != nil {
+		if err := jsonschema.ValidateSchema(providerConfig.ExportSchema.RawMessage); err != nil {
+			return nil, lserrors.NewWrappedError(err,
+				currOp, "ValidateExportSchema", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+	}
+
 	status, err := DecodeProviderStatus(item.Status.ProviderStatus)
 	if err != nil {
 		return nil, lserrors.NewWrappedError(err,
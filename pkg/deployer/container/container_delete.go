@@ -27,11 +27,24 @@ func (c *Container) Delete(ctx context.Context) error {
 		}
 	}
 
-	if err := CleanupRBAC(ctx, c.DeployItem, c.hostUncachedClient, c.Configuration.Namespace); err != nil {
+	if c.useRunnerPool() {
+		if err := c.releaseAssignedRunner(ctx); err != nil {
+			return lserrors.NewWrappedError(err,
+				"Delete", "ReleaseRunner", err.Error())
+		}
+	}
+
+	if err := CleanupRBAC(ctx, c.DeployItem, c.hostUncachedClient, c.HostNamespace()); err != nil {
 		return lserrors.NewWrappedError(err,
 			"Delete", "CleanupRBAC", err.Error())
 	}
-	if err := CleanupDeployItem(ctx, c.DeployItem, c.lsUncachedClient, c.hostUncachedClient, c.Configuration.Namespace); err != nil {
+	if c.useNamespaceIsolation() {
+		if err := DeleteHostNamespace(ctx, c.hostUncachedClient, c.HostNamespace()); err != nil {
+			return lserrors.NewWrappedError(err,
+				"Delete", "DeleteHostNamespace", err.Error())
+		}
+	}
+	if err := CleanupDeployItem(ctx, c.DeployItem, c.lsUncachedClient, c.hostUncachedClient, c.HostNamespace()); err != nil {
 		return lserrors.NewWrappedError(err,
 			"Delete", "CleanupDeployItem", err.Error())
 	}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// reconcilePlan handles the plan phase of a deploy item that requires a plan approval.
+// A new plan is computed for every job of the deploy item and the apply is only started
+// once the plan of the current job has been approved.
+func (c *Container) reconcilePlan(ctx context.Context) error {
+	logger := logging.FromContextOrDiscard(ctx)
+
+	if !c.hasPlan() {
+		if c.shouldRunNewPod(ctx, nil) {
+			return c.runPod(ctx, container.OperationPlan)
+		}
+		return nil
+	}
+
+	planStatus := c.ProviderStatus.PlanStatus
+	if c.DeployItem.Annotations[container.ContainerDeployerApprovePlanAnnotation] != planStatus.JobID {
+		c.setPlanApprovalCondition(lsv1alpha1.ConditionProgressing, "WaitingForApproval",
+			fmt.Sprintf("Plan is waiting for approval, annotate the deploy item with %s=%s to apply it",
+				container.ContainerDeployerApprovePlanAnnotation, planStatus.JobID))
+		c.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+		return nil
+	}
+
+	logger.Info("Plan has been approved, starting apply", lc.KeyJobID, planStatus.JobID)
+	planStatus.Approved = true
+	c.setPlanApprovalCondition(lsv1alpha1.ConditionTrue, "PlanApproved", "Plan has been approved")
+	return c.runPod(ctx, container.OperationReconcile)
+}
+
+// completePlan collects the result of a finished plan pod.
+func (c *Container) completePlan(ctx context.Context, pod *corev1.Pod) error {
+	operationName := "CompletePlan"
+	logger := logging.FromContextOrDiscard(ctx)
+
+	if pod.Status.Phase == corev1.PodSucceeded {
+		planRef, err := c.SyncPlan(ctx)
+		if err != nil {
+			return lserrors.NewWrappedError(err,
+				operationName, "SyncPlan", err.Error())
+		}
+		c.ProviderStatus.PlanStatus = &containerv1alpha1.PlanStatus{
+			JobID:         c.DeployItem.Status.JobID,
+			PlanReference: planRef,
+		}
+		c.setPlanApprovalCondition(lsv1alpha1.ConditionProgressing, "WaitingForApproval",
+			fmt.Sprintf("Plan is waiting for approval, annotate the deploy item with %s=%s to apply it",
+				container.ContainerDeployerApprovePlanAnnotation, c.DeployItem.Status.JobID))
+	} else if pod.Status.Phase == corev1.PodFailed {
+		lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
+	}
+
+	if err := c.collectAndSetPodStatus(pod, false); err != nil {
+		return lserrors.NewWrappedError(err,
+			operationName, "UpdatePodStatus", err.Error())
+	}
+
+	// write status to ensure the plan status is saved before deleting the pod
+	lsWriter := read_write_layer.NewWriter(c.lsUncachedClient)
+	if err := lsWriter.UpdateDeployItemStatus(ctx, read_write_layer.W000150, c.DeployItem); err != nil {
+		return lserrors.NewWrappedError(err, operationName, "UpdateDeployItemStatus", err.Error())
+	}
+
+	logger.Debug("Deleting plan pod, as it has finished", "podStatus", pod.Status.Phase)
+	return c.CleanupPod(ctx, pod)
+}
+
+// SyncPlan syncs the plan secret from the wait container to the landscaper cluster.
+func (c *Container) SyncPlan(ctx context.Context) (*lsv1alpha1.ObjectReference, error) {
+	secret := &corev1.Secret{}
+	key := kutil.ObjectKey(PlanSecretName(c.DeployItem.Namespace, c.DeployItem.Name), c.HostNamespace())
+	if err := c.hostUncachedClient.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("unable to fetch plan secret %s from host cluster: %w", key.String(), err)
+	}
+
+	planSecret := &corev1.Secret{}
+	planSecret.Name = DeployItemPlanSecretName(c.DeployItem.Name)
+	planSecret.Namespace = c.DeployItem.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, c.lsUncachedClient, planSecret, func() error {
+		planSecret.Data = secret.Data
+		return controllerutil.SetControllerReference(c.DeployItem, planSecret, api.LandscaperScheme)
+	}); err != nil {
+		return nil, fmt.Errorf("unable to sync plan to landscaper cluster: %w", err)
+	}
+
+	return &lsv1alpha1.ObjectReference{
+		Name:      planSecret.Name,
+		Namespace: planSecret.Namespace,
+	}, nil
+}
+
+// hasPlan returns whether a plan has been computed for the current job of the deploy item.
+func (c *Container) hasPlan() bool {
+	return c.ProviderStatus != nil && c.ProviderStatus.PlanStatus != nil &&
+		c.ProviderStatus.PlanStatus.JobID == c.DeployItem.Status.JobID
+}
+
+// isPlanApproved returns whether the plan of the current job of the deploy item has been approved.
+func (c *Container) isPlanApproved() bool {
+	return c.hasPlan() && c.ProviderStatus.PlanStatus.Approved
+}
+
+func (c *Container) setPlanApprovalCondition(status lsv1alpha1.ConditionStatus, reason, message string) {
+	cond := lsv1alpha1helper.GetOrInitCondition(c.DeployItem.Status.Conditions, container.PlanApprovalConditionType)
+	cond = lsv1alpha1helper.UpdatedCondition(cond, status, reason, message)
+	c.DeployItem.Status.Conditions = lsv1alpha1helper.MergeConditions(c.DeployItem.Status.Conditions, cond)
+}
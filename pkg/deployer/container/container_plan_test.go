@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	containerctlr "github.com/gardener/landscaper/pkg/deployer/container"
+)
+
+var _ = Describe("Plan Approval", func() {
+
+	const hostNamespace = "host"
+
+	var (
+		ctx        context.Context
+		lsClient   client.Client
+		hostClient client.Client
+		item       *lsv1alpha1.DeployItem
+	)
+
+	newContainer := func() *containerctlr.Container {
+		di := &lsv1alpha1.DeployItem{}
+		Expect(lsClient.Get(ctx, kutil.ObjectKeyFromObject(item), di)).To(Succeed())
+		c, err := containerctlr.New(lsClient, lsClient, hostClient, hostClient,
+			containerv1alpha1.Configuration{Namespace: hostNamespace}, di, &lsv1alpha1.Context{}, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	// reconcile runs a reconcile of the deploy item and persists its status as the deployer library does.
+	reconcile := func() *containerctlr.Container {
+		c := newContainer()
+		Expect(c.Reconcile(ctx, container.OperationReconcile)).To(Succeed())
+		Expect(lsClient.Status().Update(ctx, c.DeployItem)).To(Succeed())
+		return c
+	}
+
+	listPods := func() []corev1.Pod {
+		pods := &corev1.PodList{}
+		Expect(hostClient.List(ctx, pods, client.InNamespace(hostNamespace))).To(Succeed())
+		return pods.Items
+	}
+
+	// getRunningOperation returns the operation of the only running pod of the deploy item.
+	getRunningOperation := func() string {
+		pods := listPods()
+		Expect(pods).To(HaveLen(1))
+		for _, cont := range pods[0].Spec.Containers {
+			if cont.Name != container.MainContainerName {
+				continue
+			}
+			for _, env := range cont.Env {
+				if env.Name == container.OperationName {
+					return env.Value
+				}
+			}
+		}
+		Fail("the main container has no operation")
+		return ""
+	}
+
+	// finishPod marks the only running pod of the deploy item as succeeded.
+	finishPod := func() {
+		pods := listPods()
+		Expect(pods).To(HaveLen(1))
+		pod := &pods[0]
+		pod.Status.Phase = corev1.PodSucceeded
+		Expect(hostClient.Status().Update(ctx, pod)).To(Succeed())
+	}
+
+	annotate := func(value string) {
+		Expect(lsClient.Get(ctx, kutil.ObjectKeyFromObject(item), item)).To(Succeed())
+		metav1.SetMetaDataAnnotation(&item.ObjectMeta, container.ContainerDeployerApprovePlanAnnotation, value)
+		Expect(lsClient.Update(ctx, item)).To(Succeed())
+	}
+
+	startJob := func(jobID string) {
+		Expect(lsClient.Get(ctx, kutil.ObjectKeyFromObject(item), item)).To(Succeed())
+		item.Status.JobID = jobID
+		item.Status.Phase = lsv1alpha1.DeployItemPhases.Init
+		Expect(lsClient.Status().Update(ctx, item)).To(Succeed())
+	}
+
+	planStatus := func(c *containerctlr.Container) *containerv1alpha1.PlanStatus {
+		Expect(c.ProviderStatus).ToNot(BeNil())
+		return c.ProviderStatus.PlanStatus
+	}
+
+	planApprovalCondition := func(c *containerctlr.Container) lsv1alpha1.Condition {
+		cond := lsv1alpha1helper.GetCondition(c.DeployItem.Status.Conditions, container.PlanApprovalConditionType)
+		Expect(cond).ToNot(BeNil())
+		return *cond
+	}
+
+	// plan runs the plan pod of the current job and returns the container once the plan is waiting for approval.
+	plan := func(jobID string) *containerctlr.Container {
+		reconcile()
+		Expect(getRunningOperation()).To(Equal(string(container.OperationPlan)))
+
+		planSecret := &corev1.Secret{}
+		planSecret.Name = containerctlr.PlanSecretName(item.Namespace, item.Name)
+		planSecret.Namespace = hostNamespace
+		planSecret.Data = map[string][]byte{lsv1alpha1.DataObjectSecretDataKey: []byte("plan of " + jobID)}
+		Expect(client.IgnoreAlreadyExists(hostClient.Create(ctx, planSecret))).To(Succeed())
+		Expect(hostClient.Update(ctx, planSecret)).To(Succeed())
+		finishPod()
+
+		c := reconcile()
+		Expect(listPods()).To(BeEmpty())
+		Expect(planStatus(c)).ToNot(BeNil())
+		Expect(planStatus(c).JobID).To(Equal(jobID))
+		Expect(planStatus(c).Approved).To(BeFalse())
+		Expect(planApprovalCondition(c).Reason).To(Equal("WaitingForApproval"))
+		return c
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+
+		var err error
+		item, err = containerctlr.NewDeployItemBuilder().
+			Key("default", "plan-test").
+			ProviderConfig(&containerv1alpha1.ProviderConfiguration{
+				Image:               "example.com/image:1.0.0",
+				RequirePlanApproval: true,
+			}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		item.Generation = 1
+		item.Status.JobID = "job-1"
+		item.Status.Phase = lsv1alpha1.DeployItemPhases.Init
+		item.Status.TransitionTimes = &lsv1alpha1.TransitionTimes{InitTime: ptr.To(metav1.Now())}
+
+		lsClient = fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.DeployItem{}).
+			WithObjects(item).
+			Build()
+
+		// the token secrets of the service accounts are usually created by kubernetes
+		serviceAccountSecrets := []client.Object{}
+		for _, saName := range []string{containerctlr.InitContainerServiceAccountName(item), containerctlr.WaitContainerServiceAccountName(item)} {
+			secret := &corev1.Secret{}
+			secret.Name = saName + "-token"
+			secret.Namespace = hostNamespace
+			secret.Annotations = map[string]string{corev1.ServiceAccountNameKey: saName}
+			secret.Type = corev1.SecretTypeServiceAccountToken
+			secret.Data = map[string][]byte{corev1.ServiceAccountTokenKey: []byte("token")}
+			serviceAccountSecrets = append(serviceAccountSecrets, secret)
+		}
+		hostClient = fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(serviceAccountSecrets...).
+			Build()
+	})
+
+	It("should only apply the plan once it has been approved", func() {
+		c := plan("job-1")
+		Expect(c.DeployItem.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+
+		planRef := planStatus(c).PlanReference
+		Expect(planRef).ToNot(BeNil())
+		planSecret := &corev1.Secret{}
+		Expect(lsClient.Get(ctx, planRef.NamespacedName(), planSecret)).To(Succeed())
+		Expect(planSecret.Data).To(HaveKeyWithValue(lsv1alpha1.DataObjectSecretDataKey, []byte("plan of job-1")))
+
+		// without approval, the deploy item keeps waiting
+		c = reconcile()
+		Expect(listPods()).To(BeEmpty())
+		Expect(planApprovalCondition(c).Reason).To(Equal("WaitingForApproval"))
+
+		annotate("job-1")
+		c = reconcile()
+		Expect(getRunningOperation()).To(Equal(string(container.OperationReconcile)))
+		Expect(planStatus(c).Approved).To(BeTrue())
+		Expect(planApprovalCondition(c).Status).To(Equal(lsv1alpha1.ConditionTrue))
+
+		// the approval is consumed by the apply
+		Expect(lsClient.Get(ctx, kutil.ObjectKeyFromObject(item), item)).To(Succeed())
+		Expect(item.Annotations).ToNot(HaveKey(container.ContainerDeployerApprovePlanAnnotation))
+
+		finishPod()
+		c = reconcile()
+		Expect(listPods()).To(BeEmpty())
+		Expect(c.DeployItem.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+	})
+
+	It("should not apply a plan that is approved for another job", func() {
+		plan("job-1")
+
+		annotate("job-0")
+		c := reconcile()
+		Expect(listPods()).To(BeEmpty())
+		Expect(planStatus(c).Approved).To(BeFalse())
+		Expect(planApprovalCondition(c).Reason).To(Equal("WaitingForApproval"))
+		Expect(planApprovalCondition(c).Message).To(ContainSubstring("job-1"))
+	})
+
+	It("should compute a new plan if the deploy item changes before the plan is approved", func() {
+		plan("job-1")
+
+		// the approval of the outdated plan must not apply the new job
+		annotate("job-1")
+		startJob("job-2")
+		c := plan("job-2")
+		Expect(listPods()).To(BeEmpty())
+		Expect(planStatus(c).JobID).To(Equal("job-2"))
+
+		planSecret := &corev1.Secret{}
+		Expect(lsClient.Get(ctx, planStatus(c).PlanReference.NamespacedName(), planSecret)).To(Succeed())
+		Expect(planSecret.Data).To(HaveKeyWithValue(lsv1alpha1.DataObjectSecretDataKey, []byte("plan of job-2")))
+	})
+
+	It("should compute a new plan for a new job after the previous plan has been applied", func() {
+		plan("job-1")
+		annotate("job-1")
+		reconcile()
+		finishPod()
+		reconcile()
+		Expect(listPods()).To(BeEmpty())
+
+		startJob("job-2")
+		c := plan("job-2")
+		Expect(planStatus(c).Approved).To(BeFalse())
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -24,6 +25,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
@@ -34,15 +36,21 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
 	"github.com/gardener/landscaper/pkg/components/registries"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
 	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
 	"github.com/gardener/landscaper/pkg/deployerlegacy"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
+// ErrInvalidExports is returned if the exports of a container do not match the export schema of the deploy item.
+var ErrInvalidExports = errors.New("exports do not match the export schema")
+
 // Reconcile handles the reconcile flow for a container deploy item.
 // todo: do retries on failure: difference between main container failure and init/wait container failure
 func (c *Container) Reconcile(ctx context.Context, operation container.OperationType) error {
@@ -50,6 +58,10 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 		return err
 	}
 
+	if c.useRunnerPool() {
+		return c.reconcileRun(ctx, operation)
+	}
+
 	pod, err := c.getPod(ctx)
 	logger := logging.FromContextOrDiscard(ctx)
 	if err != nil && !apierrors.IsNotFound(err) {
@@ -84,111 +96,34 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 		}
 	}
 
-	if c.shouldRunNewPod(ctx, pod) {
-		operationName := "DeployPod"
-
-		// before we start syncing lets read the current deploy item from the server
-		oldDeployItem := &lsv1alpha1.DeployItem{}
-		if err := read_write_layer.GetDeployItem(ctx, c.lsUncachedClient, kutil.ObjectKey(c.DeployItem.GetName(),
-			c.DeployItem.GetNamespace()), oldDeployItem, read_write_layer.R000027); err != nil {
-			return lserrors.NewWrappedError(err,
-				operationName, "FetchDeployItem", err.Error())
-		}
-		defaultLabels := DefaultLabels(c.Configuration.Identity, c.DeployItem.Name, c.DeployItem.Name, c.DeployItem.Namespace)
-
-		if err := c.SyncConfiguration(ctx, defaultLabels); err != nil {
-			return lserrors.NewWrappedError(err,
-				operationName, "SyncConfiguration", err.Error())
-		}
-
-		if err := c.SyncTarget(ctx, defaultLabels); err != nil {
-			return lserrors.NewWrappedError(err,
-				operationName, "SyncTarget", err.Error())
-		}
-
-		imagePullSecret, blueprintSecret, componentDescriptorSecret, err := c.parseAndSyncSecrets(ctx, defaultLabels)
-		if err != nil {
-			return lserrors.NewWrappedError(err,
-				operationName, "ParseAndSyncSecrets", err.Error())
-		}
-		// ensure new pod
-		serviceAccountSecrets, err := EnsureServiceAccounts(ctx, c.hostUncachedClient, c.DeployItem, c.Configuration.Namespace, defaultLabels)
-		if err != nil {
-			return lserrors.NewWrappedError(err,
-				operationName, "EnsurePodRBAC", err.Error())
-		}
-		c.InitContainerServiceAccountSecret, c.WaitContainerServiceAccountSecret = serviceAccountSecrets.InitContainerServiceAccountSecret, serviceAccountSecrets.WaitContainerServiceAccountSecret
-
-		c.ProviderStatus = &containerv1alpha1.ProviderStatus{}
-		podOpts := PodOptions{
-			DeployerID: c.Configuration.Identity,
-
-			ProviderConfiguration:             c.ProviderConfiguration,
-			InitContainer:                     c.Configuration.InitContainer,
-			WaitContainer:                     c.Configuration.WaitContainer,
-			InitContainerServiceAccountSecret: c.InitContainerServiceAccountSecret,
-			WaitContainerServiceAccountSecret: c.WaitContainerServiceAccountSecret,
-			ConfigurationSecretName:           ConfigurationSecretName(c.DeployItem.Namespace, c.DeployItem.Name),
-			TargetSecretName:                  TargetSecretName(c.DeployItem.Namespace, c.DeployItem.Name),
-
-			ImagePullSecret:               imagePullSecret,
-			BluePrintPullSecret:           blueprintSecret,
-			ComponentDescriptorPullSecret: componentDescriptorSecret,
-
-			UseOCM: c.Context.UseOCM,
-
-			Name:                 c.DeployItem.Name,
-			Namespace:            c.Configuration.Namespace,
-			DeployItemName:       c.DeployItem.Name,
-			DeployItemNamespace:  c.DeployItem.Namespace,
-			DeployItemGeneration: c.DeployItem.Generation,
-
-			Operation: operation,
-			Debug:     true,
-		}
-		pod, err := generatePod(podOpts)
-		if err != nil {
-			return lserrors.NewWrappedError(err,
-				operationName, "PodGeneration", err.Error())
-		}
-
-		if err := c.hostUncachedClient.Create(ctx, pod); err != nil {
-			return lserrors.NewWrappedError(err,
-				operationName, "CreatePod", err.Error())
-		}
-
-		// update status
-		c.ProviderStatus.LastOperation = string(operation)
-		if err := c.collectAndSetPodStatus(pod, false); err != nil {
-			return lserrors.NewWrappedError(err,
-				operationName, "UpdatePodStatus", err.Error())
+	if operation == container.OperationReconcile && c.ProviderConfiguration.RequirePlanApproval {
+		if pod != nil && c.ProviderStatus.LastOperation == string(container.OperationPlan) {
+			return c.completePlan(ctx, pod)
 		}
-
-		c.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
-		if operation == container.OperationDelete {
-			c.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Deleting
-		}
-
-		if err := lsWriter.UpdateDeployItemStatus(ctx, read_write_layer.W000063, c.DeployItem); err != nil {
-			return lserrors.NewWrappedError(err, operationName, "UpdateDeployItemStatus", err.Error())
+		if pod == nil && !c.isPlanApproved() {
+			return c.reconcilePlan(ctx)
 		}
+	}
 
-		if lsv1alpha1helper.HasOperation(c.DeployItem.ObjectMeta, lsv1alpha1.ReconcileOperation) {
-			delete(c.DeployItem.Annotations, lsv1alpha1.OperationAnnotation)
-			if err := lsWriter.UpdateDeployItem(ctx, read_write_layer.W000039, c.DeployItem); err != nil {
-				return lserrors.NewWrappedError(err, operationName, "RemoveReconcileAnnotation", err.Error())
-			}
-		}
-		return nil
+	if c.shouldRunNewPod(ctx, pod) {
+		return c.runPod(ctx, operation)
 	}
 
 	operationName := "Complete"
+	var exportErr error
 	if pod != nil {
 		podSucceeded := pod.Status.Phase == corev1.PodSucceeded
 		if podSucceeded {
-			if err := c.SyncExport(ctx); err != nil {
-				return lserrors.NewWrappedError(err,
-					operationName, "SyncExport", err.Error())
+			if err := c.SyncExport(ctx, operation); err != nil {
+				if !errors.Is(err, ErrInvalidExports) {
+					return lserrors.NewWrappedError(err,
+						operationName, "SyncExport", err.Error())
+				}
+				// invalid exports cannot be fixed by a retry, therefore the deploy item fails.
+				exportErr = lserrors.NewWrappedError(err,
+					operationName, "ValidateExports", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+				lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
+				podSucceeded = false
 			}
 		} else if pod.Status.Phase == corev1.PodFailed {
 			lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
@@ -211,6 +146,9 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 			return err
 		}
 	}
+	if exportErr != nil {
+		return exportErr
+	}
 	if c.ProviderStatus != nil && c.ProviderStatus.PodStatus != nil && c.ProviderStatus.PodStatus.LastSuccessfulJobID != nil && *c.ProviderStatus.PodStatus.LastSuccessfulJobID == c.DeployItem.Status.JobID {
 		logger.Debug("Setting phase to 'Succeeded', because pod was seen successfully finished for current jobID", lc.KeyJobID, c.DeployItem.Status.JobID)
 		c.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
@@ -218,6 +156,153 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 	return nil
 }
 
+// runPod creates a new pod that executes the given operation.
+func (c *Container) runPod(ctx context.Context, operation container.OperationType) error {
+	lsWriter := read_write_layer.NewWriter(c.lsUncachedClient)
+	operationName := "DeployPod"
+
+	// before we start syncing lets read the current deploy item from the server
+	oldDeployItem := &lsv1alpha1.DeployItem{}
+	if err := read_write_layer.GetDeployItem(ctx, c.lsUncachedClient, kutil.ObjectKey(c.DeployItem.GetName(),
+		c.DeployItem.GetNamespace()), oldDeployItem, read_write_layer.R000027); err != nil {
+		return lserrors.NewWrappedError(err,
+			operationName, "FetchDeployItem", err.Error())
+	}
+	defaultLabels := DefaultLabels(c.Configuration.Identity, c.DeployItem.Name, c.DeployItem.Name, c.DeployItem.Namespace)
+
+	if err := c.ensureHostNamespace(ctx, defaultLabels); err != nil {
+		return lserrors.NewWrappedError(err,
+			operationName, "EnsureHostNamespace", err.Error())
+	}
+
+	if err := c.migrateToHostNamespace(ctx); err != nil {
+		return lserrors.NewWrappedError(err,
+			operationName, "MigrateToHostNamespace", err.Error())
+	}
+
+	if err := c.SyncConfiguration(ctx, defaultLabels); err != nil {
+		return lserrors.NewWrappedError(err,
+			operationName, "SyncConfiguration", err.Error())
+	}
+
+	if err := c.SyncTarget(ctx, defaultLabels); err != nil {
+		return lserrors.NewWrappedError(err,
+			operationName, "SyncTarget", err.Error())
+	}
+
+	imagePullSecret, blueprintSecret, componentDescriptorSecret, err := c.parseAndSyncSecrets(ctx, defaultLabels)
+	if err != nil {
+		return lserrors.NewWrappedError(err,
+			operationName, "ParseAndSyncSecrets", err.Error())
+	}
+
+	// the plan status is kept as it belongs to the current job and not to a single pod
+	var planStatus *containerv1alpha1.PlanStatus
+	if c.ProviderStatus != nil {
+		planStatus = c.ProviderStatus.PlanStatus
+	}
+	c.ProviderStatus = &containerv1alpha1.ProviderStatus{PlanStatus: planStatus}
+
+	var pod *corev1.Pod
+	if c.useRunnerPool() {
+		pod, err = c.dispatchRun(ctx, operation, imagePullSecret, map[string]string{
+			"blueprint-pull-secret": blueprintSecret,
+			"cd-pull-secret":        componentDescriptorSecret,
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		pod, err = c.createPod(ctx, operation, imagePullSecret, blueprintSecret, componentDescriptorSecret, defaultLabels)
+		if err != nil {
+			return err
+		}
+	}
+
+	// update status
+	c.ProviderStatus.LastOperation = string(operation)
+	if err := c.collectAndSetPodStatus(pod, false); err != nil {
+		return lserrors.NewWrappedError(err,
+			operationName, "UpdatePodStatus", err.Error())
+	}
+
+	c.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+	if operation == container.OperationDelete {
+		c.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Deleting
+	}
+
+	if err := lsWriter.UpdateDeployItemStatus(ctx, read_write_layer.W000063, c.DeployItem); err != nil {
+		return lserrors.NewWrappedError(err, operationName, "UpdateDeployItemStatus", err.Error())
+	}
+
+	if lsv1alpha1helper.HasOperation(c.DeployItem.ObjectMeta, lsv1alpha1.ReconcileOperation) {
+		delete(c.DeployItem.Annotations, lsv1alpha1.OperationAnnotation)
+		if err := lsWriter.UpdateDeployItem(ctx, read_write_layer.W000039, c.DeployItem); err != nil {
+			return lserrors.NewWrappedError(err, operationName, "RemoveReconcileAnnotation", err.Error())
+		}
+	}
+
+	if _, ok := c.DeployItem.Annotations[container.ContainerDeployerApprovePlanAnnotation]; ok && operation == container.OperationReconcile {
+		delete(c.DeployItem.Annotations, container.ContainerDeployerApprovePlanAnnotation)
+		if err := lsWriter.UpdateDeployItem(ctx, read_write_layer.W000151, c.DeployItem); err != nil {
+			return lserrors.NewWrappedError(err, operationName, "RemoveApprovePlanAnnotation", err.Error())
+		}
+	}
+	return nil
+}
+
+// createPod creates a new pod that executes the given operation.
+func (c *Container) createPod(ctx context.Context, operation container.OperationType,
+	imagePullSecret, blueprintSecret, componentDescriptorSecret string, defaultLabels map[string]string) (*corev1.Pod, error) {
+	operationName := "DeployPod"
+
+	// ensure new pod
+	serviceAccountSecrets, err := EnsureServiceAccounts(ctx, c.hostUncachedClient, c.DeployItem, c.HostNamespace(), defaultLabels)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			operationName, "EnsurePodRBAC", err.Error())
+	}
+	c.InitContainerServiceAccountSecret, c.WaitContainerServiceAccountSecret = serviceAccountSecrets.InitContainerServiceAccountSecret, serviceAccountSecrets.WaitContainerServiceAccountSecret
+
+	podOpts := PodOptions{
+		DeployerID: c.Configuration.Identity,
+
+		ProviderConfiguration:             c.ProviderConfiguration,
+		InitContainer:                     c.Configuration.InitContainer,
+		WaitContainer:                     c.Configuration.WaitContainer,
+		InitContainerServiceAccountSecret: c.InitContainerServiceAccountSecret,
+		WaitContainerServiceAccountSecret: c.WaitContainerServiceAccountSecret,
+		ConfigurationSecretName:           ConfigurationSecretName(c.DeployItem.Namespace, c.DeployItem.Name),
+		TargetSecretName:                  TargetSecretName(c.DeployItem.Namespace, c.DeployItem.Name),
+
+		ImagePullSecret:               imagePullSecret,
+		BluePrintPullSecret:           blueprintSecret,
+		ComponentDescriptorPullSecret: componentDescriptorSecret,
+
+		UseOCM: c.Context.UseOCM,
+
+		Name:                 c.DeployItem.Name,
+		Namespace:            c.HostNamespace(),
+		DeployItemName:       c.DeployItem.Name,
+		DeployItemNamespace:  c.DeployItem.Namespace,
+		DeployItemGeneration: c.DeployItem.Generation,
+
+		Operation: operation,
+		Debug:     true,
+	}
+	pod, err := generatePod(podOpts)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			operationName, "PodGeneration", err.Error())
+	}
+
+	if err := c.hostUncachedClient.Create(ctx, pod); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			operationName, "CreatePod", err.Error())
+	}
+	return pod, nil
+}
+
 // collectAndSetPodStatus the pod status and updates the container provider status
 func (c *Container) collectAndSetPodStatus(pod *corev1.Pod, updateLastSuccessfulJobID bool) error {
 	c.DeployItem.Status.Conditions = setConditionsFromPod(pod, c.DeployItem.Status.Conditions)
@@ -438,7 +523,7 @@ const (
 func (c *Container) syncSecrets(ctx context.Context,
 	secretName,
 	imageReference string,
-	keyring *credentials.GeneralOciKeyring,
+	keyring credentials.OCIKeyring,
 	defaultLabels map[string]string) (string, error) {
 	authConfig := keyring.Get(imageReference)
 	if authConfig == nil {
@@ -475,7 +560,7 @@ func (c *Container) syncSecrets(ctx context.Context,
 
 	authSecret := &corev1.Secret{}
 	authSecret.Name = secretName
-	authSecret.Namespace = c.Configuration.Namespace
+	authSecret.Namespace = c.HostNamespace()
 	authSecret.Type = corev1.SecretTypeDockerConfigJson
 	if _, err := controllerutil.CreateOrUpdate(ctx, c.hostUncachedClient, authSecret, func() error {
 		InjectDefaultLabels(authSecret, defaultLabels)
@@ -491,6 +576,9 @@ func (c *Container) syncSecrets(ctx context.Context,
 }
 
 // parseAndSyncSecrets parses and synchronizes relevant pull secrets for container image, blueprint & component descriptor secrets from the landscaper and host cluster.
+// Credentials of token sources are short-lived. They are written into the synced secrets on every reconcile, i.e. before
+// a pod is created, and are not refreshed afterwards. Image pulls after the expiry of the token, e.g. when a pod of the
+// same run is rescheduled, fail until the deploy item is reconciled again.
 func (c *Container) parseAndSyncSecrets(ctx context.Context, defaultLabels map[string]string) (imagePullSecret, blueprintSecret, componentDescriptorSecret string, erro error) {
 	log, ctx := logging.FromContextOrNew(ctx, nil)
 	fs := osfs.New()
@@ -537,8 +625,21 @@ func (c *Container) parseAndSyncSecrets(ctx context.Context, defaultLabels map[s
 		}
 	}
 
+	// registry credentials of the context take precedence over the pull secrets for matching registries
+	var registryCredentials *registrycredentials.Credentials
+	var keyring credentials.OCIKeyring = ociKeyring
+	if len(c.Context.RegistryCredentials) > 0 {
+		var err error
+		registryCredentials, err = registrycredentials.NewCredentials(ctx, c.lsUncachedClient, c.Context.Namespace, c.Context.RegistryCredentials)
+		if err != nil {
+			erro = fmt.Errorf("unable to read registry credentials of context %s/%s: %w", c.Context.Namespace, c.Context.Name, err)
+			return
+		}
+		keyring = registrycredentials.NewKeyring(ociKeyring, registryCredentials)
+	}
+
 	var err error
-	imagePullSecret, err = c.syncSecrets(ctx, ImagePullSecretName(c.DeployItem.Namespace, c.DeployItem.Name), c.ProviderConfiguration.Image, ociKeyring, defaultLabels)
+	imagePullSecret, err = c.syncSecrets(ctx, ImagePullSecretName(c.DeployItem.Namespace, c.DeployItem.Name), c.ProviderConfiguration.Image, keyring, defaultLabels)
 	if err != nil {
 		erro = fmt.Errorf("unable to obtain and sync image pull secret to host cluster: %w", err)
 		return
@@ -561,7 +662,7 @@ func (c *Container) parseAndSyncSecrets(ctx context.Context, defaultLabels map[s
 			erro = fmt.Errorf("unable to generate component descriptor oci reference: %w", err)
 			return
 		}
-		componentDescriptorSecret, err = c.syncSecrets(ctx, ComponentDescriptorPullSecretName(c.DeployItem.Namespace, c.DeployItem.Name), cdRef, ociKeyring, defaultLabels)
+		componentDescriptorSecret, err = c.syncSecrets(ctx, ComponentDescriptorPullSecretName(c.DeployItem.Namespace, c.DeployItem.Name), cdRef, keyring, defaultLabels)
 		if err != nil {
 			erro = fmt.Errorf("unable to obtain and sync component descriptor secret to host cluster: %w", err)
 			return
@@ -575,6 +676,12 @@ func (c *Container) parseAndSyncSecrets(ctx context.Context, defaultLabels map[s
 			erro = fmt.Errorf("unable create registry reference to resolve component descriptor for ref %#v: %w", c.ProviderConfiguration.Blueprint.Reference, err)
 			return
 		}
+		if registryCredentials != nil {
+			if err := model.SetRegistryCredentials(registryAccess, registryCredentials); err != nil {
+				erro = err
+				return
+			}
+		}
 
 		compRef := deployerlegacy.GetReferenceFromComponentDescriptorDefinition(c.ProviderConfiguration.ComponentDescriptor)
 		blueprintName := c.ProviderConfiguration.Blueprint.Reference.ResourceName
@@ -611,7 +718,7 @@ func (c *Container) parseAndSyncSecrets(ctx context.Context, defaultLabels map[s
 			return
 		}
 
-		blueprintSecret, err = c.syncSecrets(ctx, BluePrintPullSecretName(c.DeployItem.Namespace, c.DeployItem.Name), ociRegistryAccess.ImageReference, ociKeyring, defaultLabels)
+		blueprintSecret, err = c.syncSecrets(ctx, BluePrintPullSecretName(c.DeployItem.Namespace, c.DeployItem.Name), ociRegistryAccess.ImageReference, keyring, defaultLabels)
 		if err != nil {
 			erro = fmt.Errorf("unable to obtain and sync blueprint pull secret to host cluster: %w", err)
 			return
@@ -625,7 +732,7 @@ func (c *Container) parseAndSyncSecrets(ctx context.Context, defaultLabels map[s
 func (c *Container) SyncConfiguration(ctx context.Context, defaultLabels map[string]string) error {
 	secret := &corev1.Secret{}
 	secret.Name = ConfigurationSecretName(c.DeployItem.Namespace, c.DeployItem.Name)
-	secret.Namespace = c.Configuration.Namespace
+	secret.Namespace = c.HostNamespace()
 	if _, err := controllerutil.CreateOrUpdate(ctx, c.hostUncachedClient, secret, func() error {
 		InjectDefaultLabels(secret, defaultLabels)
 		kutil.SetMetaDataLabel(&secret.ObjectMeta, container.ContainerDeployerTypeLabel, "configuration")
@@ -643,7 +750,7 @@ func (c *Container) SyncConfiguration(ctx context.Context, defaultLabels map[str
 func (c *Container) SyncTarget(ctx context.Context, defaultLabels map[string]string) error {
 	secret := &corev1.Secret{}
 	secret.Name = TargetSecretName(c.DeployItem.Namespace, c.DeployItem.Name)
-	secret.Namespace = c.Configuration.Namespace
+	secret.Namespace = c.HostNamespace()
 	if _, err := controllerutil.CreateOrUpdate(ctx, c.hostUncachedClient, secret, func() error {
 		InjectDefaultLabels(secret, defaultLabels)
 		kutil.SetMetaDataLabel(&secret.ObjectMeta, container.ContainerDeployerTypeLabel, "target")
@@ -662,11 +769,12 @@ func (c *Container) SyncTarget(ctx context.Context, defaultLabels map[string]str
 }
 
 // SyncExport syncs the export secret from the wait container to the deploy item export.
-func (c *Container) SyncExport(ctx context.Context) error {
+// The exports are validated against the export schema of the provider configuration unless the deploy item is deleted.
+func (c *Container) SyncExport(ctx context.Context, operation container.OperationType) error {
 	log, ctx := logging.FromContextOrNew(ctx, nil)
 	log.Debug("Sync export to landscaper cluster")
 	secret := &corev1.Secret{}
-	key := kutil.ObjectKey(ExportSecretName(c.DeployItem.Namespace, c.DeployItem.Name), c.Configuration.Namespace)
+	key := kutil.ObjectKey(ExportSecretName(c.DeployItem.Namespace, c.DeployItem.Name), c.HostNamespace())
 	if err := c.hostUncachedClient.Get(ctx, key, secret); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.Info("No export found for deploy item", "deployitem", key.String())
@@ -675,6 +783,12 @@ func (c *Container) SyncExport(ctx context.Context) error {
 		return fmt.Errorf("unable to fetch exported secret %s from host cluster: %w", ExportSecretName(c.DeployItem.Namespace, c.DeployItem.Name), err)
 	}
 
+	if operation != container.OperationDelete {
+		if err := c.validateExports(secret.Data[lsv1alpha1.DataObjectSecretDataKey]); err != nil {
+			return err
+		}
+	}
+
 	expSecret := &corev1.Secret{}
 	expSecret.Name = DeployItemExportSecretName(c.DeployItem.Name)
 	expSecret.Namespace = c.DeployItem.Namespace
@@ -693,6 +807,23 @@ func (c *Container) SyncExport(ctx context.Context) error {
 	return nil
 }
 
+// validateExports validates the exports of the container against the export schema of the provider configuration.
+// Empty exports are not validated, as the container has not exported anything.
+// The returned error wraps ErrInvalidExports if the exports do not match the schema.
+func (c *Container) validateExports(data []byte) error {
+	if c.ProviderConfiguration.ExportSchema == nil || len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return fmt.Errorf("%w: unable to decode exports: %s", ErrInvalidExports, err.Error())
+	}
+	if err := jsonschema.ValidateBytes(c.ProviderConfiguration.ExportSchema.RawMessage, jsonData, nil); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidExports, err.Error())
+	}
+	return nil
+}
+
 // CleanupPod cleans up a pod that was started with the container deployer.
 func (c *Container) CleanupPod(ctx context.Context, pod *corev1.Pod) error {
 	return CleanupPod(ctx, c.hostUncachedClient, pod, c.Configuration.DebugOptions != nil && c.Configuration.DebugOptions.KeepPod)
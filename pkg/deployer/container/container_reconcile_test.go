@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	containerctlr "github.com/gardener/landscaper/pkg/deployer/container"
+)
+
+var _ = Describe("Export Validation", func() {
+
+	const hostNamespace = "host"
+
+	var (
+		ctx        context.Context
+		lsClient   client.Client
+		hostClient client.Client
+		c          *containerctlr.Container
+	)
+
+	// setExports sets the exports as they are written by the wait container.
+	setExports := func(data string) {
+		secret := &corev1.Secret{}
+		secret.Name = containerctlr.ExportSecretName(c.DeployItem.Namespace, c.DeployItem.Name)
+		secret.Namespace = hostNamespace
+		secret.Data = map[string][]byte{lsv1alpha1.DataObjectSecretDataKey: []byte(data)}
+		Expect(hostClient.Create(ctx, secret)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+
+		schema := json.RawMessage(`{"type": "object", "required": ["url"], "properties": {"url": {"type": "string"}}}`)
+		item, err := containerctlr.NewDeployItemBuilder().
+			Key("default", "export-test").
+			ProviderConfig(&containerv1alpha1.ProviderConfiguration{
+				Image:        "example.com/image:1.0.0",
+				ExportSchema: &lsv1alpha1.JSONSchemaDefinition{RawMessage: schema},
+			}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(item).Build()
+		hostClient = fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		c, err = containerctlr.New(lsClient, lsClient, hostClient, hostClient,
+			containerv1alpha1.Configuration{Namespace: hostNamespace}, item, &lsv1alpha1.Context{}, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should sync exports that match the export schema", func() {
+		setExports("url: https://example.com")
+		Expect(c.SyncExport(ctx, container.OperationReconcile)).To(Succeed())
+
+		Expect(c.DeployItem.Status.ExportReference).ToNot(BeNil())
+		secret := &corev1.Secret{}
+		Expect(lsClient.Get(ctx, c.DeployItem.Status.ExportReference.NamespacedName(), secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKeyWithValue(lsv1alpha1.DataObjectSecretDataKey, []byte("url: https://example.com")))
+	})
+
+	It("should reject exports that do not match the export schema", func() {
+		setExports("url: 42")
+		err := c.SyncExport(ctx, container.OperationReconcile)
+		Expect(err).To(MatchError(containerctlr.ErrInvalidExports))
+		Expect(c.DeployItem.Status.ExportReference).To(BeNil())
+	})
+
+	It("should reject exports that cannot be decoded", func() {
+		setExports("url: [")
+		Expect(c.SyncExport(ctx, container.OperationReconcile)).To(MatchError(containerctlr.ErrInvalidExports))
+	})
+
+	It("should not validate empty exports", func() {
+		setExports(" \n")
+		Expect(c.SyncExport(ctx, container.OperationReconcile)).To(Succeed())
+	})
+
+	It("should not validate missing exports", func() {
+		Expect(c.SyncExport(ctx, container.OperationReconcile)).To(Succeed())
+		Expect(c.DeployItem.Status.ExportReference).To(BeNil())
+	})
+
+	It("should not validate the exports of a deletion", func() {
+		setExports("url: 42")
+		Expect(c.SyncExport(ctx, container.OperationDelete)).To(Succeed())
+	})
+})
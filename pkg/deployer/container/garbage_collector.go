@@ -41,6 +41,7 @@ type GarbageCollector struct {
 	config             containerv1alpha1.GarbageCollection
 	requeueAfter       time.Duration
 	keepPods           bool
+	namespaceIsolation bool
 }
 
 // NewGarbageCollector creates a new Garbage collector that cleanups leaked service accounts, rbac rules and pods.
@@ -65,6 +66,22 @@ func NewGarbageCollector(
 	}
 }
 
+// WithNamespaceIsolation configures whether the deploy items are executed in dedicated host namespaces.
+// If enabled, leaked resources are collected in all namespaces and leaked dedicated namespaces are deleted.
+func (gc *GarbageCollector) WithNamespaceIsolation(enabled bool) *GarbageCollector {
+	gc.namespaceIsolation = enabled
+	return gc
+}
+
+// listNamespace returns the namespace in which resources of deploy items are garbage collected.
+// An empty namespace is returned if the resources are distributed over dedicated namespaces.
+func (gc *GarbageCollector) listNamespace() string {
+	if gc.namespaceIsolation {
+		return ""
+	}
+	return gc.hostNamespace
+}
+
 func (gc *GarbageCollector) StartDeployerJob(ctx context.Context) error {
 	gc.log.Info("GarbageCollector: starting garbage collection")
 
@@ -76,10 +93,10 @@ func (gc *GarbageCollector) Cleanup(ctx context.Context) {
 	ctx = logging.NewContext(ctx, gc.log)
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
-	listOptions := []client.ListOption{client.InNamespace(gc.hostNamespace),
+	listOptions := []client.ListOption{client.InNamespace(gc.listNamespace()),
 		client.HasLabels{container.ContainerDeployerDeployItemNameLabel, container.ContainerDeployerDeployItemNamespaceLabel}}
 	if len(gc.deployerID) != 0 {
-		listOptions = []client.ListOption{client.InNamespace(gc.hostNamespace),
+		listOptions = []client.ListOption{client.InNamespace(gc.listNamespace()),
 			client.HasLabels{container.ContainerDeployerDeployItemNameLabel, container.ContainerDeployerDeployItemNamespaceLabel},
 			client.MatchingLabels{container.ContainerDeployerIDLabel: gc.deployerID}}
 	}
@@ -136,6 +153,9 @@ func (gc *GarbageCollector) Cleanup(ctx context.Context) {
 		}
 	}
 
+	gc.cleanupRunners(ctx)
+	gc.cleanupNamespaces(ctx)
+
 	if !gc.keepPods {
 		// cleanup pods
 		podList := &corev1.PodList{}
@@ -152,6 +172,107 @@ func (gc *GarbageCollector) Cleanup(ctx context.Context) {
 	}
 }
 
+// cleanupRunners deletes terminated runner pods and releases runner pods
+// whose run belongs to a deploy item that does not exist anymore.
+func (gc *GarbageCollector) cleanupRunners(ctx context.Context) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	listOptions := []client.ListOption{client.InNamespace(gc.listNamespace()),
+		client.HasLabels{container.ContainerDeployerRunnerPoolLabel}}
+	if len(gc.deployerID) != 0 {
+		listOptions = append(listOptions, client.MatchingLabels{container.ContainerDeployerIDLabel: gc.deployerID})
+	}
+
+	podList := &corev1.PodList{}
+	if err := read_write_layer.ListPods(ctx, gc.hostUncachedClient, podList, read_write_layer.R000114, listOptions...); err != nil {
+		logger.Error(err, err.Error())
+		return
+	}
+
+	for i := range podList.Items {
+		next := &podList.Items[i]
+		if err := gc.cleanupRunner(ctx, next); err != nil {
+			logger.Error(err, "cleanup runner", lc.KeyResource, kutil.ObjectKeyFromObject(next).String())
+		}
+	}
+}
+
+// cleanupRunner deletes a terminated runner pod or releases the runner if the deploy item of its run does not exist anymore.
+func (gc *GarbageCollector) cleanupRunner(ctx context.Context, runner *corev1.Pod) error {
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+	if runner.Status.Phase == corev1.PodSucceeded || runner.Status.Phase == corev1.PodFailed {
+		if gc.keepPods {
+			return nil
+		}
+		logger.Debug("Garbage collected", lc.KeyReason, "runner is terminated")
+		if err := gc.hostUncachedClient.Delete(ctx, runner); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to garbage collect runner %s: %w", kutil.ObjectKeyFromObject(runner).String(), err)
+		}
+		return nil
+	}
+
+	run, err := GetRunnerRun(runner)
+	if err != nil || run == nil {
+		return err
+	}
+	runStatus, err := GetRunnerRunStatus(runner, run)
+	if err != nil {
+		return err
+	}
+	if !runStatus.IsFinished() {
+		// a running run is released by the deployer as soon as it has finished.
+		return nil
+	}
+
+	di := &lsv1alpha1.DeployItem{}
+	if err := read_write_layer.GetDeployItem(ctx, gc.lsUncachedClient, run.DeployItem.NamespacedName(), di, read_write_layer.R000115); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Debug("Released runner", lc.KeyReason, "deploy item does not exist anymore")
+			return ReleaseRunner(ctx, gc.hostUncachedClient, runner)
+		}
+		return err
+	}
+	return nil
+}
+
+// cleanupNamespaces deletes dedicated host namespaces whose deploy item does not exist anymore.
+func (gc *GarbageCollector) cleanupNamespaces(ctx context.Context) {
+	if !gc.namespaceIsolation {
+		return
+	}
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	listOptions := []client.ListOption{
+		client.HasLabels{container.ContainerDeployerDeployItemNameLabel, container.ContainerDeployerDeployItemNamespaceLabel}}
+	if len(gc.deployerID) != 0 {
+		listOptions = append(listOptions, client.MatchingLabels{container.ContainerDeployerIDLabel: gc.deployerID})
+	}
+
+	nsList := &corev1.NamespaceList{}
+	if err := gc.hostUncachedClient.List(ctx, nsList, listOptions...); err != nil {
+		logger.Error(err, err.Error())
+		return
+	}
+
+	for i := range nsList.Items {
+		next := &nsList.Items[i]
+		if next.DeletionTimestamp != nil {
+			continue
+		}
+		shouldGC, err := gc.shouldGarbageCollect(ctx, next)
+		if err != nil {
+			logger.Error(err, "cleanup namespace", lc.KeyResource, next.Name)
+			continue
+		}
+		if !shouldGC {
+			continue
+		}
+		if err := DeleteHostNamespace(ctx, gc.hostUncachedClient, next.Name); err != nil {
+			logger.Error(err, "cleanup namespace", lc.KeyResource, next.Name)
+		}
+	}
+}
+
 func (gc *GarbageCollector) cleanupRBACResources(ctx context.Context, obj client.Object) error {
 	shouldGC, err := gc.shouldGarbageCollect(ctx, obj)
 	if err != nil {
@@ -238,7 +359,7 @@ func (gc *GarbageCollector) isLatestPod(ctx context.Context, pod *corev1.Pod) (b
 
 	podList := &corev1.PodList{}
 	if err := read_write_layer.ListPods(ctx, gc.hostUncachedClient, podList, read_write_layer.R000076,
-		client.InNamespace(gc.hostNamespace),
+		client.InNamespace(pod.Namespace),
 		client.MatchingLabels{
 			container.ContainerDeployerDeployItemNameLabel:      diName,
 			container.ContainerDeployerDeployItemNamespaceLabel: diNamespace,
@@ -247,7 +368,7 @@ func (gc *GarbageCollector) isLatestPod(ctx context.Context, pod *corev1.Pod) (b
 	}
 
 	if len(podList.Items) == 0 {
-		return false, fmt.Errorf("no pods found in the host namespace %s", gc.hostNamespace)
+		return false, fmt.Errorf("no pods found in the host namespace %s", pod.Namespace)
 	}
 
 	// only return latest pod and ignore previous runs
@@ -76,6 +76,23 @@ func Run(ctx context.Context, fs vfs.FileSystem) error {
 	return run(ctx, opts, kubeClient, fs)
 }
 
+// Prepare downloads the import config, the component descriptor and the blob content of the given deploy item
+// to the paths defined by the env vars using the given kubernetes client.
+// In contrast to Run, the deploy item is not read from the env vars so that multiple deploy items can be prepared
+// in the same container, e.g. by a runner.
+func Prepare(ctx context.Context, kubeClient client.Client, fs vfs.FileSystem, deployItemKey lsv1alpha1.ObjectReference, useOCM bool) error {
+	opts := &options{}
+	opts.Complete()
+	opts.deployItemName = deployItemKey.Name
+	opts.deployItemNamespace = deployItemKey.Namespace
+	opts.DeployItemKey = deployItemKey
+	opts.UseOCM = useOCM
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	return run(ctx, opts, kubeClient, fs)
+}
+
 func run(ctx context.Context, opts *options, kubeClient client.Client, fs vfs.FileSystem) error {
 	log, ctx := logging.FromContextOrNew(ctx, nil)
 	providerConfigBytes, err := vfs.ReadFile(fs, opts.ConfigurationFilePath)
@@ -96,6 +113,11 @@ func run(ctx context.Context, opts *options, kubeClient client.Client, fs vfs.Fi
 	if err := fs.MkdirAll(path.Dir(opts.ExportsFilePath), os.ModePerm); err != nil {
 		return err
 	}
+	if len(opts.PlanFilePath) != 0 {
+		if err := fs.MkdirAll(path.Dir(opts.PlanFilePath), os.ModePerm); err != nil {
+			return err
+		}
+	}
 	if err := fs.MkdirAll(path.Dir(opts.ComponentDescriptorFilePath), os.ModePerm); err != nil {
 		return err
 	}
@@ -25,6 +25,7 @@ type options struct {
 	ConfigurationFilePath       string
 	ImportsFilePath             string
 	ExportsFilePath             string
+	PlanFilePath                string
 	ComponentDescriptorFilePath string
 	TargetFilePath              string
 	ContentDirPath              string
@@ -46,6 +47,7 @@ func (o *options) Complete() {
 	o.ConfigurationFilePath = os.Getenv(container.ConfigurationPathName)
 	o.ImportsFilePath = os.Getenv(container.ImportsPathName)
 	o.ExportsFilePath = os.Getenv(container.ExportsPathName)
+	o.PlanFilePath = os.Getenv(container.PlanPathName)
 	o.ComponentDescriptorFilePath = os.Getenv(container.ComponentDescriptorPathName)
 	o.TargetFilePath = os.Getenv(container.TargetPathName)
 	o.ContentDirPath = os.Getenv(container.ContentPathName)
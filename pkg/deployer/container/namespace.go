@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/container/state"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// HostNamespaceResourceQuotaName is the name of the resource quota that is created in a dedicated host namespace.
+const HostNamespaceResourceQuotaName = "container-deployer"
+
+// HostNamespaceName returns the name of the dedicated host namespace of a deploy item.
+// The deploy item namespace and name are hashed as their concatenation may exceed the maximum length of a namespace name.
+func HostNamespaceName(prefix, deployItemNamespace, deployItemName string) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s/%s", deployItemNamespace, deployItemName)))
+	return prefix + hex.EncodeToString(h[:])[:16]
+}
+
+// useNamespaceIsolation returns whether the pods of the deploy item are executed in a dedicated namespace.
+func (c *Container) useNamespaceIsolation() bool {
+	return c.Configuration.NamespaceIsolation != nil
+}
+
+// HostNamespace returns the namespace of the host cluster in which the pods and secrets of the deploy item are created.
+func (c *Container) HostNamespace() string {
+	if !c.useNamespaceIsolation() {
+		return c.Configuration.Namespace
+	}
+	return HostNamespaceName(c.Configuration.NamespaceIsolation.NamePrefix, c.DeployItem.Namespace, c.DeployItem.Name)
+}
+
+// ensureHostNamespace creates the dedicated host namespace of the deploy item
+// including its network policies and resource quota if namespace isolation is enabled.
+func (c *Container) ensureHostNamespace(ctx context.Context, labels map[string]string) error {
+	if !c.useNamespaceIsolation() {
+		return nil
+	}
+	return EnsureHostNamespace(ctx, c.hostUncachedClient, c.Configuration.NamespaceIsolation, c.HostNamespace(), labels)
+}
+
+// migrateToHostNamespace moves a deploy item that was executed before the namespace isolation has been enabled
+// from the configured namespace to its dedicated host namespace.
+// The latest state is moved, finished pods and all other resources of the deploy item in the configured namespace are removed.
+// An error is returned as long as a pod of the deploy item is still running in the configured namespace.
+func (c *Container) migrateToHostNamespace(ctx context.Context) error {
+	if !c.useNamespaceIsolation() || c.Configuration.Namespace == c.HostNamespace() {
+		return nil
+	}
+	log := logging.FromContextOrDiscard(ctx)
+	previousNamespace := c.Configuration.Namespace
+
+	podList := &corev1.PodList{}
+	if err := read_write_layer.ListPods(ctx, c.hostUncachedClient, podList, read_write_layer.R000126,
+		client.InNamespace(previousNamespace), client.MatchingLabels{
+			container.ContainerDeployerDeployItemNameLabel:      c.DeployItem.Name,
+			container.ContainerDeployerDeployItemNamespaceLabel: c.DeployItem.Namespace,
+		}); err != nil {
+		return err
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if !controllerutil.ContainsFinalizer(pod, container.ContainerDeployerFinalizer) {
+			continue
+		}
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			return fmt.Errorf("pod %s is still running in namespace %s", pod.Name, previousNamespace)
+		}
+		if err := c.CleanupPod(ctx, pod); err != nil {
+			return err
+		}
+	}
+
+	if err := state.MoveState(ctx, c.hostUncachedClient, previousNamespace, c.HostNamespace(),
+		lsv1alpha1helper.ObjectReferenceFromObject(c.DeployItem)); err != nil {
+		return fmt.Errorf("unable to move state: %w", err)
+	}
+	if err := CleanupRBAC(ctx, c.DeployItem, c.hostUncachedClient, previousNamespace); err != nil {
+		return err
+	}
+	if err := cleanupHostSecrets(ctx, c.DeployItem, c.hostUncachedClient, previousNamespace); err != nil {
+		return err
+	}
+	log.Debug("Successfully migrated deploy item to host namespace", "namespace", c.HostNamespace())
+	return nil
+}
+
+// EnsureHostNamespace ensures that the given dedicated host namespace exists
+// and contains the network policies and the resource quota of the namespace isolation configuration.
+func EnsureHostNamespace(ctx context.Context, hostClient client.Client, config *containerv1alpha1.NamespaceIsolationConfiguration, namespace string, labels map[string]string) error {
+	ns := &corev1.Namespace{}
+	ns.Name = namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, hostClient, ns, func() error {
+		InjectDefaultLabels(ns, labels)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("unable to create namespace %s: %w", namespace, err)
+	}
+
+	for i, raw := range config.NetworkPolicies {
+		desired := &networkingv1.NetworkPolicy{}
+		if err := yaml.Unmarshal(raw.Raw, desired); err != nil {
+			return fmt.Errorf("unable to decode network policy %d: %w", i, err)
+		}
+		if len(desired.Name) == 0 {
+			return fmt.Errorf("network policy %d has no name", i)
+		}
+		np := &networkingv1.NetworkPolicy{}
+		np.Name = desired.Name
+		np.Namespace = namespace
+		if _, err := controllerutil.CreateOrUpdate(ctx, hostClient, np, func() error {
+			InjectDefaultLabels(np, desired.Labels)
+			InjectDefaultLabels(np, labels)
+			np.Spec = desired.Spec
+			return nil
+		}); err != nil {
+			return fmt.Errorf("unable to create network policy %s: %w", desired.Name, err)
+		}
+	}
+
+	if config.ResourceQuota != nil {
+		quota := &corev1.ResourceQuota{}
+		quota.Name = HostNamespaceResourceQuotaName
+		quota.Namespace = namespace
+		if _, err := controllerutil.CreateOrUpdate(ctx, hostClient, quota, func() error {
+			InjectDefaultLabels(quota, labels)
+			quota.Spec = *config.ResourceQuota.DeepCopy()
+			return nil
+		}); err != nil {
+			return fmt.Errorf("unable to create resource quota: %w", err)
+		}
+	}
+	return nil
+}
+
+// DeleteHostNamespace deletes a dedicated host namespace.
+// Finalizers of remaining pods are removed so that the deletion of the namespace is not blocked.
+func DeleteHostNamespace(ctx context.Context, hostClient client.Client, namespace string) error {
+	log := logging.FromContextOrDiscard(ctx)
+
+	podList := &corev1.PodList{}
+	if err := read_write_layer.ListPods(ctx, hostClient, podList, read_write_layer.R000118, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if !controllerutil.ContainsFinalizer(pod, container.ContainerDeployerFinalizer) {
+			continue
+		}
+		controllerutil.RemoveFinalizer(pod, container.ContainerDeployerFinalizer)
+		if err := hostClient.Update(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to remove finalizer from pod: %w", err)
+		}
+	}
+
+	ns := &corev1.Namespace{}
+	ns.Name = namespace
+	if err := hostClient.Delete(ctx, ns); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	log.Debug("Successfully deleted host namespace", "namespace", namespace)
+	return nil
+}
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	containerctlr "github.com/gardener/landscaper/pkg/deployer/container"
+	"github.com/gardener/landscaper/pkg/deployer/container/state"
+)
+
+var _ = Describe("Namespace Isolation", func() {
+
+	const (
+		sharedNamespace = "host"
+		namePrefix      = "ls-container-"
+	)
+
+	var (
+		ctx        context.Context
+		hostClient client.Client
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+		hostClient = fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	})
+
+	Context("HostNamespaceName", func() {
+		It("should return a valid and unique namespace name per deploy item", func() {
+			name := containerctlr.HostNamespaceName(namePrefix, "a-very-long-namespace-name-of-a-deploy-item", "a-very-long-name-of-a-deploy-item")
+			Expect(name).To(HavePrefix(namePrefix))
+			Expect(len(name)).To(BeNumerically("<=", 63))
+			Expect(containerctlr.HostNamespaceName(namePrefix, "a-very-long-namespace-name-of-a-deploy-item", "a-very-long-name-of-a-deploy-item")).To(Equal(name))
+
+			Expect(containerctlr.HostNamespaceName(namePrefix, "a", "b-c")).ToNot(Equal(containerctlr.HostNamespaceName(namePrefix, "a-b", "c")))
+		})
+	})
+
+	Context("EnsureHostNamespace", func() {
+		It("should create the namespace with its network policies and resource quota", func() {
+			config := &containerv1alpha1.NamespaceIsolationConfiguration{
+				NamePrefix: namePrefix,
+				NetworkPolicies: []runtime.RawExtension{{Raw: []byte(`
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: deny-ingress
+  namespace: other
+  labels:
+    policy: deny
+spec:
+  podSelector: {}
+  policyTypes:
+  - Ingress
+`)}},
+				ResourceQuota: &corev1.ResourceQuotaSpec{
+					Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("2")},
+				},
+			}
+			labels := map[string]string{container.ContainerDeployerDeployItemNameLabel: "test"}
+			Expect(containerctlr.EnsureHostNamespace(ctx, hostClient, config, "ls-container-test", labels)).To(Succeed())
+			// the namespace is reconciled again before every pod
+			Expect(containerctlr.EnsureHostNamespace(ctx, hostClient, config, "ls-container-test", labels)).To(Succeed())
+
+			ns := &corev1.Namespace{}
+			Expect(hostClient.Get(ctx, kutil.ObjectKey("ls-container-test", ""), ns)).To(Succeed())
+			Expect(ns.Labels).To(HaveKeyWithValue(container.ContainerDeployerDeployItemNameLabel, "test"))
+
+			np := &networkingv1.NetworkPolicy{}
+			Expect(hostClient.Get(ctx, kutil.ObjectKey("deny-ingress", "ls-container-test"), np)).To(Succeed())
+			Expect(np.Labels).To(HaveKeyWithValue("policy", "deny"))
+			Expect(np.Labels).To(HaveKeyWithValue(container.ContainerDeployerDeployItemNameLabel, "test"))
+			Expect(np.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress))
+
+			quota := &corev1.ResourceQuota{}
+			Expect(hostClient.Get(ctx, kutil.ObjectKey(containerctlr.HostNamespaceResourceQuotaName, "ls-container-test"), quota)).To(Succeed())
+			Expect(quota.Spec.Hard).To(HaveKeyWithValue(corev1.ResourcePods, resource.MustParse("2")))
+		})
+
+		It("should reject network policies without a name", func() {
+			config := &containerv1alpha1.NamespaceIsolationConfiguration{
+				NetworkPolicies: []runtime.RawExtension{{Raw: []byte(`{"spec": {"podSelector": {}}}`)}},
+			}
+			Expect(containerctlr.EnsureHostNamespace(ctx, hostClient, config, "ls-container-test", nil)).To(MatchError(ContainSubstring("has no name")))
+		})
+	})
+
+	Context("DeleteHostNamespace", func() {
+		It("should remove the finalizers of remaining pods and delete the namespace", func() {
+			ns := &corev1.Namespace{}
+			ns.Name = "ls-container-test"
+			pod := &corev1.Pod{}
+			pod.Name = "test"
+			pod.Namespace = ns.Name
+			pod.Finalizers = []string{container.ContainerDeployerFinalizer}
+			Expect(hostClient.Create(ctx, ns)).To(Succeed())
+			Expect(hostClient.Create(ctx, pod)).To(Succeed())
+
+			Expect(containerctlr.DeleteHostNamespace(ctx, hostClient, ns.Name)).To(Succeed())
+
+			Expect(hostClient.Get(ctx, kutil.ObjectKeyFromObject(pod), pod)).To(Succeed())
+			Expect(pod.Finalizers).To(BeEmpty())
+			Expect(apierrors.IsNotFound(hostClient.Get(ctx, kutil.ObjectKeyFromObject(ns), ns))).To(BeTrue())
+			// a namespace that is already deleted is ignored
+			Expect(containerctlr.DeleteHostNamespace(ctx, hostClient, ns.Name)).To(Succeed())
+		})
+	})
+
+	Context("Reconcile", func() {
+
+		var (
+			lsClient           client.Client
+			item               *lsv1alpha1.DeployItem
+			dedicatedNamespace string
+		)
+
+		newContainer := func() *containerctlr.Container {
+			c, err := containerctlr.New(lsClient, lsClient, hostClient, hostClient,
+				containerv1alpha1.Configuration{
+					Namespace:          sharedNamespace,
+					NamespaceIsolation: &containerv1alpha1.NamespaceIsolationConfiguration{NamePrefix: namePrefix},
+				}, item, &lsv1alpha1.Context{}, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			return c
+		}
+
+		listPods := func(namespace string) []corev1.Pod {
+			pods := &corev1.PodList{}
+			Expect(hostClient.List(ctx, pods, client.InNamespace(namespace))).To(Succeed())
+			return pods.Items
+		}
+
+		// stateSecret returns a chunk of a state as it is written by the wait container.
+		stateSecret := func(namespace, name, uuid string, created time.Time) *corev1.Secret {
+			secret := &corev1.Secret{}
+			secret.Name = name
+			secret.Namespace = namespace
+			secret.CreationTimestamp = metav1.NewTime(created)
+			secret.Labels = map[string]string{
+				container.ContainerDeployerDeployItemNameLabel:      item.Name,
+				container.ContainerDeployerDeployItemNamespaceLabel: item.Namespace,
+				container.ContainerDeployerTypeLabel:                "state",
+			}
+			secret.Annotations = map[string]string{
+				container.ContainerDeployerStateUUIDAnnotation: uuid,
+				container.ContainerDeployerStateNumAnnotation:  "0",
+			}
+			secret.Data = map[string][]byte{lsv1alpha1.DataObjectSecretDataKey: []byte(uuid)}
+			return secret
+		}
+
+		// previousPod returns a pod of the deploy item that has been executed before the namespace isolation was enabled.
+		previousPod := func(phase corev1.PodPhase) *corev1.Pod {
+			pod := &corev1.Pod{}
+			pod.Name = "previous"
+			pod.Namespace = sharedNamespace
+			pod.Finalizers = []string{container.ContainerDeployerFinalizer}
+			pod.Labels = containerctlr.DefaultLabels("", item.Name, item.Name, item.Namespace)
+			pod.Status.Phase = phase
+			return pod
+		}
+
+		BeforeEach(func() {
+			var err error
+			item, err = containerctlr.NewDeployItemBuilder().
+				Key("default", "isolation-test").
+				ProviderConfig(&containerv1alpha1.ProviderConfiguration{
+					Image: "example.com/image:1.0.0",
+				}).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			item.Generation = 1
+			item.Status.JobID = "job-1"
+			item.Status.Phase = lsv1alpha1.DeployItemPhases.Init
+			item.Status.TransitionTimes = &lsv1alpha1.TransitionTimes{InitTime: ptr.To(metav1.Now())}
+			dedicatedNamespace = containerctlr.HostNamespaceName(namePrefix, item.Namespace, item.Name)
+
+			lsClient = fake.NewClientBuilder().
+				WithScheme(api.LandscaperScheme).
+				WithStatusSubresource(&lsv1alpha1.DeployItem{}).
+				WithObjects(item).
+				Build()
+
+			// the token secrets of the service accounts are usually created by kubernetes
+			for _, saName := range []string{containerctlr.InitContainerServiceAccountName(item), containerctlr.WaitContainerServiceAccountName(item)} {
+				secret := &corev1.Secret{}
+				secret.Name = saName + "-token"
+				secret.Namespace = dedicatedNamespace
+				secret.Annotations = map[string]string{corev1.ServiceAccountNameKey: saName}
+				secret.Type = corev1.SecretTypeServiceAccountToken
+				secret.Data = map[string][]byte{corev1.ServiceAccountTokenKey: []byte("token")}
+				Expect(hostClient.Create(ctx, secret)).To(Succeed())
+			}
+		})
+
+		It("should execute the deploy item in its dedicated namespace", func() {
+			c := newContainer()
+			Expect(c.HostNamespace()).To(Equal(dedicatedNamespace))
+			Expect(c.Reconcile(ctx, container.OperationReconcile)).To(Succeed())
+
+			ns := &corev1.Namespace{}
+			Expect(hostClient.Get(ctx, kutil.ObjectKey(dedicatedNamespace, ""), ns)).To(Succeed())
+			Expect(listPods(dedicatedNamespace)).To(HaveLen(1))
+			Expect(listPods(sharedNamespace)).To(BeEmpty())
+
+			secret := &corev1.Secret{}
+			Expect(hostClient.Get(ctx, kutil.ObjectKey(containerctlr.ConfigurationSecretName(item.Namespace, item.Name), dedicatedNamespace), secret)).To(Succeed())
+		})
+
+		It("should migrate a deploy item from the configured namespace to its dedicated namespace", func() {
+			now := time.Now()
+			sa := &corev1.ServiceAccount{}
+			sa.Name = containerctlr.InitContainerServiceAccountName(item)
+			sa.Namespace = sharedNamespace
+			configSecret := &corev1.Secret{}
+			configSecret.Name = containerctlr.ConfigurationSecretName(item.Namespace, item.Name)
+			configSecret.Namespace = sharedNamespace
+			for _, obj := range []client.Object{
+				previousPod(corev1.PodSucceeded),
+				sa,
+				configSecret,
+				stateSecret(sharedNamespace, "state-old", "old", now.Add(-time.Hour)),
+				stateSecret(sharedNamespace, "state-new", "new", now),
+			} {
+				Expect(hostClient.Create(ctx, obj)).To(Succeed())
+			}
+
+			c := newContainer()
+			Expect(c.Reconcile(ctx, container.OperationReconcile)).To(Succeed())
+
+			Expect(listPods(sharedNamespace)).To(BeEmpty())
+			Expect(listPods(dedicatedNamespace)).To(HaveLen(1))
+			Expect(apierrors.IsNotFound(hostClient.Get(ctx, kutil.ObjectKeyFromObject(sa), sa))).To(BeTrue())
+			Expect(apierrors.IsNotFound(hostClient.Get(ctx, kutil.ObjectKeyFromObject(configSecret), configSecret))).To(BeTrue())
+
+			ref := lsv1alpha1.ObjectReference{Name: item.Name, Namespace: item.Namespace}
+			secrets := &corev1.SecretList{}
+			Expect(hostClient.List(ctx, secrets, state.StateSecretListOptions(sharedNamespace, ref)...)).To(Succeed())
+			Expect(secrets.Items).To(BeEmpty())
+			Expect(hostClient.List(ctx, secrets, state.StateSecretListOptions(dedicatedNamespace, ref)...)).To(Succeed())
+			Expect(secrets.Items).To(HaveLen(1))
+			Expect(secrets.Items[0].Data).To(HaveKeyWithValue(lsv1alpha1.DataObjectSecretDataKey, []byte("new")))
+		})
+
+		It("should wait for a running pod in the configured namespace before migrating the deploy item", func() {
+			pod := previousPod(corev1.PodRunning)
+			Expect(hostClient.Create(ctx, pod)).To(Succeed())
+
+			c := newContainer()
+			Expect(c.Reconcile(ctx, container.OperationReconcile)).To(MatchError(ContainSubstring("still running")))
+			Expect(listPods(dedicatedNamespace)).To(BeEmpty())
+			Expect(listPods(sharedNamespace)).To(HaveLen(1))
+		})
+	})
+})
@@ -54,6 +54,16 @@ func DeployItemExportSecretName(deployItemName string) string {
 	return fmt.Sprintf("%s-export", deployItemName)
 }
 
+// PlanSecretName generates the secret name for the plan secret that is written by the wait container.
+func PlanSecretName(deployItemNamespace, deployItemName string) string {
+	return fmt.Sprintf("%s-%s-plan", deployItemNamespace, deployItemName)
+}
+
+// DeployItemPlanSecretName generates the secret name for the plan secret in the landscaper cluster.
+func DeployItemPlanSecretName(deployItemName string) string {
+	return fmt.Sprintf("%s-plan", deployItemName)
+}
+
 // ConfigurationSecretName generates the secret name for the imported secret.
 // todo: use container identity
 func ConfigurationSecretName(deployItemNamespace, deployItemName string) string {
@@ -250,6 +260,10 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 			Name:  container.DeployItemNamespaceName,
 			Value: opts.DeployItemNamespace,
 		},
+		{
+			Name:  container.OperationName,
+			Value: string(opts.Operation),
+		},
 	}
 	additionalEnvVars := []corev1.EnvVar{
 		{
@@ -368,7 +382,7 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 func (c *Container) getPod(ctx context.Context) (*corev1.Pod, error) {
 	podList := &corev1.PodList{}
 	if err := read_write_layer.ListPods(ctx, c.hostUncachedClient, podList, read_write_layer.R000077,
-		client.InNamespace(c.Configuration.Namespace), client.MatchingLabels{
+		client.InNamespace(c.HostNamespace()), client.MatchingLabels{
 			container.ContainerDeployerDeployItemNameLabel:      c.DeployItem.Name,
 			container.ContainerDeployerDeployItemNamespaceLabel: c.DeployItem.Namespace,
 		}); err != nil {
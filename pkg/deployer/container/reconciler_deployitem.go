@@ -6,6 +6,7 @@ package container
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/gardener/component-cli/ociclient/cache"
@@ -16,7 +17,9 @@ import (
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/cache/shared"
 	cnudieutils "github.com/gardener/landscaper/pkg/components/cnudie/utils"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
 	"github.com/gardener/landscaper/pkg/components/registries"
 	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
@@ -29,6 +32,7 @@ const (
 const (
 	TimeoutCheckpointContainerStartReconcile = "container deployer: start reconcile"
 	TimeoutCheckpointContainerStartDelete    = "container deployer: start delete"
+	TimeoutCheckpointContainerDispatchRun    = "container deployer: dispatch run"
 )
 
 // NewDeployer creates a new deployer that reconciles deploy items of type "landscaper.gardener.cloud/container".
@@ -43,10 +47,20 @@ func NewDeployer(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCache
 		if err != nil {
 			return nil, err
 		}
+		sharedCache, err = shared.NewCacheFromConfiguration(logging.NewContext(context.Background(), log), log, sharedCache, config.OCI)
+		if err != nil {
+			return nil, fmt.Errorf("unable to setup shared oci cache: %w", err)
+		}
 	}
 
 	registries.SetOCMLibraryMode(config.UseOCMLib)
 
+	operatorTokenSources, err := registrycredentials.OperatorTokenSourcesFromConfiguration(config.OCI)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load registry token sources: %w", err)
+	}
+	registrycredentials.SetOperatorTokenSources(operatorTokenSources)
+
 	dep := &deployer{
 		lsUncachedClient:   lsUncachedClient,
 		lsCachedClient:     lsCachedClient,
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+const (
+	// handoverRunFileName is the name of the file in the handover directory that contains the current run.
+	handoverRunFileName = "run.json"
+	// handoverResultFileName is the name of the file in the handover directory that contains the result of the current run.
+	handoverResultFileName = "result.json"
+	// handoverTerminateFileName is the name of the file in the handover directory that tells the executor to exit.
+	handoverTerminateFileName = "terminate"
+
+	// commandWaitDelay is the time the executor waits for the output of a killed command to be closed.
+	commandWaitDelay = 10 * time.Second
+)
+
+// handoverRun is the command of a run that the runner hands over to the executor in the main container.
+type handoverRun struct {
+	ID        string                  `json:"id"`
+	Operation container.OperationType `json:"operation"`
+	Command   []string                `json:"command"`
+	Args      []string                `json:"args,omitempty"`
+	// Deadline is the time at which the command is killed.
+	Deadline *metav1.Time `json:"deadline,omitempty"`
+}
+
+// handoverResult is the result of a run that the executor hands back to the runner.
+type handoverResult struct {
+	ID       string `json:"id"`
+	ExitCode *int32 `json:"exitCode,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+type executor struct {
+	handoverPath string
+	pollInterval time.Duration
+}
+
+// RunExecutor runs the executor of a runner pod.
+// The executor runs in the main container with the image of the deploy items and without any access to the cluster.
+// It executes the commands that the runner in the wait container hands over to it and hands back their results.
+// The executor exits once the runner has terminated.
+func RunExecutor(ctx context.Context) error {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+	log = log.WithName("container").WithName("executor")
+	ctx = logging.NewContext(ctx, log)
+
+	e := &executor{
+		handoverPath: container.RunnerHandoverPath,
+		pollInterval: time.Second,
+	}
+	return e.run(ctx)
+}
+
+func (e *executor) run(ctx context.Context) error {
+	log := logging.FromContextOrDiscard(ctx)
+	var lastRunID string
+
+	for {
+		if _, err := os.Stat(filepath.Join(e.handoverPath, handoverTerminateFileName)); err == nil {
+			log.Info("Runner has terminated, exiting")
+			return nil
+		}
+
+		run := &handoverRun{}
+		found, err := readHandoverFile(filepath.Join(e.handoverPath, handoverRunFileName), run)
+		if err != nil {
+			log.Error(err, "Unable to read run")
+		} else if found && run.ID != lastRunID {
+			lastRunID = run.ID
+			result := e.execute(ctx, run)
+			if err := writeHandoverFile(filepath.Join(e.handoverPath, handoverResultFileName), result); err != nil {
+				log.Error(err, "Unable to hand back result of run", "run", run.ID)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(e.pollInterval):
+		}
+	}
+}
+
+// execute executes the command of the given run until it has finished or its deadline is exceeded.
+func (e *executor) execute(ctx context.Context, run *handoverRun) *handoverResult {
+	log := logging.FromContextOrDiscard(ctx).WithValues("run", run.ID)
+	result := &handoverResult{ID: run.ID}
+	if len(run.Command) == 0 {
+		result.Error = "no command defined"
+		return result
+	}
+
+	if run.Deadline != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, run.Deadline.Time)
+		defer cancel()
+	}
+
+	log.Info("Executing command", "operation", run.Operation)
+	cmd := exec.CommandContext(ctx, run.Command[0], append(run.Command[1:], run.Args...)...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", container.OperationName, run.Operation))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	// the command runs in its own process group, so that the processes that it starts in the background are killed
+	// together with it and cannot affect later runs of the runner.
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return killProcessGroup(cmd)
+	}
+	// processes that are started by the command may keep its output open after it has been killed
+	cmd.WaitDelay = commandWaitDelay
+	err := cmd.Run()
+	if cmd.Process != nil {
+		// remove the background processes that are still running after the command has finished
+		if err := killProcessGroup(cmd); err != nil {
+			log.Error(err, "Unable to kill the processes of the command")
+		}
+		reapOrphans()
+	}
+	if cmd.ProcessState != nil {
+		result.ExitCode = ptr.To(int32(cmd.ProcessState.ExitCode()))
+	}
+
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		result.Error = fmt.Sprintf("command has not finished before the timeout of the deploy item at %s", run.Deadline.Format(time.RFC3339))
+	case err != nil:
+		result.Error = fmt.Sprintf("command failed: %s", err.Error())
+	}
+	return result
+}
+
+// killProcessGroup kills all processes in the process group of the given command.
+func killProcessGroup(cmd *exec.Cmd) error {
+	if err := syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return err
+	}
+	return nil
+}
+
+// reapOrphans reaps the terminated processes that have been orphaned by the commands.
+// The executor is the init process of the main container, so orphaned processes become its children.
+func reapOrphans() {
+	if os.Getpid() != 1 {
+		return
+	}
+	for {
+		pid, err := syscall.Wait4(-1, nil, syscall.WNOHANG, nil)
+		if err != nil || pid <= 0 {
+			return
+		}
+	}
+}
+
+// readHandoverFile reads the given file of the handover directory.
+// False is returned if the file does not exist.
+func readHandoverFile(path string, obj interface{}) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return false, fmt.Errorf("unable to decode %s: %w", path, err)
+	}
+	return true, nil
+}
+
+// writeHandoverFile replaces the given file of the handover directory atomically,
+// so that the other container never reads a partially written file.
+func writeHandoverFile(path string, obj interface{}) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	containerpkg "github.com/gardener/landscaper/pkg/deployer/container"
+)
+
+var _ = Describe("Executor", func() {
+
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		dir      string
+		r        *runner
+		done     chan error
+		newRunID func() string
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(logging.NewContext(context.Background(), logging.Discard()))
+		dir = GinkgoT().TempDir()
+		r = &runner{opts: &options{
+			HandoverPath:       dir,
+			ResultPollInterval: 10 * time.Millisecond,
+			ResultGracePeriod:  time.Second,
+		}}
+
+		e := &executor{handoverPath: dir, pollInterval: 10 * time.Millisecond}
+		done = make(chan error, 1)
+		go func() {
+			done <- e.run(ctx)
+		}()
+
+		counter := 0
+		newRunID = func() string {
+			counter++
+			return fmt.Sprintf("run-%d", counter)
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(Receive())
+	})
+
+	It("should execute the command that is handed over by the runner", func() {
+		out := filepath.Join(dir, "out")
+		result, err := r.executeCommand(ctx, &containerpkg.RunnerRun{
+			ID:        newRunID(),
+			Operation: container.OperationReconcile,
+			Command:   []string{"sh", "-c"},
+			Args:      []string{"echo -n $OPERATION > " + out},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Error).To(BeEmpty())
+		Expect(result.ExitCode).To(Equal(ptr.To[int32](0)))
+		Expect(os.ReadFile(out)).To(Equal([]byte(container.OperationReconcile)))
+
+		// a second run is executed by the same executor
+		result, err = r.executeCommand(ctx, &containerpkg.RunnerRun{
+			ID:      newRunID(),
+			Command: []string{"sh", "-c", "exit 3"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Error).To(ContainSubstring("command failed"))
+		Expect(result.ExitCode).To(Equal(ptr.To[int32](3)))
+	})
+
+	It("should kill the command at the deadline of the run", func() {
+		start := time.Now()
+		result, err := r.executeCommand(ctx, &containerpkg.RunnerRun{
+			ID:       newRunID(),
+			Command:  []string{"sleep", "60"},
+			Deadline: ptr.To(metav1.NewTime(time.Now().Add(200 * time.Millisecond))),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Error).To(ContainSubstring("has not finished before the timeout"))
+		Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+	})
+
+	It("should kill the processes that the command has started in the background", func() {
+		pidFile := filepath.Join(dir, "pid")
+		result, err := r.executeCommand(ctx, &containerpkg.RunnerRun{
+			ID:      newRunID(),
+			Command: []string{"sh", "-c"},
+			Args:    []string{"sleep 60 > /dev/null 2>&1 & echo -n $! > " + pidFile},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Error).To(BeEmpty())
+
+		data, err := os.ReadFile(pidFile)
+		Expect(err).ToNot(HaveOccurred())
+		pid, err := strconv.Atoi(string(data))
+		Expect(err).ToNot(HaveOccurred())
+		// the killed process is reaped by the init process of the test environment
+		Eventually(func() error {
+			return syscall.Kill(pid, 0)
+		}).WithTimeout(5 * time.Second).Should(MatchError(syscall.ESRCH))
+	})
+
+	It("should kill the processes of the command at the deadline of the run", func() {
+		pidFile := filepath.Join(dir, "pid")
+		start := time.Now()
+		result, err := r.executeCommand(ctx, &containerpkg.RunnerRun{
+			ID:       newRunID(),
+			Command:  []string{"sh", "-c"},
+			Args:     []string{"sleep 60 & echo -n $! > " + pidFile + "; wait"},
+			Deadline: ptr.To(metav1.NewTime(time.Now().Add(500 * time.Millisecond))),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Error).To(ContainSubstring("has not finished before the timeout"))
+		// the output of the killed background process does not delay the result
+		Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+
+		data, err := os.ReadFile(pidFile)
+		Expect(err).ToNot(HaveOccurred())
+		pid, err := strconv.Atoi(string(data))
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(func() error {
+			return syscall.Kill(pid, 0)
+		}).WithTimeout(5 * time.Second).Should(MatchError(syscall.ESRCH))
+	})
+
+	It("should exit once the runner has terminated", func() {
+		r.stopExecutor(ctx)
+		Eventually(done).Should(Receive(BeNil()))
+		done <- nil
+	})
+
+	It("should fail the run if the executor does not return a result", func() {
+		r.stopExecutor(ctx)
+		Eventually(done).Should(Receive(BeNil()))
+		done <- nil
+
+		_, err := r.executeCommand(ctx, &containerpkg.RunnerRun{
+			ID:       newRunID(),
+			Command:  []string{"true"},
+			Deadline: ptr.To(metav1.NewTime(time.Now())),
+		})
+		Expect(err).To(MatchError(ContainSubstring("executor has not returned a result")))
+	})
+})
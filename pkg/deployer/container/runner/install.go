@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runner
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Install copies the currently executed binary to the given target path,
+// so that it can be executed as run executor in the main container of a runner pod.
+func Install(target string) error {
+	source, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("unable to determine executable: %w", err)
+	}
+
+	in, err := os.Open(source)
+	if err != nil {
+		return fmt.Errorf("unable to open executable %s: %w", source, err)
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return fmt.Errorf("unable to create runner binary %s: %w", target, err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return fmt.Errorf("unable to copy runner binary to %s: %w", target, err)
+	}
+	return out.Close()
+}
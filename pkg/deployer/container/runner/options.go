@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runner
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+)
+
+type options struct {
+	DefaultBackoff wait.Backoff
+	PollInterval   time.Duration
+	IdleTimeout    time.Duration
+
+	// HandoverPath is the directory through which the runs are handed over to the executor in the main container.
+	HandoverPath string
+	// ResultPollInterval is the interval in which the runner checks whether the executor has finished a run.
+	ResultPollInterval time.Duration
+	// ResultGracePeriod is the time the runner waits for the result of a run after its deadline has been exceeded.
+	ResultGracePeriod time.Duration
+
+	ConfigurationFilePath  string
+	ExportFilePath         string
+	PlanFilePath           string
+	StatePath              string
+	RegistrySecretBasePath string
+
+	podName      string
+	podNamespace string
+	PodKey       lsv1alpha1.ObjectReference
+
+	rawIdleTimeout string
+}
+
+// Setup reads necessary options from the expected sources.
+func (o *options) Setup() {
+	o.ConfigurationFilePath = os.Getenv(container.ConfigurationPathName)
+	o.ExportFilePath = os.Getenv(container.ExportsPathName)
+	o.PlanFilePath = os.Getenv(container.PlanPathName)
+	o.StatePath = os.Getenv(container.StatePathName)
+	o.RegistrySecretBasePath = os.Getenv(container.RegistrySecretBasePathName)
+
+	o.podName = os.Getenv(container.PodName)
+	o.podNamespace = os.Getenv(container.PodNamespaceName)
+	o.PodKey = lsv1alpha1.ObjectReference{Name: o.podName, Namespace: o.podNamespace}
+
+	o.rawIdleTimeout = os.Getenv(container.RunnerIdleTimeoutName)
+	o.PollInterval = 5 * time.Second
+
+	o.HandoverPath = container.RunnerHandoverPath
+	o.ResultPollInterval = time.Second
+	o.ResultGracePeriod = time.Minute
+
+	o.DefaultBackoff = wait.Backoff{
+		Duration: 10 * time.Second,
+		Factor:   1.25,
+		Steps:    math.MaxInt32,
+		Cap:      5 * time.Minute,
+	}
+}
+
+// Validate validates the options data.
+func (o *options) Validate() error {
+	var err *multierror.Error
+	if len(o.ConfigurationFilePath) == 0 {
+		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.ConfigurationPathName))
+	}
+	if len(o.ExportFilePath) == 0 {
+		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.ExportsPathName))
+	}
+	if len(o.PlanFilePath) == 0 {
+		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.PlanPathName))
+	}
+	if len(o.StatePath) == 0 {
+		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.StatePathName))
+	}
+	if len(o.RegistrySecretBasePath) == 0 {
+		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.RegistrySecretBasePathName))
+	}
+	if len(o.podName) == 0 {
+		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.PodName))
+	}
+	if len(o.podNamespace) == 0 {
+		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.PodNamespaceName))
+	}
+
+	idleTimeoutSeconds, parseErr := strconv.Atoi(o.rawIdleTimeout)
+	if parseErr != nil || idleTimeoutSeconds <= 0 {
+		err = multierror.Append(err, fmt.Errorf("%s has to be a positive number of seconds", container.RunnerIdleTimeoutName))
+	} else {
+		o.IdleTimeout = time.Duration(idleTimeoutSeconds) * time.Second
+	}
+	return err.ErrorOrNil()
+}
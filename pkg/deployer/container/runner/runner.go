@@ -0,0 +1,363 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	containerpkg "github.com/gardener/landscaper/pkg/deployer/container"
+	containerinit "github.com/gardener/landscaper/pkg/deployer/container/init"
+	"github.com/gardener/landscaper/pkg/deployer/container/state"
+	containerwait "github.com/gardener/landscaper/pkg/deployer/container/wait"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+type runner struct {
+	opts       *options
+	kubeClient client.Client
+}
+
+// Run runs the container deployer runner.
+// The runner runs in the wait container of a runner pod and polls its own pod for runs that are dispatched to it
+// by the container deployer. It prepares the data of each run, hands over the command to the executor in the main
+// container, uploads the results and reports them back to its pod.
+// The runner exits if no run has been dispatched to it for the configured idle timeout.
+func Run(ctx context.Context) error {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+	log = log.WithName("container").WithName("runner")
+	ctx = logging.NewContext(ctx, log)
+	opts := &options{}
+	opts.Setup()
+
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	restConfig, err := clientcmd.BuildConfigFromFlags("", "")
+	if err != nil {
+		return err
+	}
+
+	var kubeClient client.Client
+	if err := wait.ExponentialBackoff(opts.DefaultBackoff, func() (bool, error) {
+		var err error
+		kubeClient, err = client.New(restConfig, client.Options{
+			Scheme: api.LandscaperScheme,
+		})
+		if err != nil {
+			log.Error(err, "Unable to build kubernetes client")
+			return false, nil
+		}
+		return true, nil
+	}); err != nil {
+		return err
+	}
+
+	r := &runner{
+		opts:       opts,
+		kubeClient: kubeClient,
+	}
+	return r.run(ctx)
+}
+
+func (r *runner) run(ctx context.Context) error {
+	log := logging.FromContextOrDiscard(ctx)
+	// the main container of the pod only exits together with the executor
+	defer r.stopExecutor(ctx)
+	var (
+		lastRunID    string
+		lastActivity = time.Now()
+	)
+
+	for {
+		pod := &corev1.Pod{}
+		if err := read_write_layer.GetPod(ctx, r.kubeClient, r.opts.PodKey.NamespacedName(), pod, read_write_layer.R000116); err != nil {
+			if apierrors.IsNotFound(err) || apierrors.IsUnauthorized(err) {
+				return err
+			}
+			log.Error(err, "Unable to get runner pod")
+		} else {
+			run, err := containerpkg.GetRunnerRun(pod)
+			if err != nil {
+				log.Error(err, "Unable to read run")
+			}
+
+			switch {
+			case run != nil && run.ID != lastRunID:
+				lastRunID = run.ID
+				r.execute(ctx, run)
+				lastActivity = time.Now()
+			case run != nil:
+				// the run has finished but the deployer has not yet released the runner.
+				lastActivity = time.Now()
+			case time.Since(lastActivity) > r.opts.IdleTimeout:
+				terminated, err := r.terminate(ctx, pod)
+				if err != nil {
+					log.Error(err, "Unable to terminate idle runner")
+				}
+				if terminated {
+					log.Info("Runner has been idle for too long, terminating", "idleTimeout", r.opts.IdleTimeout.String())
+					return nil
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.opts.PollInterval):
+		}
+	}
+}
+
+// execute executes the given run and reports its status to the runner pod.
+func (r *runner) execute(ctx context.Context, run *containerpkg.RunnerRun) {
+	log := logging.FromContextOrDiscard(ctx).WithValues("run", run.ID, "deployItem", run.DeployItem.NamespacedName().String())
+	ctx = logging.NewContext(ctx, log)
+	log.Info("Executing run", "operation", run.Operation)
+
+	status := &containerpkg.RunnerRunStatus{
+		ID:        run.ID,
+		Phase:     containerpkg.RunnerRunPhaseRunning,
+		StartTime: ptr.To(metav1.Now()),
+	}
+	if err := r.reportStatus(ctx, status); err != nil {
+		log.Error(err, "Unable to report run status")
+	}
+
+	exitCode, err := r.executeRun(ctx, run)
+	status.ExitCode = exitCode
+	status.CompletionTime = ptr.To(metav1.Now())
+	if err != nil {
+		log.Error(err, "Run failed")
+		status.Phase = containerpkg.RunnerRunPhaseFailed
+		status.Message = err.Error()
+	} else {
+		log.Info("Run succeeded")
+		status.Phase = containerpkg.RunnerRunPhaseSucceeded
+	}
+
+	// the deployer waits for the final status, therefore retry until it is reported.
+	if err := wait.ExponentialBackoffWithContext(ctx, r.opts.DefaultBackoff, func(ctx context.Context) (bool, error) {
+		if err := r.reportStatus(ctx, status); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, err
+			}
+			log.Error(err, "Unable to report run status")
+			return false, nil
+		}
+		return true, nil
+	}); err != nil {
+		log.Error(err, "Unable to report final run status")
+	}
+}
+
+// executeRun prepares the shared data of the run, lets the executor execute the command
+// and uploads the state as well as the exports or the plan.
+// The command is killed if it has not finished before the deadline of the run.
+func (r *runner) executeRun(ctx context.Context, run *containerpkg.RunnerRun) (*int32, error) {
+	if len(run.Command) == 0 {
+		return nil, errors.New("no command defined")
+	}
+
+	prepareCtx := ctx
+	if run.Deadline != nil {
+		var cancel context.CancelFunc
+		prepareCtx, cancel = context.WithDeadline(ctx, run.Deadline.Time)
+		defer cancel()
+	}
+	if err := r.prepare(prepareCtx, run); err != nil {
+		return nil, fmt.Errorf("unable to prepare run: %w", err)
+	}
+
+	result, cmdErr := r.executeCommand(ctx, run)
+	var exitCode *int32
+	if result != nil {
+		exitCode = result.ExitCode
+		if cmdErr == nil && len(result.Error) != 0 {
+			cmdErr = errors.New(result.Error)
+		}
+	}
+
+	// even if the command has failed, the state is still backed up.
+	if err := state.New(r.kubeClient, r.opts.podNamespace, run.DeployItem, r.opts.StatePath).Backup(ctx); err != nil {
+		return exitCode, fmt.Errorf("unable to backup state: %w", err)
+	}
+
+	if cmdErr != nil {
+		return exitCode, cmdErr
+	}
+
+	// upload the plan instead of the exports if only a plan was computed
+	if run.Operation == container.OperationPlan {
+		if err := containerwait.WritePlan(ctx, r.kubeClient, run.DeployItem, r.opts.podNamespace, r.opts.PlanFilePath); err != nil {
+			return exitCode, fmt.Errorf("unable to upload plan: %w", err)
+		}
+		return exitCode, nil
+	}
+	if err := containerwait.WriteExport(ctx, r.kubeClient, run.DeployItem, r.opts.podNamespace, r.opts.ExportFilePath); err != nil {
+		return exitCode, fmt.Errorf("unable to upload exports: %w", err)
+	}
+	return exitCode, nil
+}
+
+// executeCommand hands over the command of the run to the executor in the main container
+// and waits until the executor hands back its result.
+func (r *runner) executeCommand(ctx context.Context, run *containerpkg.RunnerRun) (*handoverResult, error) {
+	resultPath := filepath.Join(r.opts.HandoverPath, handoverResultFileName)
+	if err := os.Remove(resultPath); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("unable to remove result of previous run: %w", err)
+	}
+	if err := writeHandoverFile(filepath.Join(r.opts.HandoverPath, handoverRunFileName), &handoverRun{
+		ID:        run.ID,
+		Operation: run.Operation,
+		Command:   run.Command,
+		Args:      run.Args,
+		Deadline:  run.Deadline,
+	}); err != nil {
+		return nil, fmt.Errorf("unable to hand over run to executor: %w", err)
+	}
+
+	// the executor kills the command at the deadline, so the result is only missing if the executor is broken.
+	if run.Deadline != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, run.Deadline.Add(r.opts.ResultGracePeriod))
+		defer cancel()
+	}
+	for {
+		result := &handoverResult{}
+		found, err := readHandoverFile(resultPath, result)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read result of run: %w", err)
+		}
+		if found && result.ID == run.ID {
+			return result, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("executor has not returned a result for the run: %w", ctx.Err())
+		case <-time.After(r.opts.ResultPollInterval):
+		}
+	}
+}
+
+// stopExecutor tells the executor in the main container to exit.
+func (r *runner) stopExecutor(ctx context.Context) {
+	if err := os.WriteFile(filepath.Join(r.opts.HandoverPath, handoverTerminateFileName), nil, 0644); err != nil {
+		logging.FromContextOrDiscard(ctx).Error(err, "Unable to stop executor")
+	}
+}
+
+// prepare resets the data of the previous run and writes the data of the given run
+// the same way as the init container of a dedicated pod.
+func (r *runner) prepare(ctx context.Context, run *containerpkg.RunnerRun) error {
+	configurationDir := filepath.Dir(r.opts.ConfigurationFilePath)
+	for _, dir := range []string{container.SharedBasePath, container.TargetInitDir, configurationDir, r.opts.RegistrySecretBasePath} {
+		if err := resetDir(dir); err != nil {
+			return err
+		}
+	}
+
+	if err := r.writeSecret(ctx, run.ConfigurationSecretName, configurationDir); err != nil {
+		return err
+	}
+	if err := r.writeSecret(ctx, run.TargetSecretName, container.TargetInitDir); err != nil {
+		return err
+	}
+	for name, secretName := range run.RegistryPullSecrets {
+		if len(secretName) == 0 {
+			continue
+		}
+		if err := r.writeSecret(ctx, secretName, filepath.Join(r.opts.RegistrySecretBasePath, name)); err != nil {
+			return err
+		}
+	}
+
+	return containerinit.Prepare(ctx, r.kubeClient, osfs.New(), run.DeployItem, run.UseOCM)
+}
+
+// writeSecret writes every key of the given secret as file into the given directory.
+func (r *runner) writeSecret(ctx context.Context, secretName, dir string) error {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Name: secretName, Namespace: r.opts.podNamespace}
+	if err := read_write_layer.GetSecret(ctx, r.kubeClient, key, secret, read_write_layer.R000117); err != nil {
+		return fmt.Errorf("unable to get secret %s: %w", key.String(), err)
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	for k, data := range secret.Data {
+		if err := os.WriteFile(filepath.Join(dir, k), data, os.ModePerm); err != nil {
+			return fmt.Errorf("unable to write key %s of secret %s: %w", k, key.String(), err)
+		}
+	}
+	return nil
+}
+
+// resetDir removes the content of the given directory.
+// The directory itself is kept, as it might be a mount point.
+func resetDir(dir string) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reportStatus writes the status of a run to the runner pod.
+func (r *runner) reportStatus(ctx context.Context, status *containerpkg.RunnerRunStatus) error {
+	encStatus, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	pod := &corev1.Pod{}
+	pod.Name = r.opts.podName
+	pod.Namespace = r.opts.podNamespace
+	patch := client.RawPatch(types.MergePatchType, []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`,
+		container.ContainerDeployerRunnerRunStatusAnnotation, string(encStatus))))
+	return r.kubeClient.Patch(ctx, pod, patch)
+}
+
+// terminate marks the runner pod as terminating so that no further runs are dispatched to it.
+// False is returned if a run has been dispatched to the runner concurrently.
+func (r *runner) terminate(ctx context.Context, pod *corev1.Pod) (bool, error) {
+	orig := pod.DeepCopy()
+	metav1.SetMetaDataLabel(&pod.ObjectMeta, container.ContainerDeployerRunnerStateLabel, container.RunnerStateTerminating)
+	if err := r.kubeClient.Patch(ctx, pod, client.MergeFromWithOptions(orig, client.MergeFromWithOptimisticLock{})); err != nil {
+		if apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,729 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// RunnerRun describes a run of a deploy item that is dispatched to a runner pod.
+type RunnerRun struct {
+	// ID is the unique id of the run.
+	ID string `json:"id"`
+	// DeployItem is the reference to the deploy item that is executed.
+	DeployItem lsv1alpha1.ObjectReference `json:"deployItem"`
+	// Generation is the generation of the deploy item that is executed.
+	Generation int64 `json:"generation"`
+	// JobID is the job id of the deploy item that is executed.
+	JobID string `json:"jobID"`
+	// Operation is the operation that is propagated to the command.
+	Operation container.OperationType `json:"operation"`
+	// Command is the command of the deploy item that is executed in the runner.
+	Command []string `json:"command"`
+	// Args are the arguments of the command.
+	Args []string `json:"args,omitempty"`
+	// Deadline is the time at which the command is killed. It is derived from the timeout of the deploy item.
+	Deadline *metav1.Time `json:"deadline,omitempty"`
+	// ConfigurationSecretName is the name of the secret that contains the provider configuration.
+	ConfigurationSecretName string `json:"configurationSecretName"`
+	// TargetSecretName is the name of the secret that contains the target.
+	TargetSecretName string `json:"targetSecretName"`
+	// RegistryPullSecrets maps the directory names in the registry secrets path to the names of the pull secrets.
+	RegistryPullSecrets map[string]string `json:"registryPullSecrets,omitempty"`
+	// UseOCM defines whether the ocm library is used to resolve the component descriptor.
+	UseOCM bool `json:"useOCM,omitempty"`
+}
+
+// RunnerRunPhase describes the phase of a run in a runner pod.
+type RunnerRunPhase string
+
+const (
+	// RunnerRunPhaseRunning is the phase of a run that is currently executed.
+	RunnerRunPhaseRunning RunnerRunPhase = "Running"
+	// RunnerRunPhaseSucceeded is the phase of a run whose command and uploads finished successfully.
+	RunnerRunPhaseSucceeded RunnerRunPhase = "Succeeded"
+	// RunnerRunPhaseFailed is the phase of a run that failed.
+	RunnerRunPhaseFailed RunnerRunPhase = "Failed"
+)
+
+// RunnerRunStatus is the status of a run that is reported by the runner pod.
+type RunnerRunStatus struct {
+	// ID is the id of the run the status belongs to.
+	ID string `json:"id"`
+	// Phase is the current phase of the run.
+	Phase RunnerRunPhase `json:"phase"`
+	// StartTime is the time when the run was started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time when the run was finished.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// ExitCode is the exit code of the command.
+	ExitCode *int32 `json:"exitCode,omitempty"`
+	// Message is a human readable message about the result of the run.
+	Message string `json:"message,omitempty"`
+}
+
+// IsFinished returns whether the run has finished.
+func (s *RunnerRunStatus) IsFinished() bool {
+	return s != nil && (s.Phase == RunnerRunPhaseSucceeded || s.Phase == RunnerRunPhaseFailed)
+}
+
+// GetRunnerRun returns the run that is dispatched to the given runner pod.
+// Nil is returned if no run is dispatched to the pod.
+func GetRunnerRun(pod *corev1.Pod) (*RunnerRun, error) {
+	raw, ok := pod.Annotations[container.ContainerDeployerRunnerRunAnnotation]
+	if !ok || len(raw) == 0 {
+		return nil, nil
+	}
+	run := &RunnerRun{}
+	if err := json.Unmarshal([]byte(raw), run); err != nil {
+		return nil, fmt.Errorf("unable to decode run of runner pod %s: %w", pod.Name, err)
+	}
+	return run, nil
+}
+
+// GetRunnerRunStatus returns the status of the given run that was reported by the runner pod.
+// Nil is returned if the runner has not yet reported a status for the run.
+func GetRunnerRunStatus(pod *corev1.Pod, run *RunnerRun) (*RunnerRunStatus, error) {
+	raw, ok := pod.Annotations[container.ContainerDeployerRunnerRunStatusAnnotation]
+	if !ok || len(raw) == 0 || run == nil {
+		return nil, nil
+	}
+	status := &RunnerRunStatus{}
+	if err := json.Unmarshal([]byte(raw), status); err != nil {
+		return nil, fmt.Errorf("unable to decode run status of runner pod %s: %w", pod.Name, err)
+	}
+	if status.ID != run.ID {
+		return nil, nil
+	}
+	return status, nil
+}
+
+// RunnerPoolName generates the name of the runner pool of a deployer for the given image and namespace of deploy items.
+// Runner pools are scoped to the namespace of the deploy items, so that runs of different tenants never share a runner
+// pod, and the runner pods of a pool are only pulled with the image pull secrets of that namespace.
+func RunnerPoolName(deployerID, deployItemNamespace, image string) string {
+	h := sha256.Sum256([]byte(deployerID + "/" + deployItemNamespace + "/" + image))
+	return fmt.Sprintf("runner-%s", hex.EncodeToString(h[:])[:16])
+}
+
+// RunnerPodName generates the name of the runner pod with the given index in a runner pool.
+// The names of the runner pods are fixed, so that concurrent dispatches cannot create more runner pods than the size
+// of the pool.
+func RunnerPodName(poolName string, index int) string {
+	return fmt.Sprintf("%s-%d", poolName, index)
+}
+
+// RunnerServiceAccountName generates the service account name for the runner pods of a pool.
+func RunnerServiceAccountName(poolName string) string {
+	return poolName
+}
+
+// RunnerPoolLabels returns the labels of all resources that belong to a runner pool.
+func RunnerPoolLabels(deployerID, poolName string) map[string]string {
+	return map[string]string{
+		container.ContainerDeployerIDLabel:         deployerID,
+		container.ContainerDeployerRunnerPoolLabel: poolName,
+	}
+}
+
+// useRunnerPool returns whether the deploy item is executed by a runner pod.
+// Runner pods replace the entrypoint of the image with the runner, therefore only deploy items
+// that explicitly define their command can be executed by a runner.
+func (c *Container) useRunnerPool() bool {
+	return c.Configuration.RunnerPool != nil && len(c.ProviderConfiguration.Command) != 0
+}
+
+// reconcileRun handles the reconcile flow of a deploy item that is executed by a runner pod.
+// It mirrors the flow of a dedicated pod but collects the result from the run status of the runner.
+func (c *Container) reconcileRun(ctx context.Context, operation container.OperationType) error {
+	logger := logging.FromContextOrDiscard(ctx)
+	lsWriter := read_write_layer.NewWriter(c.lsUncachedClient)
+
+	runner, run, runStatus, err := c.getAssignedRunner(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err,
+			"Reconcile", "FetchAssignedRunner", err.Error())
+	}
+
+	// do nothing if the run is still in progress
+	if runner != nil && !runStatus.IsFinished() {
+		if err := runnerIsInErrorState(runner); err != nil {
+			lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
+			if err := lsWriter.UpdateDeployItemStatus(ctx, read_write_layer.W000153, c.DeployItem); err != nil {
+				return err // returns the error and retry
+			}
+			// a broken runner cannot execute further runs
+			if err := c.hostUncachedClient.Delete(ctx, runner); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			return err
+		}
+		if err := c.collectAndSetRunStatus(runner, runStatus, false); err != nil {
+			return lserrors.NewWrappedError(err,
+				"Reconcile", "UpdateRunStatus", err.Error())
+		}
+		c.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+		return nil
+	}
+
+	if operation == container.OperationReconcile && c.ProviderConfiguration.RequirePlanApproval {
+		if runner != nil && run.Operation == container.OperationPlan {
+			return c.completePlanRun(ctx, runner, runStatus)
+		}
+		if runner == nil && !c.isPlanApproved() {
+			return c.reconcilePlan(ctx)
+		}
+	}
+
+	if runner == nil && c.shouldRunNewPod(ctx, nil) {
+		return c.runPod(ctx, operation)
+	}
+
+	operationName := "Complete"
+	var exportErr error
+	if runner != nil {
+		runSucceeded := runStatus.Phase == RunnerRunPhaseSucceeded
+		if runSucceeded {
+			if err := c.SyncExport(ctx, operation); err != nil {
+				if !errors.Is(err, ErrInvalidExports) {
+					return lserrors.NewWrappedError(err,
+						operationName, "SyncExport", err.Error())
+				}
+				// invalid exports cannot be fixed by a retry, therefore the deploy item fails.
+				exportErr = lserrors.NewWrappedError(err,
+					operationName, "ValidateExports", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+				lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
+				runSucceeded = false
+			}
+		} else {
+			lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
+		}
+
+		c.ProviderStatus.LastOperation = string(operation)
+		if err := c.collectAndSetRunStatus(runner, runStatus, runSucceeded); err != nil {
+			return lserrors.NewWrappedError(err,
+				operationName, "UpdateRunStatus", err.Error())
+		}
+
+		// write status to ensure podStatus is saved before the runner is released
+		if err := lsWriter.UpdateDeployItemStatus(ctx, read_write_layer.W000152, c.DeployItem); err != nil {
+			return lserrors.NewWrappedError(err, operationName, "UpdateDeployItemStatus", err.Error())
+		}
+
+		logger.Debug("Releasing runner, as the run has finished", "runner", runner.Name, "runPhase", runStatus.Phase)
+		if err := ReleaseRunner(ctx, c.hostUncachedClient, runner); err != nil {
+			return lserrors.NewWrappedError(err, operationName, "ReleaseRunner", err.Error())
+		}
+	}
+	if exportErr != nil {
+		return exportErr
+	}
+	if c.ProviderStatus != nil && c.ProviderStatus.PodStatus != nil && c.ProviderStatus.PodStatus.LastSuccessfulJobID != nil && *c.ProviderStatus.PodStatus.LastSuccessfulJobID == c.DeployItem.Status.JobID {
+		logger.Debug("Setting phase to 'Succeeded', because run was seen successfully finished for current jobID", lc.KeyJobID, c.DeployItem.Status.JobID)
+		c.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+	}
+	return nil
+}
+
+// completePlanRun collects the result of a finished plan run.
+func (c *Container) completePlanRun(ctx context.Context, runner *corev1.Pod, runStatus *RunnerRunStatus) error {
+	operationName := "CompletePlan"
+
+	if runStatus.Phase == RunnerRunPhaseSucceeded {
+		planRef, err := c.SyncPlan(ctx)
+		if err != nil {
+			return lserrors.NewWrappedError(err,
+				operationName, "SyncPlan", err.Error())
+		}
+		c.ProviderStatus.PlanStatus = &containerv1alpha1.PlanStatus{
+			JobID:         c.DeployItem.Status.JobID,
+			PlanReference: planRef,
+		}
+		c.setPlanApprovalCondition(lsv1alpha1.ConditionProgressing, "WaitingForApproval",
+			fmt.Sprintf("Plan is waiting for approval, annotate the deploy item with %s=%s to apply it",
+				container.ContainerDeployerApprovePlanAnnotation, c.DeployItem.Status.JobID))
+	} else {
+		lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
+	}
+
+	if err := c.collectAndSetRunStatus(runner, runStatus, false); err != nil {
+		return lserrors.NewWrappedError(err,
+			operationName, "UpdateRunStatus", err.Error())
+	}
+
+	// write status to ensure the plan status is saved before the runner is released
+	lsWriter := read_write_layer.NewWriter(c.lsUncachedClient)
+	if err := lsWriter.UpdateDeployItemStatus(ctx, read_write_layer.W000154, c.DeployItem); err != nil {
+		return lserrors.NewWrappedError(err, operationName, "UpdateDeployItemStatus", err.Error())
+	}
+	return ReleaseRunner(ctx, c.hostUncachedClient, runner)
+}
+
+// dispatchRun dispatches a new run of the deploy item to an idle runner pod of the pool of its image.
+// Missing runner pods are created up to the configured pool size.
+// An error with the ErrorForInfoOnly code is returned if no runner is available yet, so that the dispatch is retried.
+func (c *Container) dispatchRun(ctx context.Context, operation container.OperationType, imagePullSecret string, registryPullSecrets map[string]string) (*corev1.Pod, error) {
+	logger := logging.FromContextOrDiscard(ctx)
+	operationName := "DispatchRun"
+	poolName := RunnerPoolName(c.Configuration.Identity, c.DeployItem.Namespace, c.ProviderConfiguration.Image)
+	poolLabels := RunnerPoolLabels(c.Configuration.Identity, poolName)
+
+	podList := &corev1.PodList{}
+	if err := read_write_layer.ListPods(ctx, c.hostUncachedClient, podList, read_write_layer.R000112,
+		client.InNamespace(c.HostNamespace()), client.MatchingLabels(poolLabels)); err != nil {
+		return nil, lserrors.NewWrappedError(err, operationName, "ListRunners", err.Error())
+	}
+
+	remainingTime, lsErr := timeout.TimeoutExceeded(ctx, c.DeployItem, TimeoutCheckpointContainerDispatchRun)
+	if lsErr != nil {
+		return nil, lsErr
+	}
+
+	run := &RunnerRun{
+		ID:                      uuid.New().String(),
+		DeployItem:              lsv1alpha1.ObjectReference{Name: c.DeployItem.Name, Namespace: c.DeployItem.Namespace},
+		Generation:              c.DeployItem.Generation,
+		JobID:                   c.DeployItem.Status.JobID,
+		Operation:               operation,
+		Command:                 c.ProviderConfiguration.Command,
+		Args:                    c.ProviderConfiguration.Args,
+		Deadline:                ptr.To(metav1.NewTime(time.Now().Add(remainingTime))),
+		ConfigurationSecretName: ConfigurationSecretName(c.DeployItem.Namespace, c.DeployItem.Name),
+		TargetSecretName:        TargetSecretName(c.DeployItem.Namespace, c.DeployItem.Name),
+		RegistryPullSecrets:     registryPullSecrets,
+		UseOCM:                  c.Context.UseOCM,
+	}
+	encRun, err := json.Marshal(run)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, operationName, "EncodeRun", err.Error())
+	}
+
+	existingRunners := sets.New[string]()
+	for i := range podList.Items {
+		runner := &podList.Items[i]
+		// terminated runners keep their name until they are deleted
+		existingRunners.Insert(runner.Name)
+		if runner.Status.Phase == corev1.PodSucceeded || runner.Status.Phase == corev1.PodFailed {
+			// runners terminate themselves after the idle timeout
+			if err := c.hostUncachedClient.Delete(ctx, runner); err != nil && !apierrors.IsNotFound(err) {
+				return nil, lserrors.NewWrappedError(err, operationName, "DeleteTerminatedRunner", err.Error())
+			}
+			continue
+		}
+		if runner.Status.Phase != corev1.PodRunning || !runner.DeletionTimestamp.IsZero() ||
+			runner.Labels[container.ContainerDeployerRunnerStateLabel] != container.RunnerStateIdle {
+			continue
+		}
+
+		// claim the runner; the update fails with a conflict if the runner was claimed concurrently.
+		runner.Labels[container.ContainerDeployerRunnerStateLabel] = container.RunnerStateBusy
+		metav1.SetMetaDataAnnotation(&runner.ObjectMeta, container.ContainerDeployerRunnerRunAnnotation, string(encRun))
+		delete(runner.Annotations, container.ContainerDeployerRunnerRunStatusAnnotation)
+		if err := c.hostUncachedClient.Update(ctx, runner); err != nil {
+			if apierrors.IsConflict(err) {
+				logger.Debug("Runner has been claimed concurrently", "runner", runner.Name)
+				continue
+			}
+			return nil, lserrors.NewWrappedError(err, operationName, "ClaimRunner", err.Error())
+		}
+		logger.Info("Dispatched run to runner", "runner", runner.Name, "run", run.ID, "operation", operation)
+		return runner, nil
+	}
+
+	for i := 0; i < c.Configuration.RunnerPool.Size; i++ {
+		runnerName := RunnerPodName(poolName, i)
+		if existingRunners.Has(runnerName) {
+			continue
+		}
+		if err := c.createRunner(ctx, runnerName, poolName, poolLabels, imagePullSecret); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				logger.Debug("Runner has been created concurrently", "runner", runnerName)
+				continue
+			}
+			return nil, lserrors.NewWrappedError(err, operationName, "CreateRunner", err.Error())
+		}
+		break
+	}
+	return nil, lserrors.NewError(operationName, "WaitForRunner",
+		fmt.Sprintf("no idle runner available in runner pool %s", poolName), lsv1alpha1.ErrorForInfoOnly)
+}
+
+// createRunner creates a new runner pod with the given name in the given runner pool.
+func (c *Container) createRunner(ctx context.Context, name, poolName string, poolLabels map[string]string, imagePullSecret string) error {
+	serviceAccountSecret, err := EnsureRunnerServiceAccount(ctx, c.hostUncachedClient, poolName, c.HostNamespace(), poolLabels)
+	if err != nil {
+		return err
+	}
+
+	runner := generateRunnerPod(RunnerPodOptions{
+		Name:                 name,
+		PoolName:             poolName,
+		PoolLabels:           poolLabels,
+		Namespace:            c.HostNamespace(),
+		Image:                c.ProviderConfiguration.Image,
+		WaitContainer:        c.Configuration.WaitContainer,
+		ServiceAccountSecret: serviceAccountSecret,
+		ImagePullSecret:      imagePullSecret,
+		IdleTimeoutSeconds:   c.Configuration.RunnerPool.IdleTimeoutSeconds,
+		UseOCM:               c.Context.UseOCM,
+		Debug:                true,
+	})
+	return c.hostUncachedClient.Create(ctx, runner)
+}
+
+// getAssignedRunner returns the runner pod that executes the current run of the deploy item together with the run and its status.
+func (c *Container) getAssignedRunner(ctx context.Context) (*corev1.Pod, *RunnerRun, *RunnerRunStatus, error) {
+	poolName := RunnerPoolName(c.Configuration.Identity, c.DeployItem.Namespace, c.ProviderConfiguration.Image)
+	podList := &corev1.PodList{}
+	if err := read_write_layer.ListPods(ctx, c.hostUncachedClient, podList, read_write_layer.R000113,
+		client.InNamespace(c.HostNamespace()), client.MatchingLabels(RunnerPoolLabels(c.Configuration.Identity, poolName))); err != nil {
+		return nil, nil, nil, err
+	}
+
+	for i := range podList.Items {
+		runner := &podList.Items[i]
+		run, err := GetRunnerRun(runner)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if run == nil || run.DeployItem.Name != c.DeployItem.Name || run.DeployItem.Namespace != c.DeployItem.Namespace {
+			continue
+		}
+		status, err := GetRunnerRunStatus(runner, run)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return runner.DeepCopy(), run, status, nil
+	}
+	return nil, nil, nil, nil
+}
+
+// collectAndSetRunStatus sets the status of a run of a runner pod as pod status of the deploy item.
+func (c *Container) collectAndSetRunStatus(runner *corev1.Pod, runStatus *RunnerRunStatus, updateLastSuccessfulJobID bool) error {
+	if err := c.collectAndSetPodStatus(runner, updateLastSuccessfulJobID); err != nil {
+		return err
+	}
+	if runStatus == nil {
+		return nil
+	}
+
+	podStatus := c.ProviderStatus.PodStatus
+	podStatus.LastRun = runStatus.StartTime
+	podStatus.ContainerStatus.Reason = string(runStatus.Phase)
+	podStatus.ContainerStatus.Message = runStatus.Message
+	podStatus.ContainerStatus.ExitCode = runStatus.ExitCode
+
+	encStatus, err := kutil.ConvertToRawExtension(c.ProviderStatus, Scheme)
+	if err != nil {
+		return err
+	}
+	c.DeployItem.Status.ProviderStatus = encStatus
+	return nil
+}
+
+// runnerIsInErrorState detects runner pods that are not able to finish the run that is assigned to them.
+func runnerIsInErrorState(runner *corev1.Pod) error {
+	if runner.Status.Phase == corev1.PodSucceeded || runner.Status.Phase == corev1.PodFailed {
+		return lserrors.NewError("RunRunner", "RunnerTerminated",
+			fmt.Sprintf("runner %s terminated before the run has finished", runner.Name))
+	}
+	// the runs are executed by the wait container, the main container only executes their commands
+	if waitStatus, err := kutil.GetStatusForContainer(runner.Status.ContainerStatuses, container.WaitContainerName); err == nil && waitStatus.State.Terminated != nil {
+		return lserrors.NewError("RunRunner", "RunnerTerminated",
+			fmt.Sprintf("wait container of runner %s terminated before the run has finished", runner.Name))
+	}
+	return podIsInErrorState(runner)
+}
+
+// ReleaseRunner removes the run from a runner pod so that the runner can accept a new run.
+func ReleaseRunner(ctx context.Context, hostClient client.Client, runner *corev1.Pod) error {
+	runner.Labels[container.ContainerDeployerRunnerStateLabel] = container.RunnerStateIdle
+	delete(runner.Annotations, container.ContainerDeployerRunnerRunAnnotation)
+	delete(runner.Annotations, container.ContainerDeployerRunnerRunStatusAnnotation)
+	if err := hostClient.Update(ctx, runner); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to release runner %s: %w", runner.Name, err)
+	}
+	return nil
+}
+
+// releaseAssignedRunner releases the runner pod that is assigned to the deploy item, if there is any.
+func (c *Container) releaseAssignedRunner(ctx context.Context) error {
+	runner, _, _, err := c.getAssignedRunner(ctx)
+	if err != nil || runner == nil {
+		return err
+	}
+	return ReleaseRunner(ctx, c.hostUncachedClient, runner)
+}
+
+// RunnerPodOptions contains the configuration that is needed for a runner pod.
+type RunnerPodOptions struct {
+	Name       string
+	PoolName   string
+	PoolLabels map[string]string
+	Namespace  string
+
+	// Image is the image of the deploy items that are executed by the runner.
+	Image string
+	// WaitContainer is the container that installs the executor into the main container
+	// and executes the runs on behalf of the main container.
+	WaitContainer        containerv1alpha1.ContainerSpec
+	ServiceAccountSecret types.NamespacedName
+	ImagePullSecret      string
+
+	IdleTimeoutSeconds int
+	UseOCM             bool
+
+	Debug bool
+}
+
+// generateRunnerPod generates a long-lived runner pod.
+// The init container installs the runner binary into the main container, which executes it as executor with the image
+// of the deploy items. The runner in the wait container prepares the runs, hands over their commands to the executor
+// and uploads their results. Only the wait container has access to the service account token,
+// so that the commands of the deploy items cannot access the cluster.
+func generateRunnerPod(opts RunnerPodOptions) *corev1.Pod {
+	// the data of the runner that must not be accessible for the commands of the deploy items
+	dataVolume := corev1.Volume{
+		Name: "runner-data",
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+	dataVolumeMount := corev1.VolumeMount{
+		Name:      dataVolume.Name,
+		MountPath: container.BasePath,
+	}
+
+	sharedVolume := corev1.Volume{
+		Name: "shared-volume",
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+	sharedVolumeMount := corev1.VolumeMount{
+		Name:      sharedVolume.Name,
+		MountPath: container.SharedBasePath,
+	}
+
+	binaryVolume := corev1.Volume{
+		Name: "runner-binary",
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+	binaryVolumeMount := corev1.VolumeMount{
+		Name:      binaryVolume.Name,
+		MountPath: filepath.Dir(container.RunnerBinaryPath),
+	}
+
+	handoverVolume := corev1.Volume{
+		Name: "runner-handover",
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+	handoverVolumeMount := corev1.VolumeMount{
+		Name:      handoverVolume.Name,
+		MountPath: container.RunnerHandoverPath,
+	}
+
+	serviceAccountVolume := corev1.Volume{
+		Name: "serviceaccount-runner",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: opts.ServiceAccountSecret.Name,
+			},
+		},
+	}
+	serviceAccountMount := corev1.VolumeMount{
+		Name:      serviceAccountVolume.Name,
+		ReadOnly:  true,
+		MountPath: filepath.Dir(PodTokenPath),
+	}
+
+	installContainer := corev1.Container{
+		Name:                     container.InitContainerName,
+		Image:                    opts.WaitContainer.Image,
+		Command:                  opts.WaitContainer.Command,
+		Args:                     []string{"install-runner", "--target", container.RunnerBinaryPath},
+		Resources:                corev1.ResourceRequirements{},
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		ImagePullPolicy:          opts.WaitContainer.ImagePullPolicy,
+		VolumeMounts:             []corev1.VolumeMount{binaryVolumeMount},
+	}
+
+	additionalWaitEnvVars := []corev1.EnvVar{
+		{
+			Name:  container.ConfigurationPathName,
+			Value: container.ConfigurationPath,
+		},
+		{
+			Name:  container.RegistrySecretBasePathName,
+			Value: container.RegistrySecretBasePath,
+		},
+		{
+			Name:  container.UseOCMName,
+			Value: fmt.Sprint(opts.UseOCM),
+		},
+		{
+			Name:  container.RunnerIdleTimeoutName,
+			Value: strconv.Itoa(opts.IdleTimeoutSeconds),
+		},
+	}
+
+	waitContainer := corev1.Container{
+		Name:                     container.WaitContainerName,
+		Image:                    opts.WaitContainer.Image,
+		Command:                  opts.WaitContainer.Command,
+		Args:                     []string{"runner"},
+		Env:                      append(container.DefaultEnvVars, additionalWaitEnvVars...),
+		Resources:                corev1.ResourceRequirements{},
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		ImagePullPolicy:          opts.WaitContainer.ImagePullPolicy,
+		VolumeMounts:             []corev1.VolumeMount{dataVolumeMount, sharedVolumeMount, handoverVolumeMount, serviceAccountMount},
+	}
+
+	binaryVolumeMount.ReadOnly = true
+	mainContainer := corev1.Container{
+		Name:                     container.MainContainerName,
+		Image:                    opts.Image,
+		Command:                  []string{container.RunnerBinaryPath},
+		Args:                     []string{"run-executor"},
+		Env:                      container.DefaultEnvVars,
+		Resources:                corev1.ResourceRequirements{},
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		ImagePullPolicy:          corev1.PullIfNotPresent,
+		VolumeMounts:             []corev1.VolumeMount{sharedVolumeMount, binaryVolumeMount, handoverVolumeMount},
+	}
+
+	if opts.Debug {
+		installContainer.ImagePullPolicy = corev1.PullAlways
+		waitContainer.ImagePullPolicy = corev1.PullAlways
+	}
+
+	pod := &corev1.Pod{}
+	pod.Name = opts.Name
+	pod.Namespace = opts.Namespace
+	InjectDefaultLabels(pod, opts.PoolLabels)
+	pod.Labels[container.ContainerDeployerRunnerStateLabel] = container.RunnerStateIdle
+
+	pod.Spec.AutomountServiceAccountToken = ptr.To[bool](false)
+	pod.Spec.RestartPolicy = corev1.RestartPolicyNever
+	pod.Spec.TerminationGracePeriodSeconds = ptr.To[int64](300)
+	pod.Spec.Volumes = []corev1.Volume{dataVolume, sharedVolume, binaryVolume, handoverVolume, serviceAccountVolume}
+	pod.Spec.SecurityContext = &corev1.PodSecurityContext{
+		RunAsUser:  ptr.To[int64](1000),
+		RunAsGroup: ptr.To[int64](3000),
+		FSGroup:    ptr.To[int64](2000),
+	}
+	pod.Spec.InitContainers = []corev1.Container{installContainer}
+	pod.Spec.Containers = []corev1.Container{mainContainer, waitContainer}
+	if len(opts.ImagePullSecret) != 0 {
+		pod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{
+			{
+				Name: opts.ImagePullSecret,
+			},
+		}
+	}
+	return pod
+}
+
+// EnsureRunnerServiceAccount ensures that the service account of the runner pods of a pool is created
+// and has the necessary permissions to prepare the runs and to upload their results.
+func EnsureRunnerServiceAccount(ctx context.Context, hostClient client.Client, poolName, hostNamespace string, labels map[string]string) (types.NamespacedName, error) {
+	log := logging.FromContextOrDiscard(ctx)
+	sa := &corev1.ServiceAccount{}
+	sa.Name = RunnerServiceAccountName(poolName)
+	sa.Namespace = hostNamespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, hostClient, sa, func() error {
+		InjectDefaultLabels(sa, labels)
+		return nil
+	}); err != nil {
+		return types.NamespacedName{}, err
+	}
+
+	role := &rbacv1.Role{}
+	role.Name = sa.Name
+	role.Namespace = sa.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, hostClient, role, func() error {
+		InjectDefaultLabels(role, labels)
+		role.Rules = []rbacv1.PolicyRule{
+			// the runner reads the configuration, the target and the pull secrets of a run,
+			// restores and backs up the state and writes the exports and the plan.
+			{
+				APIGroups: []string{corev1.SchemeGroupVersion.Group},
+				Resources: []string{"secrets"},
+				Verbs:     []string{"create", "update", "get", "list", "delete"},
+			},
+			// the runner reads its run from its own pod and reports the run status to it.
+			{
+				APIGroups: []string{corev1.SchemeGroupVersion.Group},
+				Resources: []string{"pods"},
+				Verbs:     []string{"get", "patch"},
+			},
+		}
+		return nil
+	}); err != nil {
+		return types.NamespacedName{}, err
+	}
+
+	rolebinding := &rbacv1.RoleBinding{}
+	rolebinding.Name = sa.Name
+	rolebinding.Namespace = sa.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, hostClient, rolebinding, func() error {
+		InjectDefaultLabels(rolebinding, labels)
+		rolebinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.SchemeGroupVersion.Group,
+			Kind:     "Role",
+			Name:     role.Name,
+		}
+		rolebinding.Subjects = []rbacv1.Subject{
+			{
+				APIGroup:  "",
+				Kind:      "ServiceAccount",
+				Name:      sa.Name,
+				Namespace: sa.Namespace,
+			},
+		}
+		return nil
+	}); err != nil {
+		return types.NamespacedName{}, err
+	}
+
+	return WaitAndGetServiceAccountSecret(ctx, log, hostClient, sa, labels)
+}
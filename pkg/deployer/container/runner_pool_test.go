@@ -0,0 +1,290 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container_test
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	containerctlr "github.com/gardener/landscaper/pkg/deployer/container"
+)
+
+var _ = Describe("Runner Pool", func() {
+
+	const (
+		hostNamespace = "host"
+		image         = "example.com/image:1.0.0"
+	)
+
+	var (
+		ctx        context.Context
+		lsClient   client.Client
+		hostClient client.Client
+		item       *lsv1alpha1.DeployItem
+		config     containerv1alpha1.Configuration
+		poolName   string
+	)
+
+	newContainer := func() *containerctlr.Container {
+		di := &lsv1alpha1.DeployItem{}
+		Expect(lsClient.Get(ctx, kutil.ObjectKeyFromObject(item), di)).To(Succeed())
+		c, err := containerctlr.New(lsClient, lsClient, hostClient, hostClient, config, di, &lsv1alpha1.Context{}, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	// reconcile runs a reconcile of the deploy item and persists its status as the deployer library does.
+	reconcile := func() (*containerctlr.Container, error) {
+		c := newContainer()
+		err := c.Reconcile(ctx, container.OperationReconcile)
+		Expect(lsClient.Status().Update(ctx, c.DeployItem)).To(Succeed())
+		return c, err
+	}
+
+	getRunner := func() *corev1.Pod {
+		pods := &corev1.PodList{}
+		Expect(hostClient.List(ctx, pods, client.InNamespace(hostNamespace),
+			client.MatchingLabels(containerctlr.RunnerPoolLabels(config.Identity, poolName)))).To(Succeed())
+		Expect(pods.Items).To(HaveLen(1))
+		return &pods.Items[0]
+	}
+
+	getContainer := func(pod *corev1.Pod, name string) corev1.Container {
+		for _, cont := range pod.Spec.Containers {
+			if cont.Name == name {
+				return cont
+			}
+		}
+		Fail("container " + name + " not found")
+		return corev1.Container{}
+	}
+
+	mountPaths := func(cont corev1.Container) []string {
+		paths := []string{}
+		for _, mount := range cont.VolumeMounts {
+			paths = append(paths, mount.MountPath)
+		}
+		return paths
+	}
+
+	// startRunner lets the runner pod become ready to accept runs.
+	startRunner := func() {
+		_, err := reconcile()
+		Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorForInfoOnly)).To(BeTrue())
+		runner := getRunner()
+		runner.Status.Phase = corev1.PodRunning
+		Expect(hostClient.Status().Update(ctx, runner)).To(Succeed())
+	}
+
+	// reportRunStatus reports the status of the dispatched run as the runner does.
+	reportRunStatus := func(phase containerctlr.RunnerRunPhase) {
+		runner := getRunner()
+		run, err := containerctlr.GetRunnerRun(runner)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(run).ToNot(BeNil())
+		status, err := json.Marshal(&containerctlr.RunnerRunStatus{ID: run.ID, Phase: phase, ExitCode: ptr.To[int32](0)})
+		Expect(err).ToNot(HaveOccurred())
+		metav1.SetMetaDataAnnotation(&runner.ObjectMeta, container.ContainerDeployerRunnerRunStatusAnnotation, string(status))
+		Expect(hostClient.Update(ctx, runner)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+
+		var err error
+		item, err = containerctlr.NewDeployItemBuilder().
+			Key("default", "runner-test").
+			ProviderConfig(&containerv1alpha1.ProviderConfiguration{
+				Image:   image,
+				Command: []string{"sh", "-c"},
+				Args:    []string{"echo test"},
+			}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		item.Generation = 1
+		item.Spec.Timeout = &lsv1alpha1.Duration{Duration: 10 * time.Minute}
+		item.Status.JobID = "job-1"
+		item.Status.Phase = lsv1alpha1.DeployItemPhases.Init
+		item.Status.TransitionTimes = &lsv1alpha1.TransitionTimes{InitTime: ptr.To(metav1.Now())}
+
+		config = containerv1alpha1.Configuration{
+			Namespace: hostNamespace,
+			RunnerPool: &containerv1alpha1.RunnerPoolConfiguration{
+				Size:               1,
+				IdleTimeoutSeconds: 60,
+			},
+		}
+		containerctlr.DefaultConfiguration(&config)
+		poolName = containerctlr.RunnerPoolName(config.Identity, item.Namespace, image)
+
+		lsClient = fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.DeployItem{}).
+			WithObjects(item).
+			Build()
+
+		// the token secret of the service account is usually created by kubernetes
+		saName := containerctlr.RunnerServiceAccountName(poolName)
+		saSecret := &corev1.Secret{}
+		saSecret.Name = saName + "-token"
+		saSecret.Namespace = hostNamespace
+		saSecret.Annotations = map[string]string{corev1.ServiceAccountNameKey: saName}
+		saSecret.Type = corev1.SecretTypeServiceAccountToken
+		saSecret.Data = map[string][]byte{corev1.ServiceAccountTokenKey: []byte("token")}
+		hostClient = fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(saSecret).
+			Build()
+	})
+
+	It("should only mount the service account token into the wait container of a runner", func() {
+		_, err := reconcile()
+		Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorForInfoOnly)).To(BeTrue())
+
+		runner := getRunner()
+		Expect(runner.Spec.AutomountServiceAccountToken).To(Equal(ptr.To(false)))
+		Expect(runner.Labels).To(HaveKeyWithValue(container.ContainerDeployerRunnerStateLabel, container.RunnerStateIdle))
+
+		tokenDir := filepath.Dir(containerctlr.PodTokenPath)
+		mainContainer := getContainer(runner, container.MainContainerName)
+		Expect(mainContainer.Image).To(Equal(image))
+		Expect(mainContainer.Command).To(Equal([]string{container.RunnerBinaryPath}))
+		Expect(mountPaths(mainContainer)).ToNot(ContainElement(tokenDir))
+		// the main container must not access the data of the runner, e.g. the targets and pull secrets of other runs
+		Expect(mountPaths(mainContainer)).ToNot(ContainElement(container.BasePath))
+		Expect(mountPaths(mainContainer)).To(ContainElements(container.SharedBasePath, container.RunnerHandoverPath))
+
+		waitContainer := getContainer(runner, container.WaitContainerName)
+		Expect(waitContainer.Image).To(Equal(config.WaitContainer.Image))
+		Expect(mountPaths(waitContainer)).To(ContainElements(tokenDir, container.BasePath, container.SharedBasePath, container.RunnerHandoverPath))
+	})
+
+	It("should allow the runner to read its runs and to upload their results", func() {
+		_, err := reconcile()
+		Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorForInfoOnly)).To(BeTrue())
+
+		role := &rbacv1.Role{}
+		Expect(hostClient.Get(ctx, client.ObjectKey{Name: containerctlr.RunnerServiceAccountName(poolName), Namespace: hostNamespace}, role)).To(Succeed())
+		Expect(role.Rules).To(ConsistOf(
+			rbacv1.PolicyRule{
+				APIGroups: []string{""},
+				Resources: []string{"secrets"},
+				Verbs:     []string{"create", "update", "get", "list", "delete"},
+			},
+			rbacv1.PolicyRule{
+				APIGroups: []string{""},
+				Resources: []string{"pods"},
+				Verbs:     []string{"get", "patch"},
+			},
+		))
+
+		binding := &rbacv1.RoleBinding{}
+		Expect(hostClient.Get(ctx, client.ObjectKey{Name: role.Name, Namespace: hostNamespace}, binding)).To(Succeed())
+		Expect(binding.RoleRef.Name).To(Equal(role.Name))
+		Expect(binding.Subjects).To(ConsistOf(rbacv1.Subject{
+			Kind:      "ServiceAccount",
+			Name:      containerctlr.RunnerServiceAccountName(poolName),
+			Namespace: hostNamespace,
+		}))
+	})
+
+	It("should dispatch a run to an idle runner and release the runner once the run has finished", func() {
+		startRunner()
+
+		c, err := reconcile()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.DeployItem.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+
+		runner := getRunner()
+		Expect(runner.Labels).To(HaveKeyWithValue(container.ContainerDeployerRunnerStateLabel, container.RunnerStateBusy))
+		run, err := containerctlr.GetRunnerRun(runner)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(run.DeployItem).To(Equal(lsv1alpha1.ObjectReference{Name: item.Name, Namespace: item.Namespace}))
+		Expect(run.JobID).To(Equal("job-1"))
+		Expect(run.Operation).To(Equal(container.OperationReconcile))
+		Expect(run.Command).To(Equal([]string{"sh", "-c"}))
+		Expect(run.Args).To(Equal([]string{"echo test"}))
+		// the command has to finish within the timeout of the deploy item
+		Expect(run.Deadline).ToNot(BeNil())
+		Expect(run.Deadline.Time).To(BeTemporally("~", time.Now().Add(10*time.Minute), time.Minute))
+
+		// the run is in progress until the runner reports its result
+		c, err = reconcile()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.DeployItem.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+
+		reportRunStatus(containerctlr.RunnerRunPhaseSucceeded)
+		c, err = reconcile()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.DeployItem.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+
+		runner = getRunner()
+		Expect(runner.Labels).To(HaveKeyWithValue(container.ContainerDeployerRunnerStateLabel, container.RunnerStateIdle))
+		Expect(runner.Annotations).ToNot(HaveKey(container.ContainerDeployerRunnerRunAnnotation))
+		Expect(runner.Annotations).ToNot(HaveKey(container.ContainerDeployerRunnerRunStatusAnnotation))
+	})
+
+	It("should create runners with fixed names up to the size of the pool", func() {
+		config.RunnerPool.Size = 2
+		for i := 0; i < 3; i++ {
+			_, err := reconcile()
+			Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorForInfoOnly)).To(BeTrue())
+		}
+
+		pods := &corev1.PodList{}
+		Expect(hostClient.List(ctx, pods, client.InNamespace(hostNamespace))).To(Succeed())
+		names := []string{}
+		for _, pod := range pods.Items {
+			names = append(names, pod.Name)
+		}
+		// a concurrent dispatch cannot create an additional runner, as the creation of an existing name fails
+		Expect(names).To(ConsistOf(containerctlr.RunnerPodName(poolName, 0), containerctlr.RunnerPodName(poolName, 1)))
+	})
+
+	It("should use separate runner pools for deploy items of different namespaces", func() {
+		Expect(containerctlr.RunnerPoolName(config.Identity, "tenant-a", image)).
+			ToNot(Equal(containerctlr.RunnerPoolName(config.Identity, "tenant-b", image)))
+	})
+
+	It("should fail the run and remove the runner if its wait container has terminated", func() {
+		startRunner()
+		_, err := reconcile()
+		Expect(err).ToNot(HaveOccurred())
+
+		runner := getRunner()
+		runner.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name:  container.WaitContainerName,
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}},
+		}}
+		Expect(hostClient.Status().Update(ctx, runner)).To(Succeed())
+
+		c, err := reconcile()
+		Expect(err).To(HaveOccurred())
+		Expect(c.DeployItem.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Failed))
+
+		pods := &corev1.PodList{}
+		Expect(hostClient.List(ctx, pods, client.InNamespace(hostNamespace))).To(Succeed())
+		Expect(pods.Items).To(BeEmpty())
+	})
+})
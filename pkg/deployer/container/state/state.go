@@ -293,3 +293,71 @@ func CleanupState(ctx context.Context, log logging.Logger, kubeClient client.Cli
 		return completed, nil
 	})
 }
+
+// MoveState moves the latest state of a deploy item from one namespace to another.
+// Older states are not moved. The state is only copied if the target namespace does not already contain it,
+// so that an interrupted move can be repeated.
+func MoveState(ctx context.Context, kubeClient client.Client, fromNamespace, toNamespace string, deployItem lsv1alpha1.ObjectReference) error {
+	secretList := &corev1.SecretList{}
+	if err := read_write_layer.ListSecrets(ctx, kubeClient, secretList, read_write_layer.R000124,
+		StateSecretListOptions(fromNamespace, deployItem)...); err != nil {
+		return err
+	}
+	if len(secretList.Items) == 0 {
+		return nil
+	}
+
+	var newest *corev1.Secret
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		if newest == nil || newest.CreationTimestamp.Before(&secret.CreationTimestamp) {
+			newest = secret
+		}
+	}
+	newestUuid := newest.Annotations[container.ContainerDeployerStateUUIDAnnotation]
+	chunks := []*corev1.Secret{}
+	for i := range secretList.Items {
+		if secretList.Items[i].Annotations[container.ContainerDeployerStateUUIDAnnotation] == newestUuid {
+			chunks = append(chunks, &secretList.Items[i])
+		}
+	}
+
+	existingList := &corev1.SecretList{}
+	if err := read_write_layer.ListSecrets(ctx, kubeClient, existingList, read_write_layer.R000125,
+		StateSecretListOptions(toNamespace, deployItem)...); err != nil {
+		return err
+	}
+	existing := []*corev1.Secret{}
+	for i := range existingList.Items {
+		if existingList.Items[i].Annotations[container.ContainerDeployerStateUUIDAnnotation] == newestUuid {
+			existing = append(existing, &existingList.Items[i])
+		}
+	}
+
+	if len(existing) != len(chunks) {
+		// remove the chunks of a previously interrupted move
+		for _, secret := range existing {
+			if err := kubeClient.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("unable to delete incomplete state secret %s: %w", secret.Name, err)
+			}
+		}
+		for _, secret := range chunks {
+			moved := &corev1.Secret{}
+			moved.GenerateName = fmt.Sprintf("state-%s-%s-", deployItem.Namespace, deployItem.Name)
+			moved.Namespace = toNamespace
+			moved.Labels = secret.Labels
+			moved.Annotations = secret.Annotations
+			moved.Data = secret.Data
+			if err := kubeClient.Create(ctx, moved); err != nil {
+				return fmt.Errorf("unable to move state secret %s: %w", secret.Name, err)
+			}
+		}
+	}
+
+	for i := range secretList.Items {
+		if err := kubeClient.Delete(ctx, &secretList.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete state secret %s: %w", secretList.Items[i].Name, err)
+		}
+	}
+	return nil
+}
@@ -21,7 +21,9 @@ type options struct {
 	DefaultBackoff wait.Backoff
 
 	ExportFilePath string
+	PlanFilePath   string
 	StatePath      string
+	Operation      container.OperationType
 
 	podName      string
 	podNamespace string
@@ -35,6 +37,8 @@ type options struct {
 // Setup reads necessary options from the expected sources.
 func (o *options) Setup() {
 	o.ExportFilePath = os.Getenv(container.ExportsPathName)
+	o.PlanFilePath = os.Getenv(container.PlanPathName)
+	o.Operation = container.OperationType(os.Getenv(container.OperationName))
 	o.StatePath = os.Getenv(container.StatePathName)
 
 	o.podName = os.Getenv(container.PodName)
@@ -60,6 +64,9 @@ func (o *options) Validate() error {
 	if len(o.ExportFilePath) == 0 {
 		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.ExportsPathName))
 	}
+	if o.Operation == container.OperationPlan && len(o.PlanFilePath) == 0 {
+		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.PlanPathName))
+	}
 	if len(o.StatePath) == 0 {
 		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.StatePathName))
 	}
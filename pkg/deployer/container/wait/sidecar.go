@@ -12,6 +12,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/landscaper/apis/deployer/container"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/container/state"
@@ -59,6 +60,14 @@ func Run(ctx context.Context) error {
 		return withTerminationLog(log, err)
 	}
 
+	// upload the plan instead of the exports if only a plan was computed
+	if opts.Operation == container.OperationPlan {
+		if err := UploadPlan(ctx, kubeClient, opts.DeployItemKey, opts.PodKey, opts.PlanFilePath); err != nil {
+			return withTerminationLog(log, err)
+		}
+		return nil
+	}
+
 	// upload exports
 	if err := UploadExport(ctx, kubeClient, opts.DeployItemKey, opts.PodKey, opts.ExportFilePath); err != nil {
 		return withTerminationLog(log, err)
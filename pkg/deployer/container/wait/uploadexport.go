@@ -26,7 +26,6 @@ import (
 // UploadExport reads the export config from the given path and stores
 // the data as secret in the host cluster
 func UploadExport(ctx context.Context, kubeClient client.Client, deployItemKey lsv1alpha1.ObjectReference, podKey lsv1alpha1.ObjectReference, exportFilePath string) error {
-	log, ctx := logging.FromContextOrNew(ctx, nil)
 	pod := &corev1.Pod{}
 	if err := read_write_layer.GetPod(ctx, kubeClient, podKey.NamespacedName(), pod, read_write_layer.R000040); err != nil {
 		return err
@@ -43,6 +42,13 @@ func UploadExport(ctx context.Context, kubeClient client.Client, deployItemKey l
 		return fmt.Errorf("main container exists with %d", mainContainerStatus.State.Terminated.ExitCode)
 	}
 
+	return WriteExport(ctx, kubeClient, deployItemKey, podKey.Namespace, exportFilePath)
+}
+
+// WriteExport reads the export config from the given path and stores
+// the data as secret in the given namespace of the host cluster.
+func WriteExport(ctx context.Context, kubeClient client.Client, deployItemKey lsv1alpha1.ObjectReference, namespace, exportFilePath string) error {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
 	exportData, err := os.ReadFile(exportFilePath)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -52,7 +58,7 @@ func UploadExport(ctx context.Context, kubeClient client.Client, deployItemKey l
 		return err
 	}
 
-	return createOrUpdateExport(ctx, kubeClient, deployItemKey.Name, deployItemKey.Namespace, podKey.Namespace, exportData)
+	return createOrUpdateExport(ctx, kubeClient, deployItemKey.Name, deployItemKey.Namespace, namespace, exportData)
 }
 
 func createOrUpdateExport(ctx context.Context, kubeClient client.Client, deployItemName, deployItemNamespace, namespace string, data []byte) error {
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	containeractuator "github.com/gardener/landscaper/pkg/deployer/container"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// UploadPlan reads the plan that was computed by the main container from the given path and stores
+// the data as secret in the host cluster.
+func UploadPlan(ctx context.Context, kubeClient client.Client, deployItemKey lsv1alpha1.ObjectReference, podKey lsv1alpha1.ObjectReference, planFilePath string) error {
+	pod := &corev1.Pod{}
+	if err := read_write_layer.GetPod(ctx, kubeClient, podKey.NamespacedName(), pod, read_write_layer.R000111); err != nil {
+		return err
+	}
+	mainContainerStatus, err := kutil.GetStatusForContainer(pod.Status.ContainerStatuses, container.MainContainerName)
+	if err != nil {
+		return err
+	}
+	// should never happen as we have the wait method before
+	if mainContainerStatus.State.Terminated == nil {
+		return errors.New("main container not terminated yet")
+	}
+	if mainContainerStatus.State.Terminated.ExitCode != 0 {
+		return fmt.Errorf("main container exists with %d", mainContainerStatus.State.Terminated.ExitCode)
+	}
+
+	return WritePlan(ctx, kubeClient, deployItemKey, podKey.Namespace, planFilePath)
+}
+
+// WritePlan reads the plan from the given path and stores
+// the data as secret in the given namespace of the host cluster.
+func WritePlan(ctx context.Context, kubeClient client.Client, deployItemKey lsv1alpha1.ObjectReference, namespace, planFilePath string) error {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+	planData, err := os.ReadFile(planFilePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		// an empty plan is uploaded so that the plan can still be approved.
+		log.Info("No plan found. Upload empty plan.")
+		planData = []byte{}
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = containeractuator.PlanSecretName(deployItemKey.Namespace, deployItemKey.Name)
+	secret.Namespace = namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, kubeClient, secret, func() error {
+		kutil.SetMetaDataLabel(&secret.ObjectMeta, container.ContainerDeployerNameLabel, deployItemKey.Name)
+		secret.Data = map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: planData,
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("unable to create or update secret %s in namespace %s: %w", secret.Name, secret.Namespace, err)
+	}
+	return nil
+}
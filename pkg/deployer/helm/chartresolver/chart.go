@@ -27,6 +27,7 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
 	"github.com/gardener/landscaper/pkg/components/ocmlib"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
 
@@ -59,7 +60,7 @@ func GetChart(ctx context.Context,
 	}
 
 	if len(chartConfig.Ref) != 0 {
-		return getChartFromOCIRef(ctx, contextObj, chartConfig.Ref, registryPullSecrets, ociConfig, sharedCache)
+		return getChartFromOCIRef(ctx, lsClient, contextObj, chartConfig.Ref, registryPullSecrets, ociConfig, sharedCache)
 	}
 
 	if chartConfig.HelmChartRepo != nil {
@@ -107,6 +108,15 @@ func getChartFromResourceRef(ctx context.Context, resourceRef string, lsCtx *lsv
 		return nil, err
 	}
 
+	// per-registry credentials take precedence over the registry pull secrets
+	if len(lsCtx.RegistryCredentials) > 0 {
+		registryCredentials, err := registrycredentials.NewCredentials(ctx, lsClient, lsCtx.Namespace, lsCtx.RegistryCredentials)
+		if err != nil {
+			return nil, lserrors.NewWrappedError(err, op, "ReadRegistryCredentials", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+		ocmlib.AddRegistryCredentialsToCredContext(registryCredentials, octx)
+	}
+
 	// resolve all credentials for helm chart repositories
 	if lsCtx != nil && lsCtx.Configurations != nil {
 		if rawAuths, ok := lsCtx.Configurations[helmv1alpha1.HelmChartRepoCredentialsKey]; ok {
@@ -206,12 +216,18 @@ func getChartFromArchive(archiveConfig *helmv1alpha1.ArchiveAccess) (*chart.Char
 }
 
 func getChartFromOCIRef(ctx context.Context,
+	lsClient client.Client,
 	contextObj *lsv1alpha1.Context,
 	ociImageRef string,
 	registryPullSecrets []corev1.Secret,
 	ociConfig *config.OCIConfiguration,
 	sharedCache cache.Cache) (*chart.Chart, error) {
 
+	registryPullSecrets, err := getRegistryPullSecretsForOCIRef(ctx, lsClient, contextObj, ociImageRef, registryPullSecrets)
+	if err != nil {
+		return nil, err
+	}
+
 	resource, err := registries.GetFactory(contextObj.UseOCM).NewHelmOCIResource(ctx, nil, ociImageRef, registryPullSecrets, ociConfig, sharedCache)
 	if err != nil {
 		return nil, err
@@ -228,6 +244,32 @@ func getChartFromOCIRef(ctx context.Context,
 	return content, nil
 }
 
+// getRegistryPullSecretsForOCIRef returns the pull secrets for the chart with the given oci reference.
+// If a registry credential of the context matches the chart, only its credentials are used, and all registry pull
+// secrets are replaced, so that credentials for other registries are not sent to the registry of the chart.
+func getRegistryPullSecretsForOCIRef(ctx context.Context,
+	lsClient client.Client,
+	contextObj *lsv1alpha1.Context,
+	ociImageRef string,
+	registryPullSecrets []corev1.Secret) ([]corev1.Secret, error) {
+
+	if len(contextObj.RegistryCredentials) == 0 {
+		return registryPullSecrets, nil
+	}
+	registryCredentials, err := registrycredentials.NewCredentials(ctx, lsClient, contextObj.Namespace, contextObj.RegistryCredentials)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, "getChartFromOCIRef", "ReadRegistryCredentials", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+	pullSecret, err := registryCredentials.PullSecret(ctx, ociImageRef)
+	if err != nil {
+		return nil, err
+	}
+	if pullSecret == nil {
+		return registryPullSecrets, nil
+	}
+	return []corev1.Secret{*pullSecret}, nil
+}
+
 func getChartFromHelmChartRepo(ctx context.Context,
 	lsClient client.Client,
 	contextObj *lsv1alpha1.Context,
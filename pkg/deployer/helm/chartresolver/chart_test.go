@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Registry pull secrets of oci charts", func() {
+
+	var (
+		ctx                 context.Context
+		kubeClient          client.Client
+		contextObj          *lsv1alpha1.Context
+		registryPullSecrets []corev1.Secret
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		secret := &corev1.Secret{}
+		secret.Name = "charts"
+		secret.Namespace = "test"
+		secret.Data = map[string][]byte{"username": []byte("charts-user"), "password": []byte("charts-password")}
+		kubeClient = fake.NewClientBuilder().WithObjects(secret).Build()
+
+		contextObj = &lsv1alpha1.Context{}
+		contextObj.Name = "default"
+		contextObj.Namespace = "test"
+		contextObj.RegistryCredentials = []lsv1alpha1.RegistryCredential{{
+			Registry:  "registry.example.com/charts",
+			SecretRef: &corev1.LocalObjectReference{Name: secret.Name},
+		}}
+
+		registryPullSecrets = []corev1.Secret{{}, {}}
+		registryPullSecrets[0].Name = "pull-secret-a"
+		registryPullSecrets[1].Name = "pull-secret-b"
+	})
+
+	It("should replace all registry pull secrets by the matching registry credential", func() {
+		secrets, err := getRegistryPullSecretsForOCIRef(ctx, kubeClient, contextObj, "registry.example.com/charts/app:1.0.0", registryPullSecrets)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(HaveLen(1))
+		Expect(secrets[0].Type).To(Equal(corev1.SecretTypeDockerConfigJson))
+
+		dockerConfig := map[string]map[string]map[string]string{}
+		Expect(json.Unmarshal(secrets[0].Data[corev1.DockerConfigJsonKey], &dockerConfig)).To(Succeed())
+		Expect(dockerConfig["auths"]).To(HaveLen(1))
+		Expect(dockerConfig["auths"]["registry.example.com/charts/app"]).To(HaveKeyWithValue("username", "charts-user"))
+	})
+
+	It("should keep the registry pull secrets if no registry credential matches", func() {
+		secrets, err := getRegistryPullSecretsForOCIRef(ctx, kubeClient, contextObj, "registry.example.com/other/app:1.0.0", registryPullSecrets)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(Equal(registryPullSecrets))
+
+		contextObj.RegistryCredentials = nil
+		secrets, err = getRegistryPullSecretsForOCIRef(ctx, kubeClient, contextObj, "registry.example.com/charts/app:1.0.0", registryPullSecrets)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(Equal(registryPullSecrets))
+	})
+})
@@ -6,6 +6,7 @@ package helm
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/gardener/landscaper/pkg/components/registries"
@@ -18,7 +19,10 @@ import (
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/cache/shared"
+	"github.com/gardener/landscaper/pkg/components/cnudie/helmrepo"
 	cnudieutils "github.com/gardener/landscaper/pkg/components/cnudie/utils"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
 	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
 	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
@@ -55,10 +59,26 @@ func NewDeployer(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCache
 		if err != nil {
 			return nil, err
 		}
+		sharedCache, err = shared.NewCacheFromConfiguration(logging.NewContext(context.Background(), log), log, sharedCache, config.OCI)
+		if err != nil {
+			return nil, fmt.Errorf("unable to setup shared oci cache: %w", err)
+		}
 	}
 
 	registries.SetOCMLibraryMode(config.UseOCMLib)
 
+	indexCache, err := helmrepo.NewIndexCacheFromConfiguration(config.OCI)
+	if err != nil {
+		return nil, fmt.Errorf("unable to setup helm chart repository index cache: %w", err)
+	}
+	helmrepo.SetIndexCache(indexCache)
+
+	operatorTokenSources, err := registrycredentials.OperatorTokenSourcesFromConfiguration(config.OCI)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load registry token sources: %w", err)
+	}
+	registrycredentials.SetOperatorTokenSources(operatorTokenSources)
+
 	dep := &deployer{
 		lsUncachedClient:   lsUncachedClient,
 		lsCachedClient:     lsCachedClient,
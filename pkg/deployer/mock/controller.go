@@ -6,6 +6,7 @@ package mock
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
@@ -64,7 +65,11 @@ func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di
 		return err
 	}
 
-	if err := d.ensureExport(ctx, di, config); err != nil {
+	if len(config.Scenario) != 0 {
+		return d.reconcileScenario(ctx, di, config)
+	}
+
+	if err := d.ensureExport(ctx, di, config.Export); err != nil {
 		return err
 	}
 
@@ -117,8 +122,8 @@ func (d *deployer) ensureDeletion(ctx context.Context, item *lsv1alpha1.DeployIt
 	return nil
 }
 
-func (d *deployer) ensureExport(ctx context.Context, item *lsv1alpha1.DeployItem, config *mockv1alpha1.ProviderConfiguration) error {
-	if config.Export == nil {
+func (d *deployer) ensureExport(ctx context.Context, item *lsv1alpha1.DeployItem, export *json.RawMessage) error {
+	if export == nil {
 		return nil
 	}
 
@@ -132,7 +137,7 @@ func (d *deployer) ensureExport(ctx context.Context, item *lsv1alpha1.DeployItem
 
 	_, err := kubernetesutil.CreateOrUpdate(ctx, d.lsUncachedClient, secret, func() error {
 		secret.Data = map[string][]byte{
-			lsv1alpha1.DataObjectSecretDataKey: *config.Export,
+			lsv1alpha1.DataObjectSecretDataKey: *export,
 		}
 		return controllerutil.SetOwnerReference(item, secret, api.LandscaperScheme)
 	})
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	mockv1alpha1 "github.com/gardener/landscaper/apis/deployer/mock/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DecodeProviderStatus decodes a RawExtension to a mock provider status.
+// An empty status is returned if the raw status is not a mock provider status,
+// e.g. because it has been set by the ProviderStatus field of the provider configuration.
+func DecodeProviderStatus(raw *runtime.RawExtension) *mockv1alpha1.ProviderStatus {
+	status := &mockv1alpha1.ProviderStatus{}
+	if raw == nil || len(raw.Raw) == 0 {
+		return status
+	}
+	if _, _, err := Decoder.Decode(raw.Raw, nil, status); err != nil {
+		return &mockv1alpha1.ProviderStatus{}
+	}
+	return status
+}
+
+// reconcileScenario executes the current step of the scenario of a deploy item.
+// The progress of the scenario is stored in the provider status of the deploy item,
+// so that every reconciliation continues with the step after the previously executed one.
+func (d *deployer) reconcileScenario(ctx context.Context, di *lsv1alpha1.DeployItem, config *mockv1alpha1.ProviderConfiguration) error {
+	op := "ReconcileScenario"
+
+	status := DecodeProviderStatus(di.Status.ProviderStatus)
+	scenario := status.Scenario
+	if scenario == nil || scenario.ObservedGeneration != di.Generation {
+		// the deploy item has changed, therefore the scenario starts from the beginning
+		scenario = &mockv1alpha1.ScenarioStatus{ObservedGeneration: di.Generation}
+	}
+
+	step, name := currentScenarioStep(config.Scenario, scenario)
+	logger, ctx := logging.FromContextOrNew(ctx, nil, "step", name)
+
+	if step.Hang && scenario.StepStartTime != nil && scenario.JobID != di.Status.GetJobID() {
+		// the hanging step has been interrupted, continue with the next step
+		logger.Info("Hanging step has been interrupted")
+		nextScenarioStep(config.Scenario, scenario, step)
+		step, name = currentScenarioStep(config.Scenario, scenario)
+		logger = logger.WithValues("step", name)
+	}
+
+	if scenario.StepStartTime == nil {
+		scenario.StepStartTime = &metav1.Time{Time: time.Now()}
+		scenario.JobID = di.Status.GetJobID()
+	}
+
+	if step.Hang {
+		logger.Debug("Step hangs until it is interrupted")
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+		return d.updateScenarioStatus(ctx, di, scenario)
+	}
+
+	if step.Delay != nil && time.Since(scenario.StepStartTime.Time) < step.Delay.Duration {
+		logger.Debug("Waiting for the delay of the step", "delay", step.Delay.Duration.String())
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+		return d.updateScenarioStatus(ctx, di, scenario)
+	}
+
+	logger.Info("Executing step", "attempt", scenario.Attempt+1)
+	if err := d.ensureExport(ctx, di, step.Export); err != nil {
+		return lserrors.NewWrappedError(err, op, "EnsureExport", err.Error())
+	}
+
+	di.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+	if step.Error != nil {
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+	}
+	if step.Phase != nil {
+		di.Status.Phase = *step.Phase
+	}
+
+	nextScenarioStep(config.Scenario, scenario, step)
+	if err := d.updateScenarioStatus(ctx, di, scenario); err != nil {
+		return err
+	}
+
+	if step.Error != nil {
+		return lserrors.NewError(op, name, step.Error.Message, step.Error.Codes...)
+	}
+	return nil
+}
+
+// currentScenarioStep returns the current step of a scenario and its name.
+// The last step is returned if the scenario has already been completed.
+func currentScenarioStep(steps []mockv1alpha1.ScenarioStep, scenario *mockv1alpha1.ScenarioStatus) (mockv1alpha1.ScenarioStep, string) {
+	index := int(scenario.Step)
+	if index >= len(steps) {
+		index = len(steps) - 1
+	}
+	step := steps[index]
+	if len(step.Name) != 0 {
+		return step, step.Name
+	}
+	return step, fmt.Sprintf("step-%d", index)
+}
+
+// nextScenarioStep records an execution of the given step
+// and advances the scenario to the next step if the step has been executed often enough.
+func nextScenarioStep(steps []mockv1alpha1.ScenarioStep, scenario *mockv1alpha1.ScenarioStatus, step mockv1alpha1.ScenarioStep) {
+	times := step.Times
+	if times <= 0 {
+		times = 1
+	}
+	scenario.Attempt++
+	scenario.StepStartTime = nil
+	scenario.JobID = ""
+	if scenario.Attempt >= times && int(scenario.Step) < len(steps)-1 {
+		scenario.Step++
+		scenario.Attempt = 0
+	}
+}
+
+// updateScenarioStatus writes the progress of the scenario to the provider status of the deploy item.
+func (d *deployer) updateScenarioStatus(ctx context.Context, di *lsv1alpha1.DeployItem, scenario *mockv1alpha1.ScenarioStatus) error {
+	status := &mockv1alpha1.ProviderStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: mockv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ProviderStatus",
+		},
+		Scenario: scenario,
+	}
+	encStatus, err := kutil.ConvertToRawExtension(status, MockScheme)
+	if err != nil {
+		return lserrors.NewWrappedError(err, "ReconcileScenario", "EncodeProviderStatus", err.Error())
+	}
+	di.Status.ProviderStatus = encStatus
+	return d.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000155, di)
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mock_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	mockv1alpha1 "github.com/gardener/landscaper/apis/deployer/mock/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/deployer/mock"
+)
+
+var _ = Describe("Scenario", func() {
+
+	var (
+		ctx      context.Context
+		lsClient client.Client
+		deployer deployerlib.Deployer
+		item     *lsv1alpha1.DeployItem
+	)
+
+	create := func(steps ...mockv1alpha1.ScenarioStep) {
+		var err error
+		item, err = mock.NewDeployItemBuilder().
+			Key("default", "scenario").
+			ProviderConfig(&mockv1alpha1.ProviderConfiguration{Scenario: steps}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		item.Generation = 1
+		item.Status.JobID = "job-1"
+		Expect(lsClient.Create(ctx, item)).To(Succeed())
+	}
+
+	// reconcile reconciles the current version of the deploy item.
+	reconcile := func() error {
+		Expect(lsClient.Get(ctx, kutil.ObjectKeyFromObject(item), item)).To(Succeed())
+		return deployer.Reconcile(ctx, nil, item, nil)
+	}
+
+	scenarioStatus := func() *mockv1alpha1.ScenarioStatus {
+		status := mock.DecodeProviderStatus(item.Status.ProviderStatus)
+		Expect(status.Scenario).ToNot(BeNil())
+		return status.Scenario
+	}
+
+	startJob := func(jobID string) {
+		Expect(lsClient.Get(ctx, kutil.ObjectKeyFromObject(item), item)).To(Succeed())
+		item.Status.JobID = jobID
+		Expect(lsClient.Status().Update(ctx, item)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+		lsClient = fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.DeployItem{}).
+			Build()
+		var err error
+		deployer, err = mock.NewDeployer(lsClient, lsClient, lsClient, lsClient, logging.Discard(), mockv1alpha1.Configuration{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should execute the steps in subsequent reconciliations", func() {
+		export := json.RawMessage(`{"key":"value"}`)
+		create(
+			mockv1alpha1.ScenarioStep{Name: "fail", Times: 2, Error: &mockv1alpha1.ScenarioError{Message: "temporary problem"}},
+			mockv1alpha1.ScenarioStep{Name: "export", Export: &export},
+		)
+
+		for i := 0; i < 2; i++ {
+			err := reconcile()
+			Expect(err).To(MatchError(ContainSubstring("temporary problem")))
+			Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+		}
+		Expect(scenarioStatus().Step).To(Equal(int32(1)))
+
+		Expect(reconcile()).To(Succeed())
+		Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+		Expect(item.Status.ExportReference).ToNot(BeNil())
+		secret := &corev1.Secret{}
+		Expect(lsClient.Get(ctx, item.Status.ExportReference.NamespacedName(), secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKeyWithValue(lsv1alpha1.DataObjectSecretDataKey, []byte(export)))
+
+		// the last step is repeated
+		Expect(reconcile()).To(Succeed())
+		Expect(scenarioStatus().Step).To(Equal(int32(1)))
+		Expect(scenarioStatus().Attempt).To(Equal(int32(2)))
+	})
+
+	It("should return the error codes and the phase of a step", func() {
+		create(mockv1alpha1.ScenarioStep{
+			Phase: ptr.To(lsv1alpha1.DeployItemPhases.Failed),
+			Error: &mockv1alpha1.ScenarioError{Message: "broken", Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem}},
+		})
+
+		err := reconcile()
+		Expect(err).To(HaveOccurred())
+		Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorConfigurationProblem)).To(BeTrue())
+		lsErr, ok := lserrors.IsError(err)
+		Expect(ok).To(BeTrue())
+		Expect(lsErr.LandscaperError().Reason).To(Equal("step-0"))
+		Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Failed))
+	})
+
+	It("should keep the deploy item progressing until the delay of a step has passed", func() {
+		create(
+			mockv1alpha1.ScenarioStep{Delay: &lsv1alpha1.Duration{Duration: time.Hour}},
+			mockv1alpha1.ScenarioStep{},
+		)
+
+		Expect(reconcile()).To(Succeed())
+		Expect(reconcile()).To(Succeed())
+		Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+		Expect(scenarioStatus().Step).To(Equal(int32(0)))
+		Expect(scenarioStatus().StepStartTime).ToNot(BeNil())
+	})
+
+	It("should continue after a hanging step once the deploy item has a new job", func() {
+		create(
+			mockv1alpha1.ScenarioStep{Name: "hang", Hang: true},
+			mockv1alpha1.ScenarioStep{Name: "done"},
+		)
+
+		Expect(reconcile()).To(Succeed())
+		Expect(reconcile()).To(Succeed())
+		Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+		Expect(scenarioStatus().Step).To(Equal(int32(0)))
+		Expect(scenarioStatus().JobID).To(Equal("job-1"))
+
+		startJob("job-2")
+		Expect(reconcile()).To(Succeed())
+		Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+		Expect(scenarioStatus().Step).To(Equal(int32(1)))
+	})
+
+	It("should restart the scenario if the deploy item changes", func() {
+		create(
+			mockv1alpha1.ScenarioStep{Name: "first"},
+			mockv1alpha1.ScenarioStep{Name: "second", Error: &mockv1alpha1.ScenarioError{Message: "second step"}},
+		)
+		Expect(reconcile()).To(Succeed())
+		Expect(scenarioStatus().Step).To(Equal(int32(1)))
+
+		item.Generation = 2
+		Expect(lsClient.Update(ctx, item)).To(Succeed())
+		Expect(reconcile()).To(Succeed())
+		Expect(scenarioStatus().ObservedGeneration).To(Equal(int64(2)))
+		Expect(scenarioStatus().Step).To(Equal(int32(1)))
+		Expect(scenarioStatus().Attempt).To(Equal(int32(0)))
+	})
+
+	Context("DecodeProviderStatus", func() {
+		It("should return an empty status if the provider status is not a mock provider status", func() {
+			Expect(mock.DecodeProviderStatus(nil)).To(Equal(&mockv1alpha1.ProviderStatus{}))
+			raw := &runtime.RawExtension{Raw: []byte(`{"apiVersion": "other/v1", "kind": "Status"}`)}
+			Expect(mock.DecodeProviderStatus(raw)).To(Equal(&mockv1alpha1.ProviderStatus{}))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentindex
+
+import (
+	"net/http"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// installationsResource is the resource whose permissions are required to read the index.
+const installationsResource = "installations"
+
+// NewAuthHandler wraps the handler of the json api of the index, so that only requests of users are served that are
+// allowed to read the requested installations.
+// The user is authenticated with the kubernetes bearer token of the request by a TokenReview. A SubjectAccessReview
+// checks whether the user may get the requested installation, or list the installations of the requested namespace,
+// or of all namespaces if the request is not restricted to a namespace.
+func NewAuthHandler(log logging.Logger, kubeClient client.Client, next http.Handler) http.Handler {
+	return &authHandler{
+		handler:    handler{log: log},
+		kubeClient: kubeClient,
+		next:       next,
+	}
+}
+
+type authHandler struct {
+	handler
+	kubeClient client.Client
+	next       http.Handler
+}
+
+func (h *authHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || len(token) == 0 {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		h.writeError(w, http.StatusUnauthorized, "a bearer token is required")
+		return
+	}
+
+	tokenReview := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}
+	if err := h.kubeClient.Create(req.Context(), tokenReview); err != nil {
+		h.log.Error(err, "unable to review token")
+		h.writeError(w, http.StatusInternalServerError, "unable to review token")
+		return
+	}
+	if !tokenReview.Status.Authenticated {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		h.writeError(w, http.StatusUnauthorized, "invalid bearer token")
+		return
+	}
+
+	user := tokenReview.Status.User
+	extra := map[string]authorizationv1.ExtraValue{}
+	for key, value := range user.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+	resourceAttributes := requestedResource(req)
+	accessReview := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:               user.Username,
+			UID:                user.UID,
+			Groups:             user.Groups,
+			Extra:              extra,
+			ResourceAttributes: resourceAttributes,
+		},
+	}
+	if err := h.kubeClient.Create(req.Context(), accessReview); err != nil {
+		h.log.Error(err, "unable to review access")
+		h.writeError(w, http.StatusInternalServerError, "unable to review access")
+		return
+	}
+	if !accessReview.Status.Allowed {
+		h.writeError(w, http.StatusForbidden, "user "+user.Username+" is not allowed to "+resourceAttributes.Verb+" the requested installations")
+		return
+	}
+
+	h.next.ServeHTTP(w, req)
+}
+
+// requestedResource returns the attributes of the installations that are read by the request.
+// The namespace is empty if the installations of all namespaces are requested.
+func requestedResource(req *http.Request) *authorizationv1.ResourceAttributes {
+	attributes := &authorizationv1.ResourceAttributes{
+		Verb:      "list",
+		Namespace: req.URL.Query().Get("namespace"),
+		Group:     lsv1alpha1.SchemeGroupVersion.Group,
+		Resource:  installationsResource,
+	}
+	subPath := strings.Trim(strings.TrimPrefix(req.URL.Path, InstallationsPath), "/")
+	if parts := strings.Split(subPath, "/"); len(parts) == 2 {
+		attributes.Verb = "get"
+		attributes.Namespace = parts[0]
+		attributes.Name = parts[1]
+	}
+	return attributes
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentindex_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/componentindex"
+)
+
+var _ = Describe("Auth", func() {
+
+	var (
+		handler  http.Handler
+		reviewed []authorizationv1.ResourceAttributes
+	)
+
+	BeforeEach(func() {
+		reviewed = nil
+
+		// the user "reader" may list and get the installations of the namespace "ns1"
+		kubeClient := fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					switch review := obj.(type) {
+					case *authenticationv1.TokenReview:
+						if review.Spec.Token == "reader-token" {
+							review.Status.Authenticated = true
+							review.Status.User = authenticationv1.UserInfo{Username: "reader", Groups: []string{"readers"}}
+						}
+					case *authorizationv1.SubjectAccessReview:
+						Expect(review.Spec.User).To(Equal("reader"))
+						Expect(review.Spec.Groups).To(ConsistOf("readers"))
+						reviewed = append(reviewed, *review.Spec.ResourceAttributes)
+						review.Status.Allowed = review.Spec.ResourceAttributes.Namespace == "ns1"
+					}
+					return nil
+				},
+			}).
+			Build()
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		handler = componentindex.NewAuthHandler(logging.Discard(), kubeClient, next)
+	})
+
+	get := func(url, token string) int {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		if len(token) != 0 {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	It("should reject requests without a valid token", func() {
+		Expect(get("/api/v1/installations?namespace=ns1", "")).To(Equal(http.StatusUnauthorized))
+		Expect(get("/api/v1/installations?namespace=ns1", "invalid-token")).To(Equal(http.StatusUnauthorized))
+		Expect(reviewed).To(BeEmpty())
+	})
+
+	It("should serve requests for installations the user may read", func() {
+		Expect(get("/api/v1/installations?namespace=ns1&component=example.com/root", "reader-token")).To(Equal(http.StatusOK))
+		Expect(get("/api/v1/installations/ns1/a", "reader-token")).To(Equal(http.StatusOK))
+
+		Expect(reviewed).To(HaveLen(2))
+		Expect(reviewed[0].Verb).To(Equal("list"))
+		Expect(reviewed[0].Namespace).To(Equal("ns1"))
+		Expect(reviewed[0].Group).To(Equal("landscaper.gardener.cloud"))
+		Expect(reviewed[0].Resource).To(Equal("installations"))
+		Expect(reviewed[1].Verb).To(Equal("get"))
+		Expect(reviewed[1].Namespace).To(Equal("ns1"))
+		Expect(reviewed[1].Name).To(Equal("a"))
+	})
+
+	It("should reject requests for installations the user must not read", func() {
+		Expect(get("/api/v1/installations?namespace=ns2", "reader-token")).To(Equal(http.StatusForbidden))
+		Expect(get("/api/v1/installations/ns2/a", "reader-token")).To(Equal(http.StatusForbidden))
+	})
+
+	It("should require the permission to list the installations of all namespaces for requests without namespace", func() {
+		Expect(get("/api/v1/installations?component=example.com/root", "reader-token")).To(Equal(http.StatusForbidden))
+		Expect(reviewed).To(HaveLen(1))
+		Expect(reviewed[0].Namespace).To(BeEmpty())
+	})
+})
@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentindex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// artifactKeys are the keys of the provider configuration of deploy items whose string values are indexed as artifacts.
+// They cover the images of the container deployer and of kubernetes manifests, as well as the chart references of the helm deployer.
+var artifactKeys = sets.New[string]("image", "imageReference", "imageRef", "ref")
+
+// ociAccessTypes are the access types of resources that contain an oci reference in the field "imageReference".
+var ociAccessTypes = sets.New[string]("ociRegistry", "OCIRegistry", "ociArtifact", "OCIImage")
+
+// RegistryAccessFactory creates a registry access for the given context.
+type RegistryAccessFactory func(ctx context.Context, contextObj lsv1alpha1.Context) (model.RegistryAccess, error)
+
+// Builder builds the index from the installations and deploy items of a cluster.
+type Builder struct {
+	lsUncachedClient  client.Client
+	newRegistryAccess RegistryAccessFactory
+}
+
+// NewBuilder creates a new index builder.
+// The component versions of the installations are resolved with the registry accesses created by the given factory.
+func NewBuilder(lsUncachedClient client.Client, newRegistryAccess RegistryAccessFactory) *Builder {
+	return &Builder{
+		lsUncachedClient:  lsUncachedClient,
+		newRegistryAccess: newRegistryAccess,
+	}
+}
+
+// resolvedComponents contains the transitively resolved component versions of a component version.
+type resolvedComponents struct {
+	components []ComponentVersionRef
+	resources  []Resource
+	err        error
+}
+
+// Build builds a new index of all installations.
+// Component versions that cannot be resolved do not fail the build, the error is reported at the affected installations instead.
+func (b *Builder) Build(ctx context.Context) (*Index, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, b.lsUncachedClient, instList, read_write_layer.R000122); err != nil {
+		return nil, fmt.Errorf("unable to list installations: %w", err)
+	}
+	diList := &lsv1alpha1.DeployItemList{}
+	if err := read_write_layer.ListDeployItems(ctx, b.lsUncachedClient, diList, read_write_layer.R000123); err != nil {
+		return nil, fmt.Errorf("unable to list deploy items: %w", err)
+	}
+
+	// the deploy items are assigned to the installations via their executions
+	deployItems := map[client.ObjectKey][]DeployItem{}
+	for i := range diList.Items {
+		di := &diList.Items[i]
+		execName, ok := di.Labels[lsv1alpha1.ExecutionManagedByLabel]
+		if !ok {
+			continue
+		}
+		execKey := client.ObjectKey{Namespace: di.Namespace, Name: execName}
+		deployItems[execKey] = append(deployItems[execKey], newDeployItem(di))
+	}
+
+	registryAccesses := map[client.ObjectKey]model.RegistryAccess{}
+	defer func() {
+		for key, registryAccess := range registryAccesses {
+			if err := model.CloseRegistryAccess(registryAccess); err != nil {
+				logger.Error(err, "unable to close registry access", "context", key.String())
+			}
+		}
+	}()
+	resolved := map[string]*resolvedComponents{}
+
+	index := &Index{
+		BuildTime:     time.Now(),
+		Installations: make([]Installation, 0, len(instList.Items)),
+	}
+	for i := range instList.Items {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		inst := &instList.Items[i]
+		entry := Installation{
+			Name:      inst.Name,
+			Namespace: inst.Namespace,
+			Parent:    inst.Labels[lsv1alpha1.EncompassedByLabel],
+			Context:   inst.Spec.Context,
+		}
+		if ref := inst.Status.ExecutionReference; ref != nil {
+			entry.DeployItems = deployItems[ref.NamespacedName()]
+		}
+
+		if installations.GetReferenceFromComponentDescriptorDefinition(inst.Spec.ComponentDescriptor) != nil {
+			components, componentRef := b.resolveComponents(ctx, inst, registryAccesses, resolved)
+			entry.Component = componentRef
+			entry.Components = components.components
+			entry.Resources = components.resources
+			if components.err != nil {
+				logger.Info("unable to resolve components of installation", "installation", client.ObjectKeyFromObject(inst).String(),
+					"error", components.err.Error())
+				entry.Error = components.err.Error()
+			}
+		}
+		index.Installations = append(index.Installations, entry)
+	}
+	sortInstallations(index.Installations)
+	return index, nil
+}
+
+// resolveComponents resolves the component version of an installation and all transitively referenced component versions.
+// The results are cached per context and component version, as many installations use the same component versions.
+func (b *Builder) resolveComponents(ctx context.Context, inst *lsv1alpha1.Installation,
+	registryAccesses map[client.ObjectKey]model.RegistryAccess, resolved map[string]*resolvedComponents) (*resolvedComponents, *ComponentVersionRef) {
+
+	// the external context is computed on a copy, because it modifies the conditions of the installation
+	externalContext, err := installations.GetExternalContext(ctx, b.lsUncachedClient, inst.DeepCopy())
+	if err != nil {
+		return &resolvedComponents{err: fmt.Errorf("unable to resolve context: %w", err)}, nil
+	}
+	cdRef := externalContext.ComponentDescriptorRef()
+	if cdRef == nil {
+		return &resolvedComponents{err: fmt.Errorf("installation has no component descriptor reference")}, nil
+	}
+	componentRef := &ComponentVersionRef{ComponentName: cdRef.ComponentName, Version: cdRef.Version}
+
+	contextKey := client.ObjectKey{Namespace: inst.Namespace, Name: externalContext.Context.Name}
+	cacheKey := fmt.Sprintf("%s/%s:%s", contextKey.String(), cdRef.ComponentName, cdRef.Version)
+	if result, ok := resolved[cacheKey]; ok {
+		return result, componentRef
+	}
+
+	result := &resolvedComponents{}
+	resolved[cacheKey] = result
+
+	registryAccess, ok := registryAccesses[contextKey]
+	if !ok {
+		registryAccess, err = b.newRegistryAccess(ctx, externalContext.Context)
+		if err != nil {
+			result.err = fmt.Errorf("unable to create registry access for context %q: %w", externalContext.Context.Name, err)
+			return result, componentRef
+		}
+		registryAccesses[contextKey] = registryAccess
+	}
+
+	componentVersion, err := registryAccess.GetComponentVersion(ctx, cdRef)
+	if err != nil {
+		result.err = fmt.Errorf("unable to get component version: %w", err)
+		return result, componentRef
+	}
+	componentVersions, err := model.GetTransitiveComponentReferences(ctx, componentVersion, cdRef.RepositoryContext, externalContext.Overwriter)
+	if err != nil {
+		result.err = fmt.Errorf("unable to get referenced component versions: %w", err)
+		return result, componentRef
+	}
+
+	for _, cv := range componentVersions.Components {
+		result.components = append(result.components, ComponentVersionRef{ComponentName: cv.GetName(), Version: cv.GetVersion()})
+		result.resources = append(result.resources, newResources(cv)...)
+	}
+	sort.Slice(result.components, func(i, j int) bool {
+		if result.components[i].ComponentName != result.components[j].ComponentName {
+			return result.components[i].ComponentName < result.components[j].ComponentName
+		}
+		return result.components[i].Version < result.components[j].Version
+	})
+	sort.SliceStable(result.resources, func(i, j int) bool {
+		return result.resources[i].ComponentName < result.resources[j].ComponentName
+	})
+	return result, componentRef
+}
+
+// newResources returns the indexed resources of a component version.
+func newResources(cv model.ComponentVersion) []Resource {
+	cd := cv.GetComponentDescriptor()
+	if cd == nil {
+		return nil
+	}
+	result := make([]Resource, 0, len(cd.Resources))
+	for _, res := range cd.Resources {
+		entry := Resource{
+			ComponentName:    cv.GetName(),
+			ComponentVersion: cv.GetVersion(),
+			Name:             res.GetName(),
+			Version:          res.GetVersion(),
+			Type:             res.GetType(),
+		}
+		if res.Access != nil {
+			entry.AccessType = res.Access.GetType()
+			if ociAccessTypes.Has(entry.AccessType) {
+				if ref, ok := res.Access.Object["imageReference"].(string); ok {
+					entry.Reference = ref
+				}
+			}
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+// newDeployItem returns the indexed data of a deploy item.
+func newDeployItem(di *lsv1alpha1.DeployItem) DeployItem {
+	result := DeployItem{
+		Name: di.Name,
+		Type: string(di.Spec.Type),
+	}
+	if di.Spec.Configuration == nil || len(di.Spec.Configuration.Raw) == 0 {
+		return result
+	}
+	var config interface{}
+	if err := json.Unmarshal(di.Spec.Configuration.Raw, &config); err != nil {
+		return result
+	}
+	artifacts := sets.New[string]()
+	collectArtifacts(config, artifacts)
+	result.Artifacts = sets.List(artifacts)
+	return result
+}
+
+// collectArtifacts walks through the given json value and collects the string values of the artifact keys.
+func collectArtifacts(value interface{}, artifacts sets.Set[string]) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if s, ok := child.(string); ok {
+				if artifactKeys.Has(key) && len(s) != 0 {
+					artifacts.Insert(s)
+				}
+				continue
+			}
+			collectArtifacts(child, artifacts)
+		}
+	case []interface{}:
+		for _, child := range v {
+			collectArtifacts(child, artifacts)
+		}
+	}
+}
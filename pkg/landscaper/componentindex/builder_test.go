@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentindex_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/landscaper/componentindex"
+)
+
+var _ = Describe("Builder", func() {
+
+	const namespace = "test"
+
+	var (
+		ctx             context.Context
+		kubeClient      client.Client
+		builder         *componentindex.Builder
+		createdAccesses int
+		closedAccesses  int
+	)
+
+	newInstallation := func(name, componentName string) *lsv1alpha1.Installation {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = name
+		inst.Namespace = namespace
+		inst.Spec.Context = lsv1alpha1.DefaultContextName
+		inst.Spec.ComponentDescriptor = &lsv1alpha1.ComponentDescriptorDefinition{
+			Reference: &lsv1alpha1.ComponentDescriptorReference{ComponentName: componentName, Version: "1.0.0"},
+		}
+		return inst
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		localRepoCtx := &types.UnstructuredTypedObject{}
+		Expect(localRepoCtx.UnmarshalJSON([]byte(`{"type":"local"}`))).To(Succeed())
+
+		lsCtx := &lsv1alpha1.Context{}
+		lsCtx.Name = lsv1alpha1.DefaultContextName
+		lsCtx.Namespace = namespace
+		lsCtx.RepositoryContext = localRepoCtx
+
+		root := newInstallation("root", "example.com/root")
+		root.Status.ExecutionReference = &lsv1alpha1.ObjectReference{Name: "root-exec", Namespace: namespace}
+
+		sub := newInstallation("sub", "example.com/child")
+		sub.Labels = map[string]string{lsv1alpha1.EncompassedByLabel: "root"}
+
+		broken := newInstallation("broken", "example.com/missing")
+
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = "root-di"
+		di.Namespace = namespace
+		di.Labels = map[string]string{lsv1alpha1.ExecutionManagedByLabel: "root-exec"}
+		di.Spec.Type = "landscaper.gardener.cloud/helm"
+		di.Spec.Configuration = &runtime.RawExtension{Raw: []byte(`{
+			"chart": {"ref": "example.com/charts/app:1.0.0"},
+			"values": {"image": {"repository": "example.com/images/app"}, "sidecar": {"image": "example.com/images/sidecar:0.1.0"}}
+		}`)}
+
+		otherDi := &lsv1alpha1.DeployItem{}
+		otherDi.Name = "other-di"
+		otherDi.Namespace = namespace
+		otherDi.Labels = map[string]string{lsv1alpha1.ExecutionManagedByLabel: "other-exec"}
+		otherDi.Spec.Type = "landscaper.gardener.cloud/container"
+
+		kubeClient = fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithObjects(lsCtx, root, sub, broken, di, otherDi).
+			Build()
+
+		createdAccesses = 0
+		closedAccesses = 0
+		builder = componentindex.NewBuilder(kubeClient,
+			func(ctx context.Context, contextObj lsv1alpha1.Context) (model.RegistryAccess, error) {
+				createdAccesses++
+				registryAccess, err := registries.GetFactory(contextObj.UseOCM).NewRegistryAccess(ctx, nil, nil, nil,
+					&config.LocalRegistryConfiguration{RootPath: "./testdata/components"}, nil, nil)
+				if err != nil {
+					return nil, err
+				}
+				return &closeRecordingRegistryAccess{RegistryAccess: registryAccess, closed: &closedAccesses}, nil
+			})
+	})
+
+	It("should index the components, resources and deploy items of all installations", func() {
+		index, err := builder.Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(index.Installations).To(HaveLen(3))
+
+		root := index.GetInstallation(namespace, "root")
+		Expect(root).ToNot(BeNil())
+		Expect(root.Error).To(BeEmpty())
+		Expect(root.Context).To(Equal(lsv1alpha1.DefaultContextName))
+		Expect(root.Component).To(Equal(&componentindex.ComponentVersionRef{ComponentName: "example.com/root", Version: "1.0.0"}))
+		Expect(root.Components).To(Equal([]componentindex.ComponentVersionRef{
+			{ComponentName: "example.com/child", Version: "1.0.0"},
+			{ComponentName: "example.com/root", Version: "1.0.0"},
+		}))
+		Expect(root.Resources).To(ConsistOf(
+			componentindex.Resource{ComponentName: "example.com/root", ComponentVersion: "1.0.0", Name: "chart", Version: "1.0.0",
+				Type: "helm.io/chart", AccessType: "ociRegistry", Reference: "example.com/charts/app:1.0.0"},
+			componentindex.Resource{ComponentName: "example.com/child", ComponentVersion: "1.0.0", Name: "image", Version: "2.3.0",
+				Type: "ociImage", AccessType: "ociRegistry", Reference: "example.com/images/app:2.3.0"},
+		))
+		Expect(root.DeployItems).To(Equal([]componentindex.DeployItem{{
+			Name:      "root-di",
+			Type:      "landscaper.gardener.cloud/helm",
+			Artifacts: []string{"example.com/charts/app:1.0.0", "example.com/images/sidecar:0.1.0"},
+		}}))
+
+		sub := index.GetInstallation(namespace, "sub")
+		Expect(sub).ToNot(BeNil())
+		Expect(sub.Parent).To(Equal("root"))
+		Expect(sub.DeployItems).To(BeEmpty())
+		Expect(sub.Components).To(Equal([]componentindex.ComponentVersionRef{{ComponentName: "example.com/child", Version: "1.0.0"}}))
+
+		broken := index.GetInstallation(namespace, "broken")
+		Expect(broken).ToNot(BeNil())
+		Expect(broken.Error).ToNot(BeEmpty())
+		Expect(broken.Components).To(BeEmpty())
+
+		Expect(index.FindByArtifact("example.com/images/app")).To(HaveLen(2))
+		Expect(index.FindByArtifact("example.com/images/sidecar:0.1.0")).To(HaveLen(1))
+		Expect(index.FindByComponent("example.com/root", "1.0.0")).To(HaveLen(1))
+	})
+
+	It("should close the registry accesses after the build", func() {
+		_, err := builder.Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(createdAccesses).To(Equal(1))
+		Expect(closedAccesses).To(Equal(1))
+
+		_, err = builder.Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(createdAccesses).To(Equal(2))
+		Expect(closedAccesses).To(Equal(2))
+	})
+})
+
+// closeRecordingRegistryAccess counts how often registry accesses are closed.
+type closeRecordingRegistryAccess struct {
+	model.RegistryAccess
+	closed *int
+}
+
+func (r *closeRecordingRegistryAccess) Close() error {
+	*r.closed++
+	return model.CloseRegistryAccess(r.RegistryAccess)
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentindex
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// InstallationsPath is the path of the api that lists and queries the indexed installations.
+const InstallationsPath = "/api/v1/installations"
+
+// IndexFunc returns the current index or nil if no index has been built yet.
+type IndexFunc func() *Index
+
+// NewHandler creates the http handler of the json api of the index.
+//
+//	GET /api/v1/installations                                  lists all installations
+//	GET /api/v1/installations?namespace=<namespace>            lists the installations of a namespace
+//	GET /api/v1/installations?component=<name>&version=<v>     lists the installations using a component (version)
+//	GET /api/v1/installations?artifact=<oci reference>         lists the installations using an image or chart
+//	GET /api/v1/installations/<namespace>/<name>               returns a single installation
+func NewHandler(log logging.Logger, getIndex IndexFunc) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(InstallationsPath, &handler{log: log, getIndex: getIndex})
+	mux.Handle(InstallationsPath+"/", &handler{log: log, getIndex: getIndex})
+	return mux
+}
+
+type handler struct {
+	log      logging.Logger
+	getIndex IndexFunc
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "only GET requests are supported")
+		return
+	}
+	index := h.getIndex()
+	if index == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "the index has not been built yet")
+		return
+	}
+
+	subPath := strings.Trim(strings.TrimPrefix(req.URL.Path, InstallationsPath), "/")
+	if len(subPath) != 0 {
+		parts := strings.Split(subPath, "/")
+		if len(parts) != 2 {
+			h.writeError(w, http.StatusNotFound, "expected path "+InstallationsPath+"/<namespace>/<name>")
+			return
+		}
+		inst := index.GetInstallation(parts[0], parts[1])
+		if inst == nil {
+			h.writeError(w, http.StatusNotFound, "installation "+parts[0]+"/"+parts[1]+" not found")
+			return
+		}
+		h.writeJSON(w, http.StatusOK, inst)
+		return
+	}
+
+	query := req.URL.Query()
+	var result []Installation
+	switch {
+	case query.Has("artifact"):
+		result = index.FindByArtifact(query.Get("artifact"))
+	case query.Has("component"):
+		result = index.FindByComponent(query.Get("component"), query.Get("version"))
+	default:
+		result = index.Installations
+	}
+	if query.Has("namespace") {
+		namespace := query.Get("namespace")
+		filtered := []Installation{}
+		for _, inst := range result {
+			if inst.Namespace == namespace {
+				filtered = append(filtered, inst)
+			}
+		}
+		result = filtered
+	}
+
+	h.writeJSON(w, http.StatusOK, &Index{
+		BuildTime:     index.BuildTime,
+		Installations: result,
+	})
+}
+
+func (h *handler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, map[string]string{"error": message})
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		h.log.Error(err, "unable to send component index response")
+	}
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentindex
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Index contains the components, resources and deploy items that are used by the installations of a landscaper instance.
+// An index is immutable after it has been built.
+type Index struct {
+	// BuildTime is the time when the index has been built.
+	BuildTime time.Time `json:"buildTime"`
+	// Installations contains the indexed installations sorted by namespace and name.
+	Installations []Installation `json:"installations"`
+}
+
+// Installation contains the indexed data of an installation.
+type Installation struct {
+	// Name is the name of the installation.
+	Name string `json:"name"`
+	// Namespace is the namespace of the installation.
+	Namespace string `json:"namespace"`
+	// Parent is the name of the parent installation. It is empty for root installations.
+	Parent string `json:"parent,omitempty"`
+	// Context is the name of the context of the installation.
+	Context string `json:"context,omitempty"`
+	// Component is the component version of the installation after the component overwrites have been applied.
+	Component *ComponentVersionRef `json:"component,omitempty"`
+	// Components contains the component version of the installation and all transitively referenced component versions.
+	Components []ComponentVersionRef `json:"components,omitempty"`
+	// Resources contains the resources of all component versions in Components.
+	Resources []Resource `json:"resources,omitempty"`
+	// DeployItems contains the deploy items of the execution of the installation.
+	DeployItems []DeployItem `json:"deployItems,omitempty"`
+	// Error describes why the components of the installation could not be resolved.
+	Error string `json:"error,omitempty"`
+}
+
+// ComponentVersionRef identifies a component version.
+type ComponentVersionRef struct {
+	ComponentName string `json:"componentName"`
+	Version       string `json:"version"`
+}
+
+// Resource describes a resource of a component version.
+type Resource struct {
+	// ComponentName is the name of the component that contains the resource.
+	ComponentName string `json:"componentName"`
+	// ComponentVersion is the version of the component that contains the resource.
+	ComponentVersion string `json:"componentVersion"`
+	Name             string `json:"name"`
+	Version          string `json:"version,omitempty"`
+	Type             string `json:"type"`
+	// AccessType is the type of the access of the resource.
+	AccessType string `json:"accessType,omitempty"`
+	// Reference is the oci reference of the resource. It is only set for resources with an oci access.
+	Reference string `json:"reference,omitempty"`
+}
+
+// DeployItem describes a deploy item that has been rendered for an installation.
+type DeployItem struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Artifacts contains the images and charts that are referenced in the provider configuration of the deploy item.
+	Artifacts []string `json:"artifacts,omitempty"`
+}
+
+// GetInstallation returns the installation with the given namespace and name or nil if it is not indexed.
+func (idx *Index) GetInstallation(namespace, name string) *Installation {
+	i := sort.Search(len(idx.Installations), func(i int) bool {
+		inst := &idx.Installations[i]
+		return inst.Namespace > namespace || (inst.Namespace == namespace && inst.Name >= name)
+	})
+	if i < len(idx.Installations) && idx.Installations[i].Namespace == namespace && idx.Installations[i].Name == name {
+		return &idx.Installations[i]
+	}
+	return nil
+}
+
+// FindByComponent returns the installations that use the given component version directly or transitively.
+// All versions of the component match if the version is empty.
+func (idx *Index) FindByComponent(componentName, version string) []Installation {
+	return idx.filter(func(inst *Installation) bool {
+		for _, c := range inst.Components {
+			if c.ComponentName == componentName && (len(version) == 0 || c.Version == version) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// FindByArtifact returns the installations whose components contain a resource with the given oci reference,
+// or whose deploy items reference the given artifact.
+// If the reference contains neither a tag nor a digest, all tags and digests of the repository match.
+func (idx *Index) FindByArtifact(ref string) []Installation {
+	return idx.filter(func(inst *Installation) bool {
+		for _, res := range inst.Resources {
+			if MatchesArtifact(ref, res.Reference) {
+				return true
+			}
+		}
+		for _, di := range inst.DeployItems {
+			for _, artifact := range di.Artifacts {
+				if MatchesArtifact(ref, artifact) {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+func (idx *Index) filter(matches func(inst *Installation) bool) []Installation {
+	result := []Installation{}
+	for i := range idx.Installations {
+		if matches(&idx.Installations[i]) {
+			result = append(result, idx.Installations[i])
+		}
+	}
+	return result
+}
+
+// MatchesArtifact checks whether the given artifact reference matches the queried reference.
+// A query without tag and digest matches all references of the same repository.
+func MatchesArtifact(query, artifact string) bool {
+	if len(query) == 0 || len(artifact) == 0 {
+		return false
+	}
+	if query == artifact {
+		return true
+	}
+	queryRepo, queryVersioned := splitReference(query)
+	if queryVersioned {
+		return false
+	}
+	artifactRepo, _ := splitReference(artifact)
+	return queryRepo == artifactRepo
+}
+
+// splitReference returns the repository of an oci reference and whether the reference contains a tag or digest.
+func splitReference(ref string) (string, bool) {
+	versioned := false
+	if i := strings.Index(ref, "@"); i >= 0 {
+		ref = ref[:i]
+		versioned = true
+	}
+	// a colon after the last slash separates the tag, other colons separate the port of the registry
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		ref = ref[:i]
+		versioned = true
+	}
+	return ref, versioned
+}
+
+// sortInstallations sorts the installations by namespace and name, as required by GetInstallation.
+func sortInstallations(installations []Installation) {
+	sort.Slice(installations, func(i, j int) bool {
+		if installations[i].Namespace != installations[j].Namespace {
+			return installations[i].Namespace < installations[j].Namespace
+		}
+		return installations[i].Name < installations[j].Name
+	})
+}
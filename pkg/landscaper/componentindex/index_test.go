@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentindex_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/componentindex"
+)
+
+var _ = Describe("Index", func() {
+
+	var index *componentindex.Index
+
+	BeforeEach(func() {
+		index = &componentindex.Index{
+			BuildTime: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+			Installations: []componentindex.Installation{
+				{
+					Name:      "a",
+					Namespace: "ns1",
+					Components: []componentindex.ComponentVersionRef{
+						{ComponentName: "example.com/root", Version: "1.0.0"},
+						{ComponentName: "example.com/child", Version: "1.0.0"},
+					},
+					Resources: []componentindex.Resource{
+						{ComponentName: "example.com/child", ComponentVersion: "1.0.0", Name: "image", Reference: "example.com/images/app:2.3.0"},
+					},
+				},
+				{
+					Name:      "b",
+					Namespace: "ns1",
+					Components: []componentindex.ComponentVersionRef{
+						{ComponentName: "example.com/child", Version: "1.1.0"},
+					},
+					DeployItems: []componentindex.DeployItem{
+						{Name: "di", Type: "landscaper.gardener.cloud/container", Artifacts: []string{"localhost:5000/images/app@sha256:abc"}},
+					},
+				},
+				{
+					Name:      "a",
+					Namespace: "ns2",
+				},
+			},
+		}
+	})
+
+	names := func(installations []componentindex.Installation) []string {
+		result := []string{}
+		for _, inst := range installations {
+			result = append(result, inst.Namespace+"/"+inst.Name)
+		}
+		return result
+	}
+
+	It("should get an installation by namespace and name", func() {
+		Expect(index.GetInstallation("ns1", "b")).To(Equal(&index.Installations[1]))
+		Expect(index.GetInstallation("ns2", "a")).To(Equal(&index.Installations[2]))
+		Expect(index.GetInstallation("ns2", "b")).To(BeNil())
+	})
+
+	It("should find installations by component", func() {
+		Expect(names(index.FindByComponent("example.com/child", ""))).To(ConsistOf("ns1/a", "ns1/b"))
+		Expect(names(index.FindByComponent("example.com/child", "1.1.0"))).To(ConsistOf("ns1/b"))
+		Expect(index.FindByComponent("example.com/other", "")).To(BeEmpty())
+	})
+
+	It("should find installations by artifact", func() {
+		Expect(names(index.FindByArtifact("example.com/images/app:2.3.0"))).To(ConsistOf("ns1/a"))
+		Expect(names(index.FindByArtifact("example.com/images/app"))).To(ConsistOf("ns1/a"))
+		Expect(index.FindByArtifact("example.com/images/app:2.4.0")).To(BeEmpty())
+		Expect(names(index.FindByArtifact("localhost:5000/images/app"))).To(ConsistOf("ns1/b"))
+	})
+
+	It("should match artifacts with and without tags and digests", func() {
+		Expect(componentindex.MatchesArtifact("example.com/app", "example.com/app:1.0.0")).To(BeTrue())
+		Expect(componentindex.MatchesArtifact("example.com/app", "example.com/app@sha256:abc")).To(BeTrue())
+		Expect(componentindex.MatchesArtifact("example.com/app:1.0.0", "example.com/app:1.0.0")).To(BeTrue())
+		Expect(componentindex.MatchesArtifact("example.com/app:1.0.0", "example.com/app:1.0.1")).To(BeFalse())
+		Expect(componentindex.MatchesArtifact("localhost:5000/app", "localhost:5000/app:1.0.0")).To(BeTrue())
+		Expect(componentindex.MatchesArtifact("example.com/app", "example.com/app-other:1.0.0")).To(BeFalse())
+	})
+
+	Context("Handler", func() {
+
+		get := func(handler http.Handler, url string, body interface{}) int {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+			if body != nil {
+				Expect(json.Unmarshal(rec.Body.Bytes(), body)).To(Succeed())
+			}
+			return rec.Code
+		}
+
+		var handler http.Handler
+
+		BeforeEach(func() {
+			handler = componentindex.NewHandler(logging.Discard(), func() *componentindex.Index { return index })
+		})
+
+		It("should list and query installations", func() {
+			result := &componentindex.Index{}
+			Expect(get(handler, "/api/v1/installations", result)).To(Equal(http.StatusOK))
+			Expect(result.BuildTime).To(BeTemporally("==", index.BuildTime))
+			Expect(names(result.Installations)).To(ConsistOf("ns1/a", "ns1/b", "ns2/a"))
+
+			Expect(get(handler, "/api/v1/installations?namespace=ns2", result)).To(Equal(http.StatusOK))
+			Expect(names(result.Installations)).To(ConsistOf("ns2/a"))
+
+			Expect(get(handler, "/api/v1/installations?component=example.com/child&version=1.0.0", result)).To(Equal(http.StatusOK))
+			Expect(names(result.Installations)).To(ConsistOf("ns1/a"))
+
+			Expect(get(handler, "/api/v1/installations?artifact=example.com/images/app&namespace=ns2", result)).To(Equal(http.StatusOK))
+			Expect(result.Installations).To(BeEmpty())
+		})
+
+		It("should return a single installation", func() {
+			inst := &componentindex.Installation{}
+			Expect(get(handler, "/api/v1/installations/ns1/b", inst)).To(Equal(http.StatusOK))
+			Expect(*inst).To(Equal(index.Installations[1]))
+
+			Expect(get(handler, "/api/v1/installations/ns1/c", nil)).To(Equal(http.StatusNotFound))
+			Expect(get(handler, "/api/v1/installations/ns1", nil)).To(Equal(http.StatusNotFound))
+		})
+
+		It("should fail if the index has not been built yet", func() {
+			handler = componentindex.NewHandler(logging.Discard(), func() *componentindex.Index { return nil })
+			Expect(get(handler, "/api/v1/installations", nil)).To(Equal(http.StatusServiceUnavailable))
+		})
+	})
+})
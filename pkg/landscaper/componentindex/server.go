@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// defaultRefreshInterval is the interval in which the index is rebuilt if none is configured.
+const defaultRefreshInterval = 10 * time.Minute
+
+// Server periodically rebuilds the index and serves it as http/json api.
+// It implements the manager.Runnable interface of the controller-runtime.
+type Server struct {
+	log             logging.Logger
+	builder         *Builder
+	kubeClient      client.Client
+	config          *config.ComponentIndexConfiguration
+	refreshInterval time.Duration
+
+	index      *Index
+	indexMutex sync.RWMutex
+}
+
+// NewServer creates a new server that serves and rebuilds the index as configured.
+// The requests are authenticated and authorized with the given client, see NewAuthHandler.
+func NewServer(logger logging.Logger, builder *Builder, kubeClient client.Client, config *config.ComponentIndexConfiguration) *Server {
+	refreshInterval := defaultRefreshInterval
+	if config.RefreshInterval != nil {
+		refreshInterval = config.RefreshInterval.Duration
+	}
+	return &Server{
+		log:             logger,
+		builder:         builder,
+		kubeClient:      kubeClient,
+		config:          config,
+		refreshInterval: refreshInterval,
+	}
+}
+
+// Index returns the current index or nil if no index has been built yet.
+func (s *Server) Index() *Index {
+	s.indexMutex.RLock()
+	defer s.indexMutex.RUnlock()
+	return s.index
+}
+
+// Refresh rebuilds the index.
+// The previous index is kept if the index cannot be built.
+func (s *Server) Refresh(ctx context.Context) error {
+	index, err := s.builder.Build(ctx)
+	if err != nil {
+		return err
+	}
+	s.indexMutex.Lock()
+	defer s.indexMutex.Unlock()
+	s.index = index
+	return nil
+}
+
+// Start serves the index until the context is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	ctx = logging.NewContext(ctx, s.log)
+
+	server := &http.Server{
+		Addr:              net.JoinHostPort(s.config.BindAddress, strconv.Itoa(int(s.config.Port))),
+		Handler:           NewAuthHandler(s.log, s.kubeClient, NewHandler(s.log, s.Index)),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	serverErr := make(chan error, 1)
+	go func() {
+		var err error
+		if s.config.TLS != nil {
+			s.log.Info("serving component index via https", "address", server.Addr)
+			err = server.ListenAndServeTLS(s.config.TLS.CertFile, s.config.TLS.KeyFile)
+		} else {
+			s.log.Info("serving component index via http", "address", server.Addr)
+			err = server.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErr <- err
+		}
+		close(serverErr)
+	}()
+
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.Refresh(ctx); err != nil {
+			s.log.Error(err, "unable to build component index")
+		}
+		select {
+		case <-ctx.Done():
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			return server.Shutdown(shutdownCtx)
+		case err, ok := <-serverErr:
+			if ok {
+				return fmt.Errorf("unable to serve component index: %w", err)
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection returns true, so that only the leader replica of the landscaper builds and serves the index.
+func (s *Server) NeedLeaderElection() bool {
+	return true
+}
@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentoverwrites
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/componentoverwrites"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// resyncInterval is the interval after which the affected installations are determined again,
+// so that created, modified and deleted installations are reflected in the status.
+const resyncInterval = 5 * time.Minute
+
+// RegistryAccessFactory creates a registry access for the given context.
+type RegistryAccessFactory func(ctx context.Context, contextObj lsv1alpha1.Context) (model.RegistryAccess, error)
+
+// Controller is the controller that reports the installations affected by ComponentVersionOverwrites objects.
+type Controller struct {
+	lsUncachedClient  client.Client
+	log               logging.Logger
+	newRegistryAccess RegistryAccessFactory
+}
+
+// NewController creates a new ComponentVersionOverwrites controller.
+// The registry accesses of the given factory are used to resolve the transitively referenced components of the installations.
+func NewController(lsUncachedClient client.Client, logger logging.Logger, newRegistryAccess RegistryAccessFactory) *Controller {
+	return &Controller{
+		lsUncachedClient:  lsUncachedClient,
+		log:               logger,
+		newRegistryAccess: newRegistryAccess,
+	}
+}
+
+// Reconcile reconciles requests for ComponentVersionOverwrites objects.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+	_, ctx = c.log.StartReconcileAndAddToContext(ctx, req)
+
+	result = reconcile.Result{}
+	defer utils.HandlePanics(ctx, &result)
+
+	result, err = c.reconcile(ctx, req)
+
+	return result, err
+}
+
+func (c *Controller) reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	cvo := &lsv1alpha1.ComponentVersionOverwrites{}
+	if err := c.lsUncachedClient.Get(ctx, req.NamespacedName, cvo); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !cvo.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	status, err := c.computeStatus(ctx, cvo)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := c.updateStatus(ctx, cvo, status); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: resyncInterval}, nil
+}
+
+// computeStatus determines the invalid overwrites and the installations whose component reference, or the reference
+// of a transitively referenced component, is replaced by the overwrites.
+// An installation is affected if its context references the ComponentVersionOverwrites object.
+func (c *Controller) computeStatus(ctx context.Context, cvo *lsv1alpha1.ComponentVersionOverwrites) (*lsv1alpha1.ComponentVersionOverwritesStatus, error) {
+	status := &lsv1alpha1.ComponentVersionOverwritesStatus{
+		ObservedGeneration: cvo.GetGeneration(),
+	}
+	for _, err := range componentoverwrites.Validate(cvo.Overwrites) {
+		status.InvalidOverwrites = append(status.InvalidOverwrites, err.Error())
+	}
+
+	contextList := &lsv1alpha1.ContextList{}
+	if err := c.lsUncachedClient.List(ctx, contextList, client.InNamespace(cvo.Namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list contexts: %w", err)
+	}
+	contexts := map[string]*lsv1alpha1.Context{}
+	for i := range contextList.Items {
+		if contextList.Items[i].ComponentVersionOverwritesReference == cvo.Name {
+			contexts[contextList.Items[i].Name] = &contextList.Items[i]
+		}
+	}
+	if len(contexts) == 0 {
+		return status, nil
+	}
+
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, c.lsUncachedClient, instList, read_write_layer.R000120,
+		client.InNamespace(cvo.Namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list installations: %w", err)
+	}
+
+	substitutions := componentoverwrites.NewSubstitutions(cvo.Overwrites)
+	registryAccesses := map[string]model.RegistryAccess{}
+	defer func() {
+		for name, registryAccess := range registryAccesses {
+			if err := model.CloseRegistryAccess(registryAccess); err != nil {
+				logger, _ := logging.FromContextOrNew(ctx, nil)
+				logger.Error(err, "unable to close registry access", "context", name)
+			}
+		}
+	}()
+	for _, inst := range instList.Items {
+		lsCtx, ok := contexts[inst.Spec.Context]
+		if !ok {
+			continue
+		}
+		ref := installations.GetReferenceFromComponentDescriptorDefinition(inst.Spec.ComponentDescriptor)
+		if ref == nil {
+			continue
+		}
+		ref = ref.DeepCopy()
+		if ref.RepositoryContext == nil {
+			ref.RepositoryContext = lsCtx.RepositoryContext.DeepCopy()
+		}
+
+		recorder := newRecordingOverwriter(substitutions)
+		c.resolveComponentReferences(ctx, &inst, lsCtx, ref, recorder, registryAccesses)
+		for _, replacement := range recorder.replacements {
+			status.AffectedInstallations = append(status.AffectedInstallations, lsv1alpha1.AffectedInstallation{
+				Name:          inst.Name,
+				Context:       lsCtx.Name,
+				ComponentName: replacement.original.ComponentName,
+				Version:       replacement.original.Version,
+				Substitution:  replacement.substitution,
+			})
+		}
+	}
+	sort.SliceStable(status.AffectedInstallations, func(i, j int) bool {
+		a, b := status.AffectedInstallations[i], status.AffectedInstallations[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.ComponentName != b.ComponentName {
+			return a.ComponentName < b.ComponentName
+		}
+		return a.Version < b.Version
+	})
+	return status, nil
+}
+
+// resolveComponentReferences applies the overwriter to the component reference of the installation and to the
+// references of all transitively referenced components.
+// Errors are only logged, so that the replacements that were found until then are still reported.
+func (c *Controller) resolveComponentReferences(ctx context.Context, inst *lsv1alpha1.Installation, lsCtx *lsv1alpha1.Context,
+	ref *lsv1alpha1.ComponentDescriptorReference, overwriter componentoverwrites.Overwriter, registryAccesses map[string]model.RegistryAccess) {
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil, "installation", inst.Name)
+
+	if c.newRegistryAccess == nil {
+		overwriter.Replace(ref)
+		return
+	}
+
+	registryAccess, ok := registryAccesses[lsCtx.Name]
+	if !ok {
+		var err error
+		registryAccess, err = c.newRegistryAccess(ctx, *lsCtx)
+		if err != nil {
+			logger.Info("unable to create registry access", "context", lsCtx.Name, "error", err.Error())
+			overwriter.Replace(ref)
+			return
+		}
+		registryAccesses[lsCtx.Name] = registryAccess
+	}
+
+	componentVersion, err := model.GetComponentVersionWithOverwriter(ctx, registryAccess, ref, overwriter)
+	if err != nil {
+		logger.Info("unable to resolve component version of installation", "error", err.Error())
+		return
+	}
+	if _, err := model.GetTransitiveComponentReferences(ctx, componentVersion, lsCtx.RepositoryContext, overwriter); err != nil {
+		logger.Info("unable to resolve transitively referenced component versions of installation", "error", err.Error())
+	}
+}
+
+// replacement is a component reference that was replaced by an overwrite.
+type replacement struct {
+	original     lsv1alpha1.ComponentDescriptorReference
+	substitution lsv1alpha1.ComponentDescriptorReference
+}
+
+// recordingOverwriter is an overwriter that records the component references that are replaced.
+type recordingOverwriter struct {
+	overwriter   componentoverwrites.Overwriter
+	replacements []replacement
+	recorded     map[string]bool
+}
+
+func newRecordingOverwriter(overwriter componentoverwrites.Overwriter) *recordingOverwriter {
+	return &recordingOverwriter{
+		overwriter: overwriter,
+		recorded:   map[string]bool{},
+	}
+}
+
+// Replace replaces the component reference with the wrapped overwriter and records the replacement.
+// A component reference that is replaced several times is only recorded once.
+func (r *recordingOverwriter) Replace(ref *lsv1alpha1.ComponentDescriptorReference) bool {
+	original := ref.DeepCopy()
+	if !r.overwriter.Replace(ref) {
+		return false
+	}
+	key := original.ComponentName + ":" + original.Version
+	if !r.recorded[key] {
+		r.recorded[key] = true
+		r.replacements = append(r.replacements, replacement{original: *original, substitution: *ref.DeepCopy()})
+	}
+	return true
+}
+
+// updateStatus updates the status of the ComponentVersionOverwrites object if it has changed.
+func (c *Controller) updateStatus(ctx context.Context, cvo *lsv1alpha1.ComponentVersionOverwrites, status *lsv1alpha1.ComponentVersionOverwritesStatus) error {
+	status.LastUpdateTime = cvo.Status.LastUpdateTime
+	if apiequality.Semantic.DeepEqual(cvo.Status, *status) {
+		return nil
+	}
+	now := metav1.Now()
+	status.LastUpdateTime = &now
+
+	patch := client.MergeFrom(cvo.DeepCopy())
+	cvo.Status = *status
+	if err := c.lsUncachedClient.Status().Patch(ctx, cvo, patch); err != nil {
+		return fmt.Errorf("unable to update status of component version overwrites object: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentoverwrites_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/componentoverwrites"
+	testutils "github.com/gardener/landscaper/test/utils"
+)
+
+var _ = Describe("ComponentVersionOverwrites Controller", func() {
+
+	const namespace = "test"
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		ctrl       *componentoverwrites.Controller
+		cvo        *lsv1alpha1.ComponentVersionOverwrites
+	)
+
+	newContext := func(name, cvoName string) *lsv1alpha1.Context {
+		lsCtx := &lsv1alpha1.Context{}
+		lsCtx.Name = name
+		lsCtx.Namespace = namespace
+		lsCtx.RepositoryContext = testutils.ExampleRepositoryContext()
+		lsCtx.ComponentVersionOverwritesReference = cvoName
+		return lsCtx
+	}
+
+	newInstallation := func(name, contextName, componentName, version string) *lsv1alpha1.Installation {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = name
+		inst.Namespace = namespace
+		inst.Spec.Context = contextName
+		inst.Spec.ComponentDescriptor = &lsv1alpha1.ComponentDescriptorDefinition{
+			Reference: &lsv1alpha1.ComponentDescriptorReference{
+				ComponentName: componentName,
+				Version:       version,
+			},
+		}
+		return inst
+	}
+
+	reconcileAndGet := func() *lsv1alpha1.ComponentVersionOverwrites {
+		_, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(cvo)})
+		Expect(err).ToNot(HaveOccurred())
+		result := &lsv1alpha1.ComponentVersionOverwrites{}
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(cvo), result)).To(Succeed())
+		return result
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		cvo = &lsv1alpha1.ComponentVersionOverwrites{}
+		cvo.Name = "overwrites"
+		cvo.Namespace = namespace
+		cvo.Generation = 2
+		cvo.Overwrites = lsv1alpha1.ComponentVersionOverwriteList{
+			{
+				Source: lsv1alpha1.ComponentVersionOverwriteReference{
+					ComponentName:     "github.com/acme/*",
+					VersionConstraint: ">= 1.2, < 2",
+				},
+				Substitution: lsv1alpha1.ComponentVersionOverwriteReference{
+					RepositoryContext: testutils.DefaultRepositoryContext("mirror.example.com"),
+				},
+			},
+		}
+
+		kubeClient = fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.ComponentVersionOverwrites{}).
+			WithObjects(
+				cvo,
+				newContext("with-overwrites", cvo.Name),
+				newContext("without-overwrites", ""),
+				newInstallation("matching", "with-overwrites", "github.com/acme/app", "v1.5.0"),
+				newInstallation("other-version", "with-overwrites", "github.com/acme/app", "v2.0.0"),
+				newInstallation("other-component", "with-overwrites", "github.com/other/app", "v1.5.0"),
+				newInstallation("other-context", "without-overwrites", "github.com/acme/app", "v1.5.0"),
+				newInstallation("a-matching", "with-overwrites", "github.com/acme/lib", "1.2.0"),
+			).
+			Build()
+
+		ctrl = componentoverwrites.NewController(kubeClient, logging.Discard(), nil)
+	})
+
+	It("should list the installations whose component reference is replaced", func() {
+		result := reconcileAndGet()
+		Expect(result.Status.ObservedGeneration).To(Equal(cvo.Generation))
+		Expect(result.Status.LastUpdateTime).ToNot(BeNil())
+		Expect(result.Status.InvalidOverwrites).To(BeEmpty())
+		Expect(result.Status.AffectedInstallations).To(HaveLen(2))
+
+		affected := result.Status.AffectedInstallations[1]
+		Expect(affected.Name).To(Equal("matching"))
+		Expect(affected.Context).To(Equal("with-overwrites"))
+		Expect(affected.ComponentName).To(Equal("github.com/acme/app"))
+		Expect(affected.Version).To(Equal("v1.5.0"))
+		Expect(affected.Substitution.ComponentName).To(Equal("github.com/acme/app"))
+		Expect(affected.Substitution.Version).To(Equal("v1.5.0"))
+		Expect(affected.Substitution.RepositoryContext.Raw).To(MatchJSON(testutils.DefaultRepositoryContext("mirror.example.com").Raw))
+		Expect(result.Status.AffectedInstallations[0].Name).To(Equal("a-matching"))
+	})
+
+	It("should not update an unchanged status", func() {
+		first := reconcileAndGet()
+		second := reconcileAndGet()
+		Expect(second.Status.LastUpdateTime).To(Equal(first.Status.LastUpdateTime))
+		Expect(second.ResourceVersion).To(Equal(first.ResourceVersion))
+	})
+
+	It("should report invalid overwrites", func() {
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(cvo), cvo)).To(Succeed())
+		cvo.Overwrites[0].Source.VersionConstraint = "not a constraint"
+		Expect(kubeClient.Update(ctx, cvo)).To(Succeed())
+
+		result := reconcileAndGet()
+		Expect(result.Status.InvalidOverwrites).To(ConsistOf(ContainSubstring("invalid version constraint")))
+		Expect(result.Status.AffectedInstallations).To(BeEmpty())
+	})
+
+	It("should list the installations whose transitively referenced components are replaced", func() {
+		localRepoCtx := &types.UnstructuredTypedObject{}
+		Expect(localRepoCtx.UnmarshalJSON([]byte(`{"type":"local"}`))).To(Succeed())
+
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(cvo), cvo)).To(Succeed())
+		cvo.Overwrites = lsv1alpha1.ComponentVersionOverwriteList{
+			{
+				Source: lsv1alpha1.ComponentVersionOverwriteReference{
+					ComponentName: "example.com/child",
+					Version:       "1.0.0",
+				},
+				Substitution: lsv1alpha1.ComponentVersionOverwriteReference{
+					Version: "1.1.0",
+				},
+			},
+		}
+		Expect(kubeClient.Update(ctx, cvo)).To(Succeed())
+
+		lsCtx := newContext("local", cvo.Name)
+		lsCtx.RepositoryContext = localRepoCtx
+		Expect(kubeClient.Create(ctx, lsCtx)).To(Succeed())
+		Expect(kubeClient.Create(ctx, newInstallation("root", "local", "example.com/root", "1.0.0"))).To(Succeed())
+		Expect(kubeClient.Create(ctx, newInstallation("child", "local", "example.com/child", "1.0.0"))).To(Succeed())
+
+		ctrl = componentoverwrites.NewController(kubeClient, logging.Discard(),
+			func(ctx context.Context, contextObj lsv1alpha1.Context) (model.RegistryAccess, error) {
+				return registries.GetFactory(contextObj.UseOCM).NewRegistryAccess(ctx, nil, nil, nil,
+					&config.LocalRegistryConfiguration{RootPath: "./testdata/components"}, nil, nil)
+			})
+
+		result := reconcileAndGet()
+		Expect(result.Status.AffectedInstallations).To(HaveLen(2))
+		for i, name := range []string{"child", "root"} {
+			affected := result.Status.AffectedInstallations[i]
+			Expect(affected.Name).To(Equal(name))
+			Expect(affected.Context).To(Equal("local"))
+			Expect(affected.ComponentName).To(Equal("example.com/child"))
+			Expect(affected.Version).To(Equal("1.0.0"))
+			Expect(affected.Substitution.ComponentName).To(Equal("example.com/child"))
+			Expect(affected.Substitution.Version).To(Equal("1.1.0"))
+		}
+	})
+
+	It("should not report installations if no context references the overwrites", func() {
+		lsCtx := &lsv1alpha1.Context{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "with-overwrites", Namespace: namespace}, lsCtx)).To(Succeed())
+		lsCtx.ComponentVersionOverwritesReference = "other"
+		Expect(kubeClient.Update(ctx, lsCtx)).To(Succeed())
+
+		result := reconcileAndGet()
+		Expect(result.Status.AffectedInstallations).To(BeEmpty())
+	})
+})
@@ -0,0 +1,333 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentprefetch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// statusUpdateInterval is the minimal interval between two status updates while component versions are prefetched.
+	statusUpdateInterval = 5 * time.Second
+	// retryInterval is the interval after which a failed prefetch is retried.
+	retryInterval = 10 * time.Minute
+	// maxReportedComponents is the maximal number of component versions that are listed in the status,
+	// so that the status of objects with many installations stays small.
+	maxReportedComponents = 100
+)
+
+// RegistryAccessFactory creates a registry access for the given context.
+type RegistryAccessFactory func(ctx context.Context, contextObj lsv1alpha1.Context) (model.RegistryAccess, error)
+
+// Controller is the controller that prefetches the component versions of ComponentPrefetch objects.
+type Controller struct {
+	lsUncachedClient  client.Client
+	log               logging.Logger
+	newRegistryAccess RegistryAccessFactory
+
+	// prefetched contains the ComponentPrefetch objects that have been prefetched successfully by this process.
+	// As the caches are not persisted across restarts, all objects are prefetched again after a restart.
+	prefetched      map[k8stypes.NamespacedName]prefetchedGeneration
+	prefetchedMutex sync.Mutex
+}
+
+type prefetchedGeneration struct {
+	uid        k8stypes.UID
+	generation int64
+}
+
+// NewController creates a new ComponentPrefetch controller.
+// The registry accesses created by the given factory determine the caches that are filled.
+func NewController(lsUncachedClient client.Client, logger logging.Logger, newRegistryAccess RegistryAccessFactory) *Controller {
+	return &Controller{
+		lsUncachedClient:  lsUncachedClient,
+		log:               logger,
+		newRegistryAccess: newRegistryAccess,
+		prefetched:        map[k8stypes.NamespacedName]prefetchedGeneration{},
+	}
+}
+
+// Reconcile reconciles requests for ComponentPrefetch objects.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+	_, ctx = c.log.StartReconcileAndAddToContext(ctx, req)
+
+	result = reconcile.Result{}
+	defer utils.HandlePanics(ctx, &result)
+
+	result, err = c.reconcile(ctx, req)
+
+	return result, err
+}
+
+func (c *Controller) reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	prefetch := &lsv1alpha1.ComponentPrefetch{}
+	if err := c.lsUncachedClient.Get(ctx, req.NamespacedName, prefetch); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			c.forget(req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !prefetch.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	if helper.HasOperation(prefetch.ObjectMeta, lsv1alpha1.ReconcileOperation) {
+		logger.Info("Removing reconcile annotation from component prefetch object.")
+		delete(prefetch.Annotations, lsv1alpha1.OperationAnnotation)
+		if err := c.lsUncachedClient.Update(ctx, prefetch); err != nil {
+			return reconcile.Result{}, err
+		}
+	} else if c.isPrefetched(prefetch) {
+		return reconcile.Result{}, nil
+	}
+
+	if err := c.Prefetch(ctx, prefetch); err != nil {
+		logger.Error(err, "prefetching component versions failed")
+		return reconcile.Result{RequeueAfter: retryInterval}, nil
+	}
+
+	c.setPrefetched(prefetch)
+	return reconcile.Result{}, nil
+}
+
+// Prefetch prefetches the component versions of the given ComponentPrefetch object and reports the progress in its status.
+// An error is returned if at least one component version could not be prefetched.
+func (c *Controller) Prefetch(ctx context.Context, prefetch *lsv1alpha1.ComponentPrefetch) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(prefetch).String()})
+
+	status := &lsv1alpha1.ComponentPrefetchStatus{
+		ObservedGeneration: prefetch.GetGeneration(),
+		Phase:              lsv1alpha1.ComponentPrefetchPhaseProgressing,
+		LastCompletionTime: prefetch.Status.LastCompletionTime,
+	}
+	if err := c.updateStatus(ctx, prefetch, status); err != nil {
+		return err
+	}
+
+	items, err := c.collectComponents(ctx, prefetch)
+	if err != nil {
+		status.Phase = lsv1alpha1.ComponentPrefetchPhaseFailed
+		status.LastError = err.Error()
+		if updateErr := c.updateStatus(ctx, prefetch, status); updateErr != nil {
+			logger.Error(updateErr, "updating status of component prefetch object failed")
+		}
+		return err
+	}
+
+	lastUpdate := time.Now()
+	prefetcher := NewPrefetcher(prefetch.Spec.IncludeReferences, func(componentName, version string, err error) {
+		component := lsv1alpha1.PrefetchedComponent{
+			ComponentName: componentName,
+			Version:       version,
+			Prefetched:    err == nil,
+		}
+		status.Total++
+		if err != nil {
+			logger.Info("unable to prefetch component version", "componentName", componentName, "version", version, "error", err.Error())
+			component.Message = err.Error()
+			status.Failed++
+		} else {
+			status.Prefetched++
+		}
+		addComponentStatus(status, component)
+
+		if time.Since(lastUpdate) >= statusUpdateInterval {
+			lastUpdate = time.Now()
+			if err := c.updateStatus(ctx, prefetch, status); err != nil {
+				logger.Error(err, "updating status of component prefetch object failed")
+			}
+		}
+	})
+
+	registryAccesses := map[string]model.RegistryAccess{}
+	defer func() {
+		for name, registryAccess := range registryAccesses {
+			if err := model.CloseRegistryAccess(registryAccess); err != nil {
+				logger.Error(err, "unable to close registry access", "context", name)
+			}
+		}
+	}()
+	for _, item := range items {
+		if ctx.Err() != nil {
+			break
+		}
+		if item.err != nil {
+			prefetcher.Fail(item.componentName, item.version, item.err)
+			continue
+		}
+
+		contextObj := item.externalContext.Context
+		registryAccess, ok := registryAccesses[contextObj.Name]
+		if !ok {
+			registryAccess, err = c.newRegistryAccess(ctx, contextObj)
+			if err != nil {
+				err = fmt.Errorf("unable to create registry access for context %q: %w", contextObj.Name, err)
+				prefetcher.Fail(item.componentName, item.version, err)
+				continue
+			}
+			registryAccesses[contextObj.Name] = registryAccess
+		}
+
+		prefetcher.Prefetch(ctx, registryAccess, item.externalContext.ComponentDescriptorRef(), item.externalContext.Overwriter)
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	now := metav1.Now()
+	status.LastCompletionTime = &now
+	status.Phase = lsv1alpha1.ComponentPrefetchPhaseSucceeded
+	if status.Failed > 0 {
+		status.Phase = lsv1alpha1.ComponentPrefetchPhaseFailed
+	}
+	if err := c.updateStatus(ctx, prefetch, status); err != nil {
+		return err
+	}
+
+	logger.Info("prefetched component versions", "prefetched", status.Prefetched, "failed", status.Failed)
+	if status.Failed > 0 {
+		return fmt.Errorf("%d of %d component versions could not be prefetched", status.Failed, status.Total)
+	}
+	return nil
+}
+
+// prefetchItem is a component version that is prefetched.
+type prefetchItem struct {
+	componentName string
+	version       string
+	// externalContext contains the context and the effective component reference after the component overwrites have been applied.
+	externalContext installations.ExternalContext
+	// err is set if the context of the component version could not be resolved.
+	err error
+}
+
+// collectComponents returns all component versions that are prefetched.
+func (c *Controller) collectComponents(ctx context.Context, prefetch *lsv1alpha1.ComponentPrefetch) ([]prefetchItem, error) {
+	contextName := prefetch.Spec.Context
+	if len(contextName) == 0 {
+		contextName = lsv1alpha1.DefaultContextName
+	}
+
+	result := []prefetchItem{}
+	for _, ref := range prefetch.Spec.Components {
+		// the explicitly listed components are resolved in the same way as the component of an installation
+		inst := &lsv1alpha1.Installation{}
+		inst.Namespace = prefetch.Namespace
+		inst.Spec.Context = contextName
+		inst.Spec.ComponentDescriptor = &lsv1alpha1.ComponentDescriptorDefinition{
+			Reference: ref.DeepCopy(),
+		}
+		result = append(result, c.resolveComponent(ctx, inst))
+	}
+
+	if !prefetch.Spec.Installations {
+		return result, nil
+	}
+
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, c.lsUncachedClient, instList, read_write_layer.R000119,
+		client.InNamespace(prefetch.Namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list installations: %w", err)
+	}
+	for i := range instList.Items {
+		inst := &instList.Items[i]
+		if inst.Spec.ComponentDescriptor == nil || inst.Spec.ComponentDescriptor.Reference == nil {
+			continue
+		}
+		result = append(result, c.resolveComponent(ctx, inst.DeepCopy()))
+	}
+	return result, nil
+}
+
+func (c *Controller) resolveComponent(ctx context.Context, inst *lsv1alpha1.Installation) prefetchItem {
+	ref := inst.Spec.ComponentDescriptor.Reference
+	result := prefetchItem{
+		componentName: ref.ComponentName,
+		version:       ref.Version,
+	}
+	result.externalContext, result.err = installations.GetExternalContext(ctx, c.lsUncachedClient, inst)
+	if result.err != nil {
+		result.err = fmt.Errorf("unable to resolve context: %w", result.err)
+	}
+	return result
+}
+
+// addComponentStatus adds the state of a component version to the status.
+// At most maxReportedComponents component versions are listed, a failed component version replaces a prefetched one
+// if the list is full.
+func addComponentStatus(status *lsv1alpha1.ComponentPrefetchStatus, component lsv1alpha1.PrefetchedComponent) {
+	if len(status.Components) < maxReportedComponents {
+		status.Components = append(status.Components, component)
+		return
+	}
+	if component.Prefetched {
+		return
+	}
+	for i := range status.Components {
+		if status.Components[i].Prefetched {
+			status.Components = append(status.Components[:i], status.Components[i+1:]...)
+			status.Components = append(status.Components, component)
+			return
+		}
+	}
+}
+
+func (c *Controller) updateStatus(ctx context.Context, prefetch *lsv1alpha1.ComponentPrefetch, status *lsv1alpha1.ComponentPrefetchStatus) error {
+	now := metav1.Now()
+	status.LastUpdateTime = &now
+
+	patch := client.MergeFrom(prefetch.DeepCopy())
+	prefetch.Status = *status.DeepCopy()
+	if err := c.lsUncachedClient.Status().Patch(ctx, prefetch, patch); err != nil {
+		return fmt.Errorf("unable to update status of component prefetch object: %w", err)
+	}
+	return nil
+}
+
+func (c *Controller) isPrefetched(prefetch *lsv1alpha1.ComponentPrefetch) bool {
+	c.prefetchedMutex.Lock()
+	defer c.prefetchedMutex.Unlock()
+	prefetched, ok := c.prefetched[client.ObjectKeyFromObject(prefetch)]
+	return ok && prefetched.uid == prefetch.GetUID() && prefetched.generation == prefetch.GetGeneration()
+}
+
+func (c *Controller) setPrefetched(prefetch *lsv1alpha1.ComponentPrefetch) {
+	c.prefetchedMutex.Lock()
+	defer c.prefetchedMutex.Unlock()
+	c.prefetched[client.ObjectKeyFromObject(prefetch)] = prefetchedGeneration{
+		uid:        prefetch.GetUID(),
+		generation: prefetch.GetGeneration(),
+	}
+}
+
+func (c *Controller) forget(key k8stypes.NamespacedName) {
+	c.prefetchedMutex.Lock()
+	defer c.prefetchedMutex.Unlock()
+	delete(c.prefetched, key)
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentprefetch_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/componentprefetch"
+)
+
+var _ = Describe("ComponentPrefetch Controller", func() {
+
+	const namespace = "test"
+
+	var (
+		ctx                context.Context
+		kubeClient         client.Client
+		ctrl               *componentprefetch.Controller
+		createdAccesses    int
+		closedAccesses     int
+		localRepoCtx       *types.UnstructuredTypedObject
+		newComponentRef    func(name string) lsv1alpha1.ComponentDescriptorReference
+		getComponentStatus func(name string) *lsv1alpha1.ComponentPrefetch
+	)
+
+	reconcileObject := func(name string) {
+		_, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: name, Namespace: namespace}})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		createdAccesses = 0
+		closedAccesses = 0
+
+		localRepoCtx = &types.UnstructuredTypedObject{}
+		Expect(localRepoCtx.UnmarshalJSON([]byte(`{"type":"local"}`))).To(Succeed())
+
+		lsCtx := &lsv1alpha1.Context{}
+		lsCtx.Name = lsv1alpha1.DefaultContextName
+		lsCtx.Namespace = namespace
+		lsCtx.RepositoryContext = localRepoCtx
+
+		kubeClient = fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.ComponentPrefetch{}).
+			WithObjects(lsCtx).
+			Build()
+
+		ctrl = componentprefetch.NewController(kubeClient, logging.Discard(),
+			func(ctx context.Context, contextObj lsv1alpha1.Context) (model.RegistryAccess, error) {
+				createdAccesses++
+				registryAccess, err := registries.GetFactory(contextObj.UseOCM).NewRegistryAccess(ctx, nil, nil, nil,
+					&config.LocalRegistryConfiguration{RootPath: "./testdata/components"}, nil, nil)
+				if err != nil {
+					return nil, err
+				}
+				return &closeRecordingRegistryAccess{RegistryAccess: registryAccess, closed: &closedAccesses}, nil
+			})
+
+		newComponentRef = func(name string) lsv1alpha1.ComponentDescriptorReference {
+			return lsv1alpha1.ComponentDescriptorReference{ComponentName: name, Version: "1.0.0"}
+		}
+
+		getComponentStatus = func(name string) *lsv1alpha1.ComponentPrefetch {
+			prefetch := &lsv1alpha1.ComponentPrefetch{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, prefetch)).To(Succeed())
+			return prefetch
+		}
+	})
+
+	It("should prefetch the listed component versions", func() {
+		prefetch := &lsv1alpha1.ComponentPrefetch{}
+		prefetch.Name = "prefetch"
+		prefetch.Namespace = namespace
+		prefetch.Spec.Components = []lsv1alpha1.ComponentDescriptorReference{newComponentRef("example.com/child")}
+		Expect(kubeClient.Create(ctx, prefetch)).To(Succeed())
+
+		reconcileObject(prefetch.Name)
+
+		prefetch = getComponentStatus(prefetch.Name)
+		Expect(prefetch.Status.Phase).To(Equal(lsv1alpha1.ComponentPrefetchPhaseSucceeded))
+		Expect(prefetch.Status.Total).To(Equal(1))
+		Expect(prefetch.Status.Prefetched).To(Equal(1))
+		Expect(prefetch.Status.Failed).To(Equal(0))
+		Expect(prefetch.Status.LastCompletionTime).ToNot(BeNil())
+		Expect(prefetch.Status.Components).To(ConsistOf(lsv1alpha1.PrefetchedComponent{
+			ComponentName: "example.com/child",
+			Version:       "1.0.0",
+			Prefetched:    true,
+		}))
+	})
+
+	It("should prefetch referenced component versions and report failed ones", func() {
+		prefetch := &lsv1alpha1.ComponentPrefetch{}
+		prefetch.Name = "prefetch"
+		prefetch.Namespace = namespace
+		prefetch.Spec.Components = []lsv1alpha1.ComponentDescriptorReference{newComponentRef("example.com/root")}
+		prefetch.Spec.IncludeReferences = true
+		Expect(kubeClient.Create(ctx, prefetch)).To(Succeed())
+
+		reconcileObject(prefetch.Name)
+
+		prefetch = getComponentStatus(prefetch.Name)
+		Expect(prefetch.Status.Phase).To(Equal(lsv1alpha1.ComponentPrefetchPhaseFailed))
+		Expect(prefetch.Status.Total).To(Equal(3))
+		Expect(prefetch.Status.Prefetched).To(Equal(2))
+		Expect(prefetch.Status.Failed).To(Equal(1))
+		Expect(prefetch.Status.Components).To(HaveLen(3))
+		for _, component := range prefetch.Status.Components {
+			if component.ComponentName == "example.com/broken" {
+				Expect(component.Prefetched).To(BeFalse())
+				Expect(component.Message).To(ContainSubstring("blueprint"))
+			} else {
+				Expect(component.Prefetched).To(BeTrue())
+			}
+		}
+	})
+
+	It("should prefetch the component versions of the installations in the namespace", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "inst"
+		inst.Namespace = namespace
+		inst.Spec.Context = lsv1alpha1.DefaultContextName
+		ref := newComponentRef("example.com/child")
+		inst.Spec.ComponentDescriptor = &lsv1alpha1.ComponentDescriptorDefinition{Reference: &ref}
+		Expect(kubeClient.Create(ctx, inst)).To(Succeed())
+
+		prefetch := &lsv1alpha1.ComponentPrefetch{}
+		prefetch.Name = "prefetch"
+		prefetch.Namespace = namespace
+		prefetch.Spec.Installations = true
+		Expect(kubeClient.Create(ctx, prefetch)).To(Succeed())
+
+		reconcileObject(prefetch.Name)
+
+		prefetch = getComponentStatus(prefetch.Name)
+		Expect(prefetch.Status.Phase).To(Equal(lsv1alpha1.ComponentPrefetchPhaseSucceeded))
+		Expect(prefetch.Status.Components).To(ConsistOf(lsv1alpha1.PrefetchedComponent{
+			ComponentName: "example.com/child",
+			Version:       "1.0.0",
+			Prefetched:    true,
+		}))
+	})
+
+	It("should prefetch a generation only once unless a reconcile is requested", func() {
+		prefetch := &lsv1alpha1.ComponentPrefetch{}
+		prefetch.Name = "prefetch"
+		prefetch.Namespace = namespace
+		prefetch.Spec.Components = []lsv1alpha1.ComponentDescriptorReference{newComponentRef("example.com/child")}
+		Expect(kubeClient.Create(ctx, prefetch)).To(Succeed())
+
+		reconcileObject(prefetch.Name)
+		reconcileObject(prefetch.Name)
+		Expect(createdAccesses).To(Equal(1))
+
+		prefetch = getComponentStatus(prefetch.Name)
+		prefetch.Annotations = map[string]string{lsv1alpha1.OperationAnnotation: string(lsv1alpha1.ReconcileOperation)}
+		Expect(kubeClient.Update(ctx, prefetch)).To(Succeed())
+
+		reconcileObject(prefetch.Name)
+		Expect(createdAccesses).To(Equal(2))
+		Expect(closedAccesses).To(Equal(2))
+		Expect(getComponentStatus(prefetch.Name).Annotations).ToNot(HaveKey(lsv1alpha1.OperationAnnotation))
+	})
+
+	It("should report a missing context", func() {
+		prefetch := &lsv1alpha1.ComponentPrefetch{}
+		prefetch.Name = "prefetch"
+		prefetch.Namespace = namespace
+		prefetch.Spec.Context = "missing"
+		prefetch.Spec.Components = []lsv1alpha1.ComponentDescriptorReference{newComponentRef("example.com/child")}
+		Expect(kubeClient.Create(ctx, prefetch)).To(Succeed())
+
+		reconcileObject(prefetch.Name)
+
+		prefetch = getComponentStatus(prefetch.Name)
+		Expect(prefetch.Status.Phase).To(Equal(lsv1alpha1.ComponentPrefetchPhaseFailed))
+		Expect(prefetch.Status.Failed).To(Equal(1))
+		Expect(prefetch.Status.Components[0].Message).To(ContainSubstring("unable to resolve context"))
+	})
+
+})
+
+// closeRecordingRegistryAccess counts how often registry accesses are closed.
+type closeRecordingRegistryAccess struct {
+	model.RegistryAccess
+	closed *int
+}
+
+func (r *closeRecordingRegistryAccess) Close() error {
+	*r.closed++
+	return model.CloseRegistryAccess(r.RegistryAccess)
+}
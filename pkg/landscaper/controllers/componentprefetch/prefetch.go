@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentprefetch
+
+import (
+	"context"
+	"fmt"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/mediatype"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/componentoverwrites"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+)
+
+// prefetchedResourceTypes are the types of the resources whose content is loaded into the caches.
+var prefetchedResourceTypes = sets.New[string](
+	mediatype.BlueprintType,
+	mediatype.OldBlueprintType,
+	types.HelmChartResourceType,
+	types.OldHelmResourceType,
+)
+
+// ReportFunc is called for every prefetched component version.
+// The error is nil if the component version and its resources have been prefetched successfully.
+type ReportFunc func(componentName, version string, err error)
+
+// Prefetcher resolves component versions together with their blueprints and helm charts,
+// so that they are stored in the caches of the used registry access and in the blueprint store.
+// Every component version is prefetched at most once.
+type Prefetcher struct {
+	includeReferences bool
+	report            ReportFunc
+	visited           sets.Set[string]
+}
+
+// NewPrefetcher creates a new prefetcher.
+// If includeReferences is true, referenced component versions are prefetched transitively.
+func NewPrefetcher(includeReferences bool, report ReportFunc) *Prefetcher {
+	return &Prefetcher{
+		includeReferences: includeReferences,
+		report:            report,
+		visited:           sets.New[string](),
+	}
+}
+
+// Prefetch prefetches the referenced component version.
+func (p *Prefetcher) Prefetch(ctx context.Context, registryAccess model.RegistryAccess, cdRef *lsv1alpha1.ComponentDescriptorReference,
+	overwriter componentoverwrites.Overwriter) {
+
+	if !p.visit(cdRef.ComponentName, cdRef.Version) {
+		return
+	}
+
+	componentVersion, err := registryAccess.GetComponentVersion(ctx, cdRef)
+	if err != nil {
+		p.report(cdRef.ComponentName, cdRef.Version, fmt.Errorf("unable to get component version: %w", err))
+		return
+	}
+	p.prefetchComponentVersion(ctx, componentVersion, cdRef.RepositoryContext, overwriter)
+}
+
+func (p *Prefetcher) prefetchComponentVersion(ctx context.Context, componentVersion model.ComponentVersion,
+	repositoryContext *types.UnstructuredTypedObject, overwriter componentoverwrites.Overwriter) {
+
+	p.report(componentVersion.GetName(), componentVersion.GetVersion(), prefetchResources(ctx, componentVersion))
+
+	if !p.includeReferences {
+		return
+	}
+
+	for _, ref := range componentVersion.GetComponentReferences() {
+		if ctx.Err() != nil {
+			return
+		}
+		ref := ref
+		if !p.visit(ref.ComponentName, ref.Version) {
+			continue
+		}
+		referencedVersion, err := componentVersion.GetReferencedComponentVersion(ctx, &ref, repositoryContext, overwriter)
+		if err != nil {
+			p.report(ref.ComponentName, ref.Version, fmt.Errorf("unable to get referenced component version: %w", err))
+			continue
+		}
+		p.prefetchComponentVersion(ctx, referencedVersion, repositoryContext, overwriter)
+	}
+}
+
+// Fail reports a component version that could not be prefetched because of the given error.
+func (p *Prefetcher) Fail(componentName, version string, err error) {
+	if p.visit(componentName, version) {
+		p.report(componentName, version, err)
+	}
+}
+
+// visit marks a component version as visited and returns false if it has already been visited before.
+func (p *Prefetcher) visit(componentName, version string) bool {
+	key := componentName + ":" + version
+	if p.visited.Has(key) {
+		return false
+	}
+	p.visited.Insert(key)
+	return true
+}
+
+// prefetchResources loads the content of all blueprints and helm charts of a component version.
+func prefetchResources(ctx context.Context, componentVersion model.ComponentVersion) error {
+	var errs []error
+	names := sets.New[string]()
+	for _, res := range componentVersion.GetComponentDescriptor().Resources {
+		if !prefetchedResourceTypes.Has(res.GetType()) || names.Has(res.GetName()) {
+			continue
+		}
+		names.Insert(res.GetName())
+
+		resource, err := componentVersion.GetResource(res.GetName(), nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to get resource %q: %w", res.GetName(), err))
+			continue
+		}
+		if _, err := resource.GetTypedContent(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("unable to prefetch resource %q: %w", res.GetName(), err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentprefetch
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("component status", func() {
+
+	newComponent := func(i int, prefetched bool) lsv1alpha1.PrefetchedComponent {
+		return lsv1alpha1.PrefetchedComponent{
+			ComponentName: fmt.Sprintf("example.com/component-%d", i),
+			Version:       "1.0.0",
+			Prefetched:    prefetched,
+		}
+	}
+
+	It("should list at most the maximal number of component versions", func() {
+		status := &lsv1alpha1.ComponentPrefetchStatus{}
+		for i := 0; i < 2*maxReportedComponents; i++ {
+			addComponentStatus(status, newComponent(i, true))
+		}
+		Expect(status.Components).To(HaveLen(maxReportedComponents))
+	})
+
+	It("should replace prefetched component versions by failed ones if the list is full", func() {
+		status := &lsv1alpha1.ComponentPrefetchStatus{}
+		for i := 0; i < maxReportedComponents-1; i++ {
+			addComponentStatus(status, newComponent(i, true))
+		}
+		addComponentStatus(status, newComponent(maxReportedComponents, false))
+		failed := newComponent(maxReportedComponents+1, false)
+		addComponentStatus(status, failed)
+
+		Expect(status.Components).To(HaveLen(maxReportedComponents))
+		Expect(status.Components).To(ContainElement(failed))
+		Expect(status.Components).ToNot(ContainElement(newComponent(0, true)))
+	})
+
+	It("should keep the first failed component versions if all listed component versions failed", func() {
+		status := &lsv1alpha1.ComponentPrefetchStatus{}
+		for i := 0; i < maxReportedComponents; i++ {
+			addComponentStatus(status, newComponent(i, false))
+		}
+		addComponentStatus(status, newComponent(maxReportedComponents, false))
+
+		Expect(status.Components).To(HaveLen(maxReportedComponents))
+		Expect(status.Components).ToNot(ContainElement(newComponent(maxReportedComponents, false)))
+	})
+
+})
@@ -20,12 +20,19 @@ import (
 	"github.com/gardener/landscaper/apis/config"
 	"github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/landscaper/componentindex"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/componentoverwrites"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/componentprefetch"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/versionupgrade"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/utils"
 )
 
 // AddControllerToManager register the installation Controller in a manager.
+// The controllers that must only run in one replica are added to the singleton manager, which uses leader election.
 func AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
-	logger logging.Logger, lsMgr manager.Manager, config *config.LandscaperConfiguration, callerName string) error {
+	logger logging.Logger, lsMgr, singletonMgr manager.Manager, config *config.LandscaperConfiguration, callerName string) error {
 
 	log := logger.Reconciles("installation", "Installation")
 	ctx := logging.NewContext(context.Background(), log)
@@ -50,6 +57,46 @@ func AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient
 		return err
 	}
 
+	// the component prefetch controller fills the caches of the installation controller
+	if err := componentprefetch.AddControllerToManager(lsUncachedClient, logger, singletonMgr,
+		func(ctx context.Context, contextObj v1alpha1.Context) (model.RegistryAccess, error) {
+			externalContext := installations.ExternalContext{Context: contextObj}
+			return a.NewRegistryAccess(ctx, contextObj, externalContext.RegistryPullSecrets(), nil)
+		}); err != nil {
+		return fmt.Errorf("unable to setup component prefetch controller: %w", err)
+	}
+
+	// the component version overwrites controller resolves the transitively referenced components with the same registry accesses
+	if err := componentoverwrites.AddControllerToManager(lsUncachedClient, logger, singletonMgr,
+		func(ctx context.Context, contextObj v1alpha1.Context) (model.RegistryAccess, error) {
+			externalContext := installations.ExternalContext{Context: contextObj}
+			return a.NewRegistryAccess(ctx, contextObj, externalContext.RegistryPullSecrets(), nil)
+		}); err != nil {
+		return fmt.Errorf("unable to setup component version overwrites controller: %w", err)
+	}
+
+	// the version upgrade controller lists the available component versions with the same registry accesses
+	if err := versionupgrade.AddControllerToManager(lsUncachedClient, logger, singletonMgr,
+		func(ctx context.Context, contextObj v1alpha1.Context) (model.RegistryAccess, error) {
+			externalContext := installations.ExternalContext{Context: contextObj}
+			return a.NewRegistryAccess(ctx, contextObj, externalContext.RegistryPullSecrets(), nil)
+		}); err != nil {
+		return fmt.Errorf("unable to setup version upgrade controller: %w", err)
+	}
+
+	// the component index resolves the components of all installations with the same registry accesses
+	if config.ComponentIndex != nil {
+		indexBuilder := componentindex.NewBuilder(lsUncachedClient,
+			func(ctx context.Context, contextObj v1alpha1.Context) (model.RegistryAccess, error) {
+				externalContext := installations.ExternalContext{Context: contextObj}
+				return a.NewRegistryAccess(ctx, contextObj, externalContext.RegistryPullSecrets(), nil)
+			})
+		if err := singletonMgr.Add(componentindex.NewServer(logger.WithName("componentIndex"), indexBuilder,
+			lsUncachedClient, config.ComponentIndex)); err != nil {
+			return fmt.Errorf("unable to setup component index: %w", err)
+		}
+	}
+
 	return builder.ControllerManagedBy(lsMgr).
 		For(&v1alpha1.Installation{}, builder.OnlyMetadata).
 		Owns(&v1alpha1.Execution{}, builder.OnlyMetadata).
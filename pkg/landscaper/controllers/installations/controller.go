@@ -29,11 +29,16 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/components/cache/shared"
+	"github.com/gardener/landscaper/pkg/components/cnudie/helmrepo"
 	cnudieutils "github.com/gardener/landscaper/pkg/components/cnudie/utils"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
 	"github.com/gardener/landscaper/pkg/components/registries"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
 	"github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/lock"
@@ -53,7 +58,7 @@ func NewController(ctx context.Context,
 	lsConfig *config.LandscaperConfiguration,
 	maxNumberOfWorkers int,
 	lockingEnabled bool,
-	callerName string) (reconcile.Reconciler, error) {
+	callerName string) (*Controller, error) {
 
 	ws := utils.NewWorkerCounter(maxNumberOfWorkers)
 
@@ -76,11 +81,40 @@ func NewController(ctx context.Context,
 		if err != nil {
 			return nil, err
 		}
+		ctrl.SharedCache, err = shared.NewCacheFromConfiguration(ctx, logger, ctrl.SharedCache, lsConfig.Registry.OCI)
+		if err != nil {
+			return nil, fmt.Errorf("unable to setup shared oci cache: %w", err)
+		}
 		logger.Debug("setup shared components registry  cache")
 	}
 
 	registries.SetOCMLibraryMode(lsConfig.UseOCMLib)
 
+	indexCache, err := helmrepo.NewIndexCacheFromConfiguration(lsConfig.Registry.OCI)
+	if err != nil {
+		return nil, fmt.Errorf("unable to setup helm chart repository index cache: %w", err)
+	}
+	helmrepo.SetIndexCache(indexCache)
+
+	operatorTokenSources, err := registrycredentials.OperatorTokenSourcesFromConfiguration(lsConfig.Registry.OCI)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load registry token sources: %w", err)
+	}
+	registrycredentials.SetOperatorTokenSources(operatorTokenSources)
+
+	templateLimits, err := template.LimitsFromConfiguration(lsConfig.TemplateLimits)
+	if err != nil {
+		return nil, err
+	}
+	template.SetLimits(templateLimits)
+	template.SetAllowedTargetResources(template.AllowedTargetResourcesFromConfiguration(lsConfig.TargetLookup))
+
+	providerConfigurationSchemas, err := jsonschema.ProviderConfigurationSchemasFromConfiguration(lsConfig.DeployItemValidation)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load deploy item provider configuration schemas: %w", err)
+	}
+	jsonschema.SetProviderConfigurationSchemas(providerConfigurationSchemas)
+
 	op := operation.NewOperation(scheme, eventRecorder, lsUncachedClient)
 	ctrl.Operation = *op
 
@@ -19,6 +19,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
 	installationsctl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
 	testutils "github.com/gardener/landscaper/test/utils"
 	"github.com/gardener/landscaper/test/utils/envtest"
@@ -146,6 +147,35 @@ var _ = Describe("Installation Controller", func() {
 			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(subinst), subinst))
 			Expect(subinst.ObjectMeta.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.InterruptOperation)))
 		})
+
+		It("should report a configuration problem if a deploy execution exceeds a template limit", func() {
+			ctx := context.Background()
+
+			limits := template.GetLimits()
+			defer template.SetLimits(limits)
+			template.SetLimits(template.Limits{MaxOutputSize: 10})
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test11")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+
+			// the installation gets a new job id
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.JobID).ToNot(Equal(inst.Status.JobIDFinished))
+
+			// the rendered deploy items exceed the maximal output size
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.InstallationPhase).To(Equal(lsv1alpha1.InstallationPhases.Failed))
+			Expect(inst.Status.LastError).ToNot(BeNil())
+			Expect(inst.Status.LastError.Codes).To(ContainElement(lsv1alpha1.ErrorConfigurationProblem))
+		})
 	})
 
 })
@@ -19,6 +19,7 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/exports"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/reconcilehelper"
@@ -245,7 +246,7 @@ func (c *Controller) handlePhaseInit(ctx context.Context, inst *lsv1alpha1.Insta
 	}
 
 	if err := c.CreateImportsAndSubobjects(ctx, instOp, imps, subInstCache); err != nil {
-		return lserrors.NewWrappedError(err, currentOperation, "CreateImportsAndSubobjects", err.Error()), nil
+		return lserrors.NewWrappedError(err, currentOperation, "CreateImportsAndSubobjects", err.Error(), template.ErrorCodes(err)...), nil
 	}
 
 	// we need to recheck the predecessors because they might have been changed during fetching the import data and therefore
@@ -502,12 +503,12 @@ func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1
 	}
 	err = con.RenderImportExecutions()
 	if err != nil {
-		return lserrors.NewWrappedError(err, currentOperation, "RenderImportExecutionsForExports", err.Error()), nil
+		return lserrors.NewWrappedError(err, currentOperation, "RenderImportExecutionsForExports", err.Error(), template.ErrorCodes(err)...), nil
 	}
 
 	dataExports, targetExports, err := exports.NewConstructor(instOp).Construct(ctx)
 	if err != nil {
-		return lserrors.NewWrappedError(err, currentOperation, "ConstructExports", err.Error()), nil
+		return lserrors.NewWrappedError(err, currentOperation, "ConstructExports", err.Error(), template.ErrorCodes(err)...), nil
 	}
 
 	if err := instOp.CreateOrUpdateExports(ctx, dataExports, targetExports); err != nil {
@@ -545,7 +546,7 @@ func (c *Controller) CreateImportsAndSubobjects(ctx context.Context, op *install
 
 	exec := executions.New(op)
 	if err := exec.Ensure(ctx, inst); err != nil {
-		return lserrors.NewWrappedError(err, currOp, "ReconcileExecution", err.Error())
+		return lserrors.NewWrappedError(err, currOp, "ReconcileExecution", err.Error(), template.ErrorCodes(err)...)
 	}
 
 	return nil
@@ -144,7 +144,7 @@ var _ = Describe("Delete", func() {
 			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
 
 			Expect(installationsctl.AddControllerToManager(mgr.GetClient(), mgr.GetClient(), mgr.GetClient(), mgr.GetClient(),
-				logging.Wrap(simplelogger.NewIOLogger(GinkgoWriter)), mgr,
+				logging.Wrap(simplelogger.NewIOLogger(GinkgoWriter)), mgr, mgr,
 				&config.LandscaperConfiguration{}, "inst-"+testutils.GetNextCounter())).To(Succeed())
 			go func() {
 				Expect(mgr.Start(ctx)).To(Succeed())
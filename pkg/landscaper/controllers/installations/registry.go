@@ -8,11 +8,17 @@ import (
 	"context"
 
 	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
 
 	corev1 "k8s.io/api/core/v1"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/registrycredentials"
 	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/model/verification"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
 )
 
@@ -20,18 +26,12 @@ import (
 func (c *Controller) SetupRegistries(ctx context.Context, op *operation.Operation, contextObj lsv1alpha1.Context, pullSecrets []lsv1alpha1.ObjectReference,
 	installation *lsv1alpha1.Installation) error {
 
-	// resolve all pull secrets
-	secrets, err := c.resolveSecrets(ctx, pullSecrets)
-	if err != nil {
-		return err
-	}
-
 	var inlineCd *types.ComponentDescriptor = nil
 	if installation.Spec.ComponentDescriptor != nil {
 		inlineCd = installation.Spec.ComponentDescriptor.Inline
 	}
 
-	registry, err := registries.GetFactory(contextObj.UseOCM).NewRegistryAccess(ctx, nil, secrets, c.SharedCache, c.LsConfig.Registry.Local, c.LsConfig.Registry.OCI, inlineCd)
+	registry, err := c.NewRegistryAccess(ctx, contextObj, pullSecrets, inlineCd)
 	if err != nil {
 		return err
 	}
@@ -39,6 +39,53 @@ func (c *Controller) SetupRegistries(ctx context.Context, op *operation.Operatio
 	return nil
 }
 
+// NewRegistryAccess creates a registry access for the given context that uses the shared cache of the controller.
+func (c *Controller) NewRegistryAccess(ctx context.Context, contextObj lsv1alpha1.Context, pullSecrets []lsv1alpha1.ObjectReference,
+	inlineCd *types.ComponentDescriptor) (model.RegistryAccess, error) {
+
+	// resolve all pull secrets
+	secrets, err := c.resolveSecrets(ctx, pullSecrets)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := registries.GetFactory(contextObj.UseOCM).NewRegistryAccess(ctx, nil, secrets, c.SharedCache, c.LsConfig.Registry.Local, c.LsConfig.Registry.OCI, inlineCd)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.LsConfig.Registry.Filesystem != nil {
+		rootFs, err := projectionfs.New(osfs.New(), c.LsConfig.Registry.Filesystem.RootPath)
+		if err != nil {
+			return nil, lserrors.NewWrappedError(err, "SetupRegistries", "FilesystemRepositoryRoot", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+		if err := model.SetFilesystemRepositoryRoot(registry, rootFs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(contextObj.RegistryCredentials) > 0 {
+		registryCredentials, err := registrycredentials.NewCredentials(ctx, c.LsUncachedClient(), contextObj.Namespace, contextObj.RegistryCredentials)
+		if err != nil {
+			return nil, lserrors.NewWrappedError(err, "SetupRegistries", "ReadRegistryCredentials", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+		if err := model.SetRegistryCredentials(registry, registryCredentials); err != nil {
+			return nil, err
+		}
+	}
+
+	if contextObj.Verification != nil {
+		policies, err := verification.NewPolicies(ctx, c.LsUncachedClient(), contextObj.Namespace, contextObj.Verification)
+		if err != nil {
+			return nil, lserrors.NewWrappedError(err, "SetupRegistries", "ReadVerificationPolicies", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+		if err := model.SetVerificationPolicies(registry, policies); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
 func (c *Controller) resolveSecrets(ctx context.Context, secretRefs []lsv1alpha1.ObjectReference) ([]corev1.Secret, error) {
 	secrets := make([]corev1.Secret, len(secretRefs))
 	for i, secretRef := range secretRefs {
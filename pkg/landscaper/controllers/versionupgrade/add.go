@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package versionupgrade
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// AddControllerToManager adds the version upgrade controller to the manager.
+// The available component versions are listed with the registry accesses of the given factory.
+// The controller requires leader election, so that only one replica checks the versions and upgrades the installations.
+func AddControllerToManager(lsUncachedClient client.Client, logger logging.Logger, lsMgr manager.Manager, newRegistryAccess RegistryAccessFactory) error {
+	log := logger.Reconciles("versionUpgrade", "Installation")
+	ctrl := NewController(lsUncachedClient, log, newRegistryAccess, clock.RealClock{})
+
+	predicates := builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))
+
+	return builder.ControllerManagedBy(lsMgr).
+		Named("versionupgrade").
+		For(&lsv1alpha1.Installation{}, predicates, builder.OnlyMetadata).
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(true)}).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package versionupgrade
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// retryInterval is the interval after which a failed evaluation of a version policy is retried.
+const retryInterval = 10 * time.Minute
+
+// RegistryAccessFactory creates a registry access for the given context.
+type RegistryAccessFactory func(ctx context.Context, contextObj lsv1alpha1.Context) (model.RegistryAccess, error)
+
+// Controller is the controller that upgrades the component versions of installations according to their version policies.
+type Controller struct {
+	lsUncachedClient  client.Client
+	log               logging.Logger
+	newRegistryAccess RegistryAccessFactory
+	clock             clock.PassiveClock
+}
+
+// NewController creates a new version upgrade controller.
+// The available versions are listed with the registry accesses created by the given factory.
+func NewController(lsUncachedClient client.Client, logger logging.Logger, newRegistryAccess RegistryAccessFactory, passiveClock clock.PassiveClock) *Controller {
+	return &Controller{
+		lsUncachedClient:  lsUncachedClient,
+		log:               logger,
+		newRegistryAccess: newRegistryAccess,
+		clock:             passiveClock,
+	}
+}
+
+// Reconcile reconciles requests for installations with version policies.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+	_, ctx = c.log.StartReconcileAndAddToContext(ctx, req)
+
+	result = reconcile.Result{}
+	defer utils.HandlePanics(ctx, &result)
+
+	result, err = c.reconcile(ctx, req)
+
+	return result, err
+}
+
+func (c *Controller) reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	inst := &lsv1alpha1.Installation{}
+	if err := read_write_layer.GetInstallation(ctx, c.lsUncachedClient, req.NamespacedName, inst, read_write_layer.R000121); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	// the component versions of sub installations are defined by the blueprints of their parents
+	if !inst.DeletionTimestamp.IsZero() || inst.Spec.VersionPolicy == nil || !installations.IsRootInstallation(inst) ||
+		inst.Spec.ComponentDescriptor == nil || inst.Spec.ComponentDescriptor.Reference == nil {
+		return reconcile.Result{}, nil
+	}
+
+	status := &lsv1alpha1.VersionUpgradeStatus{}
+	if inst.Status.VersionUpgradeStatus != nil {
+		status = inst.Status.VersionUpgradeStatus.DeepCopy()
+	}
+
+	result, err := c.evaluatePolicy(ctx, inst, status)
+	if err != nil {
+		logger.Error(err, "evaluating version policy failed")
+		status.LastError = err.Error()
+		result = reconcile.Result{RequeueAfter: retryInterval}
+	}
+	if updateErr := c.updateStatus(ctx, inst, status); updateErr != nil {
+		return reconcile.Result{}, updateErr
+	}
+	return result, nil
+}
+
+// evaluatePolicy handles the rollback and approval annotations, checks the available versions if the schedule is due,
+// and applies or proposes the newest allowed version.
+func (c *Controller) evaluatePolicy(ctx context.Context, inst *lsv1alpha1.Installation, status *lsv1alpha1.VersionUpgradeStatus) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	policy := inst.Spec.VersionPolicy
+	now := c.clock.Now()
+
+	if inst.Annotations[lsv1alpha1.RollbackVersionUpgradeAnnotation] == "true" {
+		if err := c.rollback(ctx, inst, status); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if approved := inst.Annotations[lsv1alpha1.ApproveVersionUpgradeAnnotation]; len(approved) != 0 && approved == status.ProposedVersion {
+		logger.Info("applying approved version", "version", approved)
+		if err := c.upgrade(ctx, inst, status, approved); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	nextCheck := now
+	if status.LastCheckTime != nil {
+		var err error
+		nextCheck, err = NextCheckTime(policy, status.LastCheckTime.Time)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	if !nextCheck.After(now) {
+		if err := c.checkVersions(ctx, inst, status); err != nil {
+			return reconcile.Result{}, err
+		}
+		checkTime := metav1.NewTime(now)
+		status.LastCheckTime = &checkTime
+		status.LastError = ""
+
+		var err error
+		nextCheck, err = NextCheckTime(policy, now)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	requeueAfter := nextCheck.Sub(now)
+
+	if len(status.ProposedVersion) != 0 && policy.Mode != lsv1alpha1.VersionPolicyModePlan {
+		inWindow, nextBegin, err := InMaintenanceWindow(policy, now)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if inWindow {
+			logger.Info("applying version", "version", status.ProposedVersion)
+			if err := c.upgrade(ctx, inst, status, status.ProposedVersion); err != nil {
+				return reconcile.Result{}, err
+			}
+		} else if untilWindow := nextBegin.Sub(now); untilWindow < requeueAfter {
+			requeueAfter = untilWindow
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// checkVersions lists the available versions of the component of the installation and proposes the newest allowed version.
+func (c *Controller) checkVersions(ctx context.Context, inst *lsv1alpha1.Installation, status *lsv1alpha1.VersionUpgradeStatus) error {
+	externalContext, err := installations.GetExternalContext(ctx, c.lsUncachedClient, inst.DeepCopy())
+	if err != nil {
+		return fmt.Errorf("unable to resolve context: %w", err)
+	}
+	cdRef := externalContext.ComponentDescriptorRef()
+	if cdRef == nil {
+		return fmt.Errorf("installation has no component descriptor reference")
+	}
+
+	registryAccess, err := c.newRegistryAccess(ctx, externalContext.Context)
+	if err != nil {
+		return fmt.Errorf("unable to create registry access for context %q: %w", externalContext.Context.Name, err)
+	}
+	defer func() {
+		if err := model.CloseRegistryAccess(registryAccess); err != nil {
+			logger, _ := logging.FromContextOrNew(ctx, nil)
+			logger.Error(err, "unable to close registry access")
+		}
+	}()
+	versions, err := model.ListComponentVersions(ctx, registryAccess, cdRef.RepositoryContext, cdRef.ComponentName)
+	if err != nil {
+		return err
+	}
+
+	current := inst.Spec.ComponentDescriptor.Reference.Version
+	selected, err := SelectVersion(inst.Spec.VersionPolicy.Constraint, current, versions, status.RejectedVersion)
+	if err != nil {
+		return err
+	}
+	status.ProposedVersion = selected
+	return nil
+}
+
+// upgrade sets the component version of the installation and triggers its reconciliation.
+func (c *Controller) upgrade(ctx context.Context, inst *lsv1alpha1.Installation, status *lsv1alpha1.VersionUpgradeStatus, version string) error {
+	previous := inst.Spec.ComponentDescriptor.Reference.Version
+	inst.Spec.ComponentDescriptor.Reference.Version = version
+	delete(inst.Annotations, lsv1alpha1.ApproveVersionUpgradeAnnotation)
+	lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	if err := read_write_layer.NewWriter(c.lsUncachedClient).UpdateInstallation(ctx, read_write_layer.W000156, inst); err != nil {
+		return fmt.Errorf("unable to upgrade installation to version %s: %w", version, err)
+	}
+
+	now := metav1.NewTime(c.clock.Now())
+	status.PreviousVersion = previous
+	status.LastUpgradeTime = &now
+	status.ProposedVersion = ""
+	return nil
+}
+
+// rollback resets the component version of the installation to the version before the last upgrade.
+// The current version is rejected, so that it is not selected again.
+func (c *Controller) rollback(ctx context.Context, inst *lsv1alpha1.Installation, status *lsv1alpha1.VersionUpgradeStatus) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	current := inst.Spec.ComponentDescriptor.Reference.Version
+	delete(inst.Annotations, lsv1alpha1.RollbackVersionUpgradeAnnotation)
+	if len(status.PreviousVersion) == 0 {
+		if err := read_write_layer.NewWriter(c.lsUncachedClient).UpdateInstallation(ctx, read_write_layer.W000156, inst); err != nil {
+			return fmt.Errorf("unable to remove rollback annotation: %w", err)
+		}
+		return fmt.Errorf("unable to roll back version %s: there is no previous version", current)
+	}
+
+	logger.Info("rolling back version", "version", current, "previousVersion", status.PreviousVersion)
+	inst.Spec.ComponentDescriptor.Reference.Version = status.PreviousVersion
+	lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	if err := read_write_layer.NewWriter(c.lsUncachedClient).UpdateInstallation(ctx, read_write_layer.W000156, inst); err != nil {
+		return fmt.Errorf("unable to roll back installation: %w", err)
+	}
+
+	status.RejectedVersion = current
+	status.PreviousVersion = ""
+	if status.ProposedVersion == current {
+		status.ProposedVersion = ""
+	}
+	return nil
+}
+
+// updateStatus updates the version upgrade status of the installation if it has changed.
+func (c *Controller) updateStatus(ctx context.Context, inst *lsv1alpha1.Installation, status *lsv1alpha1.VersionUpgradeStatus) error {
+	if inst.Status.VersionUpgradeStatus != nil && apiequality.Semantic.DeepEqual(*inst.Status.VersionUpgradeStatus, *status) {
+		return nil
+	}
+	inst.Status.VersionUpgradeStatus = status
+	if err := read_write_layer.NewWriter(c.lsUncachedClient).UpdateInstallationStatus(ctx, read_write_layer.W000157, inst); err != nil {
+		return fmt.Errorf("unable to update version upgrade status of installation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package versionupgrade_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/components/cnudie/componentresolvers"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/versionupgrade"
+)
+
+// versionListingRegistryAccess is a registry access that only lists a fixed set of component versions.
+type versionListingRegistryAccess struct {
+	versions []string
+	err      error
+}
+
+func (r *versionListingRegistryAccess) GetComponentVersion(_ context.Context, _ *lsv1alpha1.ComponentDescriptorReference) (model.ComponentVersion, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *versionListingRegistryAccess) ListComponentVersions(_ context.Context, _ *types.UnstructuredTypedObject, _ string) ([]string, error) {
+	return r.versions, r.err
+}
+
+var _ = Describe("Version Upgrade Controller", func() {
+
+	const (
+		namespace = "test"
+		name      = "inst"
+	)
+
+	var (
+		ctx            context.Context
+		kubeClient     client.Client
+		fakeClock      *testingclock.FakePassiveClock
+		registryAccess *versionListingRegistryAccess
+		ctrl           *versionupgrade.Controller
+	)
+
+	reconcileInstallation := func() reconcile.Result {
+		result, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: name, Namespace: namespace}})
+		Expect(err).ToNot(HaveOccurred())
+		return result
+	}
+
+	getInstallation := func() *lsv1alpha1.Installation {
+		inst := &lsv1alpha1.Installation{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, inst)).To(Succeed())
+		return inst
+	}
+
+	createInstallation := func(policy *lsv1alpha1.VersionPolicy) {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = name
+		inst.Namespace = namespace
+		inst.Spec.Context = lsv1alpha1.DefaultContextName
+		inst.Spec.ComponentDescriptor = &lsv1alpha1.ComponentDescriptorDefinition{
+			Reference: &lsv1alpha1.ComponentDescriptorReference{ComponentName: "example.com/component", Version: "1.4.0"},
+		}
+		inst.Spec.Blueprint.Reference = &lsv1alpha1.RemoteBlueprintReference{ResourceName: "blueprint"}
+		inst.Spec.VersionPolicy = policy
+		Expect(kubeClient.Create(ctx, inst)).To(Succeed())
+	}
+
+	setAnnotation := func(key, value string) {
+		inst := getInstallation()
+		metav1.SetMetaDataAnnotation(&inst.ObjectMeta, key, value)
+		Expect(kubeClient.Update(ctx, inst)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClock = testingclock.NewFakePassiveClock(time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC))
+		registryAccess = &versionListingRegistryAccess{versions: []string{"1.3.0", "1.4.0", "1.4.1", "1.4.3", "1.5.0"}}
+
+		repositoryContext, err := componentresolvers.NewOCIRepositoryContext("example.com/components")
+		Expect(err).ToNot(HaveOccurred())
+		lsCtx := &lsv1alpha1.Context{}
+		lsCtx.Name = lsv1alpha1.DefaultContextName
+		lsCtx.Namespace = namespace
+		lsCtx.RepositoryContext = &repositoryContext
+
+		kubeClient = fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.Installation{}).
+			WithObjects(lsCtx).
+			Build()
+
+		ctrl = versionupgrade.NewController(kubeClient, logging.Discard(),
+			func(ctx context.Context, contextObj lsv1alpha1.Context) (model.RegistryAccess, error) {
+				return registryAccess, nil
+			}, fakeClock)
+	})
+
+	It("should upgrade the installation to the newest allowed version", func() {
+		createInstallation(&lsv1alpha1.VersionPolicy{Constraint: "~1.4"})
+
+		result := reconcileInstallation()
+		Expect(result.RequeueAfter).To(Equal(30 * time.Minute))
+
+		inst := getInstallation()
+		Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal("1.4.3"))
+		Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeTrue())
+		Expect(inst.Status.VersionUpgradeStatus).ToNot(BeNil())
+		Expect(inst.Status.VersionUpgradeStatus.PreviousVersion).To(Equal("1.4.0"))
+		Expect(inst.Status.VersionUpgradeStatus.ProposedVersion).To(BeEmpty())
+		Expect(inst.Status.VersionUpgradeStatus.LastCheckTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		Expect(inst.Status.VersionUpgradeStatus.LastUpgradeTime).ToNot(BeNil())
+	})
+
+	It("should not check the versions before the schedule is due", func() {
+		createInstallation(&lsv1alpha1.VersionPolicy{Constraint: "latest-patch"})
+		reconcileInstallation()
+		Expect(getInstallation().Spec.ComponentDescriptor.Reference.Version).To(Equal("1.4.3"))
+
+		registryAccess.versions = append(registryAccess.versions, "1.4.4")
+		fakeClock.SetTime(fakeClock.Now().Add(10 * time.Minute))
+		result := reconcileInstallation()
+		Expect(result.RequeueAfter).To(Equal(20 * time.Minute))
+		Expect(getInstallation().Spec.ComponentDescriptor.Reference.Version).To(Equal("1.4.3"))
+
+		fakeClock.SetTime(fakeClock.Now().Add(20 * time.Minute))
+		reconcileInstallation()
+		Expect(getInstallation().Spec.ComponentDescriptor.Reference.Version).To(Equal("1.4.4"))
+	})
+
+	It("should only propose versions in plan mode until they are approved", func() {
+		createInstallation(&lsv1alpha1.VersionPolicy{Constraint: "latest-minor", Mode: lsv1alpha1.VersionPolicyModePlan})
+
+		reconcileInstallation()
+		inst := getInstallation()
+		Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal("1.4.0"))
+		Expect(inst.Status.VersionUpgradeStatus.ProposedVersion).To(Equal("1.5.0"))
+
+		setAnnotation(lsv1alpha1.ApproveVersionUpgradeAnnotation, "1.5.0")
+		reconcileInstallation()
+		inst = getInstallation()
+		Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal("1.5.0"))
+		Expect(inst.Annotations).ToNot(HaveKey(lsv1alpha1.ApproveVersionUpgradeAnnotation))
+		Expect(inst.Status.VersionUpgradeStatus.ProposedVersion).To(BeEmpty())
+		Expect(inst.Status.VersionUpgradeStatus.PreviousVersion).To(Equal("1.4.0"))
+	})
+
+	It("should apply versions only inside the maintenance window", func() {
+		createInstallation(&lsv1alpha1.VersionPolicy{
+			Constraint:        "~1.4",
+			Schedule:          "0 0 * * *",
+			MaintenanceWindow: &lsv1alpha1.MaintenanceWindow{Begin: "22:00", End: "04:00"},
+		})
+
+		result := reconcileInstallation()
+		Expect(result.RequeueAfter).To(Equal(11*time.Hour + 30*time.Minute))
+		inst := getInstallation()
+		Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal("1.4.0"))
+		Expect(inst.Status.VersionUpgradeStatus.ProposedVersion).To(Equal("1.4.3"))
+
+		fakeClock.SetTime(fakeClock.Now().Add(11*time.Hour + 30*time.Minute))
+		reconcileInstallation()
+		inst = getInstallation()
+		Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal("1.4.3"))
+		Expect(inst.Status.VersionUpgradeStatus.ProposedVersion).To(BeEmpty())
+	})
+
+	It("should roll back an upgrade and not select the rolled back version again", func() {
+		createInstallation(&lsv1alpha1.VersionPolicy{Constraint: "~1.4"})
+		reconcileInstallation()
+		Expect(getInstallation().Spec.ComponentDescriptor.Reference.Version).To(Equal("1.4.3"))
+
+		setAnnotation(lsv1alpha1.RollbackVersionUpgradeAnnotation, "true")
+		reconcileInstallation()
+		inst := getInstallation()
+		Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal("1.4.0"))
+		Expect(inst.Annotations).ToNot(HaveKey(lsv1alpha1.RollbackVersionUpgradeAnnotation))
+		Expect(inst.Status.VersionUpgradeStatus.RejectedVersion).To(Equal("1.4.3"))
+		Expect(inst.Status.VersionUpgradeStatus.PreviousVersion).To(BeEmpty())
+
+		fakeClock.SetTime(fakeClock.Now().Add(time.Hour))
+		reconcileInstallation()
+		Expect(getInstallation().Spec.ComponentDescriptor.Reference.Version).To(Equal("1.4.1"))
+	})
+
+	It("should report errors in the status and retry", func() {
+		registryAccess.err = errors.New("registry not reachable")
+		createInstallation(&lsv1alpha1.VersionPolicy{Constraint: "latest"})
+
+		result := reconcileInstallation()
+		Expect(result.RequeueAfter).To(Equal(10 * time.Minute))
+		inst := getInstallation()
+		Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal("1.4.0"))
+		Expect(inst.Status.VersionUpgradeStatus.LastError).To(ContainSubstring("registry not reachable"))
+		Expect(inst.Status.VersionUpgradeStatus.LastCheckTime).To(BeNil())
+	})
+
+	It("should ignore installations without version policy", func() {
+		createInstallation(nil)
+
+		result := reconcileInstallation()
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(getInstallation().Status.VersionUpgradeStatus).To(BeNil())
+	})
+})
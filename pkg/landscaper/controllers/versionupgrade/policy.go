@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package versionupgrade
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/robfig/cron/v3"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const (
+	// DefaultSchedule is the schedule of version policies without explicit schedule.
+	DefaultSchedule = "0 * * * *"
+
+	// ConstraintLatest allows any version that is newer than the current version.
+	ConstraintLatest = "latest"
+	// ConstraintLatestMinor allows newer versions with the same major version as the current version.
+	ConstraintLatestMinor = "latest-minor"
+	// ConstraintLatestPatch allows newer versions with the same major and minor version as the current version.
+	ConstraintLatestPatch = "latest-patch"
+
+	maintenanceWindowTimeFormat = "15:04"
+)
+
+// SelectVersion returns the newest of the available versions that is newer than the current version and allowed by
+// the given constraint. The rejected version is never selected.
+// An empty string is returned if there is no such version. Available versions that are no semantic versions are ignored.
+func SelectVersion(constraint, current string, available []string, rejected string) (string, error) {
+	currentVersion, err := semver.NewVersion(current)
+	if err != nil {
+		return "", fmt.Errorf("current version %q is no semantic version: %w", current, err)
+	}
+	allowed, err := newVersionFilter(constraint, currentVersion)
+	if err != nil {
+		return "", err
+	}
+
+	var selected *semver.Version
+	for _, v := range available {
+		if v == rejected {
+			continue
+		}
+		version, err := semver.NewVersion(v)
+		if err != nil {
+			continue
+		}
+		if !version.GreaterThan(currentVersion) || !allowed(version) {
+			continue
+		}
+		if selected == nil || version.GreaterThan(selected) {
+			selected = version
+		}
+	}
+	if selected == nil {
+		return "", nil
+	}
+	return selected.Original(), nil
+}
+
+// newVersionFilter returns a function that checks whether a version is allowed by the given constraint.
+// Pre-releases are only allowed by semantic version constraints that contain a pre-release themselves.
+func newVersionFilter(constraint string, current *semver.Version) (func(v *semver.Version) bool, error) {
+	switch constraint {
+	case ConstraintLatest:
+		return func(v *semver.Version) bool {
+			return len(v.Prerelease()) == 0
+		}, nil
+	case ConstraintLatestMinor:
+		return func(v *semver.Version) bool {
+			return len(v.Prerelease()) == 0 && v.Major() == current.Major()
+		}, nil
+	case ConstraintLatestPatch:
+		return func(v *semver.Version) bool {
+			return len(v.Prerelease()) == 0 && v.Major() == current.Major() && v.Minor() == current.Minor()
+		}, nil
+	}
+
+	constraints, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+	}
+	return constraints.Check, nil
+}
+
+// NextCheckTime returns the time after the last check when the available versions are checked again.
+func NextCheckTime(policy *lsv1alpha1.VersionPolicy, lastCheck time.Time) (time.Time, error) {
+	spec := policy.Schedule
+	if len(spec) == 0 {
+		spec = DefaultSchedule
+	}
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid schedule %q: %w", spec, err)
+	}
+	return schedule.Next(lastCheck), nil
+}
+
+// InMaintenanceWindow checks whether the given time is inside the maintenance window of the policy.
+// If the policy has no maintenance window, every time is inside.
+// If the window is not inside, the begin of the next window is returned as well.
+func InMaintenanceWindow(policy *lsv1alpha1.VersionPolicy, now time.Time) (bool, time.Time, error) {
+	window := policy.MaintenanceWindow
+	if window == nil {
+		return true, time.Time{}, nil
+	}
+
+	location := time.UTC
+	if len(window.Location) != 0 {
+		var err error
+		location, err = time.LoadLocation(window.Location)
+		if err != nil {
+			return false, time.Time{}, fmt.Errorf("invalid location %q of maintenance window: %w", window.Location, err)
+		}
+	}
+	begin, err := time.Parse(maintenanceWindowTimeFormat, window.Begin)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid begin %q of maintenance window: %w", window.Begin, err)
+	}
+	end, err := time.Parse(maintenanceWindowTimeFormat, window.End)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid end %q of maintenance window: %w", window.End, err)
+	}
+
+	now = now.In(location)
+	minute := now.Hour()*60 + now.Minute()
+	beginMinute := begin.Hour()*60 + begin.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+
+	var inside bool
+	if beginMinute < endMinute {
+		inside = minute >= beginMinute && minute < endMinute
+	} else {
+		// the window ends on the next day
+		inside = minute >= beginMinute || minute < endMinute
+	}
+	if inside {
+		return true, time.Time{}, nil
+	}
+
+	nextBegin := time.Date(now.Year(), now.Month(), now.Day(), begin.Hour(), begin.Minute(), 0, 0, location)
+	if !nextBegin.After(now) {
+		nextBegin = nextBegin.AddDate(0, 0, 1)
+	}
+	return false, nextBegin, nil
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package versionupgrade_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/versionupgrade"
+)
+
+var _ = Describe("Version Policy", func() {
+
+	available := []string{"1.3.0", "1.4.0", "1.4.1", "1.4.3", "1.5.0", "1.6.0-rc.1", "2.0.0", "invalid"}
+
+	DescribeTable("should select the newest allowed version",
+		func(constraint, current, rejected, expected string) {
+			selected, err := versionupgrade.SelectVersion(constraint, current, available, rejected)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selected).To(Equal(expected))
+		},
+		Entry("semver constraint", "~1.4", "1.4.0", "", "1.4.3"),
+		Entry("semver range", ">= 1.4, < 2", "1.4.0", "", "1.5.0"),
+		Entry("latest", "latest", "1.4.0", "", "2.0.0"),
+		Entry("latest-minor", "latest-minor", "1.4.0", "", "1.5.0"),
+		Entry("latest-patch", "latest-patch", "1.4.0", "", "1.4.3"),
+		Entry("rejected version", "latest-patch", "1.4.0", "1.4.3", "1.4.1"),
+		Entry("no newer version", "latest", "2.0.0", "", ""),
+		Entry("no downgrade", "~1.3", "1.4.0", "", ""),
+	)
+
+	It("should fail for an invalid constraint or current version", func() {
+		_, err := versionupgrade.SelectVersion("latest-major-minor", "1.4.0", available, "")
+		Expect(err).To(HaveOccurred())
+		_, err = versionupgrade.SelectVersion("latest", "main", available, "")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should compute the next check time from the schedule", func() {
+		last := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+
+		next, err := versionupgrade.NextCheckTime(&lsv1alpha1.VersionPolicy{}, last)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(Equal(time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC)))
+
+		next, err = versionupgrade.NextCheckTime(&lsv1alpha1.VersionPolicy{Schedule: "0 3 * * *"}, last)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(Equal(time.Date(2024, 5, 2, 3, 0, 0, 0, time.UTC)))
+	})
+
+	It("should check whether a time is inside the maintenance window", func() {
+		policy := &lsv1alpha1.VersionPolicy{
+			MaintenanceWindow: &lsv1alpha1.MaintenanceWindow{Begin: "22:00", End: "04:00"},
+		}
+
+		inside, _, err := versionupgrade.InMaintenanceWindow(policy, time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inside).To(BeTrue())
+
+		inside, _, err = versionupgrade.InMaintenanceWindow(policy, time.Date(2024, 5, 1, 3, 59, 0, 0, time.UTC))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inside).To(BeTrue())
+
+		inside, nextBegin, err := versionupgrade.InMaintenanceWindow(policy, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inside).To(BeFalse())
+		Expect(nextBegin).To(BeTemporally("==", time.Date(2024, 5, 1, 22, 0, 0, 0, time.UTC)))
+	})
+
+	It("should respect the location of the maintenance window", func() {
+		policy := &lsv1alpha1.VersionPolicy{
+			MaintenanceWindow: &lsv1alpha1.MaintenanceWindow{Begin: "01:00", End: "02:00", Location: "Europe/Berlin"},
+		}
+
+		// 23:30 UTC is 01:30 in Berlin during summer time
+		inside, _, err := versionupgrade.InMaintenanceWindow(policy, time.Date(2024, 7, 1, 23, 30, 0, 0, time.UTC))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inside).To(BeTrue())
+
+		inside, nextBegin, err := versionupgrade.InMaintenanceWindow(policy, time.Date(2024, 7, 1, 1, 30, 0, 0, time.UTC))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inside).To(BeFalse())
+		Expect(nextBegin).To(BeTemporally("==", time.Date(2024, 7, 1, 23, 0, 0, 0, time.UTC)))
+	})
+})
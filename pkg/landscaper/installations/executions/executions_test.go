@@ -16,10 +16,12 @@ import (
 
 	"github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/components/registries"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/reconcilehelper"
 	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
@@ -119,4 +121,18 @@ var _ = Describe("DeployItemExecutions", func() {
 		Expect(err.Error()).To(Equal("invalid deployitem specification \"myDi\": target import \"targetListImp\" not found"))
 	})
 
+	It("should report a configuration problem if a template limit is exceeded", func() {
+		limits := template.GetLimits()
+		defer template.SetLimits(limits)
+		template.SetLimits(template.Limits{MaxOutputSize: 10})
+
+		ctx, inst := Load("test2/root")
+		exec := executions.New(op)
+		_, err := exec.RenderDeployItemTemplates(ctx, inst)
+		Expect(err).To(HaveOccurred())
+		lsErr, ok := err.(lserrors.LsError)
+		Expect(ok).To(BeTrue())
+		Expect(lsErr.LandscaperError().Codes).To(ContainElement(lsv1alpha1.ErrorConfigurationProblem))
+	})
+
 })
@@ -22,11 +22,15 @@ import (
 	"github.com/gardener/landscaper/apis/core/validation"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	genericresolver "github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/generic"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -67,7 +71,9 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 		Inst:       inst.GetInstallation(),
 	}
 	targetResolver := genericresolver.New(o.LsUncachedClient())
-	tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver))
+	outputRecorder := template.NewRenderedOutputRecorder(o.LsUncachedClient(), inst.GetInstallation())
+	tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver), cue.New(templateStateHandler), jsonnet.New(templateStateHandler, targetResolver)).
+		WithRenderedOutputRecorder(outputRecorder)
 	executions, err := tmpl.TemplateDeployExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -76,11 +82,15 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 				o.ComponentVersion,
 				o.ResolvedComponentDescriptorList,
 				inst.GetImports())))
+	if storeErr := outputRecorder.Store(ctx); storeErr != nil {
+		logging.FromContextOrDiscard(ctx).Error(storeErr, "unable to store rendered output of deploy executions")
+	}
 
 	if err != nil {
 		inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
 			TemplatingFailedReason, "Unable to template executions"))
-		return nil, lserrors.NewWrappedError(err, op, "Template", "unable to template executions", lsv1alpha1.ErrorForInfoOnly)
+		codes := append([]lsv1alpha1.ErrorCode{lsv1alpha1.ErrorForInfoOnly}, template.ErrorCodes(err)...)
+		return nil, lserrors.NewWrappedError(err, op, "Template", "unable to template executions", codes...)
 	}
 
 	if len(executions) == 0 {
@@ -162,9 +172,36 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 		return nil, err2
 	}
 
+	if err := validateProviderConfigurations(execTemplates); err != nil {
+		err2 := fmt.Errorf("error validating deployitem configurations: %w", err)
+		inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
+			TemplatingFailedReason, err2.Error()))
+		return nil, err2
+	}
+
 	return execTemplates, nil
 }
 
+// validateProviderConfigurations validates the provider configurations of the rendered deploy items
+// against the provider configuration schemas of their deployers,
+// so that invalid configurations are reported at the installation instead of the deploy item.
+func validateProviderConfigurations(execTemplates core.DeployItemTemplateList) error {
+	schemas, err := jsonschema.GetProviderConfigurationSchemas()
+	if err != nil {
+		return err
+	}
+	allErrs := field.ErrorList{}
+	for _, elem := range execTemplates {
+		if elem.Configuration == nil {
+			continue
+		}
+		if err := schemas.Validate(lsv1alpha1.DeployItemType(elem.Type), elem.Configuration.Raw); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("deployExecutions").Key(elem.Name).Child("config"), string(elem.Type), err.Error()))
+		}
+	}
+	return allErrs.ToAggregate()
+}
+
 func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.InstallationImportsAndBlueprint) error {
 	execTemplates, err := o.RenderDeployItemTemplates(ctx, inst)
 	if execTemplates == nil || err != nil {
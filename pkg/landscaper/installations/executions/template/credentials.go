@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/webhook/certificates"
+)
+
+const (
+	// credentialStatePrefix is the prefix of the state names of generated credentials.
+	credentialStatePrefix = "credential/"
+
+	// RotateAllCredentials is the value of the rotate credentials annotation that rotates all credentials of an installation.
+	RotateAllCredentials = "*"
+
+	// credentialRenewFraction is the fraction of the validity of a certificate after which it is renewed.
+	credentialRenewFraction = 0.8
+
+	passwordCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// CredentialRotationSource is implemented by state handlers that know which credentials should be rotated.
+type CredentialRotationSource interface {
+	// CredentialRotation returns the value of the rotate credentials annotation of the installation.
+	CredentialRotation() string
+}
+
+// CredentialRotation returns the value of the rotate credentials annotation of the installation of the state handler.
+func (s KubernetesStateHandler) CredentialRotation() string {
+	if s.Inst == nil {
+		return ""
+	}
+	return s.Inst.GetAnnotations()[lsv1alpha1.RotateCredentialsAnnotation]
+}
+
+// CredentialStore generates passwords and certificates and persists them in the state of an installation,
+// so that they are stable across reconciles.
+// A credential is generated again if its parameters change, if a certificate is due for renewal,
+// or if it is listed in the rotate credentials annotation of the installation.
+type CredentialStore struct {
+	state    GenericStateHandler
+	limiter  *ExecutionLimiter
+	rotation string
+	rotate   map[string]bool
+}
+
+// NewCredentialStore creates a credential store that persists the credentials with the given state handler.
+// If no state handler is given, the credentials are only stable within a single template execution.
+func NewCredentialStore(state GenericStateHandler) *CredentialStore {
+	if state == nil {
+		state = NewMemoryStateHandler()
+	}
+	store := &CredentialStore{
+		state:  state,
+		rotate: map[string]bool{},
+	}
+	if source, ok := state.(CredentialRotationSource); ok {
+		store.rotation = source.CredentialRotation()
+		for _, name := range strings.Split(store.rotation, ",") {
+			if name = strings.TrimSpace(name); len(name) != 0 {
+				store.rotate[name] = true
+			}
+		}
+	}
+	return store
+}
+
+// WithLimiter sets the limiter of the template execution that uses the store.
+// Credentials are not persisted anymore once the execution exceeded one of its limits,
+// so that an execution that is stopped does not change the state of the installation.
+func (c *CredentialStore) WithLimiter(limiter *ExecutionLimiter) *CredentialStore {
+	c.limiter = limiter
+	return c
+}
+
+// credentialState is the persisted state of a generated credential.
+type credentialState struct {
+	// Params is the hash of the parameters the credential was generated with.
+	Params string `json:"params"`
+	// RotatedFor is the value of the rotate credentials annotation the credential was last rotated for.
+	RotatedFor string `json:"rotatedFor,omitempty"`
+	// RenewAfter is the time after which the credential is generated again.
+	RenewAfter *time.Time `json:"renewAfter,omitempty"`
+	// Values contains the generated values.
+	Values map[string]string `json:"values"`
+}
+
+// GeneratePassword returns a random alphanumeric password with the given length.
+func (c *CredentialStore) GeneratePassword(ctx context.Context, name string, length int) (string, error) {
+	if length <= 0 {
+		return "", fmt.Errorf("the length of password %q must be positive", name)
+	}
+	state, err := c.getOrGenerate(ctx, name, hashParams("password", length), func() (*credentialState, error) {
+		password, err := randomString(length)
+		if err != nil {
+			return nil, err
+		}
+		return &credentialState{Values: map[string]string{"password": password}}, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return state.Values["password"], nil
+}
+
+// GenerateCA returns a self-signed certificate authority with the given common name that is valid for the given number of days.
+func (c *CredentialStore) GenerateCA(ctx context.Context, name, commonName string, validityDays int) (map[string]interface{}, error) {
+	if validityDays <= 0 {
+		return nil, fmt.Errorf("the validity of certificate authority %q must be positive", name)
+	}
+	state, err := c.getOrGenerate(ctx, name, hashParams("ca", commonName, validityDays), func() (*credentialState, error) {
+		return generateCertificate(&certificates.CertificateSecretConfig{
+			Name:       name,
+			CommonName: commonName,
+			CertType:   certificates.CACert,
+			PKCS:       certificates.PKCS1,
+		}, validityDays)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return certificateValues(state), nil
+}
+
+// GenerateCert returns a certificate for servers and clients with the given common name and subject alternative names
+// that is signed by the given certificate authority and valid for the given number of days.
+// Subject alternative names that are IP addresses are added as IP SANs, all others as DNS SANs.
+// The certificate authority is the result of GenerateCA, it is generated again if the certificate authority changes.
+func (c *CredentialStore) GenerateCert(ctx context.Context, name, commonName string, sans []string, validityDays int, ca map[string]interface{}) (map[string]interface{}, error) {
+	if validityDays <= 0 {
+		return nil, fmt.Errorf("the validity of certificate %q must be positive", name)
+	}
+	caCert, _ := ca["cert"].(string)
+	caKey, _ := ca["key"].(string)
+	if len(caCert) == 0 || len(caKey) == 0 {
+		return nil, fmt.Errorf("certificate %q requires a certificate authority with a cert and a key", name)
+	}
+
+	sortedSANs := append([]string{}, sans...)
+	sort.Strings(sortedSANs)
+	state, err := c.getOrGenerate(ctx, name, hashParams("cert", commonName, sortedSANs, validityDays, caCert), func() (*credentialState, error) {
+		signingCA, err := certificates.LoadCertificate(name+"-ca", []byte(caKey), []byte(caCert), certificates.PKCS1)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load certificate authority of certificate %q: %w", name, err)
+		}
+		config := &certificates.CertificateSecretConfig{
+			Name:       name,
+			CommonName: commonName,
+			CertType:   certificates.ServerClientCert,
+			SigningCA:  signingCA,
+			PKCS:       certificates.PKCS1,
+		}
+		for _, san := range sans {
+			if ip := net.ParseIP(san); ip != nil {
+				config.IPAddresses = append(config.IPAddresses, ip)
+			} else {
+				config.DNSNames = append(config.DNSNames, san)
+			}
+		}
+		return generateCertificate(config, validityDays)
+	})
+	if err != nil {
+		return nil, err
+	}
+	values := certificateValues(state)
+	values["ca"] = caCert
+	return values, nil
+}
+
+// getOrGenerate returns the persisted state of a credential or generates and persists it
+// if it does not exist, if its parameters changed, if it is due for renewal or if it should be rotated.
+func (c *CredentialStore) getOrGenerate(ctx context.Context, name, params string, generate func() (*credentialState, error)) (*credentialState, error) {
+	if len(name) == 0 {
+		return nil, errors.New("the name of a credential must not be empty")
+	}
+	existing, err := c.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	rotate := c.rotate[name] || c.rotate[RotateAllCredentials]
+	if existing != nil && existing.Params == params &&
+		(existing.RenewAfter == nil || time.Now().Before(*existing.RenewAfter)) &&
+		(!rotate || existing.RotatedFor == c.rotation) {
+		if !rotate && len(existing.RotatedFor) != 0 {
+			// the credential is no longer listed in the annotation, so that it is rotated again when it is listed again.
+			existing.RotatedFor = ""
+			if err := c.store(ctx, name, existing); err != nil {
+				return nil, err
+			}
+		}
+		return existing, nil
+	}
+
+	state, err := generate()
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate credential %q: %w", name, err)
+	}
+	state.Params = params
+	if rotate {
+		state.RotatedFor = c.rotation
+	}
+	if err := c.store(ctx, name, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (c *CredentialStore) get(ctx context.Context, name string) (*credentialState, error) {
+	data, err := c.state.Get(ctx, credentialStatePrefix+name)
+	if err != nil {
+		if errors.Is(err, StateNotFoundErr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get state of credential %q: %w", name, err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	state := &credentialState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("unable to decode state of credential %q: %w", name, err)
+	}
+	return state, nil
+}
+
+func (c *CredentialStore) store(ctx context.Context, name string, state *credentialState) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("unable to store state of credential %q: %w", name, err)
+	}
+	if err := c.limiter.Err(); err != nil {
+		return err
+	}
+	if err := c.limiter.CheckTimeout(); err != nil {
+		return err
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("unable to encode state of credential %q: %w", name, err)
+	}
+	if err := c.state.Store(ctx, credentialStatePrefix+name, data); err != nil {
+		return fmt.Errorf("unable to store state of credential %q: %w", name, err)
+	}
+	return nil
+}
+
+// generateCertificate generates a certificate that is renewed after 80% of its validity.
+func generateCertificate(config *certificates.CertificateSecretConfig, validityDays int) (*credentialState, error) {
+	validity := time.Duration(validityDays) * 24 * time.Hour
+	config.Validity = &validity
+	cert, err := config.GenerateCertificate()
+	if err != nil {
+		return nil, err
+	}
+	notBefore := cert.Certificate.NotBefore
+	notAfter := cert.Certificate.NotAfter
+	renewAfter := notBefore.Add(time.Duration(float64(validity) * credentialRenewFraction))
+	return &credentialState{
+		RenewAfter: &renewAfter,
+		Values: map[string]string{
+			"cert":      string(cert.CertificatePEM),
+			"key":       string(cert.PrivateKeyPEM),
+			"notBefore": notBefore.UTC().Format(time.RFC3339),
+			"notAfter":  notAfter.UTC().Format(time.RFC3339),
+		},
+	}, nil
+}
+
+// certificateValues returns the values of a generated certificate that are available in templates.
+func certificateValues(state *credentialState) map[string]interface{} {
+	values := map[string]interface{}{}
+	for k, v := range state.Values {
+		values[k] = v
+	}
+	if state.RenewAfter != nil {
+		values["renewAfter"] = state.RenewAfter.UTC().Format(time.RFC3339)
+	}
+	return values
+}
+
+// hashParams returns a hash of the parameters of a credential.
+func hashParams(params ...interface{}) string {
+	data, _ := json.Marshal(params)
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// randomString returns a cryptographically secure random alphanumeric string.
+func randomString(length int) (string, error) {
+	numCharacters := big.NewInt(int64(len(passwordCharacters)))
+	res := make([]byte, length)
+	for i := range res {
+		n, err := rand.Int(rand.Reader, numCharacters)
+		if err != nil {
+			return "", err
+		}
+		res[i] = passwordCharacters[n.Int64()]
+	}
+	return string(res), nil
+}
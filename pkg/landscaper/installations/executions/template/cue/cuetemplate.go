@@ -0,0 +1,368 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/ast"
+	"cuelang.org/go/cue/build"
+	"cuelang.org/go/cue/cuecontext"
+	"cuelang.org/go/cue/parser"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+)
+
+const (
+	importExecutionDefinition          = "#ImportExecution"
+	subinstallationExecutionDefinition = "#SubinstallationExecution"
+	deployExecutionDefinition          = "#DeployExecution"
+	exportExecutionDefinition          = "#ExportExecution"
+
+	// blueprintFilesIdentifier is the top-level identifier under which the files of the blueprint
+	// that are not cue files are available in a template.
+	blueprintFilesIdentifier = "blueprintFiles"
+
+	// previousStateIdentifier is the top-level identifier under which the state of the previous evaluation is available.
+	// The state cannot be provided as "state", as the template defines the new state in the field "state".
+	previousStateIdentifier = "previousState"
+)
+
+// Templater describes the cue template implementation for execution templater.
+//
+// The template is evaluated with all template input values (e.g. imports, cd, components and blueprint)
+// in scope, so that they can be referenced as top-level identifiers.
+// The state of the previous evaluation is available as top-level identifier "previousState".
+// A template file of the blueprint that declares a package is evaluated together with all other
+// cue files of the same directory and package.
+// All other files of the blueprint are available as top-level identifier "blueprintFiles",
+// a map of the file paths relative to the blueprint root to the file contents.
+type Templater struct {
+	state          template.GenericStateHandler
+	inputFormatter *template.TemplateInputFormatter
+	outputRecorder *template.RenderedOutputRecorder
+}
+
+// New creates a new cue execution templater.
+func New(state template.GenericStateHandler) *Templater {
+	return &Templater{
+		state:          state,
+		inputFormatter: template.NewTemplateInputFormatter(false, "imports", "values", previousStateIdentifier),
+	}
+}
+
+// WithInputFormatter ads a custom input formatter to this templater used for error messages.
+func (t *Templater) WithInputFormatter(inputFormatter *template.TemplateInputFormatter) *Templater {
+	t.inputFormatter = inputFormatter
+	return t
+}
+
+// SetRenderedOutputRecorder sets the recorder that captures the raw rendered output of all executions.
+func (t *Templater) SetRenderedOutputRecorder(recorder *template.RenderedOutputRecorder) {
+	t.outputRecorder = recorder
+}
+
+func (t Templater) Type() lsv1alpha1.TemplateType {
+	return lsv1alpha1.CUETemplateType
+}
+
+func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*template.ImportExecutorOutput, error) {
+
+	data, err := t.execute(tmplExec, blueprint, importExecutionDefinition, values)
+	if err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.ImportExecutionKind, tmplExec.Name, data)
+
+	output := &template.ImportExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*template.SubinstallationExecutorOutput, error) {
+
+	ctx := context.Background()
+	defer ctx.Done()
+	state, err := template.GetExecutionState(ctx, t.state, "deploy", tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values[previousStateIdentifier] = state
+	data, err := t.execute(tmplExec, blueprint, subinstallationExecutionDefinition, values)
+	if err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.SubinstallationExecutionKind, tmplExec.Name, data)
+
+	if err := template.StoreExecutionState(ctx, t.state, "deploy", tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+
+	output := &template.SubinstallationExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*template.DeployExecutorOutput, error) {
+
+	ctx := context.Background()
+	defer ctx.Done()
+	state, err := template.GetExecutionState(ctx, t.state, "deploy", tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values[previousStateIdentifier] = state
+	data, err := t.execute(tmplExec, blueprint, deployExecutionDefinition, values)
+	if err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.DeployExecutionKind, tmplExec.Name, data)
+
+	if err := template.StoreExecutionState(ctx, t.state, "deploy", tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+
+	output := &template.DeployExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*template.ExportExecutorOutput, error) {
+
+	ctx := context.Background()
+	defer ctx.Done()
+	state, err := template.GetExecutionState(ctx, t.state, "export", tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values[previousStateIdentifier] = state
+	data, err := t.execute(tmplExec, blueprint, exportExecutionDefinition, values)
+	if err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.ExportExecutionKind, tmplExec.Name, data)
+
+	if err := template.StoreExecutionState(ctx, t.state, "export", tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+
+	output := &template.ExportExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// execute evaluates the cue template of the execution with the given values in scope,
+// validates the result against the output definition of the execution kind
+// and returns the result as json.
+func (t *Templater) execute(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	definition string,
+	values map[string]interface{}) ([]byte, error) {
+
+	inst, err := buildInstance(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	scopeValues := values
+	if referencesIdentifier(inst, blueprintFilesIdentifier) {
+		files, err := readBlueprintFiles(blueprint)
+		if err != nil {
+			return nil, err
+		}
+		scopeValues = make(map[string]interface{}, len(values)+1)
+		for k, v := range values {
+			scopeValues[k] = v
+		}
+		scopeValues[blueprintFilesIdentifier] = files
+	}
+
+	cuectx := cuecontext.New()
+	scope := cuectx.Encode(scopeValues)
+	if err := scope.Err(); err != nil {
+		return nil, fmt.Errorf("unable to encode template input: %w", err)
+	}
+
+	schema := cuectx.CompileString(outputSchema, cue.Filename("landscaper-schema.cue")).LookupPath(cue.MakePath(cue.Def(definition)))
+	if err := schema.Err(); err != nil {
+		return nil, fmt.Errorf("unable to compile output schema: %w", err)
+	}
+
+	res := cuectx.BuildInstance(inst, cue.Scope(scope))
+	if err := res.Err(); err != nil {
+		return nil, TemplateErrorBuilder(err).WithInput(values, t.inputFormatter).Build()
+	}
+
+	res = schema.Unify(res)
+	if err := res.Validate(cue.Concrete(true)); err != nil {
+		return nil, TemplateErrorBuilder(err).WithInput(values, t.inputFormatter).Build()
+	}
+
+	data, err := res.MarshalJSON()
+	if err != nil {
+		return nil, TemplateErrorBuilder(err).WithInput(values, t.inputFormatter).Build()
+	}
+	return data, nil
+}
+
+// buildInstance creates the cue instance of a template execution.
+// An inline template is expected to be a string that contains the cue source.
+// A template file that declares a package is combined with all other cue files of its directory
+// that declare the same package.
+func buildInstance(tmplExec lsv1alpha1.TemplateExecutor, blueprint *blueprints.Blueprint) (*build.Instance, error) {
+	if len(tmplExec.Libraries) != 0 {
+		return nil, fmt.Errorf("template libraries are not supported by cue templates")
+	}
+	var files []*ast.File
+	if len(tmplExec.Template.RawMessage) != 0 {
+		var rawTemplate string
+		if err := json.Unmarshal(tmplExec.Template.RawMessage, &rawTemplate); err != nil {
+			return nil, fmt.Errorf("a cue template has to be a string: %w", err)
+		}
+		file, err := parser.ParseFile(tmplExec.Name+".cue", rawTemplate, parser.ParseComments)
+		if err != nil {
+			return nil, TemplateErrorBuilder(err).Build()
+		}
+		files = append(files, file)
+	} else if len(tmplExec.File) != 0 {
+		templateFile := path.Clean(tmplExec.File)
+		file, err := parseFile(blueprint.Fs, templateFile)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+
+		if pkg := file.PackageName(); len(pkg) != 0 {
+			dir := path.Dir(templateFile)
+			entries, err := vfs.ReadDir(blueprint.Fs, dir)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read template directory %q: %w", dir, err)
+			}
+			for _, entry := range entries {
+				name := path.Join(dir, entry.Name())
+				if entry.IsDir() || path.Ext(name) != ".cue" || name == templateFile {
+					continue
+				}
+				pkgFile, err := parseFile(blueprint.Fs, name)
+				if err != nil {
+					return nil, err
+				}
+				if pkgFile.PackageName() == pkg {
+					files = append(files, pkgFile)
+				}
+			}
+		}
+	} else {
+		return nil, fmt.Errorf("no template found")
+	}
+
+	inst := build.NewContext().NewInstance("", nil)
+	for _, file := range files {
+		if err := inst.AddSyntax(file); err != nil {
+			return nil, TemplateErrorBuilder(err).Build()
+		}
+	}
+	if inst.Err != nil {
+		return nil, TemplateErrorBuilder(inst.Err).Build()
+	}
+	return inst, nil
+}
+
+// parseFile parses a cue file of the blueprint.
+func parseFile(fs vfs.FileSystem, name string) (*ast.File, error) {
+	data, err := vfs.ReadFile(fs, name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read template file %q: %w", name, err)
+	}
+	file, err := parser.ParseFile(name, data, parser.ParseComments)
+	if err != nil {
+		return nil, TemplateErrorBuilder(err).Build()
+	}
+	return file, nil
+}
+
+// referencesIdentifier returns whether a file of the cue instance references the given identifier.
+func referencesIdentifier(inst *build.Instance, name string) bool {
+	found := false
+	for _, file := range inst.Files {
+		ast.Walk(file, func(node ast.Node) bool {
+			if ident, ok := node.(*ast.Ident); ok && ident.Name == name {
+				found = true
+			}
+			return !found
+		}, nil)
+	}
+	return found
+}
+
+// readBlueprintFiles reads all files of the blueprint that are not cue files.
+// Files with utf-8 content are returned as strings, all other files as bytes.
+func readBlueprintFiles(blueprint *blueprints.Blueprint) (map[string]interface{}, error) {
+	files := map[string]interface{}{}
+	if blueprint == nil || blueprint.Fs == nil {
+		return files, nil
+	}
+	err := vfs.Walk(blueprint.Fs, "/", func(name string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() || path.Ext(name) == ".cue" {
+			return nil
+		}
+		data, err := vfs.ReadFile(blueprint.Fs, name)
+		if err != nil {
+			return err
+		}
+		key := strings.TrimPrefix(path.Clean(name), "/")
+		if utf8.Valid(data) {
+			files[key] = string(data)
+		} else {
+			files[key] = data
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read blueprint files: %w", err)
+	}
+	return files, nil
+}
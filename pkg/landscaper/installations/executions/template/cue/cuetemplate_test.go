@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cue_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
+)
+
+func inlineExecution(name, source string) lsv1alpha1.TemplateExecutor {
+	raw, err := json.Marshal(source)
+	Expect(err).ToNot(HaveOccurred())
+	return lsv1alpha1.TemplateExecutor{
+		Name:     name,
+		Type:     lsv1alpha1.CUETemplateType,
+		Template: lsv1alpha1.AnyJSON{RawMessage: raw},
+	}
+}
+
+var _ = Describe("CUE Templater", func() {
+
+	var bp *blueprints.Blueprint
+
+	BeforeEach(func() {
+		bp = blueprints.New(nil, memoryfs.New())
+	})
+
+	It("should template deploy items from the imports", func() {
+		exec := inlineExecution("deploy", `
+_replicas: imports.replicas * 2
+deployItems: [{
+	name: "app"
+	type: "landscaper.gardener.cloud/mock"
+	config: replicas: _replicas
+}]
+`)
+		values := map[string]interface{}{
+			"imports": map[string]interface{}{"replicas": 2},
+		}
+		out, err := cue.New(nil).TemplateDeployExecutions(exec, bp, nil, nil, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.DeployItems).To(HaveLen(1))
+		Expect(out.DeployItems[0].Name).To(Equal("app"))
+		Expect(string(out.DeployItems[0].Configuration.Raw)).To(MatchJSON(`{"replicas": 4}`))
+	})
+
+	It("should reject output that does not match the execution schema", func() {
+		exec := inlineExecution("deploy", `
+deployItems: [{
+	name: "app"
+	type: 5
+}]
+`)
+		_, err := cue.New(nil).TemplateDeployExecutions(exec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject template libraries", func() {
+		exec := inlineExecution("export", `exports: a: "b"`)
+		exec.Libraries = []lsv1alpha1.TemplateLibraryReference{{Name: "lib", Ref: "cd://resources/lib"}}
+		_, err := cue.New(nil).TemplateExportExecutions(exec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).To(MatchError(ContainSubstring("not supported")))
+	})
+
+	It("should reject incomplete output", func() {
+		exec := inlineExecution("export", `exports: a: string`)
+		_, err := cue.New(nil).TemplateExportExecutions(exec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should template import bindings and errors", func() {
+		exec := inlineExecution("import", `
+bindings: b: imports.a + "-suffix"
+errors: [ if imports.a == "" {"a must not be empty"} ]
+`)
+		values := map[string]interface{}{
+			"imports": map[string]interface{}{"a": "val"},
+		}
+		out, err := cue.New(nil).TemplateImportExecutions(exec, bp, nil, nil, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.Bindings).To(HaveKeyWithValue("b", "val-suffix"))
+		Expect(out.Errors).To(BeEmpty())
+	})
+
+	It("should evaluate a template file together with the files of its package", func() {
+		Expect(bp.Fs.MkdirAll("export", 0755)).To(Succeed())
+		Expect(vfs.WriteFile(bp.Fs, "export/export.cue", []byte(`package export
+
+exports: url: "https://\(_host)"
+`), 0600)).To(Succeed())
+		Expect(vfs.WriteFile(bp.Fs, "export/host.cue", []byte(`package export
+
+_host: values.deployitems.app.host
+`), 0600)).To(Succeed())
+		Expect(vfs.WriteFile(bp.Fs, "export/other.cue", []byte(`package other
+
+exports: other: "value"
+`), 0600)).To(Succeed())
+
+		exec := lsv1alpha1.TemplateExecutor{
+			Name: "export",
+			Type: lsv1alpha1.CUETemplateType,
+			File: "export/export.cue",
+		}
+		values := map[string]interface{}{
+			"values": map[string]interface{}{
+				"deployitems": map[string]interface{}{
+					"app": map[string]interface{}{"host": "example.com"},
+				},
+			},
+		}
+		out, err := cue.New(nil).TemplateExportExecutions(exec, bp, nil, nil, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.Exports).To(Equal(map[string]interface{}{"url": "https://example.com"}))
+	})
+
+	It("should provide the other files of the blueprint to the template", func() {
+		Expect(bp.Fs.MkdirAll("data", 0755)).To(Succeed())
+		Expect(vfs.WriteFile(bp.Fs, "data/config.yaml", []byte("replicas: 3\n"), 0600)).To(Succeed())
+		Expect(vfs.WriteFile(bp.Fs, "data/binary", []byte{0xff, 0xfe}, 0600)).To(Succeed())
+
+		exec := inlineExecution("deploy", `
+import "encoding/yaml"
+
+_config: yaml.Unmarshal(blueprintFiles["data/config.yaml"])
+deployItems: [{
+	name: "app"
+	type: "landscaper.gardener.cloud/mock"
+	config: {
+		replicas: _config.replicas
+		binary: blueprintFiles["data/binary"]
+	}
+}]
+`)
+		out, err := cue.New(nil).TemplateDeployExecutions(exec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.DeployItems).To(HaveLen(1))
+		Expect(string(out.DeployItems[0].Configuration.Raw)).To(MatchJSON(`{"replicas": 3, "binary": "//4="}`))
+	})
+
+	It("should provide the state of the previous evaluation", func() {
+		state := template.NewMemoryStateHandler()
+		exec := inlineExecution("deploy", `
+_previous: *previousState.counter | 0
+deployItems: []
+state: counter: _previous + 1
+`)
+		for i := 1; i <= 2; i++ {
+			_, err := cue.New(state).TemplateDeployExecutions(exec, bp, nil, nil, map[string]interface{}{})
+			Expect(err).ToNot(HaveOccurred())
+			stored, err := state.Get(context.Background(), "deploy"+exec.Name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored).To(MatchJSON(fmt.Sprintf(`{"counter": %d}`, i)))
+		}
+	})
+
+})
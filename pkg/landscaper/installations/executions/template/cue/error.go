@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cue
+
+import (
+	"strings"
+
+	cueerrors "cuelang.org/go/cue/errors"
+
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+)
+
+// TemplateError wraps a cue evaluation error and adds more human-readable information.
+type TemplateError struct {
+	err            error
+	input          map[string]interface{}
+	inputFormatter *template.TemplateInputFormatter
+	message        string
+}
+
+// TemplateErrorBuilder creates a new TemplateError.
+func TemplateErrorBuilder(err error) *TemplateError {
+	return &TemplateError{
+		err: err,
+	}
+}
+
+// WithInput adds the template input with a formatter to the error.
+func (e *TemplateError) WithInput(input map[string]interface{}, inputFormatter *template.TemplateInputFormatter) *TemplateError {
+	e.input = input
+	e.inputFormatter = inputFormatter
+	return e
+}
+
+// Build builds the error message.
+// All errors reported by cue are listed including their positions in the template source.
+func (e *TemplateError) Build() *TemplateError {
+	builder := strings.Builder{}
+	builder.WriteString(strings.TrimSpace(cueerrors.Details(e.err, nil)))
+
+	if e.input != nil && e.inputFormatter != nil {
+		builder.WriteString("\ntemplate input:\n")
+		builder.WriteString(e.inputFormatter.Format(e.input, "\t"))
+	}
+
+	e.message = builder.String()
+	return e
+}
+
+// Error returns the error message.
+func (e *TemplateError) Error() string {
+	return e.message
+}
+
+// Unwrap returns the original cue error.
+func (e *TemplateError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cue
+
+// outputSchema contains the cue definitions of the outputs of all execution kinds.
+// The result of a cue template is unified with the definition of its execution kind,
+// so that typos and wrongly typed fields are reported before the output is decoded.
+// Hidden fields (e.g. _replicas) can be used for intermediate values as they are not part of the output.
+const outputSchema = `
+#ImportExecution: {
+	bindings?: [string]: _
+	errors?: [...string]
+}
+
+#SubinstallationExecution: {
+	subinstallations?: [...#InstallationTemplate]
+	state?: _
+}
+
+#DeployExecution: {
+	deployItems?: [...#DeployItem]
+	state?: _
+}
+
+#ExportExecution: {
+	exports?: [string]: _
+	state?: _
+}
+
+#InstallationTemplate: {
+	name: string & !=""
+	...
+}
+
+#DeployItem: {
+	name:    string & !=""
+	type:    string & !=""
+	config?: {...}
+	target?: {
+		name?:   string
+		import?: string
+		index?:  int
+		key?:    string
+	}
+	labels?: [string]: string
+	dependsOn?: [...string]
+	timeout?:            string
+	updateOnChangeOnly?: bool
+	onDelete?: {...}
+}
+`
@@ -53,10 +53,12 @@ func LandscaperSprigFuncMap() gotmpl.FuncMap {
 
 // LandscaperTplFuncMap contains all additional landscaper functions that are
 // available in the executors templates.
+// The functions that fetch files or resources fail if the content exceeds the blob size limit of the limiter.
 func LandscaperTplFuncMap(blueprint *blueprints.Blueprint,
 	componentVersion model.ComponentVersion,
 	componentVersions *model.ComponentVersionList,
-	targetResolver targetresolver.TargetResolver) (map[string]interface{}, error) {
+	targetResolver targetresolver.TargetResolver,
+	limiter *lstmpl.ExecutionLimiter) (map[string]interface{}, error) {
 
 	ocmSchemaVersion := common.DetermineOCMSchemaVersion(blueprint, componentVersion)
 
@@ -71,7 +73,7 @@ func LandscaperTplFuncMap(blueprint *blueprints.Blueprint,
 	}
 
 	funcs := map[string]interface{}{
-		"readFile": readFileFunc(blueprint.Fs),
+		"readFile": readFileFunc(blueprint.Fs, limiter),
 		"readDir":  readDir(blueprint.Fs),
 
 		"toYaml": toYAML,
@@ -79,10 +81,10 @@ func LandscaperTplFuncMap(blueprint *blueprints.Blueprint,
 		"parseOCIRef":   lstmpl.ParseOCIReference,
 		"ociRefRepo":    getOCIReferenceRepository,
 		"ociRefVersion": getOCIReferenceVersion,
-		"resolve":       resolveArtifactFunc(componentVersion),
+		"resolve":       resolveArtifactFunc(componentVersion, limiter),
 
 		"getResourceKey":       getResourceKeyGoFunc(componentVersion),
-		"getResourceContent":   getResourceContentGoFunc(componentVersion),
+		"getResourceContent":   getResourceContentGoFunc(componentVersion, limiter),
 		"getResource":          getResourceGoFunc(cd),
 		"getResources":         getResourcesGoFunc(cd),
 		"getComponent":         getComponentGoFunc(cd, cdList, ocmSchemaVersion),
@@ -93,14 +95,24 @@ func LandscaperTplFuncMap(blueprint *blueprints.Blueprint,
 		"getServiceAccountKubeconfig":                        getServiceAccountKubeconfigGoFunc(targetResolver),
 		"getServiceAccountKubeconfigWithExpirationTimestamp": getServiceAccountKubeconfigWithExpirationTimestampGoFunc(targetResolver),
 		"getOidcKubeconfig":                                  getOidcKubeconfigGoFunc(targetResolver),
+		"getTargetServerVersion":                             getTargetServerVersionGoFunc(targetResolver),
+		"targetHasAPI":                                       targetHasAPIGoFunc(targetResolver),
+		"lookup":                                             lookupGoFunc(targetResolver),
 	}
 
 	return funcs, nil
 }
 
 // readFileFunc returns a function that reads a file from a location in a filesystem
-func readFileFunc(fs vfs.FileSystem) func(path string) []byte {
+func readFileFunc(fs vfs.FileSystem, limiter *lstmpl.ExecutionLimiter) func(path string) []byte {
 	return func(path string) []byte {
+		info, err := fs.Stat(path)
+		if err != nil {
+			panic(err)
+		}
+		if err := limiter.CheckBlobSize(fmt.Sprintf("file %q", path), info.Size()); err != nil {
+			panic(err)
+		}
 		file, err := vfs.ReadFile(fs, path)
 		if err != nil {
 			// maybe we should ignore the error and return an empty byte array
@@ -146,7 +158,7 @@ func getOCIReferenceRepository(ref string) string {
 }
 
 // resolveArtifactFunc returns a function that can resolve artifact defined by a component descriptor access
-func resolveArtifactFunc(componentVersion model.ComponentVersion) func(access map[string]interface{}) ([]byte, error) {
+func resolveArtifactFunc(componentVersion model.ComponentVersion, limiter *lstmpl.ExecutionLimiter) func(access map[string]interface{}) ([]byte, error) {
 	return func(access map[string]interface{}) ([]byte, error) {
 		ctx := context.Background()
 		defer ctx.Done()
@@ -166,7 +178,7 @@ func resolveArtifactFunc(componentVersion model.ComponentVersion) func(access ma
 		}
 
 		var data bytes.Buffer
-		if _, err := blobResolver.Resolve(ctx, types.Resource{Access: cdv2.NewUnstructuredType(access["type"].(string), access)}, &data); err != nil {
+		if _, err := blobResolver.Resolve(ctx, types.Resource{Access: cdv2.NewUnstructuredType(access["type"].(string), access)}, limiter.BlobWriter("artifact", &data)); err != nil {
 			panic(err)
 		}
 		return data.Bytes(), nil
@@ -262,7 +274,7 @@ func getResourceKeyGoFunc(cv model.ComponentVersion) func(args ...interface{}) (
 // getResourceContentGoFunc returns a function that resolves a relative resource reference
 // (https://github.com/open-component-model/ocm-spec/blob/restruc3/doc/05-guidelines/03-references.md#relative-artifact-references),
 // based on an ocm component version given as input parameter and returns the content of the corresponding resource.
-func getResourceContentGoFunc(cv model.ComponentVersion) func(args ...interface{}) (string, error) {
+func getResourceContentGoFunc(cv model.ComponentVersion, limiter *lstmpl.ExecutionLimiter) func(args ...interface{}) (string, error) {
 	return func(args ...interface{}) (string, error) {
 		if args == nil {
 			return "", errors.New("unable to provide key for empty relative artifact reference")
@@ -296,8 +308,14 @@ func getResourceContentGoFunc(cv model.ComponentVersion) func(args ...interface{
 		if err != nil {
 			return "", fmt.Errorf("unable to get access method for resource: %w", err)
 		}
+		defer m.Close()
 
-		data, err := m.Get()
+		reader, err := m.Reader()
+		if err != nil {
+			return "", fmt.Errorf("unable to read resource content: %w", err)
+		}
+		defer reader.Close()
+		data, err := limiter.ReadBlob(fmt.Sprintf("content of resource %q", resourceRefStr), reader)
 		if err != nil {
 			return "", fmt.Errorf("unable to read resource content: %w", err)
 		}
@@ -559,6 +577,138 @@ func getOidcKubeconfigGoFunc(targetResolver targetresolver.TargetResolver) func(
 	}
 }
 
+func getTargetServerVersionGoFunc(targetResolver targetresolver.TargetResolver) func(args ...interface{}) (string, error) {
+	return func(args ...interface{}) (string, error) {
+		if len(args) != 1 {
+			return "", fmt.Errorf("templating function getTargetServerVersion expects 1 argument: target")
+		}
+
+		target, err := toTarget(args[0])
+		if err != nil {
+			return "", fmt.Errorf("templating function getTargetServerVersion expects a target object as 1st argument: %w", err)
+		}
+
+		ctx, cancel := lstmpl.NewTargetLookupContext()
+		defer cancel()
+		return lstmpl.GetTargetServerVersion(ctx, target, targetResolver)
+	}
+}
+
+func targetHasAPIGoFunc(targetResolver targetresolver.TargetResolver) func(args ...interface{}) (bool, error) {
+	return func(args ...interface{}) (bool, error) {
+		if len(args) != 2 {
+			return false, fmt.Errorf("templating function targetHasAPI expects 2 arguments: api and target")
+		}
+
+		api, ok := args[0].(string)
+		if !ok {
+			return false, fmt.Errorf("templating function targetHasAPI expects a string as 1st argument, namely the api")
+		}
+
+		target, err := toTarget(args[1])
+		if err != nil {
+			return false, fmt.Errorf("templating function targetHasAPI expects a target object as 2nd argument: %w", err)
+		}
+
+		ctx, cancel := lstmpl.NewTargetLookupContext()
+		defer cancel()
+		return lstmpl.TargetHasAPI(ctx, api, target, targetResolver)
+	}
+}
+
+func lookupGoFunc(targetResolver targetresolver.TargetResolver) func(args ...interface{}) (map[string]interface{}, error) {
+	return func(args ...interface{}) (map[string]interface{}, error) {
+		if len(args) != 5 {
+			return nil, fmt.Errorf("templating function lookup expects 5 arguments: api version, kind, namespace, name, and target")
+		}
+
+		strArgs := make([]string, 4)
+		for i, name := range []string{"api version", "kind", "namespace", "name"} {
+			val, ok := args[i].(string)
+			if !ok {
+				return nil, fmt.Errorf("templating function lookup expects a string as argument %d, namely the %s", i+1, name)
+			}
+			strArgs[i] = val
+		}
+
+		target, err := toTarget(args[4])
+		if err != nil {
+			return nil, fmt.Errorf("templating function lookup expects a target object as 5th argument: %w", err)
+		}
+
+		ctx, cancel := lstmpl.NewTargetLookupContext()
+		defer cancel()
+		return lstmpl.LookupTargetObject(ctx, strArgs[0], strArgs[1], strArgs[2], strArgs[3], target, targetResolver)
+	}
+}
+
+// toTarget converts a target object of a template into a target.
+func toTarget(targetObj interface{}) (*v1alpha1.Target, error) {
+	targetBytes, err := json.Marshal(targetObj)
+	if err != nil {
+		return nil, fmt.Errorf("error during marshaling: %w", err)
+	}
+
+	target := &v1alpha1.Target{}
+	if err := json.Unmarshal(targetBytes, target); err != nil {
+		return nil, fmt.Errorf("error during unmarshaling: %w", err)
+	}
+	return target, nil
+}
+
+// credentialFuncs returns the functions that generate credentials and persist them in the credential store.
+func credentialFuncs(store *lstmpl.CredentialStore) map[string]interface{} {
+	return map[string]interface{}{
+		"generatePassword": func(name string, length interface{}) (string, error) {
+			l, err := toInt64(length)
+			if err != nil {
+				return "", fmt.Errorf("templating function generatePassword expects an integer as 2nd argument, namely the length: %w", err)
+			}
+			return store.GeneratePassword(context.Background(), name, int(l))
+		},
+		"generateCA": func(name, commonName string, validityDays interface{}) (map[string]interface{}, error) {
+			days, err := toInt64(validityDays)
+			if err != nil {
+				return nil, fmt.Errorf("templating function generateCA expects an integer as 3rd argument, namely the validity in days: %w", err)
+			}
+			return store.GenerateCA(context.Background(), name, commonName, int(days))
+		},
+		"generateCert": func(name, commonName string, sans interface{}, validityDays interface{}, ca map[string]interface{}) (map[string]interface{}, error) {
+			sanList, err := toStringList(sans)
+			if err != nil {
+				return nil, fmt.Errorf("templating function generateCert expects a list of strings as 3rd argument, namely the subject alternative names: %w", err)
+			}
+			days, err := toInt64(validityDays)
+			if err != nil {
+				return nil, fmt.Errorf("templating function generateCert expects an integer as 4th argument, namely the validity in days: %w", err)
+			}
+			return store.GenerateCert(context.Background(), name, commonName, sanList, int(days), ca)
+		},
+	}
+}
+
+// toStringList converts a list of a template into a list of strings.
+func toStringList(value interface{}) ([]string, error) {
+	switch list := value.(type) {
+	case nil:
+		return nil, nil
+	case []string:
+		return list, nil
+	case []interface{}:
+		res := make([]string, len(list))
+		for i, v := range list {
+			str, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported type %T of element %d", v, i)
+			}
+			res[i] = str
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %T", value)
+	}
+}
+
 func toInt64(value interface{}) (int64, error) {
 	switch n := value.(type) {
 	case int64:
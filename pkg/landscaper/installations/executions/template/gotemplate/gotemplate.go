@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	gotmpl "text/template"
+	"text/template/parse"
 
 	"github.com/mandelsoft/vfs/pkg/vfs"
 	"github.com/pkg/errors"
@@ -22,15 +23,12 @@ import (
 	lstmpl "github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 )
 
-const (
-	recursionMaxNums = 100
-)
-
 // Templater is the go template implementation for landscaper templating.
 type Templater struct {
 	state          lstmpl.GenericStateHandler
 	inputFormatter *lstmpl.TemplateInputFormatter
 	targetResolver targetresolver.TargetResolver
+	outputRecorder *lstmpl.RenderedOutputRecorder
 }
 
 // New creates a new go template execution templater.
@@ -48,10 +46,17 @@ func (t *Templater) WithInputFormatter(inputFormatter *lstmpl.TemplateInputForma
 	return t
 }
 
+// SetRenderedOutputRecorder sets the recorder that captures the raw rendered output of all executions.
+func (t *Templater) SetRenderedOutputRecorder(recorder *lstmpl.RenderedOutputRecorder) {
+	t.outputRecorder = recorder
+}
+
 type TemplateExecution struct {
-	funcMap       map[string]interface{}
-	blueprint     *blueprints.Blueprint
-	includedNames map[string]int
+	funcMap          map[string]interface{}
+	blueprint        *blueprints.Blueprint
+	includeDepth     int
+	libraryTemplates []lstmpl.LibraryFile
+	limiter          *lstmpl.ExecutionLimiter
 }
 
 func NewTemplateExecution(blueprint *blueprints.Blueprint,
@@ -59,55 +64,160 @@ func NewTemplateExecution(blueprint *blueprints.Blueprint,
 	cdList *model.ComponentVersionList,
 	targetResolver targetresolver.TargetResolver) (*TemplateExecution, error) {
 
-	funcs, err := LandscaperTplFuncMap(blueprint, cd, cdList, targetResolver)
+	limiter := lstmpl.NewExecutionLimiter(lstmpl.GetLimits())
+	funcs, err := LandscaperTplFuncMap(blueprint, cd, cdList, targetResolver, limiter)
 	if err != nil {
 		return nil, err
 	}
 
 	t := &TemplateExecution{
-		funcMap:       funcs,
-		blueprint:     blueprint,
-		includedNames: map[string]int{},
+		funcMap:   funcs,
+		blueprint: blueprint,
+		limiter:   limiter,
 	}
 	t.funcMap["include"] = t.include
+	t.funcMap[checkLimitsFuncName] = t.checkLimits
 	return t, nil
 }
 
+// WithLibraries makes the named templates of the given template libraries available to the execution.
+func (te *TemplateExecution) WithLibraries(libraries []*lstmpl.TemplateLibrary) error {
+	files, err := lstmpl.LibraryFiles(libraries, lstmpl.LibraryTemplatesDir)
+	if err != nil {
+		return err
+	}
+	te.libraryTemplates = files
+	return nil
+}
+
+// WithCredentials makes the functions available to the execution that generate credentials and persist them in the store.
+func (te *TemplateExecution) WithCredentials(store *lstmpl.CredentialStore) {
+	for name, fn := range credentialFuncs(store.WithLimiter(te.limiter)) {
+		te.funcMap[name] = fn
+	}
+}
+
 func (te *TemplateExecution) include(name string, binding interface{}) (string, error) {
-	if v, ok := te.includedNames[name]; ok {
-		if v > recursionMaxNums {
-			return "", errors.Wrapf(fmt.Errorf("unable to execute template"), "rendering template has a nested reference name: %s", name)
-		}
-		te.includedNames[name]++
-	} else {
-		te.includedNames[name] = 1
+	te.includeDepth++
+	defer func() { te.includeDepth-- }()
+	if err := te.limiter.CheckIncludeDepth(name, te.includeDepth); err != nil {
+		return "", err
+	}
+	if err := te.limiter.CheckTimeout(); err != nil {
+		return "", err
 	}
 	data, err := vfs.ReadFile(te.blueprint.Fs, name)
 	if err != nil {
 		return "", errors.Wrapf(err, "unable to read include file %q", name)
 	}
-	res, err := te.Execute(string(data), binding)
-	te.includedNames[name]--
+	res, err := te.execute(name, string(data), binding)
 	return string(res), err
 }
 
+// Execute executes the given template of an execution within the limits of the execution.
 func (te *TemplateExecution) Execute(template string, binding interface{}) ([]byte, error) {
-	tmpl, err := gotmpl.New("execution").
+	var res []byte
+	err := te.limiter.Run(func() error {
+		var err error
+		res, err = te.execute(executionTemplateName, template, binding)
+		return err
+	})
+	if limitErr := te.limiter.Err(); limitErr != nil {
+		return nil, limitErr
+	}
+	return res, err
+}
+
+// execute executes a template with the given name.
+// Included templates are named by their file path, so that errors point to the location in the included file.
+// The templates of the libraries are parsed first, so that the template can use and overwrite their named templates.
+func (te *TemplateExecution) execute(name, template string, binding interface{}) ([]byte, error) {
+	tmpl := gotmpl.New(name).
 		Funcs(LandscaperSprigFuncMap()).Funcs(te.funcMap).
-		Option("missingkey=zero").
-		Parse(template)
-	if err != nil {
-		parseError := TemplateErrorBuilder(err).WithSource(&template).Build()
-		return nil, parseError
+		Option("missingkey=zero")
+	for _, lib := range te.libraryTemplates {
+		if _, err := tmpl.New(lib.Path).Parse(string(lib.Content)); err != nil {
+			return nil, err
+		}
 	}
+	if _, err := tmpl.Parse(template); err != nil {
+		return nil, err
+	}
+	addLimitHooks(tmpl)
 
 	data := bytes.NewBuffer([]byte{})
-	if err := tmpl.Execute(data, binding); err != nil {
+	if err := tmpl.Execute(te.limiter.Writer(data), binding); err != nil {
 		return nil, err
 	}
 	return data.Bytes(), nil
 }
 
+// checkLimitsFuncName is the name of the function that is called by the limit hooks of go templates.
+const checkLimitsFuncName = "landscaperCheckLimits"
+
+// checkLimits stops the execution if it exceeded its timeout.
+// It renders nothing, so that the hooks do not change the output of the template.
+func (te *TemplateExecution) checkLimits() (string, error) {
+	return "", te.limiter.CheckTimeout()
+}
+
+// addLimitHooks adds a call of the limit check to the beginning of all templates and range bodies,
+// so that loops and recursive templates that do not write any output or call any other function
+// are stopped when the execution exceeds its timeout.
+func addLimitHooks(tmpl *gotmpl.Template) {
+	hooked := map[*parse.Tree]bool{}
+	for _, t := range tmpl.Templates() {
+		if t.Tree == nil || t.Tree.Root == nil || hooked[t.Tree] {
+			continue
+		}
+		hooked[t.Tree] = true
+		addLimitHooksToList(t.Tree, t.Tree.Root, true)
+	}
+}
+
+// addLimitHooksToList adds the limit hooks to the range bodies of a list of nodes
+// and to the beginning of the list itself if hook is true.
+func addLimitHooksToList(tree *parse.Tree, list *parse.ListNode, hook bool) {
+	if list == nil {
+		return
+	}
+	for _, node := range list.Nodes {
+		switch n := node.(type) {
+		case *parse.RangeNode:
+			addLimitHooksToList(tree, n.List, true)
+			addLimitHooksToList(tree, n.ElseList, false)
+		case *parse.IfNode:
+			addLimitHooksToList(tree, n.List, false)
+			addLimitHooksToList(tree, n.ElseList, false)
+		case *parse.WithNode:
+			addLimitHooksToList(tree, n.List, false)
+			addLimitHooksToList(tree, n.ElseList, false)
+		}
+	}
+	if hook {
+		list.Nodes = append([]parse.Node{newLimitHook(tree, list.Position())}, list.Nodes...)
+	}
+}
+
+// newLimitHook creates an action that calls the limit check.
+func newLimitHook(tree *parse.Tree, pos parse.Pos) parse.Node {
+	return &parse.ActionNode{
+		NodeType: parse.NodeAction,
+		Pos:      pos,
+		Pipe: &parse.PipeNode{
+			NodeType: parse.NodePipe,
+			Pos:      pos,
+			Cmds: []*parse.CommandNode{
+				{
+					NodeType: parse.NodeCommand,
+					Pos:      pos,
+					Args:     []parse.Node{parse.NewIdentifier(checkLimitsFuncName).SetTree(tree).SetPos(pos)},
+				},
+			},
+		},
+	}
+}
+
 // StateTemplateResult describes the result of go templating.
 type StateTemplateResult struct {
 	State json.RawMessage `json:"state"`
@@ -118,6 +228,7 @@ func (t Templater) Type() lsv1alpha1.TemplateType {
 }
 
 func (t *Templater) TemplateExecution(rawTemplate string,
+	libraries []*lstmpl.TemplateLibrary,
 	blueprint *blueprints.Blueprint,
 	cd model.ComponentVersion,
 	cdList *model.ComponentVersionList,
@@ -127,6 +238,10 @@ func (t *Templater) TemplateExecution(rawTemplate string,
 	if err != nil {
 		return nil, err
 	}
+	if err := te.WithLibraries(libraries); err != nil {
+		return nil, err
+	}
+	te.WithCredentials(lstmpl.NewCredentialStore(t.state))
 
 	return te.Execute(rawTemplate, values)
 }
@@ -146,19 +261,25 @@ func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.Templa
 
 	ctx := context.Background()
 	defer ctx.Done()
+	libraries, err := lstmpl.ResolveTemplateLibraries(ctx, tmplExec, cd)
+	if err != nil {
+		return nil, err
+	}
 	state, err := t.getDeployExecutionState(ctx, tmplExec)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load state: %w", err)
 	}
 
 	values["state"] = state
-	data, err := t.TemplateExecution(rawTemplate, blueprint, cd, cdList, values)
+	data, err := t.TemplateExecution(rawTemplate, libraries, blueprint, cd, cdList, values)
 	if err != nil {
 		executeError := TemplateErrorBuilder(err).WithSource(&rawTemplate).
+			WithExecution(templateName, tmplExec.Name, NewSourceMap(tmplExec, blueprint)).
 			WithInput(values, t.inputFormatter).
 			Build()
 		return nil, executeError
 	}
+	t.outputRecorder.Record(lstmpl.SubinstallationExecutionKind, tmplExec.Name, data)
 
 	if err := CreateErrorIfContainsNoValue(string(data), templateName, values, t.inputFormatter); err != nil {
 		return nil, err
@@ -181,6 +302,8 @@ func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	cdList *model.ComponentVersionList,
 	values map[string]interface{}) (*lstmpl.ImportExecutorOutput, error) {
 
+	const templateName = "import execution"
+
 	rawTemplate, err := getTemplateFromExecution(tmplExec, blueprint)
 	if err != nil {
 		return nil, err
@@ -188,14 +311,20 @@ func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 
 	ctx := context.Background()
 	defer ctx.Done()
+	libraries, err := lstmpl.ResolveTemplateLibraries(ctx, tmplExec, descriptor)
+	if err != nil {
+		return nil, err
+	}
 
-	data, err := t.TemplateExecution(rawTemplate, blueprint, descriptor, cdList, values)
+	data, err := t.TemplateExecution(rawTemplate, libraries, blueprint, descriptor, cdList, values)
 	if err != nil {
 		executeError := TemplateErrorBuilder(err).WithSource(&rawTemplate).
+			WithExecution(templateName, tmplExec.Name, NewSourceMap(tmplExec, blueprint)).
 			WithInput(values, t.inputFormatter).
 			Build()
 		return nil, executeError
 	}
+	t.outputRecorder.Record(lstmpl.ImportExecutionKind, tmplExec.Name, data)
 
 	output := &lstmpl.ImportExecutorOutput{}
 	if err := yaml.Unmarshal(data, output); err != nil {
@@ -220,19 +349,25 @@ func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecuto
 
 	ctx := context.Background()
 	defer ctx.Done()
+	libraries, err := lstmpl.ResolveTemplateLibraries(ctx, tmplExec, descriptor)
+	if err != nil {
+		return nil, err
+	}
 	state, err := t.getDeployExecutionState(ctx, tmplExec)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load state: %w", err)
 	}
 
 	values["state"] = state
-	data, err := t.TemplateExecution(rawTemplate, blueprint, descriptor, cdList, values)
+	data, err := t.TemplateExecution(rawTemplate, libraries, blueprint, descriptor, cdList, values)
 	if err != nil {
 		executeError := TemplateErrorBuilder(err).WithSource(&rawTemplate).
+			WithExecution(templateName, tmplExec.Name, NewSourceMap(tmplExec, blueprint)).
 			WithInput(values, t.inputFormatter).
 			Build()
 		return nil, executeError
 	}
+	t.outputRecorder.Record(lstmpl.DeployExecutionKind, tmplExec.Name, data)
 
 	if err := CreateErrorIfContainsNoValue(string(data), templateName, values, t.inputFormatter); err != nil {
 		return nil, err
@@ -262,19 +397,25 @@ func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 
 	ctx := context.Background()
 	defer ctx.Done()
+	libraries, err := lstmpl.ResolveTemplateLibraries(ctx, tmplExec, descriptor)
+	if err != nil {
+		return nil, err
+	}
 	state, err := t.getExportExecutionState(ctx, tmplExec)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load state: %w", err)
 	}
 
 	values["state"] = state
-	data, err := t.TemplateExecution(rawTemplate, blueprint, descriptor, cdList, values)
+	data, err := t.TemplateExecution(rawTemplate, libraries, blueprint, descriptor, cdList, values)
 	if err != nil {
 		executeError := TemplateErrorBuilder(err).WithSource(&rawTemplate).
+			WithExecution(templateName, tmplExec.Name, NewSourceMap(tmplExec, blueprint)).
 			WithInput(values, t.inputFormatter).
 			Build()
 		return nil, executeError
 	}
+	t.outputRecorder.Record(lstmpl.ExportExecutionKind, tmplExec.Name, data)
 
 	if err := CreateErrorIfContainsNoValue(string(data), templateName, values, t.inputFormatter); err != nil {
 		return nil, err
@@ -5,12 +5,19 @@
 package gotemplate_test
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
 	"github.com/mandelsoft/vfs/pkg/memoryfs"
 	"github.com/mandelsoft/vfs/pkg/vfs"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	lstmpl "github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 )
 
@@ -67,4 +74,304 @@ var _ = Describe("TemplateDeployExecutions", func() {
 		Expect(res).To(BeEquivalentTo("config:\n  value: foo\n  const: bar"))
 	})
 
+	It("should render a go template with named templates of a library", func() {
+		libFs := memoryfs.New()
+		Expect(libFs.MkdirAll("templates", 0700)).To(Succeed())
+		Expect(vfs.WriteFile(libFs, "templates/helpers.tpl", []byte(`{{ define "lib.name" }}lib-{{ .values.test }}{{ end }}
+{{ define "lib.labels" }}app: {{ template "lib.name" . }}{{ end }}`), 0600)).To(Succeed())
+		bp := blueprints.New(nil, memoryfs.New())
+		tmpl := `{{ define "lib.name" }}custom-{{ .values.test }}{{ end }}{{ template "lib.labels" . }}`
+		t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.WithLibraries([]*lstmpl.TemplateLibrary{{Name: "lib", Fs: libFs}})).To(Succeed())
+		values := map[string]interface{}{
+			"values": map[string]interface{}{
+				"test": "foo",
+			},
+		}
+		res, err := t.Execute(tmpl, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(BeEquivalentTo("app: custom-foo"))
+	})
+
+	Context("limits", func() {
+
+		AfterEach(func() {
+			lstmpl.SetLimits(lstmpl.DefaultLimits)
+		})
+
+		It("should fail if a template exceeds the timeout", func() {
+			lstmpl.SetLimits(lstmpl.Limits{Timeout: 50 * time.Millisecond})
+			bp := blueprints.New(nil, memoryfs.New())
+			t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = t.Execute(`{{ range $i := until 100000000 }}{{ end }}`, map[string]interface{}{})
+			Expect(err).To(HaveOccurred())
+			Expect(lstmpl.IsLimitExceededError(err)).To(BeTrue())
+			Expect(lstmpl.ErrorCodes(err)).To(ConsistOf(lsv1alpha1.ErrorConfigurationProblem))
+		})
+
+		It("should stop loops that neither write output nor call functions when the timeout is exceeded", func() {
+			lstmpl.SetLimits(lstmpl.Limits{Timeout: 50 * time.Millisecond})
+			bp := blueprints.New(nil, memoryfs.New())
+			t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			list := make([]interface{}, 100000)
+			start := time.Now()
+			_, err = t.Execute(`{{ range $i := .list }}{{ range $j := $.list }}{{ end }}{{ end }}`, map[string]interface{}{
+				"list": list,
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(lstmpl.IsLimitExceededError(err)).To(BeTrue())
+			Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+		})
+
+		It("should stop recursive templates when the timeout is exceeded", func() {
+			lstmpl.SetLimits(lstmpl.Limits{Timeout: 50 * time.Millisecond})
+			bp := blueprints.New(nil, memoryfs.New())
+			t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			start := time.Now()
+			_, err = t.Execute(`{{ define "loop" }}{{ template "loop" . }}{{ template "loop" . }}{{ end }}{{ template "loop" . }}`, map[string]interface{}{})
+			Expect(err).To(HaveOccurred())
+			Expect(lstmpl.IsLimitExceededError(err)).To(BeTrue())
+			Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+		})
+
+		It("should not render anything for the limit hooks", func() {
+			bp := blueprints.New(nil, memoryfs.New())
+			t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			res, err := t.Execute(`{{ define "item" }}<{{ . }}>{{ end }}{{ range $i := until 3 }}{{ template "item" $i }}{{ else }}empty{{ end }}`, map[string]interface{}{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(res)).To(Equal("<0><1><2>"))
+		})
+
+		It("should fail if the output of a template exceeds the maximum output size", func() {
+			lstmpl.SetLimits(lstmpl.Limits{MaxOutputSize: 10})
+			bp := blueprints.New(nil, memoryfs.New())
+			t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = t.Execute(`{{ repeat 20 "a" }}`, map[string]interface{}{})
+			Expect(err).To(HaveOccurred())
+			Expect(lstmpl.IsLimitExceededError(err)).To(BeTrue())
+		})
+
+		It("should fail if a file that is read by a template exceeds the maximum blob size", func() {
+			lstmpl.SetLimits(lstmpl.Limits{MaxBlobSize: 10})
+			fs := memoryfs.New()
+			Expect(vfs.WriteFile(fs, "data.txt", []byte("aaaaaaaaaaaaaaaaaaaa"), 0600)).To(Succeed())
+			bp := blueprints.New(nil, fs)
+			t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = t.Execute(`{{ readFile "data.txt" | toString }}`, map[string]interface{}{})
+			Expect(err).To(HaveOccurred())
+			Expect(lstmpl.IsLimitExceededError(err)).To(BeTrue())
+		})
+
+		It("should fail if includes are nested deeper than the maximum include depth", func() {
+			lstmpl.SetLimits(lstmpl.Limits{MaxIncludeDepth: 3})
+			fs := memoryfs.New()
+			Expect(vfs.WriteFile(fs, "recursive.tpl", []byte(`{{ include "recursive.tpl" . }}`), 0600)).To(Succeed())
+			bp := blueprints.New(nil, fs)
+			t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = t.Execute(`{{ include "recursive.tpl" . }}`, map[string]interface{}{})
+			Expect(err).To(HaveOccurred())
+			Expect(lstmpl.IsLimitExceededError(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("maximum include depth of 3"))
+		})
+
+	})
+
+	Context("target lookup", func() {
+
+		AfterEach(func() {
+			lstmpl.SetAllowedTargetResources(nil)
+		})
+
+		It("should not allow to read resources that are not in the allow-list", func() {
+			lstmpl.SetAllowedTargetResources([]lstmpl.TargetResource{{APIVersion: "v1", Kind: "ConfigMap"}})
+			bp := blueprints.New(nil, memoryfs.New())
+			t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = t.Execute(`{{ lookup "v1" "Secret" "default" "my-secret" .target }}`, map[string]interface{}{
+				"target": map[string]interface{}{},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`reading resources of api version "v1" and kind "Secret" from target clusters is not allowed`))
+		})
+
+		It("should allow all kinds of an api version with a wildcard", func() {
+			lstmpl.SetAllowedTargetResources([]lstmpl.TargetResource{{APIVersion: "apps/v1", Kind: lstmpl.AllKinds}})
+			Expect(lstmpl.IsTargetResourceAllowed("apps/v1", "Deployment", "default")).To(BeTrue())
+			Expect(lstmpl.IsTargetResourceAllowed("apps/v1", "StatefulSet", "default")).To(BeTrue())
+			Expect(lstmpl.IsTargetResourceAllowed("v1", "Secret", "default")).To(BeFalse())
+		})
+
+		It("should only allow the configured namespaces of a resource", func() {
+			lstmpl.SetAllowedTargetResources(lstmpl.AllowedTargetResourcesFromConfiguration(&config.TargetLookupConfiguration{
+				AllowedResources: []config.TargetLookupResource{
+					{APIVersion: "v1", Kind: "ConfigMap", Namespaces: []string{"kube-system"}},
+					{APIVersion: "v1", Kind: "Namespace"},
+				},
+			}))
+			Expect(lstmpl.IsTargetResourceAllowed("v1", "ConfigMap", "kube-system")).To(BeTrue())
+			Expect(lstmpl.IsTargetResourceAllowed("v1", "ConfigMap", "default")).To(BeFalse())
+			// lists of all namespaces are not allowed for resources that are restricted to namespaces
+			Expect(lstmpl.IsTargetResourceAllowed("v1", "ConfigMap", "")).To(BeFalse())
+			Expect(lstmpl.IsTargetResourceAllowed("v1", "Namespace", "")).To(BeTrue())
+
+			bp := blueprints.New(nil, memoryfs.New())
+			t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = t.Execute(`{{ lookup "v1" "ConfigMap" "default" "my-config" .target }}`, map[string]interface{}{
+				"target": map[string]interface{}{},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`(namespace "default")`))
+		})
+
+	})
+
+	Context("credentials", func() {
+
+		var (
+			bp    *blueprints.Blueprint
+			state lstmpl.MemoryStateHandler
+		)
+
+		BeforeEach(func() {
+			bp = blueprints.New(nil, memoryfs.New())
+			state = lstmpl.NewMemoryStateHandler()
+		})
+
+		It("should generate a password that is stable across executions", func() {
+			tmpl := `{{ generatePassword "db" 24 }}`
+			first, err := gotemplate.New(state, nil).TemplateExecution(tmpl, nil, bp, nil, nil, map[string]interface{}{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(first).To(HaveLen(24))
+
+			second, err := gotemplate.New(state, nil).TemplateExecution(tmpl, nil, bp, nil, nil, map[string]interface{}{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second).To(Equal(first))
+		})
+
+		It("should generate a certificate with subject alternative names that is signed by a generated ca", func() {
+			tmpl := `{{- $ca := generateCA "ca" "my-ca" 365 }}
+{{- $cert := generateCert "server" "my-server" (list "example.com" "10.0.0.1") 30 $ca }}
+{{- $cert.cert }}---{{ $cert.ca }}`
+			res, err := gotemplate.New(state, nil).TemplateExecution(tmpl, nil, bp, nil, nil, map[string]interface{}{})
+			Expect(err).ToNot(HaveOccurred())
+
+			certBlock, rest := pem.Decode(res)
+			Expect(certBlock).ToNot(BeNil())
+			cert, err := x509.ParseCertificate(certBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.Subject.CommonName).To(Equal("my-server"))
+			Expect(cert.DNSNames).To(ConsistOf("example.com"))
+			Expect(cert.IPAddresses).To(HaveLen(1))
+			Expect(cert.IPAddresses[0].String()).To(Equal("10.0.0.1"))
+
+			caBlock, _ := pem.Decode(rest[len("---"):])
+			Expect(caBlock).ToNot(BeNil())
+			ca, err := x509.ParseCertificate(caBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.CheckSignatureFrom(ca)).To(Succeed())
+		})
+
+		It("should rotate a credential once per value of the rotate credentials annotation", func() {
+			tmpl := `{{ generatePassword "db" 24 }}`
+			execute := func(rotation string) []byte {
+				res, err := gotemplate.New(rotatingStateHandler{MemoryStateHandler: state, rotation: rotation}, nil).
+					TemplateExecution(tmpl, nil, bp, nil, nil, map[string]interface{}{})
+				Expect(err).ToNot(HaveOccurred())
+				return res
+			}
+
+			initial := execute("")
+			rotated := execute("db")
+			Expect(rotated).ToNot(Equal(initial))
+			Expect(execute("db")).To(Equal(rotated))
+
+			Expect(execute("")).To(Equal(rotated))
+			Expect(execute("db")).ToNot(Equal(rotated))
+		})
+
+	})
+
+	Context("errors", func() {
+
+		const blueprintFile = `apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+
+deployExecutions:
+- name: deploy
+  type: GoTemplate
+  template: |
+    deployItems:
+    - name: my-item
+      type: landscaper.gardener.cloud/mock
+      config:
+        value: {{ .imports.foo.bar }}
+`
+
+		var (
+			fs     vfs.FileSystem
+			values map[string]interface{}
+		)
+
+		BeforeEach(func() {
+			fs = memoryfs.New()
+			Expect(vfs.WriteFile(fs, lsv1alpha1.BlueprintFileName, []byte(blueprintFile), 0600)).To(Succeed())
+			values = map[string]interface{}{
+				"imports": map[string]interface{}{
+					"baz": "val",
+				},
+			}
+		})
+
+		It("should report the location of an inline template in the blueprint file and the offending value", func() {
+			bp := blueprints.New(nil, fs)
+			tmplExec := lsv1alpha1.TemplateExecutor{
+				Name:     "deploy",
+				Type:     lsv1alpha1.GOTemplateType,
+				Template: lsv1alpha1.AnyJSON{RawMessage: []byte(`"deployItems:\n- name: my-item\n  type: landscaper.gardener.cloud/mock\n  config:\n    value: {{ .imports.foo.bar }}\n"`)},
+			}
+
+			_, err := gotemplate.New(nil, nil).TemplateDeployExecutions(tmplExec, bp, nil, nil, values)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix(`template "deploy execution" of execution "deploy" failed at blueprint.yaml:12:27: `))
+			Expect(err.Error()).To(ContainSubstring("12:           value: {{ .imports.foo.bar }}"))
+			Expect(err.Error()).To(ContainSubstring("offending value:\n\t.imports.foo is not defined in .imports"))
+		})
+
+		It("should report the location in an included template", func() {
+			Expect(fs.MkdirAll("templates", 0700)).To(Succeed())
+			Expect(vfs.WriteFile(fs, "templates/item.tpl", []byte("name: my-item\nvalue: {{ .imports.foo.bar }}\n"), 0600)).To(Succeed())
+			bp := blueprints.New(nil, fs)
+			tmplExec := lsv1alpha1.TemplateExecutor{
+				Name:     "deploy",
+				Type:     lsv1alpha1.GOTemplateType,
+				Template: lsv1alpha1.AnyJSON{RawMessage: []byte(`"deployItems:\n- {{ include \"templates/item.tpl\" . | indent 2 }}\n"`)},
+			}
+
+			_, err := gotemplate.New(nil, nil).TemplateDeployExecutions(tmplExec, bp, nil, nil, values)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix(`template "deploy execution" of execution "deploy" failed at templates/item.tpl:2:`))
+			Expect(err.Error()).To(ContainSubstring("2:    value: {{ .imports.foo.bar }}"))
+		})
+
+	})
+
 })
+
+// rotatingStateHandler is a memory state handler with a rotate credentials annotation.
+type rotatingStateHandler struct {
+	lstmpl.MemoryStateHandler
+	rotation string
+}
+
+func (s rotatingStateHandler) CredentialRotation() string {
+	return s.rotation
+}
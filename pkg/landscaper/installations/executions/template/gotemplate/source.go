@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gotemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+)
+
+const (
+	// executionTemplateName is the name of the go template of an execution.
+	// Templates that are included by an execution are named by their file path.
+	executionTemplateName = "execution"
+	// maxOffendingValueLength is the maximal length of the offending value that is printed in an error message.
+	maxOffendingValueLength = 512
+)
+
+var (
+	// templateLocationRegexp matches the locations in go template errors, e.g. "template: execution:12:4:"
+	templateLocationRegexp = regexp.MustCompile(`template: ([^:\s]+):([0-9]+)(:([0-9]+))?:`)
+	// templateNodeRegexp matches the node of a go template execution error, e.g. "at <.imports.foo.bar>:"
+	templateNodeRegexp = regexp.MustCompile(`at <([^>]*)>:`)
+	// fieldChainRegexp matches field chains like ".imports.foo" or "$.imports.foo" in a template node.
+	fieldChainRegexp = regexp.MustCompile(`\$?(\.[A-Za-z_][A-Za-z0-9_]*)+`)
+)
+
+// SourceMap maps the lines of the template of an execution to the blueprint file that defines the template.
+type SourceMap struct {
+	// File is the path of the file in the blueprint that contains the template.
+	File string
+	// LineOffset is the number of lines in the file before the first line of the template.
+	LineOffset int
+	// ColumnOffset is the indentation of the template in the file.
+	ColumnOffset int
+
+	fs vfs.FileSystem
+}
+
+// NewSourceMap creates a source map for the template of the given execution.
+// If an inline template cannot be located in the blueprint file, the file of the source map is empty
+// and lines are reported relative to the inline template.
+func NewSourceMap(tmplExec lsv1alpha1.TemplateExecutor, blueprint *blueprints.Blueprint) *SourceMap {
+	if blueprint == nil || blueprint.Fs == nil {
+		return nil
+	}
+	if len(tmplExec.File) != 0 {
+		return &SourceMap{File: tmplExec.File, fs: blueprint.Fs}
+	}
+
+	inline := &SourceMap{fs: blueprint.Fs}
+	var rawTemplate string
+	if err := json.Unmarshal(tmplExec.Template.RawMessage, &rawTemplate); err != nil {
+		return inline
+	}
+	data, err := vfs.ReadFile(blueprint.Fs, lsv1alpha1.BlueprintFileName)
+	if err != nil {
+		return inline
+	}
+	line, column, ok := findTemplate(strings.Split(string(data), "\n"), strings.Split(strings.TrimRight(rawTemplate, "\n"), "\n"))
+	if !ok {
+		return inline
+	}
+	return &SourceMap{
+		File:         lsv1alpha1.BlueprintFileName,
+		LineOffset:   line,
+		ColumnOffset: column,
+		fs:           blueprint.Fs,
+	}
+}
+
+// findTemplate searches the lines of an inline template in the lines of a file.
+// The line offset and the indentation of the first match are returned.
+func findTemplate(fileLines, templateLines []string) (int, int, bool) {
+	if len(templateLines) == 0 || len(strings.TrimSpace(templateLines[0])) == 0 {
+		return 0, 0, false
+	}
+	for i := 0; i+len(templateLines) <= len(fileLines); i++ {
+		indent := strings.Index(fileLines[i], templateLines[0])
+		if indent < 0 || len(strings.TrimSpace(fileLines[i][:indent])) != 0 || len(fileLines[i]) != indent+len(templateLines[0]) {
+			continue
+		}
+		prefix := fileLines[i][:indent]
+		match := true
+		for j, line := range templateLines {
+			fileLine := fileLines[i+j]
+			if len(line) == 0 {
+				if len(strings.TrimSpace(fileLine)) != 0 {
+					match = false
+					break
+				}
+				continue
+			}
+			if fileLine != prefix+line {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i, indent, true
+		}
+	}
+	return 0, 0, false
+}
+
+// templateLocation describes a location in a go template.
+type templateLocation struct {
+	name   string
+	line   int
+	column int
+}
+
+// innermostTemplateLocation returns the location of the innermost template of a go template error.
+// The error of a template that is included by another template contains the location of the include
+// as well as the location in the included template, where the latter is the one that caused the error.
+func innermostTemplateLocation(errStr string) (*templateLocation, bool) {
+	matches := templateLocationRegexp.FindAllStringSubmatch(errStr, -1)
+	if len(matches) == 0 {
+		return nil, false
+	}
+	m := matches[len(matches)-1]
+	loc := &templateLocation{name: m[1]}
+	var err error
+	if loc.line, err = strconv.Atoi(m[2]); err != nil {
+		return nil, false
+	}
+	if len(m[4]) != 0 {
+		if loc.column, err = strconv.Atoi(m[4]); err != nil {
+			loc.column = 0
+		}
+	}
+	return loc, true
+}
+
+// resolve returns the file, line and column of a template location in the blueprint
+// together with the lines of the file.
+func (m *SourceMap) resolve(loc *templateLocation, source *string) (string, int, int, []string) {
+	if loc.name != executionTemplateName {
+		// included templates are referenced by their path in the blueprint
+		data, err := vfs.ReadFile(m.fs, loc.name)
+		if err != nil {
+			return loc.name, loc.line, loc.column, nil
+		}
+		return loc.name, loc.line, loc.column, strings.Split(string(data), "\n")
+	}
+
+	if len(m.File) == 0 || (m.LineOffset == 0 && m.ColumnOffset == 0 && source != nil) {
+		if source == nil {
+			return m.File, loc.line, loc.column, nil
+		}
+		return m.File, loc.line, loc.column, strings.Split(*source, "\n")
+	}
+	data, err := vfs.ReadFile(m.fs, m.File)
+	if err != nil {
+		return m.File, loc.line + m.LineOffset, loc.column + m.ColumnOffset, nil
+	}
+	return m.File, loc.line + m.LineOffset, loc.column + m.ColumnOffset, strings.Split(string(data), "\n")
+}
+
+// formatOffendingValue returns the value of the field chain of the template node that caused a go template error.
+// The value is resolved against the template input as far as possible, so that the message shows
+// which part of the chain is not defined.
+func formatOffendingValue(errStr string, input map[string]interface{}) string {
+	nodes := templateNodeRegexp.FindAllStringSubmatch(errStr, -1)
+	if len(nodes) == 0 {
+		return ""
+	}
+	chain := fieldChainRegexp.FindString(nodes[len(nodes)-1][1])
+	if len(chain) == 0 {
+		return ""
+	}
+
+	fields := strings.Split(strings.TrimPrefix(strings.TrimPrefix(chain, "$"), "."), ".")
+	var (
+		current  interface{} = input
+		resolved             = ""
+	)
+	for _, field := range fields {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return fmt.Sprintf("%s is not an object but %s\n", displayPath(resolved), truncateValue(current))
+		}
+		next, ok := m[field]
+		if !ok {
+			return fmt.Sprintf("%s is not defined in %s\n", displayPath(resolved+"."+field), displayPath(resolved))
+		}
+		current = next
+		resolved += "." + field
+	}
+	return fmt.Sprintf("%s: %s\n", displayPath(resolved), truncateValue(current))
+}
+
+func displayPath(path string) string {
+	if len(path) == 0 {
+		return "."
+	}
+	return path
+}
+
+func truncateValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	if len(data) > maxOffendingValueLength {
+		return string(data[:maxOffendingValueLength]) + "..."
+	}
+	return string(data)
+}
@@ -5,6 +5,7 @@
 package gotemplate
 
 import (
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -22,6 +23,9 @@ type TemplateError struct {
 	source         *string
 	input          map[string]interface{}
 	inputFormatter *template.TemplateInputFormatter
+	templateName   string
+	executionName  string
+	sourceMap      *SourceMap
 	message        string
 }
 
@@ -39,6 +43,15 @@ func (e *TemplateError) WithSource(source *string) *TemplateError {
 	return e
 }
 
+// WithExecution adds the name of the template kind and of the execution as well as the source map of the execution to the error.
+// With a source map, the error location is reported as location in the blueprint file that defines the template.
+func (e *TemplateError) WithExecution(templateName, executionName string, sourceMap *SourceMap) *TemplateError {
+	e.templateName = templateName
+	e.executionName = executionName
+	e.sourceMap = sourceMap
+	return e
+}
+
 // WithInput adds the template input with a formatter to the error.
 func (e *TemplateError) WithInput(input map[string]interface{}, inputFormatter *template.TemplateInputFormatter) *TemplateError {
 	e.input = input
@@ -49,13 +62,28 @@ func (e *TemplateError) WithInput(input map[string]interface{}, inputFormatter *
 // Build builds the error message.
 func (e *TemplateError) Build() *TemplateError {
 	builder := strings.Builder{}
+	if len(e.templateName) != 0 {
+		builder.WriteString(e.formatHeader())
+	}
 	builder.WriteString(e.err.Error())
 
-	if e.source != nil {
+	if e.sourceMap != nil {
+		if source := e.formatMappedSource(); len(source) != 0 {
+			builder.WriteString("\ntemplate source:\n")
+			builder.WriteString(source)
+		}
+	} else if e.source != nil {
 		builder.WriteString("\ntemplate source:\n")
 		builder.WriteString(e.formatSource())
 	}
 
+	if e.input != nil {
+		if value := formatOffendingValue(e.err.Error(), e.input); len(value) != 0 {
+			builder.WriteString("\noffending value:\n\t")
+			builder.WriteString(value)
+		}
+	}
+
 	if e.input != nil && e.inputFormatter != nil {
 		builder.WriteString("\ntemplate input:\n")
 		builder.WriteString(e.inputFormatter.Format(e.input, "\t"))
@@ -70,6 +98,11 @@ func (e *TemplateError) Error() string {
 	return e.message
 }
 
+// Unwrap returns the wrapped templating error.
+func (e *TemplateError) Unwrap() error {
+	return e.err
+}
+
 // formatSource extracts the significant template source code that was the reason of the template error.
 func (e *TemplateError) formatSource() string {
 	var (
@@ -104,3 +137,36 @@ func (e *TemplateError) formatSource() string {
 	formatted.WriteString(CreateSourceSnippet(errorLine, errorColumn, strings.Split(*e.source, "\n")))
 	return formatted.String()
 }
+
+// formatHeader describes the template and the location that caused the error.
+func (e *TemplateError) formatHeader() string {
+	header := fmt.Sprintf("template %q", e.templateName)
+	if len(e.executionName) != 0 {
+		header += fmt.Sprintf(" of execution %q", e.executionName)
+	}
+	loc, ok := innermostTemplateLocation(e.err.Error())
+	switch {
+	case !ok:
+	case e.sourceMap != nil && (len(e.sourceMap.File) != 0 || loc.name != executionTemplateName):
+		file, line, column, _ := e.sourceMap.resolve(loc, e.source)
+		header += fmt.Sprintf(" failed at %s:%d:%d", file, line, column+1)
+	case loc.name != executionTemplateName:
+		header += fmt.Sprintf(" failed at %s:%d:%d", loc.name, loc.line, loc.column+1)
+	default:
+		header += fmt.Sprintf(" failed at line %d:%d of the inline template", loc.line, loc.column+1)
+	}
+	return header + ": "
+}
+
+// formatMappedSource extracts the source code of the blueprint file that caused the template error.
+func (e *TemplateError) formatMappedSource() string {
+	loc, ok := innermostTemplateLocation(e.err.Error())
+	if !ok {
+		return ""
+	}
+	_, line, column, lines := e.sourceMap.resolve(loc, e.source)
+	if len(lines) == 0 || line < 1 || line > len(lines) {
+		return ""
+	}
+	return CreateSourceSnippet(line, column, lines)
+}
@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/go-jsonnet"
+	"github.com/google/go-jsonnet/ast"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/common"
+	"github.com/gardener/landscaper/pkg/utils/clusters"
+)
+
+// LandscaperNativeFuncs returns all landscaper functions that are available in jsonnet templates
+// via std.native, e.g. std.native("getResource")(cd, {name: "my-resource"}).
+func LandscaperNativeFuncs(blueprint *blueprints.Blueprint,
+	componentVersion model.ComponentVersion,
+	componentVersions *model.ComponentVersionList,
+	targetResolver targetresolver.TargetResolver) ([]*jsonnet.NativeFunction, error) {
+
+	ocmSchemaVersion := common.DetermineOCMSchemaVersion(blueprint, componentVersion)
+
+	cd, err := model.GetComponentDescriptor(componentVersion)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get component descriptor to register jsonnet functions: %w", err)
+	}
+
+	cdList, err := model.ConvertComponentVersionList(componentVersions)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert component descriptor list to register jsonnet functions: %w", err)
+	}
+
+	return []*jsonnet.NativeFunction{
+		nativeFunc("getResource", ast.Identifiers{"cd", "selector"}, getResourceFunc(cd)),
+		nativeFunc("getComponent", ast.Identifiers{"cd", "selector"}, getComponentFunc(cd, cdList, ocmSchemaVersion)),
+		nativeFunc("parseOCIRef", ast.Identifiers{"ref"}, parseOCIReference),
+		nativeFunc("ociRefRepo", ast.Identifiers{"ref"}, getOCIReferenceRepository),
+		nativeFunc("ociRefVersion", ast.Identifiers{"ref"}, getOCIReferenceVersion),
+		nativeFunc("getShootAdminKubeconfig", ast.Identifiers{"shootName", "shootNamespace", "expirationSeconds", "target"},
+			getShootAdminKubeconfigFunc(targetResolver, false)),
+		nativeFunc("getShootAdminKubeconfigWithExpirationTimestamp", ast.Identifiers{"shootName", "shootNamespace", "expirationSeconds", "target"},
+			getShootAdminKubeconfigFunc(targetResolver, true)),
+		nativeFunc("getServiceAccountKubeconfig", ast.Identifiers{"serviceAccountName", "serviceAccountNamespace", "expirationSeconds", "target"},
+			getServiceAccountKubeconfigFunc(targetResolver, false)),
+		nativeFunc("getServiceAccountKubeconfigWithExpirationTimestamp", ast.Identifiers{"serviceAccountName", "serviceAccountNamespace", "expirationSeconds", "target"},
+			getServiceAccountKubeconfigFunc(targetResolver, true)),
+		nativeFunc("getOidcKubeconfig", ast.Identifiers{"issuerURL", "clientID", "target"}, getOidcKubeconfigFunc(targetResolver)),
+	}, nil
+}
+
+// nativeFunc creates a jsonnet native function.
+// The result of the function is converted to its generic json representation as jsonnet only accepts json types.
+func nativeFunc(name string, params ast.Identifiers, fn func(args []interface{}) (interface{}, error)) *jsonnet.NativeFunction {
+	return &jsonnet.NativeFunction{
+		Name:   name,
+		Params: params,
+		Func: func(args []interface{}) (interface{}, error) {
+			res, err := fn(args)
+			if err != nil {
+				return nil, err
+			}
+			data, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("unable to marshal result of %s: %w", name, err)
+			}
+			var val interface{}
+			if err := json.Unmarshal(data, &val); err != nil {
+				return nil, fmt.Errorf("unable to unmarshal result of %s: %w", name, err)
+			}
+			return val, nil
+		},
+	}
+}
+
+// selectorArgs converts a component descriptor and a selector object into the argument list
+// expected by the resolve helpers: the component descriptor followed by sorted key value pairs.
+func selectorArgs(cd interface{}, selector interface{}) ([]interface{}, error) {
+	sel, ok := selector.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("the selector has to be an object")
+	}
+	keys := make([]string, 0, len(sel))
+	for k := range sel {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	args := []interface{}{}
+	if cd != nil {
+		args = append(args, cd)
+	}
+	for _, k := range keys {
+		args = append(args, k, sel[k])
+	}
+	return args, nil
+}
+
+func getResourceFunc(cd *types.ComponentDescriptor) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		if cd == nil {
+			return nil, errors.New("unable to search for a resource as no component descriptor is defined")
+		}
+		resolveArgs, err := selectorArgs(args[0], args[1])
+		if err != nil {
+			return nil, err
+		}
+		resources, err := template.ResolveResources(cd, resolveArgs)
+		if err != nil {
+			return nil, err
+		}
+		if len(resources) == 0 {
+			return nil, errors.New("no resource found")
+		}
+		return resources[0], nil
+	}
+}
+
+func getComponentFunc(cd *types.ComponentDescriptor, list *types.ComponentDescriptorList, ocmSchemaVersion string) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		if cd == nil {
+			return nil, errors.New("unable to search for a component as no component descriptor is defined")
+		}
+		resolveArgs, err := selectorArgs(args[0], args[1])
+		if err != nil {
+			return nil, err
+		}
+		components, err := template.ResolveComponents(cd, list, ocmSchemaVersion, resolveArgs)
+		if err != nil {
+			return nil, err
+		}
+		if len(components) == 0 {
+			return nil, errors.New("no component found")
+		}
+		return components[0], nil
+	}
+}
+
+func parseOCIReference(args []interface{}) (interface{}, error) {
+	ref, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("the oci reference has to be a string")
+	}
+	return template.ParseOCIReference(ref), nil
+}
+
+func getOCIReferenceRepository(args []interface{}) (interface{}, error) {
+	ref, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("the oci reference has to be a string")
+	}
+	return template.ParseOCIReference(ref)[0], nil
+}
+
+func getOCIReferenceVersion(args []interface{}) (interface{}, error) {
+	ref, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("the oci reference has to be a string")
+	}
+	return template.ParseOCIReference(ref)[1], nil
+}
+
+func getShootAdminKubeconfigFunc(targetResolver targetresolver.TargetResolver, includeExpirationTimestamp bool) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		shootName, shootNamespace, expirationSeconds, target, err := kubeconfigArgs("getShootAdminKubeconfig", args)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx := context.Background()
+		shootClient, err := clusters.NewShootClientFromTarget(ctx, target, targetResolver)
+		if err != nil {
+			return nil, err
+		}
+		kcfg, expirationTimestamp, err := shootClient.GetShootAdminKubeconfig(ctx, shootName, shootNamespace, expirationSeconds)
+		if err != nil {
+			return nil, err
+		}
+
+		if includeExpirationTimestamp {
+			return kubeconfigWithExpirationTimestamp(kcfg, expirationTimestamp), nil
+		}
+		return kcfg, nil
+	}
+}
+
+func getServiceAccountKubeconfigFunc(targetResolver targetresolver.TargetResolver, includeExpirationTimestamp bool) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		name, namespace, expirationSeconds, target, err := kubeconfigArgs("getServiceAccountKubeconfig", args)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx := context.Background()
+		tokenClient, err := clusters.NewTokenClientFromTarget(ctx, target, targetResolver)
+		if err != nil {
+			return nil, err
+		}
+		kcfg, expirationTimestamp, err := tokenClient.GetServiceAccountKubeconfig(ctx, name, namespace, expirationSeconds)
+		if err != nil {
+			return nil, err
+		}
+
+		if includeExpirationTimestamp {
+			return kubeconfigWithExpirationTimestamp(kcfg, expirationTimestamp), nil
+		}
+		return kcfg, nil
+	}
+}
+
+func getOidcKubeconfigFunc(targetResolver targetresolver.TargetResolver) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		issuerURL, ok := args[0].(string)
+		if !ok {
+			return nil, errors.New("getOidcKubeconfig expects a string as 1st argument, namely the issuer url")
+		}
+		clientID, ok := args[1].(string)
+		if !ok {
+			return nil, errors.New("getOidcKubeconfig expects a string as 2nd argument, namely the client id")
+		}
+		target, err := toTarget(args[2])
+		if err != nil {
+			return nil, fmt.Errorf("getOidcKubeconfig expects a target object as 3rd argument: %w", err)
+		}
+		return clusters.BuildOIDCKubeconfig(context.Background(), issuerURL, clientID, target, targetResolver)
+	}
+}
+
+// kubeconfigArgs parses the common arguments of the kubeconfig functions:
+// a name, a namespace, the expiration seconds and a target.
+func kubeconfigArgs(funcName string, args []interface{}) (string, string, int64, *lsv1alpha1.Target, error) {
+	name, ok := args[0].(string)
+	if !ok {
+		return "", "", 0, nil, fmt.Errorf("%s expects a string as 1st argument, namely the name", funcName)
+	}
+	namespace, ok := args[1].(string)
+	if !ok {
+		return "", "", 0, nil, fmt.Errorf("%s expects a string as 2nd argument, namely the namespace", funcName)
+	}
+	// jsonnet numbers are always passed as float64
+	expirationSeconds, ok := args[2].(float64)
+	if !ok {
+		return "", "", 0, nil, fmt.Errorf("%s expects a number as 3rd argument, namely the expiration seconds", funcName)
+	}
+	target, err := toTarget(args[3])
+	if err != nil {
+		return "", "", 0, nil, fmt.Errorf("%s expects a target object as 4th argument: %w", funcName, err)
+	}
+	return name, namespace, int64(expirationSeconds), target, nil
+}
+
+func toTarget(obj interface{}) (*lsv1alpha1.Target, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error during marshaling: %w", err)
+	}
+	target := &lsv1alpha1.Target{}
+	if err := json.Unmarshal(data, target); err != nil {
+		return nil, fmt.Errorf("error during unmarshaling: %w", err)
+	}
+	return target, nil
+}
+
+func kubeconfigWithExpirationTimestamp(kcfg string, expirationTimestamp metav1.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"kubeconfig":                  kcfg,
+		"expirationTimestamp":         expirationTimestamp.Unix(),
+		"expirationTimestampReadable": expirationTimestamp.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/go-jsonnet"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+)
+
+// blueprintImporter resolves jsonnet imports against the filesystem of a blueprint.
+// Relative imports are resolved against the directory of the importing file,
+// absolute imports against the root of the blueprint.
+// Imports of the form "libraries/<library name>/<file path>" are resolved against the filesystem of the template library.
+type blueprintImporter struct {
+	fs        vfs.FileSystem
+	libraries map[string]vfs.FileSystem
+	cache     map[string]jsonnet.Contents
+}
+
+var _ jsonnet.Importer = &blueprintImporter{}
+
+func newBlueprintImporter(fs vfs.FileSystem, libraries []*template.TemplateLibrary) *blueprintImporter {
+	i := &blueprintImporter{
+		fs:        fs,
+		libraries: map[string]vfs.FileSystem{},
+		cache:     map[string]jsonnet.Contents{},
+	}
+	for _, lib := range libraries {
+		i.libraries[lib.Name] = lib.Fs
+	}
+	return i
+}
+
+// Import implements the jsonnet importer interface.
+func (i *blueprintImporter) Import(importedFrom, importedPath string) (jsonnet.Contents, string, error) {
+	foundAt := importedPath
+	if !path.IsAbs(importedPath) {
+		foundAt = path.Join(path.Dir(importedFrom), importedPath)
+	}
+	foundAt = path.Clean("/" + foundAt)
+
+	if contents, ok := i.cache[foundAt]; ok {
+		return contents, foundAt, nil
+	}
+	data, err := i.read(foundAt)
+	if err != nil {
+		return jsonnet.Contents{}, "", fmt.Errorf("unable to import %q: %w", importedPath, err)
+	}
+	contents := jsonnet.MakeContentsRaw(data)
+	i.cache[foundAt] = contents
+	return contents, foundAt, nil
+}
+
+// read reads a file of the blueprint or of a template library.
+func (i *blueprintImporter) read(filePath string) ([]byte, error) {
+	parts := strings.SplitN(strings.TrimPrefix(filePath, "/"), "/", 3)
+	if len(parts) == 3 && parts[0] == template.LibrariesPathPrefix {
+		if fs, ok := i.libraries[parts[1]]; ok {
+			return vfs.ReadFile(fs, "/"+parts[2])
+		}
+	}
+	return vfs.ReadFile(i.fs, filePath)
+}
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/google/go-jsonnet"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+)
+
+// Templater describes the jsonnet template implementation for execution templater.
+//
+// All template input values (e.g. imports, cd, components and state) are available as external variables,
+// e.g. std.extVar("imports"). Imports are resolved against the filesystem of the blueprint.
+type Templater struct {
+	state          template.GenericStateHandler
+	inputFormatter *template.TemplateInputFormatter
+	targetResolver targetresolver.TargetResolver
+	outputRecorder *template.RenderedOutputRecorder
+}
+
+// New creates a new jsonnet execution templater.
+func New(state template.GenericStateHandler, targetResolver targetresolver.TargetResolver) *Templater {
+	return &Templater{
+		state:          state,
+		inputFormatter: template.NewTemplateInputFormatter(false, "imports", "values", "state"),
+		targetResolver: targetResolver,
+	}
+}
+
+// WithInputFormatter ads a custom input formatter to this templater used for error messages.
+func (t *Templater) WithInputFormatter(inputFormatter *template.TemplateInputFormatter) *Templater {
+	t.inputFormatter = inputFormatter
+	return t
+}
+
+// SetRenderedOutputRecorder sets the recorder that captures the raw rendered output of all executions.
+func (t *Templater) SetRenderedOutputRecorder(recorder *template.RenderedOutputRecorder) {
+	t.outputRecorder = recorder
+}
+
+func (t Templater) Type() lsv1alpha1.TemplateType {
+	return lsv1alpha1.JsonnetTemplateType
+}
+
+func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	descriptor model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*template.ImportExecutorOutput, error) {
+
+	ctx := context.Background()
+	defer ctx.Done()
+	libraries, err := template.ResolveTemplateLibraries(ctx, tmplExec, descriptor)
+	if err != nil {
+		return nil, err
+	}
+	data, err := t.TemplateExecution(tmplExec, libraries, blueprint, descriptor, cdList, values)
+	if err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.ImportExecutionKind, tmplExec.Name, data)
+
+	output := &template.ImportExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	cd model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*template.SubinstallationExecutorOutput, error) {
+
+	ctx := context.Background()
+	defer ctx.Done()
+	libraries, err := template.ResolveTemplateLibraries(ctx, tmplExec, cd)
+	if err != nil {
+		return nil, err
+	}
+	state, err := template.GetExecutionState(ctx, t.state, "deploy", tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values["state"] = state
+	data, err := t.TemplateExecution(tmplExec, libraries, blueprint, cd, cdList, values)
+	if err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.SubinstallationExecutionKind, tmplExec.Name, data)
+
+	if err := template.StoreExecutionState(ctx, t.state, "deploy", tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+
+	output := &template.SubinstallationExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	descriptor model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*template.DeployExecutorOutput, error) {
+
+	ctx := context.Background()
+	defer ctx.Done()
+	libraries, err := template.ResolveTemplateLibraries(ctx, tmplExec, descriptor)
+	if err != nil {
+		return nil, err
+	}
+	state, err := template.GetExecutionState(ctx, t.state, "deploy", tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values["state"] = state
+	data, err := t.TemplateExecution(tmplExec, libraries, blueprint, descriptor, cdList, values)
+	if err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.DeployExecutionKind, tmplExec.Name, data)
+
+	if err := template.StoreExecutionState(ctx, t.state, "deploy", tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+
+	output := &template.DeployExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	descriptor model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*template.ExportExecutorOutput, error) {
+
+	ctx := context.Background()
+	defer ctx.Done()
+	libraries, err := template.ResolveTemplateLibraries(ctx, tmplExec, descriptor)
+	if err != nil {
+		return nil, err
+	}
+	state, err := template.GetExecutionState(ctx, t.state, "export", tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values["state"] = state
+	data, err := t.TemplateExecution(tmplExec, libraries, blueprint, descriptor, cdList, values)
+	if err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.ExportExecutionKind, tmplExec.Name, data)
+
+	if err := template.StoreExecutionState(ctx, t.state, "export", tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+
+	output := &template.ExportExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// TemplateExecution evaluates the jsonnet template of an execution and returns the resulting json document.
+// The files of the given template libraries can be imported with the path "libraries/<library name>/<file path>".
+func (t *Templater) TemplateExecution(tmplExec lsv1alpha1.TemplateExecutor,
+	libraries []*template.TemplateLibrary,
+	blueprint *blueprints.Blueprint,
+	cd model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) ([]byte, error) {
+
+	filename, rawTemplate, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	vm := jsonnet.MakeVM()
+	vm.Importer(newBlueprintImporter(blueprint.Fs, libraries))
+
+	funcs, err := LandscaperNativeFuncs(blueprint, cd, cdList, t.targetResolver)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range funcs {
+		vm.NativeFunction(f)
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		data, err := json.Marshal(values[k])
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal template input %q: %w", k, err)
+		}
+		vm.ExtCode(k, string(data))
+	}
+
+	res, err := vm.EvaluateSnippet(filename, rawTemplate)
+	if err != nil {
+		return nil, TemplateErrorBuilder(err).WithInput(values, t.inputFormatter).Build()
+	}
+	return []byte(res), nil
+}
+
+// getTemplateFromExecution returns the filename that is used to resolve relative imports and the jsonnet source of an execution.
+// An inline template is expected to be a string that contains the jsonnet source.
+func getTemplateFromExecution(tmplExec lsv1alpha1.TemplateExecutor, blueprint *blueprints.Blueprint) (string, string, error) {
+	if len(tmplExec.Template.RawMessage) != 0 {
+		var rawTemplate string
+		if err := json.Unmarshal(tmplExec.Template.RawMessage, &rawTemplate); err != nil {
+			return "", "", fmt.Errorf("a jsonnet template has to be a string: %w", err)
+		}
+		return tmplExec.Name + ".jsonnet", rawTemplate, nil
+	}
+	if len(tmplExec.File) != 0 {
+		rawTemplateBytes, err := vfs.ReadFile(blueprint.Fs, tmplExec.File)
+		if err != nil {
+			return "", "", err
+		}
+		return tmplExec.File, string(rawTemplateBytes), nil
+	}
+	return "", "", fmt.Errorf("no template found")
+}
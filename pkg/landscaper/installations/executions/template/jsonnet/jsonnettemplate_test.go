@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
+)
+
+type memoryStateHandler map[string][]byte
+
+func (s memoryStateHandler) Store(_ context.Context, name string, data []byte) error {
+	s[name] = data
+	return nil
+}
+
+func (s memoryStateHandler) Get(_ context.Context, name string) ([]byte, error) {
+	data, ok := s[name]
+	if !ok {
+		return nil, template.StateNotFoundErr
+	}
+	return data, nil
+}
+
+func inlineExecution(name, source string) lsv1alpha1.TemplateExecutor {
+	raw, err := json.Marshal(source)
+	Expect(err).ToNot(HaveOccurred())
+	return lsv1alpha1.TemplateExecutor{
+		Name:     name,
+		Type:     lsv1alpha1.JsonnetTemplateType,
+		Template: lsv1alpha1.AnyJSON{RawMessage: raw},
+	}
+}
+
+var _ = Describe("Jsonnet Templater", func() {
+
+	var bp *blueprints.Blueprint
+
+	BeforeEach(func() {
+		bp = blueprints.New(nil, memoryfs.New())
+	})
+
+	It("should template deploy items from the imports", func() {
+		exec := inlineExecution("deploy", `
+local imports = std.extVar("imports");
+{
+  deployItems: [{
+    name: "app",
+    type: "landscaper.gardener.cloud/mock",
+    config: { replicas: imports.replicas * 2 },
+  }],
+}
+`)
+		values := map[string]interface{}{
+			"imports": map[string]interface{}{"replicas": 2},
+		}
+		out, err := jsonnet.New(nil, nil).TemplateDeployExecutions(exec, bp, nil, nil, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.DeployItems).To(HaveLen(1))
+		Expect(out.DeployItems[0].Name).To(Equal("app"))
+		Expect(string(out.DeployItems[0].Configuration.Raw)).To(MatchJSON(`{"replicas": 4}`))
+	})
+
+	It("should resolve imports against the blueprint filesystem", func() {
+		Expect(bp.Fs.MkdirAll("templates/lib", 0755)).To(Succeed())
+		Expect(vfs.WriteFile(bp.Fs, "templates/lib/url.libsonnet", []byte(`{ url(host):: "https://" + host }`), 0600)).To(Succeed())
+		Expect(vfs.WriteFile(bp.Fs, "templates/export.jsonnet", []byte(`
+local lib = import "lib/url.libsonnet";
+{ exports: { url: lib.url(std.extVar("values").deployitems.app.host) } }
+`), 0600)).To(Succeed())
+
+		exec := lsv1alpha1.TemplateExecutor{
+			Name: "export",
+			Type: lsv1alpha1.JsonnetTemplateType,
+			File: "templates/export.jsonnet",
+		}
+		values := map[string]interface{}{
+			"values": map[string]interface{}{
+				"deployitems": map[string]interface{}{
+					"app": map[string]interface{}{"host": "example.com"},
+				},
+			},
+		}
+		out, err := jsonnet.New(nil, nil).TemplateExportExecutions(exec, bp, nil, nil, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.Exports).To(Equal(map[string]interface{}{"url": "https://example.com"}))
+	})
+
+	It("should resolve imports of template libraries", func() {
+		libFs := memoryfs.New()
+		Expect(libFs.MkdirAll("lib", 0755)).To(Succeed())
+		Expect(vfs.WriteFile(libFs, "lib/url.libsonnet", []byte(`local scheme = import "scheme.libsonnet"; { url(host):: scheme + host }`), 0600)).To(Succeed())
+		Expect(vfs.WriteFile(libFs, "lib/scheme.libsonnet", []byte(`"https://"`), 0600)).To(Succeed())
+		libraries := []*template.TemplateLibrary{{Name: "helpers", Fs: libFs}}
+
+		exec := inlineExecution("export", `
+local lib = import "libraries/helpers/lib/url.libsonnet";
+{ exports: { url: lib.url("example.com") } }
+`)
+		data, err := jsonnet.New(nil, nil).TemplateExecution(exec, libraries, bp, nil, nil, map[string]interface{}{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"exports": {"url": "https://example.com"}}`))
+
+		exec = inlineExecution("export", `import "libraries/unknown/lib/url.libsonnet"`)
+		_, err = jsonnet.New(nil, nil).TemplateExecution(exec, libraries, bp, nil, nil, map[string]interface{}{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should provide the landscaper functions as native functions", func() {
+		exec := inlineExecution("import", `
+{ bindings: { repo: std.native("ociRefRepo")("example.com/app:1.0.0") } }
+`)
+		out, err := jsonnet.New(nil, nil).TemplateImportExecutions(exec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.Bindings).To(HaveKeyWithValue("repo", "example.com/app"))
+	})
+
+	It("should store and provide the state of a deploy execution", func() {
+		state := memoryStateHandler{}
+		exec := inlineExecution("deploy", `
+local state = std.extVar("state");
+local counter = if std.objectHas(state, "counter") then state.counter + 1 else 1;
+{ deployItems: [], state: { counter: counter } }
+`)
+		templater := jsonnet.New(state, nil)
+		_, err := templater.TemplateDeployExecutions(exec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = templater.TemplateDeployExecutions(exec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(state["deploydeploy"])).To(MatchJSON(`{"counter": 2}`))
+	})
+
+	It("should return an error for an invalid template", func() {
+		exec := inlineExecution("deploy", `{ deployItems: std.extVar("missing") }`)
+		_, err := jsonnet.New(nil, nil).TemplateDeployExecutions(exec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).To(HaveOccurred())
+	})
+
+})
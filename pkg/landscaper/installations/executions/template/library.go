@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/mediatype"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/registry/components/cdutils"
+)
+
+const (
+	// LibraryTemplatesDir is the directory of a template library that contains go templates with named templates.
+	LibraryTemplatesDir = "templates"
+	// LibraryStubsDir is the directory of a template library that contains spiff stubs.
+	LibraryStubsDir = "stubs"
+	// LibrariesPathPrefix is the prefix of the paths that are used to reference the files of a library
+	// in error messages and jsonnet imports.
+	LibrariesPathPrefix = "libraries"
+)
+
+// TemplateLibrary is a resolved template library of a template executor.
+type TemplateLibrary struct {
+	// Name is the name of the library in the template executor.
+	Name string
+	// Fs is the read-only filesystem of the library.
+	Fs vfs.FileSystem
+}
+
+// LibraryFile is a file of a template library.
+type LibraryFile struct {
+	// Path is the path of the file that is used to reference it in error messages,
+	// e.g. "libraries/<library name>/templates/helpers.tpl".
+	Path string
+	// Content is the content of the file.
+	Content []byte
+}
+
+// ResolveTemplateLibraries resolves the libraries of a template executor.
+// The libraries are referenced relative to the component of the blueprint.
+// Their content is fetched like a remote blueprint and is therefore cached in the blueprint store.
+func ResolveTemplateLibraries(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor, cd model.ComponentVersion) ([]*TemplateLibrary, error) {
+	if len(tmplExec.Libraries) == 0 {
+		return nil, nil
+	}
+	if cd == nil {
+		return nil, errors.New("no component descriptor defined to resolve the template libraries")
+	}
+
+	libraries := make([]*TemplateLibrary, 0, len(tmplExec.Libraries))
+	for _, ref := range tmplExec.Libraries {
+		lib, err := resolveTemplateLibrary(ctx, ref, cd)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve template library %q: %w", ref.Name, err)
+		}
+		libraries = append(libraries, lib)
+	}
+	return libraries, nil
+}
+
+func resolveTemplateLibrary(ctx context.Context, ref lsv1alpha1.TemplateLibraryReference, cd model.ComponentVersion) (*TemplateLibrary, error) {
+	uri, err := cdutils.ParseURI(ref.Ref)
+	if err != nil {
+		return nil, err
+	}
+	_, resource, err := uri.GetResource(cd, cd.GetRepositoryContext())
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve library ref in component descriptor %s: %w", cd.GetName(), err)
+	}
+	switch resource.GetType() {
+	case mediatype.BlueprintLibraryType, mediatype.BlueprintType, mediatype.OldBlueprintType:
+	default:
+		return nil, fmt.Errorf("library resource %s has wrong type %s", resource.GetName(), resource.GetType())
+	}
+
+	content, err := resource.GetTypedContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blueprint, ok := content.Resource.(*blueprints.Blueprint)
+	if !ok {
+		return nil, fmt.Errorf("received resource of type %T but expected type *Blueprint", content.Resource)
+	}
+	return &TemplateLibrary{
+		Name: ref.Name,
+		Fs:   blueprint.Fs,
+	}, nil
+}
+
+// Files returns all files of the given directory of the library and its subdirectories, sorted by their path.
+// No files are returned if the directory does not exist.
+func (l *TemplateLibrary) Files(dir string) ([]LibraryFile, error) {
+	if _, err := l.Fs.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	files := []LibraryFile{}
+	err := vfs.Walk(l.Fs, dir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		data, err := vfs.ReadFile(l.Fs, filePath)
+		if err != nil {
+			return fmt.Errorf("unable to read file %q of library %q: %w", filePath, l.Name, err)
+		}
+		files = append(files, LibraryFile{
+			Path:    path.Join(LibrariesPathPrefix, l.Name, filePath),
+			Content: data,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files, nil
+}
+
+// LibraryFiles returns the files of the given directory of all libraries in the order of the libraries.
+func LibraryFiles(libraries []*TemplateLibrary, dir string) ([]LibraryFile, error) {
+	files := []LibraryFile{}
+	for _, lib := range libraries {
+		libFiles, err := lib.Files(dir)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, libFiles...)
+	}
+	return files, nil
+}
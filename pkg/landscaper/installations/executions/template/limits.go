@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// Limits defines the resource limits of a single template execution.
+// A limit with a zero value is disabled.
+type Limits struct {
+	// Timeout is the maximum duration of a template execution.
+	Timeout time.Duration
+	// MaxOutputSize is the maximum size of the rendered output in bytes.
+	MaxOutputSize int64
+	// MaxBlobSize is the maximum size in bytes of a file or resource content that is fetched by a template function.
+	MaxBlobSize int64
+	// MaxIncludeDepth is the maximum depth of nested includes of go templates.
+	MaxIncludeDepth int
+}
+
+// DefaultLimits are the limits of template executions if no limits are configured.
+var DefaultLimits = Limits{
+	Timeout:         2 * time.Minute,
+	MaxOutputSize:   10 * 1024 * 1024,
+	MaxBlobSize:     10 * 1024 * 1024,
+	MaxIncludeDepth: 100,
+}
+
+var (
+	limits    = DefaultLimits
+	limitsMux sync.RWMutex
+)
+
+// SetLimits sets the limits of all template executions.
+// It is determined by the landscaper configuration.
+func SetLimits(l Limits) {
+	limitsMux.Lock()
+	defer limitsMux.Unlock()
+	limits = l
+}
+
+// GetLimits returns the limits of template executions.
+func GetLimits() Limits {
+	limitsMux.RLock()
+	defer limitsMux.RUnlock()
+	return limits
+}
+
+// LimitsFromConfiguration creates the limits of template executions from the landscaper configuration.
+// Limits that are not configured are defaulted.
+func LimitsFromConfiguration(cfg *config.TemplateLimits) (Limits, error) {
+	l := DefaultLimits
+	if cfg == nil {
+		return l, nil
+	}
+	if cfg.Timeout != nil {
+		l.Timeout = cfg.Timeout.Duration
+	}
+	if len(cfg.MaxOutputSize) != 0 {
+		size, err := resource.ParseQuantity(cfg.MaxOutputSize)
+		if err != nil {
+			return l, fmt.Errorf("unable to parse max output size of templates: %w", err)
+		}
+		l.MaxOutputSize = size.Value()
+	}
+	if len(cfg.MaxBlobSize) != 0 {
+		size, err := resource.ParseQuantity(cfg.MaxBlobSize)
+		if err != nil {
+			return l, fmt.Errorf("unable to parse max blob size of templates: %w", err)
+		}
+		l.MaxBlobSize = size.Value()
+	}
+	if cfg.MaxIncludeDepth != nil {
+		l.MaxIncludeDepth = int(*cfg.MaxIncludeDepth)
+	}
+	return l, nil
+}
+
+// LimitExceededError is the error of a template execution that exceeded one of its limits.
+type LimitExceededError struct {
+	message string
+}
+
+// NewLimitExceededError creates a new error for an exceeded limit.
+func NewLimitExceededError(format string, args ...interface{}) *LimitExceededError {
+	return &LimitExceededError{
+		message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *LimitExceededError) Error() string {
+	return e.message
+}
+
+// IsLimitExceededError returns true if the error or one of its wrapped errors is a LimitExceededError.
+func IsLimitExceededError(err error) bool {
+	var limitErr *LimitExceededError
+	return errors.As(err, &limitErr)
+}
+
+// ErrorCodes returns the landscaper error codes of a templating error.
+// A template that exceeds a limit is a configuration problem of the blueprint, so that a retry will not succeed.
+func ErrorCodes(err error) []lsv1alpha1.ErrorCode {
+	if IsLimitExceededError(err) {
+		return []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem}
+	}
+	return nil
+}
+
+// ExecutionLimiter enforces the limits of a single template execution.
+// All methods can be called on a nil limiter, which does not enforce any limits.
+type ExecutionLimiter struct {
+	limits   Limits
+	deadline time.Time
+
+	mux sync.Mutex
+	err *LimitExceededError
+}
+
+// NewExecutionLimiter creates a limiter for a template execution that starts now.
+func NewExecutionLimiter(l Limits) *ExecutionLimiter {
+	e := &ExecutionLimiter{
+		limits: l,
+	}
+	if l.Timeout > 0 {
+		e.deadline = time.Now().Add(l.Timeout)
+	}
+	return e
+}
+
+// Err returns the error of the first limit that was exceeded during the execution.
+func (e *ExecutionLimiter) Err() error {
+	if e == nil {
+		return nil
+	}
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	if e.err == nil {
+		return nil
+	}
+	return e.err
+}
+
+// exceeded records that a limit was exceeded and returns the error.
+func (e *ExecutionLimiter) exceeded(err *LimitExceededError) error {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	if e.err == nil {
+		e.err = err
+	}
+	return err
+}
+
+// CheckTimeout returns an error if the execution exceeded its timeout.
+func (e *ExecutionLimiter) CheckTimeout() error {
+	if e == nil || e.deadline.IsZero() || time.Now().Before(e.deadline) {
+		return nil
+	}
+	return e.exceeded(NewLimitExceededError("template execution exceeded the timeout of %s", e.limits.Timeout))
+}
+
+// CheckOutputSize returns an error if the rendered output exceeds the maximum output size.
+func (e *ExecutionLimiter) CheckOutputSize(size int64) error {
+	if e == nil || e.limits.MaxOutputSize <= 0 || size <= e.limits.MaxOutputSize {
+		return nil
+	}
+	return e.exceeded(NewLimitExceededError("rendered template exceeds the maximum output size of %d bytes", e.limits.MaxOutputSize))
+}
+
+// CheckBlobSize returns an error if a blob that is fetched by a template function exceeds the maximum blob size.
+func (e *ExecutionLimiter) CheckBlobSize(name string, size int64) error {
+	if e == nil || e.limits.MaxBlobSize <= 0 || size <= e.limits.MaxBlobSize {
+		return nil
+	}
+	return e.exceeded(NewLimitExceededError("%s exceeds the maximum blob size of %d bytes", name, e.limits.MaxBlobSize))
+}
+
+// CheckIncludeDepth returns an error if the depth of nested includes exceeds the maximum include depth.
+func (e *ExecutionLimiter) CheckIncludeDepth(name string, depth int) error {
+	if e == nil || e.limits.MaxIncludeDepth <= 0 || depth <= e.limits.MaxIncludeDepth {
+		return nil
+	}
+	return e.exceeded(NewLimitExceededError("include of %q exceeds the maximum include depth of %d", name, e.limits.MaxIncludeDepth))
+}
+
+// ReadBlob reads a blob that is fetched by a template function and fails if it exceeds the maximum blob size.
+// At most one byte more than the limit is read from the reader.
+func (e *ExecutionLimiter) ReadBlob(name string, reader io.Reader) ([]byte, error) {
+	if e != nil && e.limits.MaxBlobSize > 0 {
+		reader = io.LimitReader(reader, e.limits.MaxBlobSize+1)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.CheckBlobSize(name, int64(len(data))); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Writer returns a writer for the rendered output that fails if the output exceeds the maximum output size
+// or if the execution exceeded its timeout.
+func (e *ExecutionLimiter) Writer(w io.Writer) io.Writer {
+	if e == nil {
+		return w
+	}
+	return &limitedWriter{
+		limiter: e,
+		writer:  w,
+	}
+}
+
+// BlobWriter returns a writer for a blob that is fetched by a template function
+// that fails if the blob exceeds the maximum blob size.
+func (e *ExecutionLimiter) BlobWriter(name string, w io.Writer) io.Writer {
+	if e == nil {
+		return w
+	}
+	return &blobWriter{
+		limiter: e,
+		name:    name,
+		writer:  w,
+	}
+}
+
+// Run runs a template execution and fails if it does not finish before the timeout.
+// Template executions cannot be interrupted from outside, so that they are stopped cooperatively
+// by the writer, the template functions and the hooks of the template engines that check the limits.
+// Run returns only after the execution has ended, so that no execution keeps running in the background.
+func (e *ExecutionLimiter) Run(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("template execution panicked: %v", r)
+		}
+		if timeoutErr := e.CheckTimeout(); timeoutErr != nil {
+			err = timeoutErr
+		}
+	}()
+	return fn()
+}
+
+// limitedWriter is a writer that enforces the output size and the timeout of a template execution.
+type limitedWriter struct {
+	limiter *ExecutionLimiter
+	writer  io.Writer
+	written int64
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if err := w.limiter.CheckTimeout(); err != nil {
+		return 0, err
+	}
+	if err := w.limiter.CheckOutputSize(w.written + int64(len(p))); err != nil {
+		return 0, err
+	}
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+	return n, err
+}
+
+// blobWriter is a writer that enforces the blob size of a template execution.
+type blobWriter struct {
+	limiter *ExecutionLimiter
+	name    string
+	writer  io.Writer
+	written int64
+}
+
+func (w *blobWriter) Write(p []byte) (int, error) {
+	if err := w.limiter.CheckBlobSize(w.name, w.written+int64(len(p))); err != nil {
+		return 0, err
+	}
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+	return n, err
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+// ExecutionKind describes the kind of a template execution.
+type ExecutionKind string
+
+const (
+	ImportExecutionKind          ExecutionKind = "import"
+	SubinstallationExecutionKind ExecutionKind = "subinstallation"
+	DeployExecutionKind          ExecutionKind = "deploy"
+	ExportExecutionKind          ExecutionKind = "export"
+)
+
+const (
+	// MaxRenderedOutputSize is the maximal number of bytes that are captured of the rendered output of an execution.
+	MaxRenderedOutputSize = 16 * 1024
+	// renderedOutputSecretSuffix is the suffix of the name of the secret that contains the captured output.
+	renderedOutputSecretSuffix = "rendered-output"
+)
+
+var invalidSecretKeyCharsRegexp = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
+// RenderedOutputRecordingTemplater is implemented by execution templaters that are able to record their raw rendered output.
+type RenderedOutputRecordingTemplater interface {
+	// SetRenderedOutputRecorder sets the recorder that is called with the raw output of every execution.
+	SetRenderedOutputRecorder(recorder *RenderedOutputRecorder)
+}
+
+// RenderedOutputRecorder captures the raw rendered output of template executions
+// and stores it in a secret of the installation so that it can be inspected after the reconcile.
+// A nil recorder does not record anything.
+type RenderedOutputRecorder struct {
+	kubeClient client.Client
+	inst       *lsv1alpha1.Installation
+	outputs    map[string][]byte
+}
+
+// NewRenderedOutputRecorder creates a new recorder for the given installation.
+// Nil is returned if the capturing of the rendered output is not enabled for the installation.
+func NewRenderedOutputRecorder(kubeClient client.Client, inst *lsv1alpha1.Installation) *RenderedOutputRecorder {
+	if inst == nil || inst.GetAnnotations()[lsv1alpha1.CaptureRenderedOutputAnnotation] != "true" {
+		return nil
+	}
+	return &RenderedOutputRecorder{
+		kubeClient: kubeClient,
+		inst:       inst,
+		outputs:    map[string][]byte{},
+	}
+}
+
+// Record records the rendered output of an execution.
+// The output is truncated to MaxRenderedOutputSize bytes.
+func (r *RenderedOutputRecorder) Record(kind ExecutionKind, executionName string, output []byte) {
+	if r == nil {
+		return
+	}
+	if len(output) > MaxRenderedOutputSize {
+		truncated := make([]byte, 0, MaxRenderedOutputSize+64)
+		truncated = append(truncated, output[:MaxRenderedOutputSize]...)
+		truncated = append(truncated, fmt.Sprintf("\n# ... truncated %d bytes", len(output)-MaxRenderedOutputSize)...)
+		output = truncated
+	}
+	r.outputs[RenderedOutputKey(kind, executionName)] = output
+}
+
+// Store writes all recorded outputs into the rendered output secret of the installation.
+// Outputs of other executions that are already contained in the secret are kept.
+func (r *RenderedOutputRecorder) Store(ctx context.Context) error {
+	if r == nil || len(r.outputs) == 0 {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = RenderedOutputSecretName(r.inst)
+	secret.Namespace = r.inst.Namespace
+	if err := r.kubeClient.Get(ctx, kutil.ObjectKey(secret.Name, secret.Namespace), secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to get rendered output secret: %w", err)
+		}
+		secret.Data = r.outputs
+		if err := controllerutil.SetControllerReference(r.inst, secret, api.LandscaperScheme); err != nil {
+			return fmt.Errorf("unable to set controller reference: %w", err)
+		}
+		return r.kubeClient.Create(ctx, secret)
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for key, output := range r.outputs {
+		secret.Data[key] = output
+	}
+	return r.kubeClient.Update(ctx, secret)
+}
+
+// RenderedOutputKey returns the key of the rendered output secret that contains the output of the given execution.
+func RenderedOutputKey(kind ExecutionKind, executionName string) string {
+	return fmt.Sprintf("%s.%s", kind, invalidSecretKeyCharsRegexp.ReplaceAllString(executionName, "_"))
+}
+
+// RenderedOutputSecretName returns the name of the secret that contains the captured rendered output of an installation.
+func RenderedOutputSecretName(inst *lsv1alpha1.Installation) string {
+	name := fmt.Sprintf("%s-%s", inst.Name, renderedOutputSecretSuffix)
+	if len(name) > validation.DNS1123SubdomainMaxLength {
+		return KubernetesStateHandler{Inst: inst}.secretName(renderedOutputSecretSuffix)
+	}
+	return name
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+var _ = Describe("RenderedOutputRecorder", func() {
+
+	var inst *lsv1alpha1.Installation
+
+	BeforeEach(func() {
+		inst = &lsv1alpha1.Installation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+				UID:       types.UID("abc-abc-abc"),
+				Annotations: map[string]string{
+					lsv1alpha1.CaptureRenderedOutputAnnotation: "true",
+				},
+			},
+		}
+	})
+
+	It("should not record anything if the annotation is not set", func() {
+		inst.Annotations = nil
+		recorder := NewRenderedOutputRecorder(nil, inst)
+		Expect(recorder).To(BeNil())
+		recorder.Record(DeployExecutionKind, "deploy", []byte("deployItems: []"))
+		Expect(recorder.Store(context.Background())).To(Succeed())
+	})
+
+	It("should store the truncated output of all executions in a secret", func() {
+		ctx := context.Background()
+		kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+
+		recorder := NewRenderedOutputRecorder(kubeClient, inst)
+		recorder.Record(DeployExecutionKind, "deploy", []byte("deployItems: []"))
+		recorder.Record(DeployExecutionKind, "large", []byte(strings.Repeat("a", MaxRenderedOutputSize+10)))
+		Expect(recorder.Store(ctx)).To(Succeed())
+
+		recorder = NewRenderedOutputRecorder(kubeClient, inst)
+		recorder.Record(ExportExecutionKind, "my export", []byte("exports: {}"))
+		Expect(recorder.Store(ctx)).To(Succeed())
+
+		secret := &corev1.Secret{}
+		Expect(kubeClient.Get(ctx, kutil.ObjectKey("test-rendered-output", "default"), secret)).To(Succeed())
+		Expect(secret.OwnerReferences).To(HaveLen(1))
+		Expect(secret.Data).To(HaveLen(3))
+		Expect(secret.Data).To(HaveKeyWithValue("deploy.deploy", []byte("deployItems: []")))
+		Expect(secret.Data).To(HaveKeyWithValue("export.my_export", []byte("exports: {}")))
+		Expect(string(secret.Data["deploy.large"])).To(HaveSuffix("\n# ... truncated 10 bytes"))
+		Expect(secret.Data["deploy.large"]).To(HaveLen(MaxRenderedOutputSize + len("\n# ... truncated 10 bytes")))
+	})
+
+})
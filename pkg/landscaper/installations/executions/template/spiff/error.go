@@ -50,3 +50,8 @@ func (e *TemplateError) Build() *TemplateError {
 func (e *TemplateError) Error() string {
 	return e.message
 }
+
+// Unwrap returns the wrapped templating error.
+func (e *TemplateError) Unwrap() error {
+	return e.err
+}
@@ -34,7 +34,9 @@ import (
 	"github.com/gardener/landscaper/pkg/utils/clusters"
 )
 
-func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Functions, componentVersion model.ComponentVersion, componentVersions *model.ComponentVersionList, targetResolver targetresolver.TargetResolver) error {
+// LandscaperSpiffFuncs registers all additional landscaper functions that are available in spiff templates.
+// The functions that fetch resources fail if the content exceeds the blob size limit of the limiter.
+func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Functions, componentVersion model.ComponentVersion, componentVersions *model.ComponentVersionList, targetResolver targetresolver.TargetResolver, limiter *template.ExecutionLimiter) error {
 	ocmSchemaVersion := common.DetermineOCMSchemaVersion(blueprint, componentVersion)
 
 	cd, err := model.GetComponentDescriptor(componentVersion)
@@ -49,7 +51,7 @@ func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Fu
 
 	functions.RegisterFunction("getResource", spiffResolveResources(cd))
 	functions.RegisterFunction("getResourceKey", spiffGetResourceKey(componentVersion))
-	functions.RegisterFunction("getResourceContent", spiffGetResourceContent(componentVersion))
+	functions.RegisterFunction("getResourceContent", spiffGetResourceContent(componentVersion, limiter))
 	functions.RegisterFunction("getComponent", spiffResolveComponent(cd, cdList, ocmSchemaVersion))
 	functions.RegisterFunction("parseOCIRef", parseOCIReference)
 	functions.RegisterFunction("ociRefRepo", getOCIReferenceRepository)
@@ -59,6 +61,9 @@ func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Fu
 	functions.RegisterFunction("getServiceAccountKubeconfig", getServiceAccountKubeconfigSpiffFunc(targetResolver, false))
 	functions.RegisterFunction("getServiceAccountKubeconfigWithExpirationTimestamp", getServiceAccountKubeconfigSpiffFunc(targetResolver, true))
 	functions.RegisterFunction("getOidcKubeconfig", getOidcKubeconfigSpiffFunc(targetResolver))
+	functions.RegisterFunction("getTargetServerVersion", getTargetServerVersionSpiffFunc(targetResolver))
+	functions.RegisterFunction("targetHasAPI", targetHasAPISpiffFunc(targetResolver))
+	functions.RegisterFunction("lookup", lookupSpiffFunc(targetResolver))
 
 	return nil
 }
@@ -190,7 +195,7 @@ func spiffGetResourceKey(cv model.ComponentVersion) func(arguments []interface{}
 // getResourceContentGoFunc returns a function that resolves a relative resource reference
 // (https://github.com/open-component-model/ocm-spec/blob/restruc3/doc/05-guidelines/03-references.md#relative-artifact-references),
 // based on an ocm component version given as input parameter and returns the content of the corresponding resource.
-func spiffGetResourceContent(cv model.ComponentVersion) func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+func spiffGetResourceContent(cv model.ComponentVersion, limiter *template.ExecutionLimiter) func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
 	return func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
 		info := dynaml.DefaultInfo()
 
@@ -230,8 +235,14 @@ func spiffGetResourceContent(cv model.ComponentVersion) func(arguments []interfa
 		if err != nil {
 			return info.Error("unable to get access method for resource: %w", err)
 		}
+		defer m.Close()
 
-		data, err := m.Get()
+		reader, err := m.Reader()
+		if err != nil {
+			return info.Error("unable to read resource content: %w", err)
+		}
+		defer reader.Close()
+		data, err := limiter.ReadBlob(fmt.Sprintf("content of resource %q", resourceRefStr), reader)
 		if err != nil {
 			return info.Error("unable to read resource content: %w", err)
 		}
@@ -509,6 +520,250 @@ func getOidcKubeconfigSpiffFunc(targetResolver targetresolver.TargetResolver) dy
 	}
 }
 
+func getTargetServerVersionSpiffFunc(targetResolver targetresolver.TargetResolver) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 1 {
+			return info.Error("templating function getTargetServerVersion expects 1 argument: target")
+		}
+
+		target, err := toTarget(args[0])
+		if err != nil {
+			return info.Error("templating function getTargetServerVersion expects a target object as 1st argument: %w", err)
+		}
+
+		ctx, cancel := template.NewTargetLookupContext()
+		defer cancel()
+		version, err := template.GetTargetServerVersion(ctx, target, targetResolver)
+		if err != nil {
+			return info.Error(err)
+		}
+
+		return version, info, true
+	}
+}
+
+func targetHasAPISpiffFunc(targetResolver targetresolver.TargetResolver) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 2 {
+			return info.Error("templating function targetHasAPI expects 2 arguments: api and target")
+		}
+
+		api, ok := args[0].(string)
+		if !ok {
+			return info.Error("templating function targetHasAPI expects a string as 1st argument, namely the api")
+		}
+
+		target, err := toTarget(args[1])
+		if err != nil {
+			return info.Error("templating function targetHasAPI expects a target object as 2nd argument: %w", err)
+		}
+
+		ctx, cancel := template.NewTargetLookupContext()
+		defer cancel()
+		hasAPI, err := template.TargetHasAPI(ctx, api, target, targetResolver)
+		if err != nil {
+			return info.Error(err)
+		}
+
+		return hasAPI, info, true
+	}
+}
+
+func lookupSpiffFunc(targetResolver targetresolver.TargetResolver) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 5 {
+			return info.Error("templating function lookup expects 5 arguments: api version, kind, namespace, name, and target")
+		}
+
+		strArgs := make([]string, 4)
+		for i, name := range []string{"api version", "kind", "namespace", "name"} {
+			val, ok := args[i].(string)
+			if !ok {
+				return info.Error("templating function lookup expects a string as argument %d, namely the %s", i+1, name)
+			}
+			strArgs[i] = val
+		}
+
+		target, err := toTarget(args[4])
+		if err != nil {
+			return info.Error("templating function lookup expects a target object as 5th argument: %w", err)
+		}
+
+		ctx, cancel := template.NewTargetLookupContext()
+		defer cancel()
+		obj, err := template.LookupTargetObject(ctx, strArgs[0], strArgs[1], strArgs[2], strArgs[3], target, targetResolver)
+		if err != nil {
+			return info.Error(err)
+		}
+
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return info.Error(err.Error())
+		}
+
+		node, err := spiffyaml.Parse("", data)
+		if err != nil {
+			return info.Error(err.Error())
+		}
+
+		result, err := binding.Flow(node, false)
+		if err != nil {
+			return info.Error(err.Error())
+		}
+
+		return result.Value(), info, true
+	}
+}
+
+// toTarget converts a target object of a spiff template into a target.
+func toTarget(targetObj interface{}) (*lsv1alpha1.Target, error) {
+	targetBytes, err := spiffyaml.Marshal(spiffyaml.NewNode(targetObj, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error during marshaling: %w", err)
+	}
+
+	target := &lsv1alpha1.Target{}
+	if err := yaml.Unmarshal(targetBytes, target); err != nil {
+		return nil, fmt.Errorf("error during unmarshaling: %w", err)
+	}
+	return target, nil
+}
+
+// RegisterCredentialFuncs registers the functions that generate credentials and persist them in the credential store.
+func RegisterCredentialFuncs(functions spiffing.Functions, store *template.CredentialStore) {
+	functions.RegisterFunction("generatePassword", generatePasswordSpiffFunc(store))
+	functions.RegisterFunction("generateCA", generateCASpiffFunc(store))
+	functions.RegisterFunction("generateCert", generateCertSpiffFunc(store))
+}
+
+func generatePasswordSpiffFunc(store *template.CredentialStore) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 2 {
+			return info.Error("templating function generatePassword expects 2 arguments: name and length")
+		}
+
+		name, ok := args[0].(string)
+		if !ok {
+			return info.Error("templating function generatePassword expects a string as 1st argument, namely the name")
+		}
+
+		length, err := toInt64(args[1])
+		if err != nil {
+			return info.Error("templating function generatePassword expects an integer as 2nd argument, namely the length: %w", err)
+		}
+
+		password, err := store.GeneratePassword(context.Background(), name, int(length))
+		if err != nil {
+			return info.Error(err)
+		}
+		return password, info, true
+	}
+}
+
+func generateCASpiffFunc(store *template.CredentialStore) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 3 {
+			return info.Error("templating function generateCA expects 3 arguments: name, common name and validity in days")
+		}
+
+		name, ok := args[0].(string)
+		if !ok {
+			return info.Error("templating function generateCA expects a string as 1st argument, namely the name")
+		}
+
+		commonName, ok := args[1].(string)
+		if !ok {
+			return info.Error("templating function generateCA expects a string as 2nd argument, namely the common name")
+		}
+
+		validityDays, err := toInt64(args[2])
+		if err != nil {
+			return info.Error("templating function generateCA expects an integer as 3rd argument, namely the validity in days: %w", err)
+		}
+
+		ca, err := store.GenerateCA(context.Background(), name, commonName, int(validityDays))
+		if err != nil {
+			return info.Error(err)
+		}
+		return toSpiffValue(ca, info, binding)
+	}
+}
+
+func generateCertSpiffFunc(store *template.CredentialStore) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 5 {
+			return info.Error("templating function generateCert expects 5 arguments: name, common name, subject alternative names, validity in days and certificate authority")
+		}
+
+		name, ok := args[0].(string)
+		if !ok {
+			return info.Error("templating function generateCert expects a string as 1st argument, namely the name")
+		}
+
+		commonName, ok := args[1].(string)
+		if !ok {
+			return info.Error("templating function generateCert expects a string as 2nd argument, namely the common name")
+		}
+
+		validityDays, err := toInt64(args[3])
+		if err != nil {
+			return info.Error("templating function generateCert expects an integer as 4th argument, namely the validity in days: %w", err)
+		}
+
+		var (
+			sans []string
+			ca   map[string]interface{}
+		)
+		if err := fromSpiffValue(args[2], &sans); err != nil {
+			return info.Error("templating function generateCert expects a list of strings as 3rd argument, namely the subject alternative names: %w", err)
+		}
+		if err := fromSpiffValue(args[4], &ca); err != nil {
+			return info.Error("templating function generateCert expects a map as 5th argument, namely the certificate authority: %w", err)
+		}
+
+		cert, err := store.GenerateCert(context.Background(), name, commonName, sans, int(validityDays), ca)
+		if err != nil {
+			return info.Error(err)
+		}
+		return toSpiffValue(cert, info, binding)
+	}
+}
+
+// fromSpiffValue decodes a spiff value into the given object.
+func fromSpiffValue(value interface{}, obj interface{}) error {
+	data, err := spiffyaml.Marshal(spiffyaml.NewNode(value, ""))
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(data, obj)
+}
+
+// toSpiffValue converts a value into the result of a spiff function.
+func toSpiffValue(value interface{}, info dynaml.EvaluationInfo, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return info.Error(err.Error())
+	}
+
+	node, err := spiffyaml.Parse("", data)
+	if err != nil {
+		return info.Error(err.Error())
+	}
+
+	result, err := binding.Flow(node, false)
+	if err != nil {
+		return info.Error(err.Error())
+	}
+
+	return result.Value(), info, true
+}
+
 func toInt64(value interface{}) (int64, error) {
 	switch n := value.(type) {
 	case int64:
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/mandelsoft/spiff/dynaml"
 	"github.com/mandelsoft/spiff/spiffing"
 	spiffyaml "github.com/mandelsoft/spiff/yaml"
 	"github.com/mandelsoft/vfs/pkg/vfs"
@@ -25,6 +26,7 @@ type Templater struct {
 	state          template.GenericStateHandler
 	inputFormatter *template.TemplateInputFormatter
 	targetResolver targetresolver.TargetResolver
+	outputRecorder *template.RenderedOutputRecorder
 }
 
 // New creates a new spiff execution templater.
@@ -42,6 +44,11 @@ func (t *Templater) WithInputFormatter(inputFormatter *template.TemplateInputFor
 	return t
 }
 
+// SetRenderedOutputRecorder sets the recorder that captures the raw rendered output of all executions.
+func (t *Templater) SetRenderedOutputRecorder(recorder *template.RenderedOutputRecorder) {
+	t.outputRecorder = recorder
+}
+
 func (t Templater) Type() lsv1alpha1.TemplateType {
 	return lsv1alpha1.SpiffTemplateType
 }
@@ -63,17 +70,23 @@ func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.Templa
 		return nil, fmt.Errorf("unable to load state: %w", err)
 	}
 
+	limiter := template.NewExecutionLimiter(template.GetLimits())
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, cd, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, cd, cdList, t.targetResolver, limiter); err != nil {
 		return nil, err
 	}
+	RegisterCredentialFuncs(functions, template.NewCredentialStore(t.state).WithLimiter(limiter))
 
-	spiff, err := spiffing.New().WithFunctions(functions).WithFileSystem(blueprint.Fs).WithValues(values)
+	spiff, err := spiffing.New().WithFunctions(newLimitedFunctions(functions, limiter)).WithFileSystem(blueprint.Fs).WithValues(values)
 	if err != nil {
 		return nil, fmt.Errorf("unable to init spiff templater: %w", err)
 	}
+	stubs, err := t.libraryStubs(ctx, tmplExec, cd)
+	if err != nil {
+		return nil, err
+	}
 
-	res, err := spiff.Cascade(rawTemplate, nil, stateNode)
+	res, err := cascade(limiter, spiff, rawTemplate, stubs, stateNode)
 	if err != nil {
 		cascadeError := TemplateErrorBuilder(err).
 			WithInput(values, t.inputFormatter).
@@ -88,6 +101,11 @@ func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.Templa
 	if err != nil {
 		return nil, err
 	}
+	if err := limiter.CheckOutputSize(int64(len(data))); err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.SubinstallationExecutionKind, tmplExec.Name, data)
+
 	output := &template.SubinstallationExecutorOutput{}
 	if err := yaml.Unmarshal(data, output); err != nil {
 		return nil, err
@@ -108,17 +126,23 @@ func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	ctx := context.Background()
 	defer ctx.Done()
 
+	limiter := template.NewExecutionLimiter(template.GetLimits())
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver, limiter); err != nil {
 		return nil, err
 	}
+	RegisterCredentialFuncs(functions, template.NewCredentialStore(t.state).WithLimiter(limiter))
 
-	spiff, err := spiffing.New().WithFunctions(functions).WithFileSystem(blueprint.Fs).WithValues(values)
+	spiff, err := spiffing.New().WithFunctions(newLimitedFunctions(functions, limiter)).WithFileSystem(blueprint.Fs).WithValues(values)
 	if err != nil {
 		return nil, fmt.Errorf("unable to init spiff templater: %w", err)
 	}
+	stubs, err := t.libraryStubs(ctx, tmplExec, descriptor)
+	if err != nil {
+		return nil, err
+	}
 
-	res, err := spiff.Cascade(rawTemplate, nil)
+	res, err := cascade(limiter, spiff, rawTemplate, stubs)
 	if err != nil {
 		cascadeError := TemplateErrorBuilder(err).
 			WithInput(values, t.inputFormatter).
@@ -130,6 +154,11 @@ func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	if err != nil {
 		return nil, err
 	}
+	if err := limiter.CheckOutputSize(int64(len(data))); err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.ImportExecutionKind, tmplExec.Name, data)
+
 	output := &template.ImportExecutorOutput{}
 	if err := yaml.Unmarshal(data, output); err != nil {
 		return nil, err
@@ -154,17 +183,23 @@ func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecuto
 		return nil, fmt.Errorf("unable to load state: %w", err)
 	}
 
+	limiter := template.NewExecutionLimiter(template.GetLimits())
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver, limiter); err != nil {
 		return nil, err
 	}
+	RegisterCredentialFuncs(functions, template.NewCredentialStore(t.state).WithLimiter(limiter))
 
-	spiff, err := spiffing.New().WithFunctions(functions).WithFileSystem(blueprint.Fs).WithValues(values)
+	spiff, err := spiffing.New().WithFunctions(newLimitedFunctions(functions, limiter)).WithFileSystem(blueprint.Fs).WithValues(values)
 	if err != nil {
 		return nil, fmt.Errorf("unable to init spiff templater: %w", err)
 	}
+	stubs, err := t.libraryStubs(ctx, tmplExec, descriptor)
+	if err != nil {
+		return nil, err
+	}
 
-	res, err := spiff.Cascade(rawTemplate, nil, stateNode)
+	res, err := cascade(limiter, spiff, rawTemplate, stubs, stateNode)
 	if err != nil {
 		cascadeError := TemplateErrorBuilder(err).
 			WithInput(values, t.inputFormatter).
@@ -179,6 +214,11 @@ func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	if err != nil {
 		return nil, err
 	}
+	if err := limiter.CheckOutputSize(int64(len(data))); err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.DeployExecutionKind, tmplExec.Name, data)
+
 	output := &template.DeployExecutorOutput{}
 	if err := yaml.Unmarshal(data, output); err != nil {
 		return nil, err
@@ -203,17 +243,23 @@ func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 		return nil, fmt.Errorf("unable to load state: %w", err)
 	}
 
+	limiter := template.NewExecutionLimiter(template.GetLimits())
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver, limiter); err != nil {
 		return nil, err
 	}
+	RegisterCredentialFuncs(functions, template.NewCredentialStore(t.state).WithLimiter(limiter))
 
-	spiff, err := spiffing.New().WithFunctions(functions).WithFileSystem(blueprint.Fs).WithValues(values)
+	spiff, err := spiffing.New().WithFunctions(newLimitedFunctions(functions, limiter)).WithFileSystem(blueprint.Fs).WithValues(values)
 	if err != nil {
 		return nil, fmt.Errorf("unable to init spiff templater: %w", err)
 	}
+	stubs, err := t.libraryStubs(ctx, tmplExec, descriptor)
+	if err != nil {
+		return nil, err
+	}
 
-	res, err := spiff.Cascade(rawTemplate, nil, stateNode)
+	res, err := cascade(limiter, spiff, rawTemplate, stubs, stateNode)
 	if err != nil {
 		cascadeError := TemplateErrorBuilder(err).
 			WithInput(values, t.inputFormatter).
@@ -228,6 +274,11 @@ func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	if err != nil {
 		return nil, err
 	}
+	if err := limiter.CheckOutputSize(int64(len(data))); err != nil {
+		return nil, err
+	}
+	t.outputRecorder.Record(template.ExportExecutionKind, tmplExec.Name, data)
+
 	output := &template.ExportExecutorOutput{}
 	if err := yaml.Unmarshal(data, output); err != nil {
 		return nil, err
@@ -259,6 +310,69 @@ func (t *Templater) templateNode(tmplExec lsv1alpha1.TemplateExecutor, blueprint
 	return nil, fmt.Errorf("no template found")
 }
 
+// cascade processes the template with the stubs and states within the limits of the execution.
+func cascade(limiter *template.ExecutionLimiter, spiff spiffing.Spiff, rawTemplate spiffyaml.Node, stubs []spiffyaml.Node, states ...spiffyaml.Node) (spiffyaml.Node, error) {
+	var res spiffyaml.Node
+	err := limiter.Run(func() error {
+		var err error
+		res, err = spiff.Cascade(rawTemplate, stubs, states...)
+		return err
+	})
+	if limitErr := limiter.Err(); limitErr != nil {
+		return nil, limitErr
+	}
+	return res, err
+}
+
+// limitedFunctions checks the limits of an execution before every call of a spiff function,
+// so that a cascade that exceeds its timeout is stopped with the next function call.
+type limitedFunctions struct {
+	spiffing.Functions
+	limiter *template.ExecutionLimiter
+}
+
+func newLimitedFunctions(functions spiffing.Functions, limiter *template.ExecutionLimiter) spiffing.Functions {
+	return &limitedFunctions{
+		Functions: functions,
+		limiter:   limiter,
+	}
+}
+
+func (f *limitedFunctions) LookupFunction(name string) dynaml.Function {
+	fn := f.Functions.LookupFunction(name)
+	if fn == nil {
+		return nil
+	}
+	return func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		if err := f.limiter.CheckTimeout(); err != nil {
+			info := dynaml.DefaultInfo()
+			return info.Error(err.Error())
+		}
+		return fn(arguments, binding)
+	}
+}
+
+// libraryStubs returns the spiff stubs of the template libraries of an execution.
+func (t *Templater) libraryStubs(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor, cd model.ComponentVersion) ([]spiffyaml.Node, error) {
+	libraries, err := template.ResolveTemplateLibraries(ctx, tmplExec, cd)
+	if err != nil {
+		return nil, err
+	}
+	files, err := template.LibraryFiles(libraries, template.LibraryStubsDir)
+	if err != nil {
+		return nil, err
+	}
+	stubs := make([]spiffyaml.Node, 0, len(files))
+	for _, file := range files {
+		stub, err := spiffyaml.Unmarshal(file.Path, file.Content)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse stub %q: %w", file.Path, err)
+		}
+		stubs = append(stubs, stub)
+	}
+	return stubs, nil
+}
+
 func (t *Templater) getDeployExecutionState(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor) (spiffyaml.Node, error) {
 	return t.getState(ctx, "deploy", tmplExec)
 }
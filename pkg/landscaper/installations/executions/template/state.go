@@ -5,9 +5,11 @@
 package template
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha1"
 	"encoding/base32"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -15,6 +17,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
@@ -29,6 +32,45 @@ type GenericStateHandler interface {
 	Get(context.Context, string) ([]byte, error)
 }
 
+// GetExecutionState returns the state of the previous evaluation of a template execution.
+// An empty state is returned if no state handler is configured or no state has been stored yet.
+func GetExecutionState(ctx context.Context, state GenericStateHandler, prefix string, tmplExec lsv1alpha1.TemplateExecutor) (interface{}, error) {
+	if state == nil {
+		return map[string]interface{}{}, nil
+	}
+	data, err := state.Get(ctx, prefix+tmplExec.Name)
+	if err != nil {
+		if err == StateNotFoundErr {
+			return map[string]interface{}{}, nil
+		}
+		return nil, err
+	}
+
+	var res interface{}
+	if err := yaml.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// StoreExecutionState stores the "state" field of the json output of a template execution.
+// Nothing is stored if no state handler is configured or the output contains no or a null state.
+func StoreExecutionState(ctx context.Context, state GenericStateHandler, prefix string, tmplExec lsv1alpha1.TemplateExecutor, data []byte) error {
+	if state == nil {
+		return nil
+	}
+	res := &struct {
+		State json.RawMessage `json:"state"`
+	}{}
+	if err := json.Unmarshal(data, res); err != nil {
+		return err
+	}
+	if trimmed := bytes.TrimSpace(res.State); len(trimmed) == 0 || string(trimmed) == "null" {
+		return nil
+	}
+	return state.Store(ctx, prefix+tmplExec.Name, res.State)
+}
+
 // KubernetesStateHandler implements the GenericStateHandler interface
 // that stores the stateHdl in a kubernetes cluster.
 type KubernetesStateHandler struct {
@@ -63,4 +63,51 @@ var _ = Describe("State", func() {
 
 	})
 
+	Context("execution state", func() {
+
+		It("should store the state of an execution output and return it for the next evaluation", func() {
+			ctx := context.Background()
+			stateHdlr := NewMemoryStateHandler()
+			exec := lsv1alpha1.TemplateExecutor{Name: "my-exec"}
+
+			state, err := GetExecutionState(ctx, stateHdlr, "deploy", exec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal(map[string]interface{}{}))
+
+			Expect(StoreExecutionState(ctx, stateHdlr, "deploy", exec, []byte(`{"deployItems": [], "state": {"counter": 1}}`))).To(Succeed())
+			state, err = GetExecutionState(ctx, stateHdlr, "deploy", exec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal(map[string]interface{}{"counter": float64(1)}))
+
+			// the state of another execution kind is independent
+			state, err = GetExecutionState(ctx, stateHdlr, "export", exec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal(map[string]interface{}{}))
+		})
+
+		It("should keep the previous state if the output contains no state", func() {
+			ctx := context.Background()
+			stateHdlr := NewMemoryStateHandler()
+			exec := lsv1alpha1.TemplateExecutor{Name: "my-exec"}
+			Expect(StoreExecutionState(ctx, stateHdlr, "deploy", exec, []byte(`{"state": {"counter": 1}}`))).To(Succeed())
+
+			Expect(StoreExecutionState(ctx, stateHdlr, "deploy", exec, []byte(`{"deployItems": []}`))).To(Succeed())
+			Expect(StoreExecutionState(ctx, stateHdlr, "deploy", exec, []byte(`{"state": null}`))).To(Succeed())
+			Expect(StoreExecutionState(ctx, stateHdlr, "deploy", exec, []byte(`{"state":  null }`))).To(Succeed())
+
+			state, err := GetExecutionState(ctx, stateHdlr, "deploy", exec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal(map[string]interface{}{"counter": float64(1)}))
+		})
+
+		It("should ignore the state if no state handler is configured", func() {
+			ctx := context.Background()
+			exec := lsv1alpha1.TemplateExecutor{Name: "my-exec"}
+			Expect(StoreExecutionState(ctx, nil, "deploy", exec, []byte(`{"state": {"counter": 1}}`))).To(Succeed())
+			state, err := GetExecutionState(ctx, nil, "deploy", exec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal(map[string]interface{}{}))
+		})
+	})
+
 })
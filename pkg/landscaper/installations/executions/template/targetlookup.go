@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/gardener/landscaper/pkg/utils/clusters"
+)
+
+const (
+	// AllKinds is the kind of an allowed target resource that allows all kinds of its api version.
+	AllKinds = "*"
+	// TargetLookupTimeout is the maximum duration of a template function that reads the state of a target cluster.
+	TargetLookupTimeout = clusters.ClusterRequestTimeout
+)
+
+// TargetResource defines a kind of resources that can be read from target clusters.
+type TargetResource struct {
+	APIVersion string
+	Kind       string
+	// Namespaces restricts the namespaces from which the resources can be read.
+	// All namespaces are allowed if empty.
+	Namespaces []string
+}
+
+// NewTargetLookupContext returns the context of a template function that reads the state of a target cluster.
+// It is bounded by the TargetLookupTimeout.
+func NewTargetLookupContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), TargetLookupTimeout)
+}
+
+var (
+	allowedTargetResources    []TargetResource
+	allowedTargetResourcesMux sync.RWMutex
+)
+
+// SetAllowedTargetResources sets the resources that templates can read from target clusters.
+// It is determined by the landscaper configuration. By default, no resources can be read.
+func SetAllowedTargetResources(resources []TargetResource) {
+	allowedTargetResourcesMux.Lock()
+	defer allowedTargetResourcesMux.Unlock()
+	allowedTargetResources = resources
+}
+
+// AllowedTargetResourcesFromConfiguration returns the resources that templates can read from target clusters
+// as defined in the landscaper configuration.
+func AllowedTargetResourcesFromConfiguration(cfg *config.TargetLookupConfiguration) []TargetResource {
+	if cfg == nil {
+		return nil
+	}
+	resources := make([]TargetResource, 0, len(cfg.AllowedResources))
+	for _, res := range cfg.AllowedResources {
+		resources = append(resources, TargetResource{
+			APIVersion: res.APIVersion,
+			Kind:       res.Kind,
+			Namespaces: res.Namespaces,
+		})
+	}
+	return resources
+}
+
+// IsTargetResourceAllowed returns whether templates can read resources of the given api version and kind
+// in the given namespace from target clusters.
+// The namespace is empty for cluster-scoped resources and for lists of resources of all namespaces,
+// which can only be read if the allowed resource is not restricted to namespaces.
+func IsTargetResourceAllowed(apiVersion, kind, namespace string) bool {
+	allowedTargetResourcesMux.RLock()
+	defer allowedTargetResourcesMux.RUnlock()
+	for _, res := range allowedTargetResources {
+		if res.APIVersion != apiVersion || (res.Kind != AllKinds && res.Kind != kind) {
+			continue
+		}
+		if len(res.Namespaces) == 0 || slices.Contains(res.Namespaces, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTargetServerVersion returns the kubernetes version of the cluster of a target, e.g. "v1.28.3".
+func GetTargetServerVersion(ctx context.Context, target *lsv1alpha1.Target, targetResolver targetresolver.TargetResolver) (string, error) {
+	client, err := clusters.NewClusterClientFromTarget(ctx, target, targetResolver)
+	if err != nil {
+		return "", err
+	}
+	return client.GetServerVersion()
+}
+
+// TargetHasAPI returns whether the cluster of a target serves an api.
+// The api is given as "<group>/<version>" or "<group>/<version>/<kind>", e.g. "apps/v1" or "apps/v1/Deployment".
+// Resources of the core api are given as "v1" or "v1/<kind>".
+func TargetHasAPI(ctx context.Context, api string, target *lsv1alpha1.Target, targetResolver targetresolver.TargetResolver) (bool, error) {
+	apiVersion, kind, err := parseAPI(api)
+	if err != nil {
+		return false, err
+	}
+	client, err := clusters.NewClusterClientFromTarget(ctx, target, targetResolver)
+	if err != nil {
+		return false, err
+	}
+	return client.HasAPI(apiVersion, kind)
+}
+
+// LookupTargetObject returns an object of the cluster of a target as unstructured map.
+// If no name is given, all objects of the kind in the namespace are returned as list.
+// An empty map is returned if the object does not exist.
+// Only resources that are allowed in the landscaper configuration can be read.
+func LookupTargetObject(ctx context.Context, apiVersion, kind, namespace, name string, target *lsv1alpha1.Target, targetResolver targetresolver.TargetResolver) (map[string]interface{}, error) {
+	if !IsTargetResourceAllowed(apiVersion, kind, namespace) {
+		return nil, fmt.Errorf("reading resources of api version %q and kind %q from target clusters is not allowed by the landscaper configuration (namespace %q)", apiVersion, kind, namespace)
+	}
+	client, err := clusters.NewClusterClientFromTarget(ctx, target, targetResolver)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetObject(ctx, apiVersion, kind, namespace, name)
+}
+
+// parseAPI splits an api of the form "<group>/<version>/<kind>" into its api version and kind.
+// The kind is optional and the group is omitted for the core api.
+func parseAPI(api string) (string, string, error) {
+	parts := strings.Split(api, "/")
+	switch {
+	case len(parts) == 1 && len(parts[0]) != 0:
+		return api, "", nil
+	case len(parts) == 2 && parts[0] == "v1":
+		// the core api has no group, so that the second part is the kind
+		return parts[0], parts[1], nil
+	case len(parts) == 2:
+		return api, "", nil
+	case len(parts) == 3:
+		return parts[0] + "/" + parts[1], parts[2], nil
+	default:
+		return "", "", fmt.Errorf("invalid api %q: expected <group>/<version> or <group>/<version>/<kind>", api)
+	}
+}
@@ -42,6 +42,17 @@ func New(templaters ...ExecutionTemplater) *Templater {
 	return t
 }
 
+// WithRenderedOutputRecorder sets the recorder that captures the raw rendered output
+// on all execution templaters that support it.
+func (o *Templater) WithRenderedOutputRecorder(recorder *RenderedOutputRecorder) *Templater {
+	for _, impl := range o.impl {
+		if r, ok := impl.(RenderedOutputRecordingTemplater); ok {
+			r.SetRenderedOutputRecorder(recorder)
+		}
+	}
+	return o
+}
+
 // ExecutionTemplater describes a implementation for a template execution
 type ExecutionTemplater interface {
 	// Type returns the type of the templater.
@@ -6,10 +6,13 @@ package template_test
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/open-component-model/ocm/pkg/runtime"
 
@@ -63,6 +66,135 @@ var _ = Describe("TemplateDeployExecutions", func() {
 			runTestSuite(testdataDirText, sharedTestdataDir)
 			runTestSuiteSpiff(testdataDirText)
 		})
+		Context("Limits", func() {
+			AfterEach(func() {
+				template.SetLimits(template.DefaultLimits)
+			})
+
+			It("should stop a cascade that exceeds the timeout with the next function call", func() {
+				template.SetLimits(template.Limits{Timeout: 50 * time.Millisecond})
+				stateHandler := template.NewMemoryStateHandler()
+				tmpl := []byte(`
+- name: test
+  type: Spiff
+  template:
+    deployItems:
+    - name: test
+      type: landscaper.gardener.cloud/mock
+      config:
+        value: (( .count(40) ))
+    count: '(( lambda |x|->x <= 0 ? length(ociRefRepo("example.com/repo:1.0.0")) : _(x - 1) + _(x - 1) ))'
+`)
+				exec := make([]lsv1alpha1.TemplateExecutor, 0)
+				Expect(yaml.Unmarshal(tmpl, &exec)).To(Succeed())
+				blue := &lsv1alpha1.Blueprint{}
+				blue.DeployExecutions = exec
+				op := template.New(gotemplate.New(stateHandler, nil), spiff.New(stateHandler, nil))
+
+				start := time.Now()
+				_, err := op.TemplateDeployExecutions(
+					template.NewDeployExecutionOptions(
+						template.NewBlueprintExecutionOptions(nil, &blueprints.Blueprint{Info: blue, Fs: nil}, nil, nil, map[string]interface{}{})))
+				Expect(err).To(HaveOccurred())
+				Expect(template.IsLimitExceededError(err)).To(BeTrue())
+				Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+			})
+		})
+		Context("Credentials", func() {
+			var stateHandler template.GenericStateHandler
+
+			BeforeEach(func() {
+				stateHandler = template.NewMemoryStateHandler()
+			})
+
+			execute := func(tmpl []byte) map[string]interface{} {
+				exec := make([]lsv1alpha1.TemplateExecutor, 0)
+				Expect(yaml.Unmarshal(tmpl, &exec)).To(Succeed())
+				blue := &lsv1alpha1.Blueprint{}
+				blue.DeployExecutions = exec
+				op := template.New(gotemplate.New(stateHandler, nil), spiff.New(stateHandler, nil))
+
+				res, err := op.TemplateDeployExecutions(
+					template.NewDeployExecutionOptions(
+						template.NewBlueprintExecutionOptions(nil, &blueprints.Blueprint{Info: blue, Fs: nil}, nil, nil, map[string]interface{}{})))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(HaveLen(1))
+				config := map[string]interface{}{}
+				Expect(yaml.Unmarshal(res[0].Configuration.Raw, &config)).To(Succeed())
+				return config
+			}
+
+			It("should generate a password that is stable across executions", func() {
+				tmpl := []byte(`
+- name: test
+  type: Spiff
+  template:
+    deployItems:
+    - name: test
+      type: landscaper.gardener.cloud/mock
+      config:
+        password: (( generatePassword("db", 24) ))
+`)
+				first := execute(tmpl)
+				Expect(first["password"]).To(HaveLen(24))
+				Expect(execute(tmpl)).To(Equal(first))
+			})
+
+			It("should generate a certificate that is signed by a generated ca", func() {
+				tmpl := []byte(`
+- name: test
+  type: Spiff
+  template:
+    deployItems:
+    - name: test
+      type: landscaper.gardener.cloud/mock
+      config:
+        cert: (( .server.cert ))
+        ca: (( .server.ca ))
+    ca: (( generateCA("ca", "my-ca", 365) ))
+    server: (( generateCert("server", "my-server", [ "example.com", "10.0.0.1" ], 30, ca) ))
+`)
+				config := execute(tmpl)
+
+				certBlock, _ := pem.Decode([]byte(config["cert"].(string)))
+				Expect(certBlock).ToNot(BeNil())
+				cert, err := x509.ParseCertificate(certBlock.Bytes)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cert.Subject.CommonName).To(Equal("my-server"))
+				Expect(cert.DNSNames).To(ConsistOf("example.com"))
+				Expect(cert.IPAddresses).To(HaveLen(1))
+
+				caBlock, _ := pem.Decode([]byte(config["ca"].(string)))
+				Expect(caBlock).ToNot(BeNil())
+				ca, err := x509.ParseCertificate(caBlock.Bytes)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cert.CheckSignatureFrom(ca)).To(Succeed())
+				Expect(execute(tmpl)).To(Equal(config))
+			})
+
+			It("should not store credentials of an execution that exceeded its timeout", func() {
+				limiter := template.NewExecutionLimiter(template.Limits{Timeout: time.Millisecond})
+				time.Sleep(5 * time.Millisecond)
+				store := template.NewCredentialStore(stateHandler).WithLimiter(limiter)
+
+				_, err := store.GeneratePassword(context.Background(), "db", 24)
+				Expect(err).To(HaveOccurred())
+				Expect(template.IsLimitExceededError(err)).To(BeTrue())
+				_, err = stateHandler.Get(context.Background(), "credential/db")
+				Expect(err).To(MatchError(template.StateNotFoundErr))
+			})
+
+			It("should not store credentials if the context is cancelled", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				store := template.NewCredentialStore(stateHandler)
+
+				_, err := store.GeneratePassword(ctx, "db", 24)
+				Expect(err).To(MatchError(context.Canceled))
+				_, err = stateHandler.Get(context.Background(), "credential/db")
+				Expect(err).To(MatchError(template.StateNotFoundErr))
+			})
+		})
 	})
 
 })
@@ -28,7 +28,9 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 )
 
@@ -83,9 +85,13 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 	}
 	targetResolver := genericresolver.New(c.LsUncachedClient())
 
+	outputRecorder := template.NewRenderedOutputRecorder(c.LsUncachedClient(), c.Inst.GetInstallation())
 	tmpl := template.New(
 		gotemplate.New(stateHdlr, targetResolver),
-		spiff.New(stateHdlr, targetResolver))
+		spiff.New(stateHdlr, targetResolver),
+		cue.New(stateHdlr),
+		jsonnet.New(stateHdlr, targetResolver)).
+		WithRenderedOutputRecorder(outputRecorder)
 	exports, err := tmpl.TemplateExportExecutions(
 		template.NewExportExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -94,6 +100,9 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 				c.ComponentVersion,
 				c.ResolvedComponentDescriptorList,
 				c.Inst.GetImports()), internalExports))
+	if storeErr := outputRecorder.Store(ctx); storeErr != nil {
+		logger.Error(storeErr, "unable to store rendered output of export executions")
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -21,7 +21,9 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 )
 
@@ -349,9 +351,13 @@ func (c *Constructor) RenderImportExecutions() error {
 		Inst:       c.Operation.Inst.GetInstallation(),
 	}
 	targetResolver := genericresolver.New(c.Operation.LsUncachedClient())
+	outputRecorder := template.NewRenderedOutputRecorder(c.Operation.LsUncachedClient(), c.Operation.Inst.GetInstallation())
 	tmpl := template.New(
 		gotemplate.New(templateStateHandler, targetResolver),
-		spiff.New(templateStateHandler, targetResolver))
+		spiff.New(templateStateHandler, targetResolver),
+		cue.New(templateStateHandler),
+		jsonnet.New(templateStateHandler, targetResolver)).
+		WithRenderedOutputRecorder(outputRecorder)
 	errors, bindings, err := tmpl.TemplateImportExecutions(
 		template.NewBlueprintExecutionOptions(
 			c.Operation.Context().External.InjectComponentDescriptorRef(c.Operation.Inst.GetInstallation()),
@@ -359,6 +365,10 @@ func (c *Constructor) RenderImportExecutions() error {
 			c.Operation.ComponentVersion,
 			c.Operation.ResolvedComponentDescriptorList,
 			c.Operation.Inst.GetImports()))
+	// the captured output is only used for debugging, so that errors while storing it do not fail the import rendering
+	ctx := context.Background()
+	defer ctx.Done()
+	_ = outputRecorder.Store(ctx)
 
 	if err != nil {
 		c.Operation.Inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
@@ -21,7 +21,9 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 	"github.com/gardener/landscaper/pkg/utils/dependencies"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
@@ -41,7 +43,7 @@ func (o *Operation) Ensure(ctx context.Context, subInstCache *lsv1alpha1.SubInst
 		return err
 	}
 
-	installationTmpl, err := o.getInstallationTemplates()
+	installationTmpl, err := o.getInstallationTemplates(ctx)
 	if err != nil {
 		err = fmt.Errorf("unable to get installation templates of blueprint: %w", err)
 		return o.NewError(err, "GetInstallationTemplates", err.Error())
@@ -183,7 +185,7 @@ func (o *Operation) cleanupOrphanedSubInstallations(ctx context.Context,
 }
 
 // getInstallationTemplates returns all installation templates defined by the referenced blueprint.
-func (o *Operation) getInstallationTemplates() ([]*lsv1alpha1.InstallationTemplate, error) {
+func (o *Operation) getInstallationTemplates(ctx context.Context) ([]*lsv1alpha1.InstallationTemplate, error) {
 	var instTmpls []*lsv1alpha1.InstallationTemplate
 	if len(o.Inst.GetBlueprint().Info.SubinstallationExecutions) != 0 {
 		templateStateHandler := template.KubernetesStateHandler{
@@ -191,7 +193,9 @@ func (o *Operation) getInstallationTemplates() ([]*lsv1alpha1.InstallationTempla
 			Inst:       o.Inst.GetInstallation(),
 		}
 		targetResolver := genericresolver.New(o.LsUncachedClient())
-		tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver))
+		outputRecorder := template.NewRenderedOutputRecorder(o.LsUncachedClient(), o.Inst.GetInstallation())
+		tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver), cue.New(templateStateHandler), jsonnet.New(templateStateHandler, targetResolver)).
+			WithRenderedOutputRecorder(outputRecorder)
 		templatedTmpls, err := tmpl.TemplateSubinstallationExecutions(template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
 				o.Context().External.InjectComponentDescriptorRef(o.Inst.GetInstallation().DeepCopy()),
@@ -199,6 +203,9 @@ func (o *Operation) getInstallationTemplates() ([]*lsv1alpha1.InstallationTempla
 				o.ComponentVersion,
 				o.ResolvedComponentDescriptorList,
 				o.Inst.GetImports())))
+		if storeErr := outputRecorder.Store(ctx); storeErr != nil {
+			logging.FromContextOrDiscard(ctx).Error(storeErr, "unable to store rendered output of subinstallation executions")
+		}
 
 		if err != nil {
 			return nil, fmt.Errorf("unable to template subinstllations: %w", err)
@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/xeipuuv/gojsonschema"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/gardener/landscaper/apis"
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	containerapi "github.com/gardener/landscaper/apis/deployer/container"
+	helmapi "github.com/gardener/landscaper/apis/deployer/helm"
+	manifestapi "github.com/gardener/landscaper/apis/deployer/manifest"
+	mockapi "github.com/gardener/landscaper/apis/deployer/mock"
+)
+
+// providerConfigurationKind is the kind of the provider configuration of the landscaper deployers.
+const providerConfigurationKind = "ProviderConfiguration"
+
+// landscaperDeployerGroups maps the deploy item types of the landscaper deployers to the api group of their provider configuration.
+var landscaperDeployerGroups = map[lsv1alpha1.DeployItemType]string{
+	"landscaper.gardener.cloud/helm":                helmapi.GroupName,
+	"landscaper.gardener.cloud/kubernetes-manifest": manifestapi.GroupName,
+	"landscaper.gardener.cloud/container":           containerapi.GroupName,
+	"landscaper.gardener.cloud/mock":                mockapi.GroupName,
+}
+
+var (
+	providerConfigurationSchemas    *ProviderConfigurationSchemas
+	providerConfigurationSchemasMux sync.RWMutex
+)
+
+// SetProviderConfigurationSchemas sets the provider configuration schemas that are used to validate rendered deploy items.
+// It is determined by the landscaper configuration.
+func SetProviderConfigurationSchemas(schemas *ProviderConfigurationSchemas) {
+	providerConfigurationSchemasMux.Lock()
+	defer providerConfigurationSchemasMux.Unlock()
+	providerConfigurationSchemas = schemas
+}
+
+// GetProviderConfigurationSchemas returns the provider configuration schemas that are used to validate rendered deploy items.
+// The schemas of the landscaper deployers are returned if no schemas have been set.
+func GetProviderConfigurationSchemas() (*ProviderConfigurationSchemas, error) {
+	providerConfigurationSchemasMux.RLock()
+	schemas := providerConfigurationSchemas
+	providerConfigurationSchemasMux.RUnlock()
+	if schemas != nil {
+		return schemas, nil
+	}
+	return DefaultProviderConfigurationSchemas()
+}
+
+var (
+	defaultProviderConfigurationSchemas     *ProviderConfigurationSchemas
+	defaultProviderConfigurationSchemasErr  error
+	defaultProviderConfigurationSchemasOnce sync.Once
+)
+
+// ProviderConfigurationSchemas contains the json schemas of the provider configurations of deploy items
+// by their deploy item type and the api version of the configuration.
+type ProviderConfigurationSchemas struct {
+	mux                 sync.RWMutex
+	rejectUnknownFields bool
+	validators          map[lsv1alpha1.DeployItemType]map[string]*Validator
+}
+
+// NewProviderConfigurationSchemas creates a new empty set of provider configuration schemas.
+func NewProviderConfigurationSchemas() *ProviderConfigurationSchemas {
+	return &ProviderConfigurationSchemas{
+		validators: map[lsv1alpha1.DeployItemType]map[string]*Validator{},
+	}
+}
+
+// WithRejectUnknownFields configures whether schemas that are registered afterwards reject fields
+// that are not defined in the schema.
+func (s *ProviderConfigurationSchemas) WithRejectUnknownFields(reject bool) *ProviderConfigurationSchemas {
+	s.rejectUnknownFields = reject
+	return s
+}
+
+// DefaultProviderConfigurationSchemas returns the provider configuration schemas of the landscaper deployers
+// (helm, manifest, container and mock) that are embedded in the landscaper apis.
+func DefaultProviderConfigurationSchemas() (*ProviderConfigurationSchemas, error) {
+	defaultProviderConfigurationSchemasOnce.Do(func() {
+		schemas := NewProviderConfigurationSchemas()
+		if err := schemas.registerLandscaperDeployers(); err != nil {
+			defaultProviderConfigurationSchemasErr = err
+			return
+		}
+		defaultProviderConfigurationSchemas = schemas
+	})
+	return defaultProviderConfigurationSchemas, defaultProviderConfigurationSchemasErr
+}
+
+// ProviderConfigurationSchemasFromConfiguration returns the provider configuration schemas as defined in the landscaper configuration.
+// The schemas of the landscaper deployers are registered first so that they can be overwritten by the deployer registrations.
+func ProviderConfigurationSchemasFromConfiguration(cfg *config.DeployItemValidationConfiguration) (*ProviderConfigurationSchemas, error) {
+	if cfg == nil {
+		return DefaultProviderConfigurationSchemas()
+	}
+	schemas := NewProviderConfigurationSchemas().WithRejectUnknownFields(cfg.RejectUnknownFields)
+	if err := schemas.registerLandscaperDeployers(); err != nil {
+		return nil, err
+	}
+	for _, registration := range cfg.DeployerRegistrations {
+		for _, providerSchema := range registration.Schemas {
+			if err := schemas.Register(lsv1alpha1.DeployItemType(registration.DeployItemType), providerSchema.APIVersion, providerSchema.Schema.RawMessage); err != nil {
+				return nil, fmt.Errorf("unable to register schema of deployer registration %s for %s: %w",
+					registration.DeployItemType, providerSchema.APIVersion, err)
+			}
+		}
+	}
+	return schemas, nil
+}
+
+// registerLandscaperDeployers registers the provider configuration schemas of the landscaper deployers
+// that are embedded in the landscaper apis.
+func (s *ProviderConfigurationSchemas) registerLandscaperDeployers() error {
+	for deployItemType, group := range landscaperDeployerGroups {
+		groupPrefix := strings.Split(group, ".")[0]
+		versions, err := apis.ListSchemaVersions(groupPrefix, providerConfigurationKind)
+		if err != nil {
+			return err
+		}
+		for _, version := range versions {
+			schemaBytes, err := apis.GetSchema(groupPrefix, version, providerConfigurationKind)
+			if err != nil {
+				return err
+			}
+			apiVersion := schema.GroupVersion{Group: group, Version: version}.String()
+			if err := s.Register(deployItemType, apiVersion, schemaBytes); err != nil {
+				return fmt.Errorf("unable to register schema for %s: %w", apiVersion, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Register registers the json schema of a provider configuration api version for a deploy item type.
+// The schema is relaxed for the validation of rendered configurations:
+// fields with a default value are not required and raw json fields accept any value.
+// Fields that are not defined in the schema are only rejected if configured with WithRejectUnknownFields.
+func (s *ProviderConfigurationSchemas) Register(deployItemType lsv1alpha1.DeployItemType, apiVersion string, schemaBytes []byte) error {
+	var data interface{}
+	if err := json.Unmarshal(schemaBytes, &data); err != nil {
+		return fmt.Errorf("unable to decode schema: %w", err)
+	}
+	data = relaxProviderConfigurationSchema(data)
+	if s.rejectUnknownFields {
+		data = rejectUnknownFields(data)
+	}
+	compiled, err := gojsonschema.NewSchemaLoader().Compile(gojsonschema.NewGoLoader(data))
+	if err != nil {
+		return fmt.Errorf("unable to compile schema: %w", err)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if _, ok := s.validators[deployItemType]; !ok {
+		s.validators[deployItemType] = map[string]*Validator{}
+	}
+	s.validators[deployItemType][apiVersion] = &Validator{Schema: compiled}
+	return nil
+}
+
+// Validate validates the provider configuration of a deploy item against the schema of its api version.
+// Configurations for which no schema is registered are not validated,
+// so that deploy items of other deployers or newer api versions are still passed to their deployer.
+func (s *ProviderConfigurationSchemas) Validate(deployItemType lsv1alpha1.DeployItemType, config []byte) error {
+	if len(config) == 0 {
+		return nil
+	}
+	typeMeta := struct {
+		APIVersion string `json:"apiVersion"`
+	}{}
+	if err := json.Unmarshal(config, &typeMeta); err != nil {
+		return fmt.Errorf("unable to decode provider configuration: %w", err)
+	}
+
+	s.mux.RLock()
+	validator, ok := s.validators[deployItemType][typeMeta.APIVersion]
+	s.mux.RUnlock()
+	if !ok {
+		return nil
+	}
+	return validator.ValidateBytes(config)
+}
+
+// relaxProviderConfigurationSchema adapts a schema that is generated from the openapi definitions of a provider configuration
+// to the configurations that are accepted by the deployers.
+// Fields without omitempty are required in the generated schema although the deployers default them,
+// and raw json fields are described as base64 encoded strings although they contain arbitrary json.
+func relaxProviderConfigurationSchema(data interface{}) interface{} {
+	switch typed := data.(type) {
+	case map[string]interface{}:
+		if typed["format"] == "byte" {
+			delete(typed, "type")
+			delete(typed, "format")
+		}
+		for key, value := range typed {
+			typed[key] = relaxProviderConfigurationSchema(value)
+		}
+		required, hasRequired := typed["required"].([]interface{})
+		properties, hasProperties := typed["properties"].(map[string]interface{})
+		if hasRequired && hasProperties {
+			relaxed := []interface{}{}
+			for _, name := range required {
+				prop, ok := properties[fmt.Sprint(name)].(map[string]interface{})
+				if !ok {
+					relaxed = append(relaxed, name)
+					continue
+				}
+				if _, hasDefault := prop["default"]; !hasDefault {
+					relaxed = append(relaxed, name)
+				}
+			}
+			if len(relaxed) == 0 {
+				delete(typed, "required")
+			} else {
+				typed["required"] = relaxed
+			}
+		}
+		return typed
+	case []interface{}:
+		for i, value := range typed {
+			typed[i] = relaxProviderConfigurationSchema(value)
+		}
+		return typed
+	}
+	return data
+}
+
+// rejectUnknownFields disallows additional properties in all object schemas that define their properties
+// and do not already describe their additional properties, e.g. maps.
+func rejectUnknownFields(data interface{}) interface{} {
+	switch typed := data.(type) {
+	case map[string]interface{}:
+		for key, value := range typed {
+			typed[key] = rejectUnknownFields(value)
+		}
+		_, hasProperties := typed["properties"].(map[string]interface{})
+		_, hasAdditionalProperties := typed["additionalProperties"]
+		if hasProperties && !hasAdditionalProperties {
+			typed["additionalProperties"] = false
+		}
+		return typed
+	case []interface{}:
+		for i, value := range typed {
+			typed[i] = rejectUnknownFields(value)
+		}
+		return typed
+	}
+	return data
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/apis/config"
+	lscore "github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+)
+
+var _ = Describe("ProviderConfigurationSchemas", func() {
+
+	var schemas *jsonschema.ProviderConfigurationSchemas
+
+	BeforeEach(func() {
+		var err error
+		schemas, err = jsonschema.DefaultProviderConfigurationSchemas()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should accept a valid helm provider configuration with arbitrary values", func() {
+		config := []byte(`{
+  "apiVersion": "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+  "kind": "ProviderConfiguration",
+  "name": "my-release",
+  "namespace": "default",
+  "chart": {"ref": "example.com/charts/app:1.0.0"},
+  "values": {"replicas": 3, "image": {"tag": "latest"}}
+}`)
+		Expect(schemas.Validate("landscaper.gardener.cloud/helm", config)).To(Succeed())
+	})
+
+	It("should reject a helm provider configuration with a wrongly typed field", func() {
+		config := []byte(`{
+  "apiVersion": "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+  "kind": "ProviderConfiguration",
+  "name": "my-release",
+  "namespace": "default",
+  "chart": "example.com/charts/app:1.0.0"
+}`)
+		err := schemas.Validate("landscaper.gardener.cloud/helm", config)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("chart"))
+	})
+
+	It("should validate the provider configuration against the schema of its api version", func() {
+		config := []byte(`{
+  "apiVersion": "manifest.deployer.landscaper.gardener.cloud/v1alpha2",
+  "kind": "ProviderConfiguration",
+  "manifests": [{"policy": "manage", "manifest": {"apiVersion": "v1", "kind": "ConfigMap"}}]
+}`)
+		Expect(schemas.Validate("landscaper.gardener.cloud/kubernetes-manifest", config)).To(Succeed())
+
+		config = []byte(`{
+  "apiVersion": "manifest.deployer.landscaper.gardener.cloud/v1alpha2",
+  "kind": "ProviderConfiguration",
+  "manifests": {"policy": "manage"}
+}`)
+		Expect(schemas.Validate("landscaper.gardener.cloud/kubernetes-manifest", config)).ToNot(Succeed())
+	})
+
+	It("should not validate provider configurations of unknown deploy item types and api versions", func() {
+		config := []byte(`{"apiVersion": "example.com/v1", "kind": "Config", "chart": "abc"}`)
+		Expect(schemas.Validate("example.com/custom", config)).To(Succeed())
+
+		config = []byte(`{"apiVersion": "helm.deployer.landscaper.gardener.cloud/v2", "kind": "ProviderConfiguration", "chart": "abc"}`)
+		Expect(schemas.Validate("landscaper.gardener.cloud/helm", config)).To(Succeed())
+	})
+
+	It("should validate provider configurations against registered schemas", func() {
+		custom := jsonschema.NewProviderConfigurationSchemas()
+		Expect(custom.Register("example.com/custom", "example.com/v1", []byte(`{
+  "type": "object",
+  "required": ["endpoint"],
+  "properties": {"endpoint": {"type": "string"}}
+}`))).To(Succeed())
+
+		Expect(custom.Validate("example.com/custom", []byte(`{"apiVersion": "example.com/v1", "endpoint": "https://example.com"}`))).To(Succeed())
+		Expect(custom.Validate("example.com/custom", []byte(`{"apiVersion": "example.com/v1"}`))).ToNot(Succeed())
+	})
+
+	It("should accept unknown fields by default", func() {
+		config := []byte(`{
+  "apiVersion": "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+  "kind": "ProviderConfiguration",
+  "name": "my-release",
+  "namespace": "default",
+  "chart": {"ref": "example.com/charts/app:1.0.0", "unknown": true},
+  "unknown": "abc"
+}`)
+		Expect(schemas.Validate("landscaper.gardener.cloud/helm", config)).To(Succeed())
+	})
+
+	Context("FromConfiguration", func() {
+
+		It("should reject unknown fields if configured", func() {
+			strict, err := jsonschema.ProviderConfigurationSchemasFromConfiguration(&config.DeployItemValidationConfiguration{
+				RejectUnknownFields: true,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			valid := []byte(`{
+  "apiVersion": "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+  "kind": "ProviderConfiguration",
+  "name": "my-release",
+  "namespace": "default",
+  "chart": {"ref": "example.com/charts/app:1.0.0"},
+  "values": {"replicas": 3, "image": {"tag": "latest"}}
+}`)
+			Expect(strict.Validate("landscaper.gardener.cloud/helm", valid)).To(Succeed())
+
+			unknown := []byte(`{
+  "apiVersion": "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+  "kind": "ProviderConfiguration",
+  "name": "my-release",
+  "namespace": "default",
+  "chart": {"ref": "example.com/charts/app:1.0.0", "unknown": true}
+}`)
+			err = strict.Validate("landscaper.gardener.cloud/helm", unknown)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown"))
+		})
+
+		It("should load the schemas of deployer registrations", func() {
+			registered, err := jsonschema.ProviderConfigurationSchemasFromConfiguration(&config.DeployItemValidationConfiguration{
+				DeployerRegistrations: []config.DeployerRegistration{
+					{
+						DeployItemType: "example.com/custom",
+						Schemas: []config.ProviderConfigurationSchema{
+							{
+								APIVersion: "example.com/v1",
+								Schema: lscore.NewAnyJSON([]byte(`{
+  "type": "object",
+  "required": ["endpoint"],
+  "properties": {"endpoint": {"type": "string"}}
+}`)),
+							},
+						},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(registered.Validate("example.com/custom", []byte(`{"apiVersion": "example.com/v1", "endpoint": "https://example.com"}`))).To(Succeed())
+			Expect(registered.Validate("example.com/custom", []byte(`{"apiVersion": "example.com/v1"}`))).ToNot(Succeed())
+			// the schemas of the landscaper deployers are still registered
+			Expect(registered.Validate("landscaper.gardener.cloud/helm", []byte(`{
+  "apiVersion": "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+  "kind": "ProviderConfiguration",
+  "chart": "example.com/charts/app:1.0.0"
+}`))).ToNot(Succeed())
+		})
+
+		It("should overwrite the schemas of the landscaper deployers with deployer registrations", func() {
+			registered, err := jsonschema.ProviderConfigurationSchemasFromConfiguration(&config.DeployItemValidationConfiguration{
+				DeployerRegistrations: []config.DeployerRegistration{
+					{
+						DeployItemType: "landscaper.gardener.cloud/helm",
+						Schemas: []config.ProviderConfigurationSchema{
+							{
+								APIVersion: "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+								Schema:     lscore.NewAnyJSON([]byte(`{"type": "object"}`)),
+							},
+						},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(registered.Validate("landscaper.gardener.cloud/helm", []byte(`{
+  "apiVersion": "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+  "kind": "ProviderConfiguration",
+  "chart": "example.com/charts/app:1.0.0"
+}`))).To(Succeed())
+		})
+
+		It("should fail for an invalid schema of a deployer registration", func() {
+			_, err := jsonschema.ProviderConfigurationSchemasFromConfiguration(&config.DeployItemValidationConfiguration{
+				DeployerRegistrations: []config.DeployerRegistration{
+					{
+						DeployItemType: "example.com/custom",
+						Schemas: []config.ProviderConfigurationSchema{
+							{
+								APIVersion: "example.com/v1",
+								Schema:     lscore.NewAnyJSON([]byte(`{"type": 5}`)),
+							},
+						},
+					},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("example.com/custom"))
+		})
+	})
+})
@@ -9,6 +9,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/gardener/landscaper/pkg/components/cache"
+	"github.com/gardener/landscaper/pkg/components/cache/shared"
+	"github.com/gardener/landscaper/pkg/components/cnudie/helmrepo"
 
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 )
@@ -23,4 +25,6 @@ func RegisterMetrics(reg prometheus.Registerer) {
 	cache.RegisterStoreMetrics(reg)
 	blueprints.RegisterStoreMetrics(reg)
 	componentcliMetrics.RegisterCacheMetrics(reg)
+	shared.RegisterMetrics(reg)
+	helmrepo.RegisterMetrics(reg)
 }
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+)
+
+const (
+	// ClusterRequestTimeout is the timeout of a single request of a cluster client.
+	ClusterRequestTimeout = 30 * time.Second
+	// DiscoveryCacheTTL is the duration for which the discovery information of a cluster is cached.
+	DiscoveryCacheTTL = 10 * time.Minute
+)
+
+// discoveryCacheEntry is a cached discovery client of a cluster.
+type discoveryCacheEntry struct {
+	client  discovery.DiscoveryInterface
+	expires time.Time
+}
+
+var (
+	// discoveryCache contains the cached discovery clients by the hash of the kubeconfig of their cluster.
+	discoveryCache    = map[string]*discoveryCacheEntry{}
+	discoveryCacheMux sync.Mutex
+)
+
+// ClusterClient reads the state of a kubernetes cluster.
+type ClusterClient struct {
+	discoveryClient discovery.DiscoveryInterface
+	dynamicClient   dynamic.Interface
+}
+
+// NewClusterClient creates a client for the cluster of a kubeconfig.
+// The discovery information of the cluster is cached per kubeconfig for the DiscoveryCacheTTL,
+// so that template functions that read the same target do not repeat the discovery of the cluster.
+func NewClusterClient(kubeconfigBytes []byte) (*ClusterClient, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfigBytes)
+	if err != nil {
+		return nil, fmt.Errorf("cluster client: unable to get rest config: %w", err)
+	}
+	if restConfig.Timeout == 0 {
+		restConfig.Timeout = ClusterRequestTimeout
+	}
+
+	discoveryClient, err := getCachedDiscoveryClient(kubeconfigBytes, func() (discovery.DiscoveryInterface, error) {
+		return discovery.NewDiscoveryClientForConfig(restConfig)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cluster client: unable to create discovery client: %w", err)
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("cluster client: unable to create dynamic client: %w", err)
+	}
+
+	return newClusterClient(discoveryClient, dynamicClient), nil
+}
+
+func newClusterClient(discoveryClient discovery.DiscoveryInterface, dynamicClient dynamic.Interface) *ClusterClient {
+	return &ClusterClient{
+		discoveryClient: discoveryClient,
+		dynamicClient:   dynamicClient,
+	}
+}
+
+// getCachedDiscoveryClient returns the cached discovery client of a kubeconfig
+// or creates and caches a new one if there is none or if it is expired.
+// Expired entries of other clusters are removed from the cache.
+func getCachedDiscoveryClient(kubeconfigBytes []byte, newClient func() (discovery.DiscoveryInterface, error)) (discovery.DiscoveryInterface, error) {
+	hash := sha256.Sum256(kubeconfigBytes)
+	key := hex.EncodeToString(hash[:])
+
+	discoveryCacheMux.Lock()
+	defer discoveryCacheMux.Unlock()
+
+	now := time.Now()
+	for k, entry := range discoveryCache {
+		if now.After(entry.expires) {
+			delete(discoveryCache, k)
+		}
+	}
+	if entry, ok := discoveryCache[key]; ok {
+		return entry.client, nil
+	}
+
+	client, err := newClient()
+	if err != nil {
+		return nil, err
+	}
+	cachedClient := memory.NewMemCacheClient(client)
+	discoveryCache[key] = &discoveryCacheEntry{
+		client:  cachedClient,
+		expires: now.Add(DiscoveryCacheTTL),
+	}
+	return cachedClient, nil
+}
+
+func NewClusterClientFromTarget(ctx context.Context, target *v1alpha1.Target, targetResolver targetresolver.TargetResolver) (*ClusterClient, error) {
+	resolvedTarget, err := targetResolver.Resolve(ctx, target)
+	if err != nil {
+		return nil, fmt.Errorf("cluster client: could not resolve target: %w", err)
+	}
+
+	targetConfig := &targettypes.KubernetesClusterTargetConfig{}
+	err = json.Unmarshal([]byte(resolvedTarget.Content), targetConfig)
+	if err != nil {
+		return nil, fmt.Errorf("cluster client: failed to unmarshal target config: %w", err)
+	}
+	if targetConfig.Kubeconfig.StrVal == nil {
+		return nil, fmt.Errorf("cluster client: target config contains no kubeconfig")
+	}
+
+	kubeconfigBytes := []byte(*targetConfig.Kubeconfig.StrVal)
+	return NewClusterClient(kubeconfigBytes)
+}
+
+// GetServerVersion returns the git version of the kubernetes api server, e.g. "v1.28.3".
+func (c *ClusterClient) GetServerVersion() (string, error) {
+	info, err := c.discoveryClient.ServerVersion()
+	if err != nil {
+		return "", fmt.Errorf("cluster client: unable to get server version: %w", err)
+	}
+	return info.GitVersion, nil
+}
+
+// HasAPI returns whether the cluster serves the given api version.
+// If a kind is given, the api version must also contain a resource of that kind.
+func (c *ClusterClient) HasAPI(apiVersion, kind string) (bool, error) {
+	resources, err := c.serverResources(apiVersion)
+	if err != nil {
+		return false, err
+	}
+	if resources == nil {
+		return false, nil
+	}
+	if len(kind) == 0 {
+		return true, nil
+	}
+	return findResource(resources, kind) != nil, nil
+}
+
+// GetObject returns the object with the given api version, kind, namespace and name as unstructured map.
+// If no name is given, all objects of the kind in the namespace are returned as list.
+// An empty map is returned if the object or the api does not exist.
+func (c *ClusterClient) GetObject(ctx context.Context, apiVersion, kind, namespace, name string) (map[string]interface{}, error) {
+	resources, err := c.serverResources(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	if resources == nil {
+		return map[string]interface{}{}, nil
+	}
+	resource := findResource(resources, kind)
+	if resource == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("cluster client: invalid api version %q: %w", apiVersion, err)
+	}
+	namespaceableClient := c.dynamicClient.Resource(gv.WithResource(resource.Name))
+	var resourceClient dynamic.ResourceInterface = namespaceableClient
+	if resource.Namespaced {
+		resourceClient = namespaceableClient.Namespace(namespace)
+	}
+
+	if len(name) == 0 {
+		list, err := resourceClient.List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("cluster client: unable to list %s %s: %w", apiVersion, kind, err)
+		}
+		return list.UnstructuredContent(), nil
+	}
+
+	obj, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[string]interface{}{}, nil
+		}
+		return nil, fmt.Errorf("cluster client: unable to get %s %s %q: %w", apiVersion, kind, name, err)
+	}
+	return obj.UnstructuredContent(), nil
+}
+
+// serverResources returns the resources of an api version or nil if the api version is not served.
+func (c *ClusterClient) serverResources(apiVersion string) (*metav1.APIResourceList, error) {
+	resources, err := c.discoveryClient.ServerResourcesForGroupVersion(apiVersion)
+	if err != nil {
+		if apierrors.IsNotFound(err) || errors.Is(err, memory.ErrCacheNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cluster client: unable to get resources of api version %q: %w", apiVersion, err)
+	}
+	return resources, nil
+}
+
+// findResource returns the resource of a kind in the resource list, ignoring subresources.
+func findResource(resources *metav1.APIResourceList, kind string) *metav1.APIResource {
+	for i, resource := range resources.APIResources {
+		if resource.Kind == kind && !strings.Contains(resource.Name, "/") {
+			return &resources.APIResources[i]
+		}
+	}
+	return nil
+}
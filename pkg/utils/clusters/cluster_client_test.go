@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://%s.example.com
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+users:
+- name: test
+  user:
+    token: abc
+`
+
+var _ = Describe("ClusterClient", func() {
+
+	var client *ClusterClient
+
+	BeforeEach(func() {
+		fakeDiscovery := &fakediscovery.FakeDiscovery{
+			Fake: &k8stesting.Fake{
+				Resources: []*metav1.APIResourceList{
+					{
+						GroupVersion: "v1",
+						APIResources: []metav1.APIResource{
+							{Name: "configmaps", Kind: "ConfigMap", Namespaced: true},
+							{Name: "namespaces", Kind: "Namespace"},
+							{Name: "namespaces/status", Kind: "Namespace"},
+						},
+					},
+				},
+			},
+			FakedServerVersion: &version.Info{GitVersion: "v1.28.3"},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		fakeDynamic := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(scheme,
+			map[schema.GroupVersionResource]string{
+				{Version: "v1", Resource: "configmaps"}: "ConfigMapList",
+				{Version: "v1", Resource: "namespaces"}: "NamespaceList",
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-config", Namespace: "default"},
+				Data:       map[string]string{"key": "value"},
+			},
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			},
+		)
+		client = newClusterClient(memory.NewMemCacheClient(fakeDiscovery), fakeDynamic)
+	})
+
+	It("should return the server version", func() {
+		serverVersion, err := client.GetServerVersion()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(serverVersion).To(Equal("v1.28.3"))
+	})
+
+	It("should return whether the cluster serves an api", func() {
+		Expect(client.HasAPI("v1", "")).To(BeTrue())
+		Expect(client.HasAPI("v1", "ConfigMap")).To(BeTrue())
+		Expect(client.HasAPI("v1", "Secret")).To(BeFalse())
+		Expect(client.HasAPI("apps/v1", "")).To(BeFalse())
+		Expect(client.HasAPI("apps/v1", "Deployment")).To(BeFalse())
+	})
+
+	It("should get a namespaced object", func() {
+		obj, err := client.GetObject(context.Background(), "v1", "ConfigMap", "default", "my-config")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obj).To(HaveKeyWithValue("data", HaveKeyWithValue("key", "value")))
+	})
+
+	It("should get a cluster-scoped object", func() {
+		obj, err := client.GetObject(context.Background(), "v1", "Namespace", "", "default")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obj).To(HaveKeyWithValue("metadata", HaveKeyWithValue("name", "default")))
+	})
+
+	It("should list the objects of a namespace if no name is given", func() {
+		obj, err := client.GetObject(context.Background(), "v1", "ConfigMap", "default", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obj).To(HaveKeyWithValue("items", HaveLen(1)))
+	})
+
+	It("should return an empty map for objects, kinds and apis that do not exist", func() {
+		obj, err := client.GetObject(context.Background(), "v1", "ConfigMap", "default", "unknown")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obj).To(BeEmpty())
+
+		obj, err = client.GetObject(context.Background(), "v1", "Secret", "default", "my-secret")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obj).To(BeEmpty())
+
+		obj, err = client.GetObject(context.Background(), "apps/v1", "Deployment", "default", "my-deployment")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obj).To(BeEmpty())
+	})
+
+	Context("discovery cache", func() {
+
+		AfterEach(func() {
+			discoveryCacheMux.Lock()
+			defer discoveryCacheMux.Unlock()
+			discoveryCache = map[string]*discoveryCacheEntry{}
+		})
+
+		It("should reuse the discovery client of a kubeconfig", func() {
+			client1, err := NewClusterClient([]byte(fmtKubeconfig("cluster-a")))
+			Expect(err).ToNot(HaveOccurred())
+			client2, err := NewClusterClient([]byte(fmtKubeconfig("cluster-a")))
+			Expect(err).ToNot(HaveOccurred())
+			client3, err := NewClusterClient([]byte(fmtKubeconfig("cluster-b")))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(client1.discoveryClient).To(BeIdenticalTo(client2.discoveryClient))
+			Expect(client1.discoveryClient).ToNot(BeIdenticalTo(client3.discoveryClient))
+		})
+
+		It("should replace expired discovery clients and evict expired entries", func() {
+			created := 0
+			newClient := func() (discovery.DiscoveryInterface, error) {
+				created++
+				return &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{}}, nil
+			}
+
+			_, err := getCachedDiscoveryClient([]byte("a"), newClient)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = getCachedDiscoveryClient([]byte("b"), newClient)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = getCachedDiscoveryClient([]byte("a"), newClient)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(Equal(2))
+
+			discoveryCacheMux.Lock()
+			for _, entry := range discoveryCache {
+				entry.expires = time.Now().Add(-time.Second)
+			}
+			discoveryCacheMux.Unlock()
+
+			_, err = getCachedDiscoveryClient([]byte("a"), newClient)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(Equal(3))
+			discoveryCacheMux.Lock()
+			Expect(discoveryCache).To(HaveLen(1))
+			discoveryCacheMux.Unlock()
+		})
+	})
+})
+
+func fmtKubeconfig(host string) string {
+	return fmt.Sprintf(testKubeconfig, host)
+}
@@ -21,7 +21,9 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 	"github.com/gardener/landscaper/pkg/landscaper/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
 	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
@@ -128,7 +130,9 @@ func (r *BlueprintRenderer) RenderImportExecutions(input *ResolvedInstallation,
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
 		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter),
+		cue.New(templateStateHandler).WithInputFormatter(formatter),
+		jsonnet.New(templateStateHandler, nil).WithInputFormatter(formatter))
 	errorList, bindings, err := tmpl.TemplateImportExecutions(
 		template.NewBlueprintExecutionOptions(
 			input.Installation,
@@ -174,7 +178,9 @@ func (r *BlueprintRenderer) RenderExportExecutions(input *ResolvedInstallation,
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
 		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter),
+		cue.New(templateStateHandler).WithInputFormatter(formatter),
+		jsonnet.New(templateStateHandler, nil).WithInputFormatter(formatter))
 	exports, err := tmpl.TemplateExportExecutions(
 		template.NewExportExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -200,7 +206,9 @@ func (r *BlueprintRenderer) renderDeployItems(input *ResolvedInstallation, impor
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
 		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter),
+		cue.New(templateStateHandler).WithInputFormatter(formatter),
+		jsonnet.New(templateStateHandler, nil).WithInputFormatter(formatter))
 	executions, err := tmpl.TemplateDeployExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -333,7 +341,9 @@ func (r *BlueprintRenderer) renderSubInstallations(input *ResolvedInstallation,
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
 		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter),
+		cue.New(templateStateHandler).WithInputFormatter(formatter),
+		jsonnet.New(templateStateHandler, nil).WithInputFormatter(formatter))
 	subInstallationTemplates, err := tmpl.TemplateSubinstallationExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintlint_test
+
+import (
+	"context"
+	"os"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/utils/landscaper/blueprintlint"
+)
+
+var _ = Describe("Blueprint Linter", func() {
+
+	var (
+		ctx     context.Context
+		imports map[string]interface{}
+	)
+
+	readBlueprint := func(dir string) *blueprints.Blueprint {
+		fs, err := projectionfs.New(osfs.New(), dir)
+		Expect(err).ToNot(HaveOccurred())
+		blueprint, err := blueprints.NewFromFs(fs)
+		Expect(err).ToNot(HaveOccurred())
+		return blueprint
+	}
+
+	diagnostic := func(severity blueprintlint.Severity, line int, field string, message string) types.GomegaMatcher {
+		return MatchFields(IgnoreExtras, Fields{
+			"Severity": Equal(severity),
+			"Line":     Equal(line),
+			"Field":    Equal(field),
+			"Message":  ContainSubstring(message),
+		})
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		data, err := os.ReadFile("./testdata/imports.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(yaml.Unmarshal(data, &imports)).To(Succeed())
+	})
+
+	It("should report no findings for a valid blueprint", func() {
+		result := blueprintlint.Lint(ctx, readBlueprint("./testdata/valid"), blueprintlint.Options{Imports: imports})
+		Expect(result.Diagnostics).To(BeEmpty())
+	})
+
+	It("should report that the rendered executions are not checked without import values", func() {
+		result := blueprintlint.Lint(ctx, readBlueprint("./testdata/valid"), blueprintlint.Options{})
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(result.Diagnostics).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Severity": Equal(blueprintlint.SeverityInfo),
+		})))
+	})
+
+	It("should report the mistakes of a blueprint with their lines", func() {
+		result := blueprintlint.Lint(ctx, readBlueprint("./testdata/invalid"), blueprintlint.Options{Imports: imports})
+		Expect(result.Count(blueprintlint.SeverityError)).To(Equal(4))
+		Expect(result.Count(blueprintlint.SeverityWarning)).To(Equal(3))
+		Expect(result.Diagnostics).To(ContainElements(
+			diagnostic(blueprintlint.SeverityWarning, 15, "imports[2]", `import "unused" is not used`),
+			diagnostic(blueprintlint.SeverityWarning, 27, "exports[1]", `export "missing" is not set`),
+			diagnostic(blueprintlint.SeverityError, 35, "importExecutions[0].file", `unable to read template file "/validate.yaml"`),
+			diagnostic(blueprintlint.SeverityError, 37, "deployItems[0][app]", "depends on undefined deploy item"),
+			diagnostic(blueprintlint.SeverityError, 77, "subinstallations[0].imports", `does not import the required import "message"`),
+			diagnostic(blueprintlint.SeverityWarning, 79, "subinstallations[0].imports.data[msg]", `import "msg" is not defined`),
+			diagnostic(blueprintlint.SeverityError, 79, "subinstallations.echo.imports.data[0][msg]", "import not satisfied"),
+		))
+	})
+
+	It("should not report unused imports if a template accesses all imports", func() {
+		blueprint := readBlueprint("./testdata/invalid")
+		blueprint.Info.DeployExecutions[0].Template.RawMessage = []byte(`"{{ toYaml .imports }}"`)
+		result := blueprintlint.Lint(ctx, blueprint, blueprintlint.Options{})
+		for _, d := range result.Diagnostics {
+			Expect(d.Message).ToNot(ContainSubstring("is not used"))
+		}
+	})
+
+})
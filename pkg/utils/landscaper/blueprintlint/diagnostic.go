@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintlint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Severity is the severity of a diagnostic.
+type Severity string
+
+const (
+	// SeverityError marks a mistake that makes the blueprint fail at runtime.
+	SeverityError Severity = "error"
+	// SeverityWarning marks a probable mistake, e.g. an import that is never used.
+	SeverityWarning Severity = "warning"
+	// SeverityInfo marks a hint, e.g. a check that has been skipped.
+	SeverityInfo Severity = "info"
+)
+
+// Diagnostic is a single finding of the linter.
+type Diagnostic struct {
+	// Severity is the severity of the finding.
+	Severity Severity
+	// File is the file of the blueprint the finding refers to.
+	// It is empty if the finding does not refer to a file, e.g. for rendered objects.
+	File string
+	// Line is the line in the file the finding refers to, starting at 1.
+	// It is 0 if the line is unknown.
+	Line int
+	// Field is the path of the field of the blueprint the finding refers to, e.g. "imports[0].schema".
+	Field string
+	// Message describes the finding.
+	Message string
+}
+
+// String returns the diagnostic in the form "<file>:<line>: <severity>: <field>: <message>".
+func (d Diagnostic) String() string {
+	sb := strings.Builder{}
+	if len(d.File) != 0 {
+		sb.WriteString(d.File)
+		if d.Line > 0 {
+			sb.WriteString(fmt.Sprintf(":%d", d.Line))
+		}
+		sb.WriteString(": ")
+	}
+	sb.WriteString(string(d.Severity))
+	sb.WriteString(": ")
+	if len(d.Field) != 0 {
+		sb.WriteString(d.Field)
+		sb.WriteString(": ")
+	}
+	sb.WriteString(d.Message)
+	return sb.String()
+}
+
+// Result contains all findings of the linter.
+type Result struct {
+	Diagnostics []Diagnostic
+}
+
+// Add adds a diagnostic to the result.
+func (r *Result) Add(d Diagnostic) {
+	r.Diagnostics = append(r.Diagnostics, d)
+}
+
+// Count returns the number of diagnostics with the given severity.
+func (r *Result) Count(severity Severity) int {
+	count := 0
+	for _, d := range r.Diagnostics {
+		if d.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// HasErrors returns whether the result contains errors.
+func (r *Result) HasErrors() bool {
+	return r.Count(SeverityError) != 0
+}
+
+// Sort sorts the diagnostics by their file and line, diagnostics without file come last.
+func (r *Result) Sort() {
+	sort.SliceStable(r.Diagnostics, func(i, j int) bool {
+		a, b := r.Diagnostics[i], r.Diagnostics[j]
+		if (len(a.File) == 0) != (len(b.File) == 0) {
+			return len(a.File) != 0
+		}
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line
+	})
+}
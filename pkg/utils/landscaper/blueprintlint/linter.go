@@ -0,0 +1,476 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintlint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/readonlyfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/mandelsoft/vfs/pkg/yamlfs"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/validation"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/registry/components/cdutils"
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+// Options are the optional inputs of the linter.
+type Options struct {
+	// ComponentVersion is the component version that contains the blueprint.
+	// If it is not given, references to resources of the component are not checked.
+	ComponentVersion model.ComponentVersion
+	// ComponentVersions contains the component versions that are referenced by the component version.
+	ComponentVersions *model.ComponentVersionList
+	// Imports are import values that are used to render the deploy and subinstallation executions.
+	// If they are not given, the rendered deploy items and subinstallations are not checked.
+	Imports map[string]interface{}
+}
+
+// linter analyses a single blueprint.
+type linter struct {
+	blueprint *blueprints.Blueprint
+	opts      Options
+	locator   *sourceLocator
+	result    *Result
+}
+
+// Lint analyses the blueprint and reports mistakes that are otherwise only found at runtime:
+// invalid definitions, imports that are never used, exports that are never set,
+// subinstallation imports that are not satisfied, unresolvable references to the component
+// and, if import values are given, invalid rendered deploy items and subinstallations.
+// The analysis of templates is based on the names they contain, so that it reports probable mistakes as warnings.
+func Lint(ctx context.Context, blueprint *blueprints.Blueprint, opts Options) *Result {
+	l := &linter{
+		blueprint: blueprint,
+		opts:      opts,
+		locator:   newSourceLocator(nil),
+		result:    &Result{},
+	}
+	if data, err := vfs.ReadFile(blueprint.Fs, lsv1alpha1.BlueprintFileName); err == nil {
+		l.locator = newSourceLocator(data)
+	}
+
+	l.validateDefinition()
+	installationTemplates := l.lintSubinstallations(ctx)
+	l.lintTemplateLibraries(ctx)
+	l.lintImports(installationTemplates)
+	l.lintExports()
+	if rendered, ok := l.lintRenderedExecutions(); ok {
+		installationTemplates = append(installationTemplates, rendered...)
+	}
+	l.validateInstallationTemplates(installationTemplates)
+
+	l.result.Sort()
+	return l.result
+}
+
+// report adds a diagnostic for a field of the blueprint file.
+func (l *linter) report(severity Severity, fieldPath string, format string, args ...interface{}) {
+	l.result.Add(Diagnostic{
+		Severity: severity,
+		File:     lsv1alpha1.BlueprintFileName,
+		Line:     l.locator.Line(fieldPath),
+		Field:    fieldPath,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// reportFieldErrors adds a diagnostic for every field error.
+// Errors that are no field errors are reported for the given default field.
+func (l *linter) reportFieldErrors(severity Severity, defaultField string, err error) {
+	var errs []error
+	if agg, ok := err.(utilerrors.Aggregate); ok {
+		errs = agg.Errors()
+	} else {
+		errs = []error{err}
+	}
+	for _, err := range errs {
+		var fieldErr *field.Error
+		if errors.As(err, &fieldErr) {
+			l.report(severity, fieldErr.Field, "%s", fieldErr.ErrorBody())
+			continue
+		}
+		l.report(severity, defaultField, "%s", err.Error())
+	}
+}
+
+// validateDefinition validates the structure of the blueprint.
+func (l *linter) validateDefinition() {
+	coreBlueprint := &core.Blueprint{}
+	if err := lsv1alpha1.Convert_v1alpha1_Blueprint_To_core_Blueprint(l.blueprint.Info, coreBlueprint, nil); err != nil {
+		l.report(SeverityError, "", "unable to convert blueprint: %s", err.Error())
+		return
+	}
+	if errs := validation.ValidateBlueprint(coreBlueprint); len(errs) != 0 {
+		l.reportFieldErrors(SeverityError, "", errs.ToAggregate())
+	}
+
+	for _, list := range []struct {
+		field      string
+		executions []lsv1alpha1.TemplateExecutor
+	}{
+		{"importExecutions", l.blueprint.Info.ImportExecutions},
+		{"deployExecutions", l.blueprint.Info.DeployExecutions},
+		{"subinstallationExecutions", l.blueprint.Info.SubinstallationExecutions},
+		{"exportExecutions", l.blueprint.Info.ExportExecutions},
+	} {
+		for i, exec := range list.executions {
+			if len(exec.File) == 0 {
+				continue
+			}
+			if _, err := vfs.ReadFile(l.blueprint.Fs, exec.File); err != nil {
+				l.report(SeverityError, fmt.Sprintf("%s[%d].file", list.field, i), "unable to read template file %q: %s", exec.File, err.Error())
+			}
+		}
+	}
+}
+
+// lintSubinstallations checks that the blueprints of the static subinstallations can be resolved
+// and that the subinstallations import all required imports of their blueprints.
+// It returns the installation templates of the static subinstallations.
+func (l *linter) lintSubinstallations(ctx context.Context) []*lsv1alpha1.InstallationTemplate {
+	installationTemplates, err := l.blueprint.GetSubinstallations()
+	if err != nil {
+		l.reportFieldErrors(SeverityError, "subinstallations", err)
+		return nil
+	}
+
+	for i, tmpl := range installationTemplates {
+		fieldPath := fmt.Sprintf("subinstallations[%d]", i)
+		subBlueprint, err := l.resolveSubinstallationBlueprint(ctx, tmpl)
+		if err != nil {
+			l.report(SeverityError, fieldPath+".blueprint", "unable to resolve blueprint of subinstallation %q: %s", tmpl.Name, err.Error())
+			continue
+		}
+		if subBlueprint == nil {
+			continue
+		}
+
+		imported := map[string]bool{}
+		for _, imp := range tmpl.Imports.Data {
+			imported[imp.Name] = true
+		}
+		for _, imp := range tmpl.Imports.Targets {
+			imported[imp.Name] = true
+		}
+		for name := range tmpl.ImportDataMappings {
+			imported[name] = true
+		}
+
+		defined := map[string]bool{}
+		for _, def := range flattenImports(subBlueprint.Info.Imports, "") {
+			defined[def.Name] = true
+			if !isRequired(def.ImportDefinition) || imported[def.Name] {
+				continue
+			}
+			if !def.conditional {
+				l.report(SeverityError, fieldPath+".imports", "subinstallation %q does not import the required import %q of its blueprint", tmpl.Name, def.Name)
+			}
+		}
+		for _, imp := range tmpl.Imports.Data {
+			if !defined[imp.Name] {
+				l.report(SeverityWarning, fmt.Sprintf("%s.imports.data[%s]", fieldPath, imp.Name), "import %q is not defined by the blueprint of subinstallation %q", imp.Name, tmpl.Name)
+			}
+		}
+		for _, imp := range tmpl.Imports.Targets {
+			if !defined[imp.Name] {
+				l.report(SeverityWarning, fmt.Sprintf("%s.imports.targets[%s]", fieldPath, imp.Name), "import %q is not defined by the blueprint of subinstallation %q", imp.Name, tmpl.Name)
+			}
+		}
+	}
+	return installationTemplates
+}
+
+// resolveSubinstallationBlueprint returns the blueprint of a subinstallation.
+// Nil is returned if the blueprint is a reference and no component version is given.
+func (l *linter) resolveSubinstallationBlueprint(ctx context.Context, tmpl *lsv1alpha1.InstallationTemplate) (*blueprints.Blueprint, error) {
+	if len(tmpl.Blueprint.Filesystem.RawMessage) != 0 {
+		inlineFs, err := yamlfs.New(tmpl.Blueprint.Filesystem.RawMessage)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create yamlfs for inline blueprint: %w", err)
+		}
+		fs := memoryfs.New()
+		if err := utils.CopyFS(inlineFs, fs, "/", "/"); err != nil {
+			return nil, fmt.Errorf("unable to copy yaml filesystem: %w", err)
+		}
+		return blueprints.NewFromFs(readonlyfs.New(fs))
+	}
+
+	if len(tmpl.Blueprint.Ref) == 0 || l.opts.ComponentVersion == nil {
+		return nil, nil
+	}
+	uri, err := cdutils.ParseURI(tmpl.Blueprint.Ref)
+	if err != nil {
+		return nil, err
+	}
+	_, resource, err := uri.GetResource(l.opts.ComponentVersion, l.opts.ComponentVersion.GetRepositoryContext())
+	if err != nil {
+		return nil, err
+	}
+	content, err := resource.GetTypedContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blueprint, ok := content.Resource.(*blueprints.Blueprint)
+	if !ok {
+		return nil, fmt.Errorf("received resource of type %T but expected type *Blueprint", content.Resource)
+	}
+	return blueprint, nil
+}
+
+// lintTemplateLibraries checks that the template libraries of all executions can be resolved.
+func (l *linter) lintTemplateLibraries(ctx context.Context) {
+	if l.opts.ComponentVersion == nil {
+		return
+	}
+	for _, list := range []struct {
+		field      string
+		executions []lsv1alpha1.TemplateExecutor
+	}{
+		{"importExecutions", l.blueprint.Info.ImportExecutions},
+		{"deployExecutions", l.blueprint.Info.DeployExecutions},
+		{"subinstallationExecutions", l.blueprint.Info.SubinstallationExecutions},
+		{"exportExecutions", l.blueprint.Info.ExportExecutions},
+	} {
+		for i, exec := range list.executions {
+			if len(exec.Libraries) == 0 {
+				continue
+			}
+			if _, err := template.ResolveTemplateLibraries(ctx, exec, l.opts.ComponentVersion); err != nil {
+				l.report(SeverityError, fmt.Sprintf("%s[%d].libraries", list.field, i), "%s", err.Error())
+			}
+		}
+	}
+}
+
+// lintImports reports imports that are neither used by an execution nor by a static subinstallation.
+func (l *linter) lintImports(installationTemplates []*lsv1alpha1.InstallationTemplate) {
+	sources := &templateSources{}
+	visited := map[string]bool{}
+	for _, executions := range [][]lsv1alpha1.TemplateExecutor{
+		l.blueprint.Info.ImportExecutions,
+		l.blueprint.Info.DeployExecutions,
+		l.blueprint.Info.SubinstallationExecutions,
+		l.blueprint.Info.ExportExecutions,
+	} {
+		for _, exec := range executions {
+			if source, err := executorSource(l.blueprint.Fs, exec); err == nil {
+				sources.add(l.blueprint.Fs, source, visited)
+			}
+		}
+	}
+	if sources.hasDynamicAccess() {
+		return
+	}
+
+	used := map[string]bool{}
+	for _, tmpl := range installationTemplates {
+		if tmpl == nil {
+			continue
+		}
+		for _, imp := range tmpl.Imports.Data {
+			used[imp.DataRef] = true
+		}
+		for _, imp := range tmpl.Imports.Targets {
+			used[imp.Target] = true
+			used[imp.TargetListReference] = true
+			used[imp.TargetMapReference] = true
+			for _, t := range imp.Targets {
+				used[t] = true
+			}
+			for _, t := range imp.TargetMap {
+				used[t] = true
+			}
+		}
+		for _, mapping := range tmpl.ImportDataMappings {
+			sources.sources = append(sources.sources, string(mapping.RawMessage))
+		}
+	}
+
+	for _, def := range flattenImports(l.blueprint.Info.Imports, "imports") {
+		if used[def.Name] || sources.references(def.Name) {
+			continue
+		}
+		l.report(SeverityWarning, def.fieldPath, "import %q is not used by any execution or subinstallation", def.Name)
+	}
+}
+
+// lintExports reports exports that are never set by an export execution.
+func (l *linter) lintExports() {
+	if len(l.blueprint.Info.Exports) == 0 {
+		return
+	}
+	if len(l.blueprint.Info.ExportExecutions) == 0 {
+		for i, def := range l.blueprint.Info.Exports {
+			l.report(SeverityError, fmt.Sprintf("exports[%d]", i), "export %q is never set, because the blueprint has no export executions", def.Name)
+		}
+		return
+	}
+
+	sources := &templateSources{}
+	visited := map[string]bool{}
+	for _, exec := range l.blueprint.Info.ExportExecutions {
+		if source, err := executorSource(l.blueprint.Fs, exec); err == nil {
+			sources.add(l.blueprint.Fs, source, visited)
+		}
+	}
+	if sources.hasDynamicAccess() {
+		return
+	}
+	for i, def := range l.blueprint.Info.Exports {
+		if !sources.references(def.Name) {
+			l.report(SeverityWarning, fmt.Sprintf("exports[%d]", i), "export %q is not set by any export execution", def.Name)
+		}
+	}
+}
+
+// lintRenderedExecutions renders the deploy and subinstallation executions with the given import values
+// and validates the rendered deploy items, e.g. their dependencies.
+// It returns the rendered installation templates and false if the executions have not been rendered.
+func (l *linter) lintRenderedExecutions() ([]*lsv1alpha1.InstallationTemplate, bool) {
+	if len(l.blueprint.Info.DeployExecutions) == 0 && len(l.blueprint.Info.SubinstallationExecutions) == 0 {
+		return nil, false
+	}
+	if l.opts.Imports == nil {
+		l.result.Add(Diagnostic{
+			Severity: SeverityInfo,
+			Message:  "the rendered deploy items and subinstallations are not checked, because no import values are given",
+		})
+		return nil, false
+	}
+
+	stateHandler := template.NewMemoryStateHandler()
+	formatter := template.NewTemplateInputFormatter(true)
+	tmpl := template.New(
+		gotemplate.New(stateHandler, nil).WithInputFormatter(formatter),
+		spiff.New(stateHandler, nil).WithInputFormatter(formatter),
+		cue.New(stateHandler).WithInputFormatter(formatter),
+		jsonnet.New(stateHandler, nil).WithInputFormatter(formatter))
+	installation := &lsv1alpha1.Installation{}
+	installation.Name = "lint"
+	opts := template.NewDeployExecutionOptions(template.NewBlueprintExecutionOptions(
+		installation, l.blueprint, l.opts.ComponentVersion, l.opts.ComponentVersions, l.opts.Imports))
+
+	if len(l.blueprint.Info.DeployExecutions) != 0 {
+		specs, err := tmpl.TemplateDeployExecutions(opts)
+		if err != nil {
+			l.report(SeverityError, "deployExecutions", "unable to render deploy executions: %s", err.Error())
+		} else {
+			deployItemTemplates := make(core.DeployItemTemplateList, len(specs))
+			for i, spec := range specs {
+				deployItemTemplates[i] = core.DeployItemTemplate{
+					Name:      spec.Name,
+					Type:      spec.Type,
+					DependsOn: spec.DependsOn,
+				}
+			}
+			for _, err := range validation.ValidateDeployItemTemplateList(field.NewPath("deployItems"), deployItemTemplates) {
+				if err.Type == field.ErrorTypeRequired && err.Field != "deployItems" {
+					// the rendered deploy items contain no configurations and targets
+					continue
+				}
+				l.result.Add(Diagnostic{
+					Severity: SeverityError,
+					File:     lsv1alpha1.BlueprintFileName,
+					Line:     l.locator.Line("deployExecutions"),
+					Field:    err.Field,
+					Message:  "rendered deploy items: " + err.ErrorBody(),
+				})
+			}
+		}
+	}
+
+	if len(l.blueprint.Info.SubinstallationExecutions) == 0 {
+		return nil, true
+	}
+	installationTemplates, err := tmpl.TemplateSubinstallationExecutions(opts)
+	if err != nil {
+		l.report(SeverityError, "subinstallationExecutions", "unable to render subinstallation executions: %s", err.Error())
+		return nil, true
+	}
+	return installationTemplates, true
+}
+
+// validateInstallationTemplates validates that all imports of the subinstallations are satisfied
+// by the imports of the blueprint or by the exports of their siblings.
+func (l *linter) validateInstallationTemplates(installationTemplates []*lsv1alpha1.InstallationTemplate) {
+	if len(installationTemplates) == 0 {
+		return
+	}
+	coreTemplates := make([]*core.InstallationTemplate, 0, len(installationTemplates))
+	for _, tmpl := range installationTemplates {
+		if tmpl == nil {
+			continue
+		}
+		coreTmpl := &core.InstallationTemplate{}
+		if err := lsv1alpha1.Convert_v1alpha1_InstallationTemplate_To_core_InstallationTemplate(tmpl, coreTmpl, nil); err != nil {
+			l.report(SeverityError, "subinstallations", "unable to convert installation template %q: %s", tmpl.Name, err.Error())
+			return
+		}
+		coreTemplates = append(coreTemplates, coreTmpl)
+	}
+
+	coreImports := make([]core.ImportDefinition, 0)
+	for _, def := range flattenImports(l.blueprint.Info.Imports, "") {
+		coreImport := core.ImportDefinition{}
+		if err := lsv1alpha1.Convert_v1alpha1_ImportDefinition_To_core_ImportDefinition(&def.ImportDefinition, &coreImport, nil); err != nil {
+			l.report(SeverityError, "imports", "unable to convert import %q: %s", def.Name, err.Error())
+			return
+		}
+		coreImports = append(coreImports, coreImport)
+	}
+
+	if errs := validation.ValidateInstallationTemplates(field.NewPath("subinstallations"), coreImports, coreTemplates); len(errs) != 0 {
+		l.reportFieldErrors(SeverityError, "subinstallations", errs.ToAggregate())
+	}
+}
+
+// importDefinition is an import definition with its field path in the blueprint.
+type importDefinition struct {
+	lsv1alpha1.ImportDefinition
+	fieldPath string
+	// conditional is true if the import is a conditional import of another import.
+	conditional bool
+}
+
+// flattenImports returns the import definitions and their nested conditional imports.
+func flattenImports(imports lsv1alpha1.ImportDefinitionList, fieldPath string) []importDefinition {
+	return appendImports(nil, imports, fieldPath, false)
+}
+
+func appendImports(res []importDefinition, imports lsv1alpha1.ImportDefinitionList, fieldPath string, conditional bool) []importDefinition {
+	for i, def := range imports {
+		defPath := fmt.Sprintf("%s[%d]", fieldPath, i)
+		res = append(res, importDefinition{
+			ImportDefinition: def,
+			fieldPath:        defPath,
+			conditional:      conditional,
+		})
+		res = appendImports(res, def.ConditionalImports, defPath+".imports", true)
+	}
+	return res
+}
+
+// isRequired returns whether an import must be satisfied.
+func isRequired(def lsv1alpha1.ImportDefinition) bool {
+	if def.Required != nil && !*def.Required {
+		return false
+	}
+	return len(def.Default.Value.RawMessage) == 0
+}
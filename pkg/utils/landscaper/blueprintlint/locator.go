@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintlint
+
+import (
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// sourceLocator finds the lines of fields in a yaml file.
+type sourceLocator struct {
+	root *yaml.Node
+}
+
+// newSourceLocator parses the given yaml file.
+// The locator returns no lines if the file cannot be parsed.
+func newSourceLocator(data []byte) *sourceLocator {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(data, doc); err != nil || len(doc.Content) == 0 {
+		return &sourceLocator{}
+	}
+	return &sourceLocator{root: doc.Content[0]}
+}
+
+// Line returns the line of the field with the given path, e.g. "subinstallations[0].imports.data[my-import]".
+// Indices in brackets select list elements, other keys in brackets or after a dot select map entries
+// or list elements with a matching name.
+// If the field does not exist, the line of its deepest existing parent is returned.
+func (l *sourceLocator) Line(path string) int {
+	if l.root == nil {
+		return 0
+	}
+	node, line := l.root, 0
+	for _, segment := range parseFieldPath(path) {
+		next, nextLine := child(node, segment)
+		if next == nil {
+			break
+		}
+		node, line = next, nextLine
+	}
+	return line
+}
+
+// child returns the child node of a map or list node with the given key and the line of the key.
+func child(node *yaml.Node, key pathSegment) (*yaml.Node, int) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key.value {
+				return node.Content[i+1], node.Content[i].Line
+			}
+		}
+	case yaml.SequenceNode:
+		if key.bracket {
+			if idx, err := strconv.Atoi(key.value); err == nil {
+				if idx >= 0 && idx < len(node.Content) {
+					return node.Content[idx], node.Content[idx].Line
+				}
+				return nil, 0
+			}
+		}
+		for _, elem := range node.Content {
+			if name, _ := child(elem, pathSegment{value: "name"}); name != nil && name.Value == key.value {
+				return elem, elem.Line
+			}
+		}
+	}
+	return nil, 0
+}
+
+// pathSegment is a segment of a field path.
+type pathSegment struct {
+	value string
+	// bracket is true if the segment is an index or key in brackets.
+	bracket bool
+}
+
+// parseFieldPath splits a field path like "imports[0].schema" into its segments.
+func parseFieldPath(path string) []pathSegment {
+	segments := make([]pathSegment, 0)
+	current := strings.Builder{}
+	flush := func() {
+		if current.Len() != 0 {
+			segments = append(segments, pathSegment{value: current.String()})
+			current.Reset()
+		}
+	}
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '.':
+			flush()
+		case '[':
+			flush()
+			end := strings.IndexByte(path[i:], ']')
+			if end == -1 {
+				current.WriteString(path[i+1:])
+				i = len(path)
+				continue
+			}
+			segments = append(segments, pathSegment{value: path[i+1 : i+end], bracket: true})
+			i += end
+		default:
+			current.WriteByte(path[i])
+		}
+	}
+	flush()
+	return segments
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintlint
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var (
+	// includeRegexp matches the includes of go templates.
+	includeRegexp = regexp.MustCompile(`include\s+"([^"]+)"`)
+	// dynamicAccessRegexp matches templates that access the imports or exports as a whole,
+	// e.g. "{{ toYaml .imports }}" or "(( imports ))", so that the referenced names cannot be determined.
+	dynamicAccessRegexp = regexp.MustCompile(`\.?\b(imports|values)\s*(\}\}|\)|\||$)`)
+)
+
+// templateSources contains the sources of the templates of one kind of executions
+// and of the templates they include.
+type templateSources struct {
+	sources []string
+}
+
+// add adds the source of a template and all templates it includes.
+func (t *templateSources) add(fs vfs.FileSystem, source string, visited map[string]bool) {
+	t.sources = append(t.sources, source)
+	for _, match := range includeRegexp.FindAllStringSubmatch(source, -1) {
+		file := match[1]
+		if visited[file] {
+			continue
+		}
+		visited[file] = true
+		data, err := vfs.ReadFile(fs, file)
+		if err != nil {
+			continue
+		}
+		t.add(fs, string(data), visited)
+	}
+}
+
+// references returns whether a source references the given name.
+func (t *templateSources) references(name string) bool {
+	nameRegexp := regexp.MustCompile(`(^|[^A-Za-z0-9_-])` + regexp.QuoteMeta(name) + `([^A-Za-z0-9_-]|$)`)
+	for _, source := range t.sources {
+		if nameRegexp.MatchString(source) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDynamicAccess returns whether a source accesses the imports or exports as a whole.
+func (t *templateSources) hasDynamicAccess() bool {
+	for _, source := range t.sources {
+		if dynamicAccessRegexp.MatchString(source) {
+			return true
+		}
+	}
+	return false
+}
+
+// executorSource returns the source of a template executor.
+// Inline go templates are json strings, all other inline templates are returned as json.
+func executorSource(fs vfs.FileSystem, exec lsv1alpha1.TemplateExecutor) (string, error) {
+	if len(exec.Template.RawMessage) != 0 {
+		var source string
+		if err := json.Unmarshal(exec.Template.RawMessage, &source); err == nil {
+			return source, nil
+		}
+		return string(exec.Template.RawMessage), nil
+	}
+	if len(exec.File) != 0 {
+		data, err := vfs.ReadFile(fs, exec.File)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/pkg/utils/landscaper/blueprinttest"
+)
+
+var _ = Describe("Blueprint Tests", func() {
+
+	const blueprintDir = "./testdata/blueprint"
+
+	var (
+		ctx      context.Context
+		testCase *blueprinttest.TestCase
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		testCases, err := blueprinttest.LoadTestCases(filepath.Join(blueprintDir, "tests"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testCases).To(HaveLen(1))
+		testCase = testCases[0]
+		Expect(testCase.Name).To(Equal("default"))
+	})
+
+	It("should render the deploy items, subinstallations and exports of a blueprint", func() {
+		result, err := blueprinttest.NewRunner(blueprintDir, false).Render(ctx, testCase)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(result.DeployItems).To(HaveKeyWithValue("root/app", HaveKeyWithValue("config", HaveKeyWithValue("replicas", BeNumerically("==", 3)))))
+		Expect(result.Subinstallations).To(HaveKey("root/echo"))
+		Expect(result.Subinstallations).ToNot(HaveKey("root"))
+		Expect(result.Exports).To(HaveKeyWithValue("root", map[string]interface{}{
+			"url":  "https://app.example.com",
+			"echo": "echo 3",
+		}))
+		Expect(result.Exports).To(HaveKeyWithValue("root/echo", map[string]interface{}{
+			"message": "echo 3",
+		}))
+	})
+
+	It("should succeed if the rendered result matches the golden files", func() {
+		res := blueprinttest.NewRunner(blueprintDir, false).Run(ctx, testCase)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Diffs).To(BeEmpty())
+		Expect(res.Failed()).To(BeFalse())
+	})
+
+	It("should write the golden files in update mode and report a diff if they do not match", func() {
+		testCase.Dir = GinkgoT().TempDir()
+		testCase.Component.Path, _ = filepath.Abs(filepath.Join(blueprintDir, "tests", "default", testCase.Component.Path))
+
+		res := blueprinttest.NewRunner(blueprintDir, false).Run(ctx, testCase)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Failed()).To(BeTrue())
+		Expect(res.Diffs).To(HaveLen(3))
+
+		res = blueprinttest.NewRunner(blueprintDir, true).Run(ctx, testCase)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(HaveLen(3))
+
+		res = blueprinttest.NewRunner(blueprintDir, false).Run(ctx, testCase)
+		Expect(res.Failed()).To(BeFalse())
+
+		goldenFile := filepath.Join(testCase.Dir, blueprinttest.GoldenDirName, blueprinttest.ExportsGoldenFile)
+		Expect(os.WriteFile(goldenFile, []byte("root:\n  url: https://other.example.com\n"), 0644)).To(Succeed())
+		res = blueprinttest.NewRunner(blueprintDir, false).Run(ctx, testCase)
+		Expect(res.Failed()).To(BeTrue())
+		Expect(res.Diffs).To(HaveKeyWithValue(goldenFile, Equal("@@ -1,2 +1,5 @@\n root:\n-  url: https://other.example.com\n+  echo: echo 3\n+  url: https://app.example.com\n+root/echo:\n+  message: echo 3\n")))
+	})
+
+	It("should return a unified diff", func() {
+		Expect(blueprinttest.Diff("a\nb\nc\n", "a\nb\nc\n")).To(BeEmpty())
+		Expect(blueprinttest.Diff("a\nb\nc\n", "a\nx\nc\nd\n")).To(Equal("@@ -1,3 +1,4 @@\n a\n-b\n+x\n c\n+d\n"))
+		Expect(blueprinttest.Diff("", "a\n")).To(Equal("@@ -0,0 +1 @@\n+a\n"))
+		Expect(blueprinttest.Diff("a\n", "")).To(Equal("@@ -1 +0,0 @@\n-a\n"))
+	})
+
+	It("should only show the context lines around the changes of a diff", func() {
+		expected := []string{}
+		for i := 1; i <= 20; i++ {
+			expected = append(expected, fmt.Sprintf("l%d", i))
+		}
+		actual := append([]string{}, expected...)
+		actual[1] = "x"
+		actual[17] = "y"
+
+		Expect(blueprinttest.Diff(strings.Join(expected, "\n")+"\n", strings.Join(actual, "\n")+"\n")).To(Equal(
+			"@@ -1,5 +1,5 @@\n l1\n-l2\n+x\n l3\n l4\n l5\n" +
+				"@@ -15,6 +15,6 @@\n l15\n l16\n l17\n-l18\n+y\n l19\n l20\n"))
+
+		// changes that are separated by less than twice the context lines are shown in one hunk
+		actual[17] = "l18"
+		actual[7] = "y"
+		Expect(blueprinttest.Diff(strings.Join(expected, "\n")+"\n", strings.Join(actual, "\n")+"\n")).To(Equal(
+			"@@ -1,11 +1,11 @@\n l1\n-l2\n+x\n l3\n l4\n l5\n l6\n l7\n-l8\n+y\n l9\n l10\n l11\n"))
+	})
+
+})
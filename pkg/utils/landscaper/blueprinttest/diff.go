@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiffContextLines is the number of unchanged lines that are shown around the changes of a diff.
+const DiffContextLines = 3
+
+// diffLine is a line of a diff.
+// Its kind is '-' for removed lines, '+' for added lines and ' ' for unchanged lines.
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// Diff returns a unified diff of the expected and the actual text without file headers.
+// Every hunk starts with a "@@ -l,s +l,s @@" header and contains the changed lines
+// together with DiffContextLines unchanged lines before and after them.
+// Removed lines are prefixed with "-", added lines with "+" and unchanged lines with a space.
+// An empty string is returned if both texts are equal.
+func Diff(expected, actual string) string {
+	if expected == actual {
+		return ""
+	}
+	lines := diffLines(splitLines(expected), splitLines(actual))
+
+	// expectedPos[i] and actualPos[i] are the number of expected and actual lines before lines[i]
+	expectedPos := make([]int, len(lines)+1)
+	actualPos := make([]int, len(lines)+1)
+	for i, line := range lines {
+		expectedPos[i+1], actualPos[i+1] = expectedPos[i], actualPos[i]
+		if line.kind != '+' {
+			expectedPos[i+1]++
+		}
+		if line.kind != '-' {
+			actualPos[i+1]++
+		}
+	}
+
+	var sb strings.Builder
+	for start := 0; start < len(lines); {
+		first := start
+		for first < len(lines) && lines[first].kind == ' ' {
+			first++
+		}
+		if first == len(lines) {
+			break
+		}
+
+		// changes that are separated by less than twice the context are combined into one hunk
+		end := first
+		for {
+			for end < len(lines) && lines[end].kind != ' ' {
+				end++
+			}
+			next := end
+			for next < len(lines) && lines[next].kind == ' ' {
+				next++
+			}
+			if next == len(lines) || next-end > 2*DiffContextLines {
+				break
+			}
+			end = next
+		}
+
+		hunkStart := max(first-DiffContextLines, start)
+		hunkEnd := min(end+DiffContextLines, len(lines))
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n",
+			formatRange(expectedPos[hunkStart], expectedPos[hunkEnd]),
+			formatRange(actualPos[hunkStart], actualPos[hunkEnd]))
+		for _, line := range lines[hunkStart:hunkEnd] {
+			sb.WriteByte(line.kind)
+			sb.WriteString(line.text)
+			sb.WriteByte('\n')
+		}
+		start = hunkEnd
+	}
+	return sb.String()
+}
+
+// diffLines returns the lines of a minimal diff of a and b.
+func diffLines(a, b []string) []diffLine {
+	// common prefixes and suffixes are not part of the (quadratic) longest common subsequence computation
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	lines := make([]diffLine, 0, len(a)+len(b))
+	for _, text := range a[:prefix] {
+		lines = append(lines, diffLine{kind: ' ', text: text})
+	}
+
+	ma := a[prefix : len(a)-suffix]
+	mb := b[prefix : len(b)-suffix]
+	// lcs[i][j] is the length of the longest common subsequence of ma[i:] and mb[j:]
+	lcs := make([][]int, len(ma)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(mb)+1)
+	}
+	for i := len(ma) - 1; i >= 0; i-- {
+		for j := len(mb) - 1; j >= 0; j-- {
+			if ma[i] == mb[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < len(ma) || j < len(mb) {
+		switch {
+		case i < len(ma) && j < len(mb) && ma[i] == mb[j]:
+			lines = append(lines, diffLine{kind: ' ', text: ma[i]})
+			i++
+			j++
+		case i < len(ma) && (j == len(mb) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{kind: '-', text: ma[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{kind: '+', text: mb[j]})
+			j++
+		}
+	}
+
+	for _, text := range a[len(a)-suffix:] {
+		lines = append(lines, diffLine{kind: ' ', text: text})
+	}
+	return lines
+}
+
+// formatRange formats the lines (from, to] of a hunk header like GNU diff.
+// The line numbers start at 1, a range of one line is written without its length
+// and an empty range refers to the line before it.
+func formatRange(from, to int) string {
+	switch to - from {
+	case 0:
+		return fmt.Sprintf("%d,0", from)
+	case 1:
+		return fmt.Sprintf("%d", from+1)
+	default:
+		return fmt.Sprintf("%d,%d", from+1, to-from)
+	}
+}
+
+func splitLines(text string) []string {
+	if len(text) == 0 {
+		return []string{}
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
+)
+
+const (
+	// DeployItemsGoldenFile is the golden file that contains the rendered deploy items by their path.
+	DeployItemsGoldenFile = "deployitems.yaml"
+	// SubinstallationsGoldenFile is the golden file that contains the rendered subinstallations by their path.
+	SubinstallationsGoldenFile = "subinstallations.yaml"
+	// ExportsGoldenFile is the golden file that contains the exports of all installations by their path.
+	ExportsGoldenFile = "exports.yaml"
+)
+
+// Result contains the rendered objects of a test case.
+type Result struct {
+	// DeployItems contains the specs of the rendered deploy items by their installation path and name.
+	DeployItems map[string]interface{}
+	// Subinstallations contains the specs of the rendered subinstallations by their installation path.
+	Subinstallations map[string]interface{}
+	// Exports contains the exports of the blueprint and of all subinstallations by their installation path.
+	Exports map[string]interface{}
+}
+
+// Files returns the golden file representation of the result.
+func (r *Result) Files() (map[string][]byte, error) {
+	files := map[string][]byte{}
+	for name, obj := range map[string]map[string]interface{}{
+		DeployItemsGoldenFile:      r.DeployItems,
+		SubinstallationsGoldenFile: r.Subinstallations,
+		ExportsGoldenFile:          r.Exports,
+	} {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal %s: %w", name, err)
+		}
+		files[name] = data
+	}
+	return files, nil
+}
+
+// TestResult describes the outcome of a test case.
+type TestResult struct {
+	// TestCase is the executed test case.
+	TestCase *TestCase
+	// Err is set if the test case could not be executed.
+	Err error
+	// Diffs contains a readable diff for every golden file that does not match the rendered result.
+	Diffs map[string]string
+	// Updated lists the golden files that have been written in update mode.
+	Updated []string
+}
+
+// Failed returns whether the test case has failed.
+func (r *TestResult) Failed() bool {
+	return r.Err != nil || len(r.Diffs) != 0
+}
+
+// Runner executes the test cases of a blueprint.
+type Runner struct {
+	blueprintDir string
+	update       bool
+}
+
+// NewRunner creates a new runner for the blueprint in the given directory.
+// If update is true, the golden files are overwritten with the rendered results instead of being compared.
+func NewRunner(blueprintDir string, update bool) *Runner {
+	return &Runner{
+		blueprintDir: blueprintDir,
+		update:       update,
+	}
+}
+
+// Run executes the given test case and compares the result with the golden files of the test case.
+func (r *Runner) Run(ctx context.Context, testCase *TestCase) *TestResult {
+	testResult := &TestResult{TestCase: testCase}
+	result, err := r.Render(ctx, testCase)
+	if err != nil {
+		testResult.Err = err
+		return testResult
+	}
+	files, err := result.Files()
+	if err != nil {
+		testResult.Err = err
+		return testResult
+	}
+
+	goldenDir := filepath.Join(testCase.Dir, GoldenDirName)
+	for _, name := range []string{DeployItemsGoldenFile, SubinstallationsGoldenFile, ExportsGoldenFile} {
+		goldenFile := filepath.Join(goldenDir, name)
+		if r.update {
+			if err := os.MkdirAll(goldenDir, os.ModePerm); err != nil {
+				testResult.Err = err
+				return testResult
+			}
+			if err := os.WriteFile(goldenFile, files[name], 0644); err != nil {
+				testResult.Err = fmt.Errorf("unable to write golden file %q: %w", goldenFile, err)
+				return testResult
+			}
+			testResult.Updated = append(testResult.Updated, goldenFile)
+			continue
+		}
+
+		expected, err := os.ReadFile(goldenFile)
+		if err != nil && !os.IsNotExist(err) {
+			testResult.Err = fmt.Errorf("unable to read golden file %q: %w", goldenFile, err)
+			return testResult
+		}
+		if !bytes.Equal(expected, files[name]) {
+			if testResult.Diffs == nil {
+				testResult.Diffs = map[string]string{}
+			}
+			testResult.Diffs[goldenFile] = Diff(string(expected), string(files[name]))
+		}
+	}
+	return testResult
+}
+
+// Render simulates the blueprint with the inputs of the test case and returns the rendered objects.
+func (r *Runner) Render(ctx context.Context, testCase *TestCase) (*Result, error) {
+	blueprintFs, err := projectionfs.New(osfs.New(), r.blueprintDir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read blueprint directory %q: %w", r.blueprintDir, err)
+	}
+	blueprint, err := blueprints.NewFromFs(blueprintFs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read blueprint: %w", err)
+	}
+
+	componentVersion, componentVersions, registryAccess, repositoryContext, err := testCase.resolveComponents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	simulator, err := lsutils.NewInstallationSimulator(componentVersions, registryAccess, repositoryContext, testCase.Exports)
+	if err != nil {
+		return nil, err
+	}
+	callbacks := &resultCallbacks{
+		result: &Result{
+			DeployItems:      map[string]interface{}{},
+			Subinstallations: map[string]interface{}{},
+			Exports:          map[string]interface{}{},
+		},
+	}
+	simulator.SetCallbacks(callbacks)
+
+	if _, err := simulator.Run(componentVersion, blueprint, testCase.Imports); err != nil {
+		return nil, err
+	}
+	if callbacks.err != nil {
+		return nil, callbacks.err
+	}
+	return callbacks.result, nil
+}
+
+// resultCallbacks collects the rendered objects of a simulation.
+type resultCallbacks struct {
+	result *Result
+	err    error
+}
+
+var _ lsutils.InstallationSimulatorCallbacks = &resultCallbacks{}
+
+func (c *resultCallbacks) OnInstallation(installationPath string, installation *lsv1alpha1.Installation) {
+	// the root installation is generated by the simulator and therefore not part of the result.
+	if !strings.Contains(installationPath, "/") {
+		return
+	}
+	c.result.Subinstallations[installationPath] = c.encode(installation.Spec)
+}
+
+func (c *resultCallbacks) OnInstallationTemplateState(_ string, _ map[string][]byte) {}
+
+func (c *resultCallbacks) OnImports(_ string, _ map[string]interface{}) {}
+
+func (c *resultCallbacks) OnDeployItem(installationPath string, deployItem *lsv1alpha1.DeployItem) {
+	c.result.DeployItems[path.Join(installationPath, deployItem.Name)] = c.encode(deployItem.Spec)
+}
+
+func (c *resultCallbacks) OnDeployItemTemplateState(_ string, _ map[string][]byte) {}
+
+func (c *resultCallbacks) OnExports(installationPath string, exports map[string]interface{}) {
+	c.result.Exports[installationPath] = c.encode(exports)
+}
+
+// encode converts an object into its generic json representation so that it is marshaled
+// the same way as it is read from a golden file.
+func (c *resultCallbacks) encode(obj interface{}) interface{} {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		c.err = err
+		return nil
+	}
+	var res interface{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		c.err = err
+		return nil
+	}
+	return res
+}
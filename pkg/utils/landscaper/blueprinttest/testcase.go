@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
+)
+
+const (
+	// TestCaseFileName is the name of the file that defines a test case.
+	// Every directory of the test directory that contains such a file is a test case.
+	TestCaseFileName = "testcase.yaml"
+	// GoldenDirName is the name of the directory of a test case that contains its golden files.
+	GoldenDirName = "golden"
+)
+
+// TestCase defines the input of a blueprint test.
+type TestCase struct {
+	// Name is the name of the test case, which is the name of its directory.
+	Name string `json:"-"`
+	// Dir is the directory of the test case.
+	Dir string `json:"-"`
+
+	// Imports contains the data and target imports of the blueprint.
+	// +optional
+	Imports map[string]interface{} `json:"imports,omitempty"`
+	// Component defines the mocked component descriptors of the blueprint.
+	// +optional
+	Component *ComponentMock `json:"component,omitempty"`
+	// Exports contains templates that mock the exports of deploy items and subinstallations.
+	// +optional
+	Exports lsutils.ExportTemplates `json:"exports,omitempty"`
+}
+
+// ComponentMock defines mocked component descriptors that are read from a local directory.
+type ComponentMock struct {
+	// Path is the directory of the component descriptors.
+	// A relative path is resolved against the test case directory.
+	// The directory has the same structure as a local registry,
+	// so that the blobs of referenced blueprints are read from the "blobs" subdirectory.
+	Path string `json:"path"`
+	// Name is the name of the component that contains the tested blueprint.
+	Name string `json:"name"`
+	// Version is the version of the component that contains the tested blueprint.
+	Version string `json:"version"`
+}
+
+// LoadTestCases reads all test cases of the given test directory sorted by their name.
+func LoadTestCases(testDir string) ([]*TestCase, error) {
+	entries, err := os.ReadDir(testDir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read test directory %q: %w", testDir, err)
+	}
+
+	testCases := make([]*TestCase, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(testDir, entry.Name())
+		if _, err := os.Stat(filepath.Join(dir, TestCaseFileName)); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		testCase, err := LoadTestCase(dir)
+		if err != nil {
+			return nil, err
+		}
+		testCases = append(testCases, testCase)
+	}
+
+	sort.Slice(testCases, func(i, j int) bool {
+		return testCases[i].Name < testCases[j].Name
+	})
+	return testCases, nil
+}
+
+// LoadTestCase reads the test case of the given directory.
+func LoadTestCase(dir string) (*TestCase, error) {
+	data, err := os.ReadFile(filepath.Join(dir, TestCaseFileName))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read test case %q: %w", dir, err)
+	}
+	testCase := &TestCase{}
+	if err := yaml.Unmarshal(data, testCase); err != nil {
+		return nil, fmt.Errorf("unable to decode test case %q: %w", dir, err)
+	}
+	testCase.Name = filepath.Base(dir)
+	testCase.Dir = dir
+	if testCase.Imports == nil {
+		testCase.Imports = map[string]interface{}{}
+	}
+	return testCase, nil
+}
+
+// resolveComponents resolves the mocked component version of the test case and all component versions it references.
+// Nil is returned if the test case defines no component.
+func (tc *TestCase) resolveComponents(ctx context.Context) (model.ComponentVersion, *model.ComponentVersionList, model.RegistryAccess, *types.UnstructuredTypedObject, error) {
+	if tc.Component == nil {
+		return nil, &model.ComponentVersionList{}, nil, nil, nil
+	}
+	return tc.Component.Resolve(ctx, tc.Dir)
+}
+
+// Resolve resolves the mocked component version and all component versions it references.
+// A relative path of the component descriptors is resolved against the given base directory.
+// It returns the repository context that resolves the blueprints of subinstallations relative to the component descriptors.
+func (m *ComponentMock) Resolve(ctx context.Context, baseDir string) (model.ComponentVersion, *model.ComponentVersionList, model.RegistryAccess, *types.UnstructuredTypedObject, error) {
+	rootPath := m.Path
+	if !filepath.IsAbs(rootPath) {
+		rootPath = filepath.Join(baseDir, rootPath)
+	}
+	localRegistryConfig := &config.LocalRegistryConfiguration{RootPath: rootPath}
+	registryAccess, err := registries.GetFactory().NewRegistryAccess(ctx, nil, nil, nil, localRegistryConfig, nil, nil)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("unable to create local registry: %w", err)
+	}
+
+	repositoryContext := &types.UnstructuredTypedObject{}
+	if err := repositoryContext.UnmarshalJSON([]byte(`{"type":"local"}`)); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	componentVersion, err := registryAccess.GetComponentVersion(ctx, &lsv1alpha1.ComponentDescriptorReference{
+		RepositoryContext: repositoryContext,
+		ComponentName:     m.Name,
+		Version:           m.Version,
+	})
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("unable to get component %s:%s: %w", m.Name, m.Version, err)
+	}
+
+	componentVersions, err := model.GetTransitiveComponentReferences(ctx, componentVersion, repositoryContext, nil)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("unable to resolve component references: %w", err)
+	}
+
+	// the blueprints of subinstallations are resolved relative to the base url of the repository context
+	repositoryContext.Raw, err = json.Marshal(&cdv2.OCIRegistryRepository{
+		ObjectType: cdv2.ObjectType{Type: repositoryContext.GetType()},
+		BaseURL:    localRegistryConfig.RootPath,
+	})
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return componentVersion, componentVersions, registryAccess, repositoryContext, nil
+}
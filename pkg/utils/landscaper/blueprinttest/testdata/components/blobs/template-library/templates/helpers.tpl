@@ -0,0 +1,3 @@
+{{- define "helpers.mockType" -}}
+landscaper.gardener.cloud/mock
+{{- end -}}